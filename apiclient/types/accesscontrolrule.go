@@ -8,6 +8,8 @@ type AccessControlRule struct {
 	PowerUserWorkspaceID      string `json:"powerUserWorkspaceID,omitempty"`
 	PowerUserID               string `json:"powerUserID,omitempty"`
 	Generated                 bool   `json:"generated,omitempty"`
+	MCPAccessRequestID        string `json:"mcpAccessRequestID,omitempty"`
+	ExpiresAt                 *Time  `json:"expiresAt,omitempty"`
 	AccessControlRuleManifest `json:",inline"`
 }
 
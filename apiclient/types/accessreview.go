@@ -0,0 +1,31 @@
+package types
+
+// EffectiveGrant describes a single way that a subject is granted access to an MCP server or catalog entry.
+type EffectiveGrant struct {
+	Subject              Subject     `json:"subject"`
+	Resource             Resource    `json:"resource"`
+	Source               GrantSource `json:"source"`
+	MCPCatalogID         string      `json:"mcpCatalogID,omitempty"`
+	PowerUserWorkspaceID string      `json:"powerUserWorkspaceID,omitempty"`
+	// AccessControlRuleID and AccessControlRuleName are set when Source is GrantSourceAccessControlRule.
+	AccessControlRuleID   string `json:"accessControlRuleID,omitempty"`
+	AccessControlRuleName string `json:"accessControlRuleName,omitempty"`
+	// MCPAccessRequestID is set when the rule was generated by an approved access request.
+	MCPAccessRequestID string `json:"mcpAccessRequestID,omitempty"`
+	ExpiresAt          *Time  `json:"expiresAt,omitempty"`
+}
+
+type EffectiveGrantList List[EffectiveGrant]
+
+type GrantSource string
+
+const (
+	// GrantSourceAccessControlRule means the grant comes from a subject and resource on an access control rule.
+	GrantSourceAccessControlRule GrantSource = "accessControlRule"
+	// GrantSourceRole means the grant comes from the user's role. Admins and owners can access everything.
+	GrantSourceRole GrantSource = "role"
+	// GrantSourceWorkspaceOwner means the grant comes from owning the workspace that contains the resource.
+	GrantSourceWorkspaceOwner GrantSource = "workspaceOwner"
+	// GrantSourceOwner means the grant comes from owning the MCP server itself.
+	GrantSourceOwner GrantSource = "owner"
+)
@@ -1,8 +1,11 @@
 package types
 
+import "fmt"
+
 // AuditLogExportCreateRequest represents a request to create an audit log export
 type AuditLogExportCreateRequest struct {
 	Name      string                `json:"name"`
+	Type      AuditLogExportType    `json:"type,omitempty"`
 	StartTime Time                  `json:"startTime"`
 	EndTime   Time                  `json:"endTime"`
 	Filters   AuditLogExportFilters `json:"filters,omitempty"`
@@ -14,6 +17,7 @@ type AuditLogExportCreateRequest struct {
 type AuditLogExportResponse struct {
 	ID              string                `json:"id"`
 	Name            string                `json:"name"`
+	Type            AuditLogExportType    `json:"type,omitempty"`
 	StorageProvider StorageProviderType   `json:"storageProvider"`
 	Bucket          string                `json:"bucket,omitempty"`
 	KeyPrefix       string                `json:"keyPrefix,omitempty"`
@@ -38,6 +42,7 @@ type AuditLogExportListResponse struct {
 // ScheduledAuditLogExportCreateRequest represents a request to create a scheduled audit log export
 type ScheduledAuditLogExportCreateRequest struct {
 	Name                  string                `json:"name"`
+	Type                  AuditLogExportType    `json:"type,omitempty"`
 	Bucket                string                `json:"bucket,omitempty"`
 	KeyPrefix             string                `json:"keyPrefix,omitempty"`
 	Schedule              Schedule              `json:"schedule"`
@@ -62,6 +67,7 @@ type ScheduledAuditLogExportResponse struct {
 	Bucket                string                `json:"bucket"`
 	KeyPrefix             string                `json:"keyPrefix"`
 	Name                  string                `json:"name"`
+	Type                  AuditLogExportType    `json:"type,omitempty"`
 	Enabled               bool                  `json:"enabled"`
 	Schedule              Schedule              `json:"schedule"`
 	RetentionPeriodInDays int                   `json:"retentionPeriodInDays,omitempty"`
@@ -89,6 +95,10 @@ type AuditLogExportFilters struct {
 	ResponseStatuses           []string `json:"responseStatuses,omitempty"`
 	ClientIPs                  []string `json:"clientIPs,omitempty"`
 	Query                      string   `json:"query,omitempty"`
+	// Search is a query in the audit log query language. It is combined with the query of the saved search, if set.
+	Search string `json:"search,omitempty"`
+	// SavedSearchID is the ID of a saved search. Its query is read each time the export runs.
+	SavedSearchID string `json:"savedSearchID,omitempty"`
 }
 
 // StorageCredentialsTestRequest represents a request to test storage credentials
@@ -109,6 +119,24 @@ type StorageCredentialsResponse struct {
 	StorageConfig
 }
 
+// AuditLogExportType is the kind of data an export contains.
+type AuditLogExportType string
+
+const (
+	// AuditLogExportTypeAuditLogs exports the MCP audit logs in the export's time range. This is the default.
+	AuditLogExportTypeAuditLogs AuditLogExportType = "auditLogs"
+	// AuditLogExportTypeAccessReview exports a snapshot of every user's effective access to MCP servers and catalog entries.
+	AuditLogExportTypeAccessReview AuditLogExportType = "accessReview"
+)
+
+func (t AuditLogExportType) Validate() error {
+	switch t {
+	case "", AuditLogExportTypeAuditLogs, AuditLogExportTypeAccessReview:
+		return nil
+	}
+	return fmt.Errorf("invalid export type: %s", t)
+}
+
 type AuditLogExportState string
 
 const (
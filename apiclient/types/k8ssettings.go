@@ -16,3 +16,51 @@ type K8sSettings struct {
 
 	Metadata Metadata `json:"metadata,omitempty"`
 }
+
+// K8sOverrides are Kubernetes settings for a single MCP server. They are merged over the global K8s settings,
+// so that one server can get what it needs without changing every other server.
+type K8sOverrides struct {
+	// Resources overrides the CPU and memory requests and limits of the MCP server container.
+	Resources *K8sResourceOverrides `json:"resources,omitempty"`
+
+	// NodeSelector is added to the node selector of the MCP server pod.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// PriorityClassName is the priority class of the MCP server pod.
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// ServiceAccountName is the service account that the MCP server pod runs as, for example to use workload identity.
+	// Only admins can set this.
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// Volumes are extra volumes mounted in the MCP server container.
+	Volumes []K8sVolume `json:"volumes,omitempty"`
+}
+
+// K8sResourceOverrides are Kubernetes resource quantities, for example "500m" or "1Gi".
+// Any that are not set come from the global K8s settings.
+type K8sResourceOverrides struct {
+	CPURequest    string `json:"cpuRequest,omitempty"`
+	CPULimit      string `json:"cpuLimit,omitempty"`
+	MemoryRequest string `json:"memoryRequest,omitempty"`
+	MemoryLimit   string `json:"memoryLimit,omitempty"`
+}
+
+// K8sVolume is an extra volume for an MCP server. Exactly one of ConfigMap, Secret, and EmptyDir must be set.
+type K8sVolume struct {
+	Name      string `json:"name"`
+	MountPath string `json:"mountPath"`
+	ReadOnly  bool   `json:"readOnly,omitempty"`
+
+	// ConfigMap is the name of a config map in the MCP namespace.
+	ConfigMap string `json:"configMap,omitempty"`
+	// Secret is the name of a secret in the MCP namespace.
+	Secret string `json:"secret,omitempty"`
+	// EmptyDir is a scratch volume that is deleted with the pod.
+	EmptyDir *K8sEmptyDirVolume `json:"emptyDir,omitempty"`
+}
+
+type K8sEmptyDirVolume struct {
+	// SizeLimit is the most space the volume can use, for example "1Gi".
+	SizeLimit string `json:"sizeLimit,omitempty"`
+}
@@ -0,0 +1,67 @@
+package types
+
+import "fmt"
+
+type MCPAccessRequest struct {
+	Metadata                 `json:",inline"`
+	MCPAccessRequestManifest `json:",inline"`
+	UserID                   string                `json:"userID,omitempty"`
+	MCPCatalogID             string                `json:"mcpCatalogID,omitempty"`
+	PowerUserWorkspaceID     string                `json:"powerUserWorkspaceID,omitempty"`
+	State                    MCPAccessRequestState `json:"state,omitempty"`
+	DecidedBy                string                `json:"decidedBy,omitempty"`
+	DecisionReason           string                `json:"decisionReason,omitempty"`
+	DecidedAt                *Time                 `json:"decidedAt,omitempty"`
+	ExpiresAt                *Time                 `json:"expiresAt,omitempty"`
+	AccessControlRuleID      string                `json:"accessControlRuleID,omitempty"`
+}
+
+type MCPAccessRequestManifest struct {
+	// Resource is the catalog entry or multi-user MCP server the user is requesting access to.
+	Resource Resource `json:"resource"`
+	// Reason is the justification provided by the requesting user.
+	Reason string `json:"reason,omitempty"`
+	// Duration is how long the user would like access for, for example "8h" or "7d".
+	// If empty, the approver's duration or the default duration is used.
+	Duration string `json:"duration,omitempty"`
+}
+
+func (m MCPAccessRequestManifest) Validate() error {
+	switch m.Resource.Type {
+	case ResourceTypeMCPServerCatalogEntry, ResourceTypeMCPServer:
+		if m.Resource.ID == "" {
+			return fmt.Errorf("resource ID is required")
+		}
+	default:
+		return fmt.Errorf("access can only be requested for %s or %s resources", ResourceTypeMCPServerCatalogEntry, ResourceTypeMCPServer)
+	}
+	return nil
+}
+
+// MCPAccessRequestDecision is the body of an approve, deny, or revoke call.
+type MCPAccessRequestDecision struct {
+	Reason string `json:"reason,omitempty"`
+	// Duration overrides the requested duration when approving.
+	Duration string `json:"duration,omitempty"`
+}
+
+type MCPAccessRequestState string
+
+const (
+	MCPAccessRequestStatePending  MCPAccessRequestState = "pending"
+	MCPAccessRequestStateApproved MCPAccessRequestState = "approved"
+	MCPAccessRequestStateDenied   MCPAccessRequestState = "denied"
+	MCPAccessRequestStateRevoked  MCPAccessRequestState = "revoked"
+	MCPAccessRequestStateExpired  MCPAccessRequestState = "expired"
+)
+
+// IsFinal returns true if the request can no longer change state.
+func (s MCPAccessRequestState) IsFinal() bool {
+	switch s {
+	case MCPAccessRequestStateDenied, MCPAccessRequestStateRevoked, MCPAccessRequestStateExpired:
+		return true
+	}
+	return false
+}
+
+type MCPAccessRequestList List[MCPAccessRequest]
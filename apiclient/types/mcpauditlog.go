@@ -28,6 +28,16 @@ type MCPAuditLog struct {
 	UserAgent                 string          `json:"userAgent,omitempty"`
 	RequestHeaders            json.RawMessage `json:"requestHeaders,omitempty"`
 	ResponseHeaders           json.RawMessage `json:"responseHeaders,omitempty"`
+	// Redacted is true if sensitive values were removed from the bodies, headers, or error before the log was stored.
+	Redacted bool `json:"redacted,omitempty"`
+	// ApprovalID is the approval of calls that needed approval, and ApprovalState and ApprovalDecidedBy the decision on them.
+	ApprovalID        string                   `json:"approvalID,omitempty"`
+	ApprovalState     MCPToolCallApprovalState `json:"approvalState,omitempty"`
+	ApprovalDecidedBy string                   `json:"approvalDecidedBy,omitempty"`
+	// InjectionScanFindings is what the injection scanner flagged in the response, and InjectionScanAction what was done
+	// about it.
+	InjectionScanFindings []MCPInjectionScanFinding `json:"injectionScanFindings,omitempty"`
+	InjectionScanAction   MCPInjectionScanAction    `json:"injectionScanAction,omitempty"`
 }
 
 type MCPAuditLogResponse struct {
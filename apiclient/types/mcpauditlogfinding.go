@@ -0,0 +1,69 @@
+package types
+
+// MCPAuditLogFinding is unusual MCP activity that the anomaly detector found by comparing audit logs to the baselines
+// it keeps for each user and MCP server.
+type MCPAuditLogFinding struct {
+	ID        uint                       `json:"id"`
+	CreatedAt Time                       `json:"createdAt"`
+	Type      MCPAuditLogFindingType     `json:"type"`
+	Severity  MCPAuditLogFindingSeverity `json:"severity"`
+	// Description explains what was unusual, compared to the baseline.
+	Description          string `json:"description"`
+	UserID               string `json:"userID,omitempty"`
+	MCPID                string `json:"mcpID,omitempty"`
+	MCPServerDisplayName string `json:"mcpServerDisplayName,omitempty"`
+	ClientIP             string `json:"clientIP,omitempty"`
+	CallIdentifier       string `json:"callIdentifier,omitempty"`
+	// AuditLogID is the audit log that raised the finding.
+	AuditLogID uint `json:"auditLogID"`
+	// Count is the number of calls that were seen in the hour of the finding, for findings about volume or error rates.
+	Count int `json:"count,omitempty"`
+	// Baseline is the expected value of Count, for findings about volume or error rates.
+	Baseline float64 `json:"baseline,omitempty"`
+
+	AcknowledgedAt   *Time  `json:"acknowledgedAt,omitempty"`
+	AcknowledgedBy   string `json:"acknowledgedBy,omitempty"`
+	AcknowledgedNote string `json:"acknowledgedNote,omitempty"`
+}
+
+type MCPAuditLogFindingType string
+
+const (
+	// MCPAuditLogFindingTypeCallVolumeSpike is raised when a user or server makes many more calls in an hour than usual.
+	MCPAuditLogFindingTypeCallVolumeSpike MCPAuditLogFindingType = "callVolumeSpike"
+	// MCPAuditLogFindingTypeBulkDataRead is raised when a user reads many more resources, or calls many more tools that read data, in an hour than usual.
+	MCPAuditLogFindingTypeBulkDataRead MCPAuditLogFindingType = "bulkDataRead"
+	// MCPAuditLogFindingTypeErrorRateSpike is raised when many more of a user's or server's calls fail in an hour than usual.
+	MCPAuditLogFindingTypeErrorRateSpike MCPAuditLogFindingType = "errorRateSpike"
+	// MCPAuditLogFindingTypeNewDestructiveTool is raised the first time a user calls a tool that deletes or overwrites data.
+	MCPAuditLogFindingTypeNewDestructiveTool MCPAuditLogFindingType = "newDestructiveTool"
+	// MCPAuditLogFindingTypeNewIPRange is raised when a user makes calls from an IP range they haven't used before.
+	MCPAuditLogFindingTypeNewIPRange MCPAuditLogFindingType = "newIPRange"
+	// MCPAuditLogFindingTypeUnusualHour is raised when a user makes calls at an hour of the day (UTC) when they are never active.
+	MCPAuditLogFindingTypeUnusualHour MCPAuditLogFindingType = "unusualHour"
+	// MCPAuditLogFindingTypeToolChanged is raised when an MCP server lists a tool whose name, description, or input schema
+	// is different from the approved version.
+	MCPAuditLogFindingTypeToolChanged MCPAuditLogFindingType = "toolChanged"
+)
+
+type MCPAuditLogFindingSeverity string
+
+const (
+	MCPAuditLogFindingSeverityLow    MCPAuditLogFindingSeverity = "low"
+	MCPAuditLogFindingSeverityMedium MCPAuditLogFindingSeverity = "medium"
+	MCPAuditLogFindingSeverityHigh   MCPAuditLogFindingSeverity = "high"
+)
+
+// MCPAuditLogFindingAcknowledgement is the body of an acknowledge call.
+type MCPAuditLogFindingAcknowledgement struct {
+	Note string `json:"note,omitempty"`
+}
+
+type MCPAuditLogFindingList List[MCPAuditLogFinding]
+
+type MCPAuditLogFindingResponse struct {
+	MCPAuditLogFindingList `json:",inline"`
+	Total                  int64 `json:"total"`
+	Limit                  int   `json:"limit"`
+	Offset                 int   `json:"offset"`
+}
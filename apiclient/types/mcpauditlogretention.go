@@ -0,0 +1,56 @@
+package types
+
+// MCPAuditLogRetentionPolicy is the global policy for how long MCP audit logs are kept in the database. Older logs are
+// archived to the audit log export storage provider, if archiving is enabled, and then deleted.
+type MCPAuditLogRetentionPolicy struct {
+	Metadata                           Metadata `json:"metadata,omitempty"`
+	MCPAuditLogRetentionPolicyManifest `json:",inline"`
+	// LastRunAt is when the policy was last enforced.
+	LastRunAt *Time `json:"lastRunAt,omitempty"`
+	// LastError is the error of the last run, if it failed.
+	LastError string `json:"lastError,omitempty"`
+	// ArchivedLogCount is the number of audit logs that were archived by the last run.
+	ArchivedLogCount int64 `json:"archivedLogCount,omitempty"`
+	// DeletedLogCount is the number of audit logs that were deleted from the database by the last run.
+	DeletedLogCount int64 `json:"deletedLogCount,omitempty"`
+}
+
+type MCPAuditLogRetentionPolicyManifest struct {
+	// HotDays is the number of days that audit logs are kept in the database. Logs are kept forever if it is 0.
+	HotDays int `json:"hotDays,omitempty"`
+	// Archive uploads audit logs to the configured storage provider before they are deleted. Otherwise, they are only deleted.
+	Archive bool `json:"archive,omitempty"`
+	// Bucket is the bucket, or the container for Azure, that archives are uploaded to. It is required to archive.
+	Bucket string `json:"bucket,omitempty"`
+	// KeyPrefix is the prefix of the keys of archives. It defaults to "mcp-audit-log-archives".
+	KeyPrefix string `json:"keyPrefix,omitempty"`
+	// Overrides change the number of hot days for the audit logs of MCP servers in a catalog or workspace.
+	Overrides []MCPAuditLogRetentionOverride `json:"overrides,omitempty"`
+}
+
+// MCPAuditLogRetentionOverride sets the number of hot days for a catalog or workspace. Exactly one of MCPCatalogID and
+// PowerUserWorkspaceID must be set.
+type MCPAuditLogRetentionOverride struct {
+	MCPCatalogID         string `json:"mcpCatalogID,omitempty"`
+	PowerUserWorkspaceID string `json:"powerUserWorkspaceID,omitempty"`
+	// HotDays is the number of days that audit logs are kept in the database. Logs are kept forever if it is 0.
+	HotDays int `json:"hotDays"`
+}
+
+// MCPAuditLogArchive is a file of audit logs that were archived to the storage provider and deleted from the database.
+type MCPAuditLogArchive struct {
+	ID        uint `json:"id"`
+	CreatedAt Time `json:"createdAt"`
+	// Scope is "default", or the catalog or workspace of an override, like "mcpCatalog/default".
+	Scope string `json:"scope"`
+	// StartTime and EndTime are the range of the creation times of the archived logs. EndTime is exclusive.
+	StartTime       Time                `json:"startTime"`
+	EndTime         Time                `json:"endTime"`
+	LogCount        int64               `json:"logCount"`
+	Size            int64               `json:"size"`
+	StorageProvider StorageProviderType `json:"storageProvider"`
+	Bucket          string              `json:"bucket"`
+	Key             string              `json:"key"`
+}
+
+type MCPAuditLogArchiveList List[MCPAuditLogArchive]
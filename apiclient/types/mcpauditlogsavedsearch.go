@@ -0,0 +1,21 @@
+package types
+
+// MCPAuditLogSavedSearch is a named audit log query. Shared searches can be used by everyone who can read audit logs,
+// and scheduled as exports.
+type MCPAuditLogSavedSearch struct {
+	Metadata                       Metadata `json:"metadata,omitempty"`
+	MCPAuditLogSavedSearchManifest `json:",inline"`
+	// UserID is the ID of the user that created the search. Only they can change it.
+	UserID string `json:"userID,omitempty"`
+}
+
+type MCPAuditLogSavedSearchManifest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Query is the search, in the audit log query language, like `tool = create_issue AND args.repo = "obot-platform/obot"`.
+	Query string `json:"query"`
+	// Shared makes the search visible to everyone who can read audit logs.
+	Shared bool `json:"shared,omitempty"`
+}
+
+type MCPAuditLogSavedSearchList List[MCPAuditLogSavedSearch]
@@ -11,6 +11,45 @@ type MCPCatalog struct {
 type MCPCatalogManifest struct {
 	DisplayName string   `json:"displayName"`
 	SourceURLs  []string `json:"sourceURLs"`
+	// SourceConfigs holds optional settings for the source URLs, keyed by source URL.
+	SourceConfigs map[string]MCPCatalogSourceConfig `json:"sourceConfigs,omitempty"`
+	// StagedRollout records new versions of the catalog's entries without releasing them. They are offered to users
+	// once they are rolled out or released through the entry's versions.
+	StagedRollout bool `json:"stagedRollout,omitempty"`
+}
+
+type MCPCatalogSourceConfig struct {
+	// PublicKey is a PEM encoded public key. If set, the source must be signed with the matching private key
+	// or it will not be synced.
+	PublicKey string `json:"publicKey,omitempty"`
+	// HasCredentials is true if credentials have been configured for the source. It is read-only.
+	HasCredentials bool `json:"hasCredentials,omitempty"`
+	// Registry controls which servers are imported from an upstream MCP registry source (registry+https://).
+	Registry *MCPRegistrySourceConfig `json:"registry,omitempty"`
+}
+
+// MCPRegistrySourceConfig curates the servers imported from an upstream MCP registry.
+// Server names and patterns use the registry's reverse-DNS names, for example "io.github.example/weather"
+// or "io.github.example/*". If AllowedServers and AutoImportPublishers are both empty, every server is imported.
+type MCPRegistrySourceConfig struct {
+	// AllowedServers are the names or patterns of servers to import.
+	AllowedServers []string `json:"allowedServers,omitempty"`
+	// DeniedServers are the names or patterns of servers to never import. They take precedence over everything else.
+	DeniedServers []string `json:"deniedServers,omitempty"`
+	// AutoImportPublishers are the namespaces, like "io.github.example", whose servers are all imported.
+	AutoImportPublishers []string `json:"autoImportPublishers,omitempty"`
+}
+
+// MCPCatalogSourceCredentials are the credentials used to read a private catalog source.
+// Token is used for Git over HTTPS, OCI registries, and raw files. SSHPrivateKey is used for Git over SSH.
+type MCPCatalogSourceCredentials struct {
+	SourceURL     string `json:"sourceURL"`
+	Username      string `json:"username,omitempty"`
+	Token         string `json:"token,omitempty"`
+	SSHPrivateKey string `json:"sshPrivateKey,omitempty"`
+	// SSHKnownHosts is the known_hosts content used to verify the Git server's host key.
+	// If empty, the system known_hosts file is used.
+	SSHKnownHosts string `json:"sshKnownHosts,omitempty"`
 }
 
 type MCPCatalogList List[MCPCatalog]
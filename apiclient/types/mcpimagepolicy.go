@@ -0,0 +1,19 @@
+package types
+
+// MCPImagePolicy is the global policy for the container images of MCP servers. It is checked before a server is deployed.
+type MCPImagePolicy struct {
+	Metadata Metadata `json:"metadata,omitempty"`
+	MCPImagePolicyManifest
+}
+
+type MCPImagePolicyManifest struct {
+	// AllowedRegistries are the registries, or registry/repository prefixes, that images can come from,
+	// for example "ghcr.io" or "docker.io/myorg". Images from anywhere are allowed if this is empty.
+	AllowedRegistries []string `json:"allowedRegistries,omitempty"`
+
+	// RequireDigest requires images to be pinned to a digest, like image@sha256:..., instead of a tag.
+	RequireDigest bool `json:"requireDigest,omitempty"`
+
+	// CosignPublicKeys are PEM encoded public keys. If any are set, images must have a cosign signature from one of them.
+	CosignPublicKeys []string `json:"cosignPublicKeys,omitempty"`
+}
@@ -0,0 +1,68 @@
+package types
+
+// MCPInjectionScanPolicy is the global policy of the built-in scanner that looks for prompt injection, hidden instructions,
+// and unicode smuggling in the tool descriptions and responses of MCP servers before they reach an LLM.
+type MCPInjectionScanPolicy struct {
+	Metadata                       Metadata `json:"metadata,omitempty"`
+	MCPInjectionScanPolicyManifest `json:",inline"`
+}
+
+type MCPInjectionScanPolicyManifest struct {
+	// Disabled turns the scanner off.
+	Disabled bool `json:"disabled,omitempty"`
+	// DescriptionAction is what happens when the tools, prompts, or resources that a server lists have flagged descriptions.
+	// It defaults to warn.
+	DescriptionAction MCPInjectionScanAction `json:"descriptionAction,omitempty"`
+	// ResponseAction is what happens when a tool result, resource, or prompt is flagged. It defaults to warn.
+	ResponseAction MCPInjectionScanAction `json:"responseAction,omitempty"`
+	// DisabledRules are built-in rules that don't run.
+	DisabledRules []MCPInjectionScanRule `json:"disabledRules,omitempty"`
+	// Patterns are additional regular expressions that are flagged. They are matched case-insensitively.
+	Patterns []MCPInjectionScanPattern `json:"patterns,omitempty"`
+}
+
+type MCPInjectionScanPattern struct {
+	// Name is used as the rule of the findings of the pattern.
+	Name    string `json:"name"`
+	Pattern string `json:"pattern"`
+}
+
+type MCPInjectionScanAction string
+
+const (
+	// MCPInjectionScanActionWarn only records findings in the audit log.
+	MCPInjectionScanActionWarn MCPInjectionScanAction = "warn"
+	// MCPInjectionScanActionAnnotate records findings and adds a warning that the LLM sees to the flagged content.
+	MCPInjectionScanActionAnnotate MCPInjectionScanAction = "annotate"
+	// MCPInjectionScanActionBlock records findings and keeps the flagged content from reaching the client. Flagged tools,
+	// prompts, and resources are left out of lists, and flagged responses are replaced with an error.
+	MCPInjectionScanActionBlock MCPInjectionScanAction = "block"
+)
+
+type MCPInjectionScanRule string
+
+const (
+	// MCPInjectionScanRuleInstructionOverride flags text that tries to replace the LLM's instructions, like "ignore all
+	// previous instructions" or chat template tokens.
+	MCPInjectionScanRuleInstructionOverride MCPInjectionScanRule = "instructionOverride"
+	// MCPInjectionScanRuleHiddenInstructions flags instructions meant for the LLM but hidden from the user, like <IMPORTANT>
+	// tags, HTML comments, or "don't tell the user".
+	MCPInjectionScanRuleHiddenInstructions MCPInjectionScanRule = "hiddenInstructions"
+	// MCPInjectionScanRuleExfiltration flags text that asks for secrets, like SSH keys or credential files, or for data to
+	// be sent elsewhere.
+	MCPInjectionScanRuleExfiltration MCPInjectionScanRule = "exfiltration"
+	// MCPInjectionScanRuleUnicodeSmuggling flags invisible unicode characters that can carry text the user can't see,
+	// like tag characters, bidirectional overrides, and zero-width spaces.
+	MCPInjectionScanRuleUnicodeSmuggling MCPInjectionScanRule = "unicodeSmuggling"
+)
+
+// MCPInjectionScanFinding is text that the scanner flagged.
+type MCPInjectionScanFinding struct {
+	// Rule is the built-in rule, or the name of the pattern, that flagged the text.
+	Rule MCPInjectionScanRule `json:"rule"`
+	// Location is where the text is, like "tool send_email: description" or "content.0.text".
+	Location string `json:"location"`
+	// Excerpt is the flagged text, with invisible characters made visible. It is left out of audit logs, whose bodies have
+	// the text.
+	Excerpt string `json:"excerpt,omitempty"`
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"strings"
@@ -18,6 +19,17 @@ const (
 	RuntimeComposite     Runtime = "composite"
 )
 
+// TrustLevel controls how strongly an MCP server is isolated from the rest of the cluster or host.
+type TrustLevel string
+
+const (
+	// TrustLevelTrusted is for servers that have been vetted. They run with the default container runtime.
+	TrustLevelTrusted TrustLevel = "trusted"
+	// TrustLevelUntrusted is for servers, like community npx and uvx packages, that should run in the sandbox runtime
+	// and with the stricter seccomp and AppArmor profiles, when those are configured.
+	TrustLevelUntrusted TrustLevel = "untrusted"
+)
+
 // UVXRuntimeConfig represents configuration for UVX runtime (Python packages via uvx)
 type UVXRuntimeConfig struct {
 	Package string   `json:"package"`        // Required: Python package name
@@ -120,6 +132,19 @@ type MCPServerCatalogEntry struct {
 	PowerUserWorkspaceID      string                        `json:"powerUserWorkspaceID,omitempty"`
 	PowerUserID               string                        `json:"powerUserID,omitempty"`
 	NeedsUpdate               bool                          `json:"needsUpdate,omitempty"`
+	LatestVersion             int                           `json:"latestVersion,omitempty"`
+	ReleasedVersion           int                           `json:"releasedVersion,omitempty"`
+	StagedRollout             bool                          `json:"stagedRollout,omitempty"`
+	// ToolPreviewScanFindings is what the injection scanner flagged in the tool previews.
+	ToolPreviewScanFindings []MCPInjectionScanFinding `json:"toolPreviewScanFindings,omitempty"`
+}
+
+// MCPServerCatalogEntryInput is the body of requests that create or update a catalog entry.
+type MCPServerCatalogEntryInput struct {
+	MCPServerCatalogEntryManifest `json:",inline"`
+	// StagedRollout records new versions of the entry without releasing them. They are offered to users once they are
+	// rolled out or released through the entry's versions. The entry's catalog can also stage all of its entries.
+	StagedRollout bool `json:"stagedRollout,omitempty"`
 }
 
 type MCPServerCatalogEntryManifest struct {
@@ -142,6 +167,153 @@ type MCPServerCatalogEntryManifest struct {
 	CompositeConfig     *CompositeCatalogConfig     `json:"compositeConfig,omitempty"`
 
 	Env []MCPEnv `json:"env,omitempty"`
+
+	// K8sOverrides are Kubernetes settings for servers created from this entry. They are merged over the global K8s settings.
+	K8sOverrides *K8sOverrides `json:"k8sOverrides,omitempty"`
+
+	// EgressPolicy lists the network destinations that servers created from this entry can reach
+	// when egress policies are enforced.
+	EgressPolicy *EgressPolicy `json:"egressPolicy,omitempty"`
+
+	// TrustLevel of servers created from this entry. If it isn't set, servers that run third-party code are untrusted.
+	// Only admins can mark an entry as trusted.
+	TrustLevel TrustLevel `json:"trustLevel,omitempty"`
+
+	// PersistentVolume is a volume for servers created from this entry that keeps its contents when they restart.
+	PersistentVolume *PersistentVolume `json:"persistentVolume,omitempty"`
+
+	// HealthProbe configures the health probes of servers created from this entry.
+	HealthProbe *HealthProbe `json:"healthProbe,omitempty"`
+
+	// Rollout configures how servers created from this entry are replaced when their configuration changes.
+	Rollout *Rollout `json:"rollout,omitempty"`
+
+	// AuditLogRedaction configures what is removed from the audit logs of servers created from this entry.
+	// Only admins can change it.
+	AuditLogRedaction *AuditLogRedaction `json:"auditLogRedaction,omitempty"`
+}
+
+// AuditLogDetector is a built-in detector that redacts a kind of sensitive value from audit log bodies and headers.
+type AuditLogDetector string
+
+const (
+	// AuditLogDetectorAPIKey finds well-known API key and token formats, and bearer tokens.
+	AuditLogDetectorAPIKey AuditLogDetector = "apiKey"
+	// AuditLogDetectorJWT finds JSON web tokens.
+	AuditLogDetectorJWT AuditLogDetector = "jwt"
+	// AuditLogDetectorEmail finds email addresses.
+	AuditLogDetectorEmail AuditLogDetector = "email"
+	// AuditLogDetectorCreditCard finds credit card numbers that pass the Luhn check.
+	AuditLogDetectorCreditCard AuditLogDetector = "creditCard"
+	// AuditLogDetectorNationalID finds US social security numbers and UK national insurance numbers.
+	AuditLogDetectorNationalID AuditLogDetector = "nationalID"
+)
+
+// AuditLogRedaction configures what is removed from the audit logs of an MCP server before they are stored.
+// All built-in detectors run on every server unless they are disabled here.
+type AuditLogRedaction struct {
+	// MetadataOnly drops the request and response bodies and headers, so only metadata like the call type,
+	// tool name, status, and timing is stored.
+	MetadataOnly bool `json:"metadataOnly,omitempty"`
+	// DisabledDetectors are built-in detectors that don't run for this server.
+	DisabledDetectors []AuditLogDetector `json:"disabledDetectors,omitempty"`
+	// Rules are additional values to redact.
+	Rules []AuditLogRedactionRule `json:"rules,omitempty"`
+}
+
+// AuditLogRedactionRule redacts values from audit log bodies and headers. Exactly one of Pattern and JSONPath must be set.
+type AuditLogRedactionRule struct {
+	// Name is shown in place of the redacted values.
+	Name string `json:"name"`
+	// Pattern is a regular expression. Matches in any string value are redacted.
+	Pattern string `json:"pattern,omitempty"`
+	// JSONPath selects values to redact entirely, like $.params.arguments.ssn. It supports child names, .* and [*] wildcards,
+	// array indexes like [0], and recursive descent like $..password. It is applied to the request and response bodies.
+	JSONPath string `json:"jsonPath,omitempty"`
+}
+
+// RolloutStrategy is how a running MCP server is replaced when its configuration changes.
+type RolloutStrategy string
+
+const (
+	// RolloutStrategyRecreate replaces the running server in place, which drops its sessions. This is the default.
+	RolloutStrategyRecreate RolloutStrategy = "recreate"
+	// RolloutStrategyBlueGreen starts the new version alongside the old one. New sessions go to the new version once it is ready,
+	// and the old version keeps serving its existing sessions until it is drained. If the new version doesn't become ready,
+	// the old version is restored. Only the Kubernetes backend supports it, and other backends replace the server in place.
+	RolloutStrategyBlueGreen RolloutStrategy = "blueGreen"
+)
+
+// Rollout configures how a running MCP server is replaced when its configuration changes.
+type Rollout struct {
+	// Strategy is how the server is replaced. It defaults to recreate.
+	Strategy RolloutStrategy `json:"strategy,omitempty"`
+	// DrainTimeoutMinutes is how long the old version keeps serving its existing sessions after a blue/green rollout.
+	// It defaults to 15 minutes.
+	DrainTimeoutMinutes int `json:"drainTimeoutMinutes,omitempty"`
+}
+
+// HealthProbe configures the synthetic health probes that Obot runs against multi-user MCP servers.
+// Each probe initializes a new session with the server and lists its tools. Multi-user and workspace servers are probed
+// even without a HealthProbe, and single-user servers are only probed if it is enabled.
+type HealthProbe struct {
+	// Enabled turns on health probes for a single-user server.
+	Enabled bool `json:"enabled,omitempty"`
+	// Disabled turns off health probes for the server.
+	Disabled bool `json:"disabled,omitempty"`
+	// IntervalSeconds is how often the server is probed. It defaults to the global probe interval and can't be shorter than it.
+	IntervalSeconds int `json:"intervalSeconds,omitempty"`
+	// ToolName is a tool that each probe calls after listing tools. The probe fails if the call returns an error.
+	// It should be a cheap, read-only tool.
+	ToolName string `json:"toolName,omitempty"`
+	// ToolArguments are the arguments for the tool call, as a JSON object.
+	ToolArguments json.RawMessage `json:"toolArguments,omitempty"`
+	// SLOTarget is the percentage of probes that should succeed, like 99.9. It defaults to 99.
+	SLOTarget float64 `json:"sloTarget,omitempty"`
+	// SLOWindowDays is the number of days that uptime is measured over. It defaults to 30, which is also the maximum.
+	SLOWindowDays int `json:"sloWindowDays,omitempty"`
+}
+
+// PersistentVolumeScope is who shares a persistent volume.
+type PersistentVolumeScope string
+
+const (
+	// PersistentVolumeScopeServer gives each MCP server its own volume.
+	PersistentVolumeScopeServer PersistentVolumeScope = "server"
+	// PersistentVolumeScopeUser gives each user one volume for a catalog entry,
+	// which is kept when they delete their server and used again by the next server they create from that entry.
+	PersistentVolumeScopeUser PersistentVolumeScope = "user"
+)
+
+// PersistentVolumeRetentionPolicy is what happens to a persistent volume when its MCP server is deleted.
+type PersistentVolumeRetentionPolicy string
+
+const (
+	PersistentVolumeRetentionDelete PersistentVolumeRetentionPolicy = "delete"
+	PersistentVolumeRetentionRetain PersistentVolumeRetentionPolicy = "retain"
+)
+
+// PersistentVolume is a writable volume, backed by a PVC in Kubernetes and a named volume in Docker,
+// for servers that keep state like a local database.
+type PersistentVolume struct {
+	// MountPath is where the volume is mounted in the MCP server container.
+	MountPath string `json:"mountPath"`
+	// Size is the requested size of the volume, like "1Gi". It defaults to 1Gi and is ignored by Docker.
+	Size string `json:"size,omitempty"`
+	// Scope is who shares the volume. It defaults to server.
+	Scope PersistentVolumeScope `json:"scope,omitempty"`
+	// RetentionPolicy is what happens to the volume when its server is deleted. It defaults to delete for
+	// server scoped volumes and retain for user scoped volumes.
+	RetentionPolicy PersistentVolumeRetentionPolicy `json:"retentionPolicy,omitempty"`
+}
+
+// EgressPolicy lists the network destinations that an MCP server can reach when egress policies are enforced.
+// All other outbound traffic from the server is blocked.
+type EgressPolicy struct {
+	// AllowedHosts are hostnames, like api.github.com, that the server can connect to.
+	AllowedHosts []string `json:"allowedHosts,omitempty"`
+	// AllowedCIDRs are IP ranges, like 10.0.0.0/8, that the server can connect to.
+	AllowedCIDRs []string `json:"allowedCIDRs,omitempty"`
 }
 
 // ToolOverride defines how a single component tool is exposed by the composite server
@@ -207,6 +379,28 @@ type MCPServerManifest struct {
 
 	Env []MCPEnv `json:"env,omitempty"`
 
+	// K8sOverrides are Kubernetes settings for this server. They are merged over the global K8s settings.
+	K8sOverrides *K8sOverrides `json:"k8sOverrides,omitempty"`
+
+	// EgressPolicy lists the network destinations that this server can reach when egress policies are enforced.
+	EgressPolicy *EgressPolicy `json:"egressPolicy,omitempty"`
+
+	// TrustLevel of this server. If it isn't set, servers that run third-party code are untrusted.
+	// Only admins can mark a server as trusted.
+	TrustLevel TrustLevel `json:"trustLevel,omitempty"`
+
+	// PersistentVolume is a volume that keeps its contents when this server restarts.
+	PersistentVolume *PersistentVolume `json:"persistentVolume,omitempty"`
+
+	// HealthProbe configures the health probes of this server, if it is a multi-user or remote server.
+	HealthProbe *HealthProbe `json:"healthProbe,omitempty"`
+
+	// Rollout configures how this server is replaced when its configuration changes.
+	Rollout *Rollout `json:"rollout,omitempty"`
+
+	// AuditLogRedaction configures what is removed from the audit logs of this server. Only admins can change it.
+	AuditLogRedaction *AuditLogRedaction `json:"auditLogRedaction,omitempty"`
+
 	// Legacy fields that are deprecated, used only for cleaning up old servers
 	Command string      `json:"command,omitempty"`
 	Args    []string    `json:"args,omitempty"`
@@ -233,6 +427,15 @@ type MCPServer struct {
 	// NeedsUpdate indicates whether the configuration in this server's catalog entry has drift from this server's configuration.
 	NeedsUpdate bool `json:"needsUpdate,omitempty"`
 
+	// CatalogEntryVersion is the version of the catalog entry that this server's configuration came from.
+	CatalogEntryVersion int `json:"catalogEntryVersion,omitempty"`
+
+	// CatalogEntryVersionPinned indicates that this server stays on its catalog entry version and is not offered updates.
+	CatalogEntryVersionPinned bool `json:"catalogEntryVersionPinned,omitempty"`
+
+	// AvailableCatalogEntryVersion is the catalog entry version this server would be updated to when NeedsUpdate is true.
+	AvailableCatalogEntryVersion int `json:"availableCatalogEntryVersion,omitempty"`
+
 	// NeedsURL indicates whether the server's URL needs to be updated to match the catalog entry.
 	NeedsURL bool `json:"needsURL,omitempty"`
 
@@ -243,7 +446,14 @@ type MCPServer struct {
 	// This is only set for multi-user servers.
 	MCPServerInstanceUserCount *int `json:"mcpServerInstanceUserCount,omitempty"`
 
-	// DeploymentStatus indicates the overall status of the MCP server deployment (Ready, Progressing, Failed).
+	// IdleTimeoutMinutes is the number of minutes without requests after which the server is scaled to zero.
+	// If nil, the default idle timeout is used. If zero, the server is never scaled to zero.
+	IdleTimeoutMinutes *int `json:"idleTimeoutMinutes,omitempty"`
+
+	// Scaling configures horizontal scaling for this server. This is only set for multi-user servers.
+	Scaling *MCPServerScaling `json:"scaling,omitempty"`
+
+	// DeploymentStatus indicates the overall status of the MCP server deployment (Ready, Progressing, Failed, ScaledToZero).
 	DeploymentStatus string `json:"deploymentStatus,omitempty"`
 
 	// DeploymentAvailableReplicas is the number of available replicas in the deployment.
@@ -286,6 +496,21 @@ type DeploymentCondition struct {
 
 type MCPServerList List[MCPServer]
 
+// MCPServerIdleTimeout is the body of a call to set how long an MCP server can go without requests before it is scaled to zero.
+// A nil value resets the server to the default idle timeout, and zero disables scale-to-zero for the server.
+type MCPServerIdleTimeout struct {
+	Minutes *int `json:"minutes"`
+}
+
+// MCPServerScaling configures horizontal scaling for a multi-user MCP server.
+// Replicas are added and removed based on the number of concurrent requests through the gateway.
+type MCPServerScaling struct {
+	MinReplicas int32 `json:"minReplicas"`
+	MaxReplicas int32 `json:"maxReplicas"`
+	// TargetConcurrency is the number of concurrent requests that each replica should handle. Defaults to 10.
+	TargetConcurrency int `json:"targetConcurrency,omitempty"`
+}
+
 type MCPServerTool struct {
 	ID          string            `json:"id"`
 	Name        string            `json:"name"`
@@ -368,14 +593,21 @@ func (e RuntimeValidationError) Error() string {
 func MapCatalogEntryToServer(catalogEntry MCPServerCatalogEntryManifest, userURL string, disableHostnameValidation bool) (MCPServerManifest, error) {
 	serverManifest := MCPServerManifest{
 		// Copy common fields
-		Metadata:         catalogEntry.Metadata,
-		Name:             catalogEntry.Name,
-		ShortDescription: catalogEntry.ShortDescription,
-		Description:      catalogEntry.Description,
-		Icon:             catalogEntry.Icon,
-		ToolPreview:      catalogEntry.ToolPreview,
-		Runtime:          catalogEntry.Runtime,
-		Env:              catalogEntry.Env,
+		Metadata:          catalogEntry.Metadata,
+		Name:              catalogEntry.Name,
+		ShortDescription:  catalogEntry.ShortDescription,
+		Description:       catalogEntry.Description,
+		Icon:              catalogEntry.Icon,
+		ToolPreview:       catalogEntry.ToolPreview,
+		Runtime:           catalogEntry.Runtime,
+		Env:               catalogEntry.Env,
+		K8sOverrides:      catalogEntry.K8sOverrides,
+		EgressPolicy:      catalogEntry.EgressPolicy,
+		TrustLevel:        catalogEntry.TrustLevel,
+		PersistentVolume:  catalogEntry.PersistentVolume,
+		HealthProbe:       catalogEntry.HealthProbe,
+		Rollout:           catalogEntry.Rollout,
+		AuditLogRedaction: catalogEntry.AuditLogRedaction,
 	}
 
 	// Handle runtime-specific mapping
@@ -0,0 +1,41 @@
+package types
+
+type MCPServerCatalogEntryVersion struct {
+	Metadata
+	MCPServerCatalogEntryID string                        `json:"mcpServerCatalogEntryID"`
+	Version                 int                           `json:"version"`
+	Manifest                MCPServerCatalogEntryManifest `json:"manifest"`
+	Released                bool                          `json:"released"`
+	RolloutUserIDs          []string                      `json:"rolloutUserIDs,omitempty"`
+	// Latest indicates whether this is the newest version of the catalog entry.
+	Latest bool `json:"latest,omitempty"`
+}
+
+type MCPServerCatalogEntryVersionList List[MCPServerCatalogEntryVersion]
+
+// MCPServerCatalogEntryVersionRollout is the body of a call to roll out a version to a set of users before it is released.
+type MCPServerCatalogEntryVersionRollout struct {
+	UserIDs []string `json:"userIDs"`
+}
+
+// MCPServerCatalogEntryVersionDiff describes the changes in a catalog entry's manifest between two versions.
+type MCPServerCatalogEntryVersionDiff struct {
+	FromVersion int                   `json:"fromVersion"`
+	ToVersion   int                   `json:"toVersion"`
+	Changes     []ManifestFieldChange `json:"changes"`
+}
+
+// ManifestFieldChange is a single changed field in a manifest. Path is a dot-separated JSON path, for example
+// "remoteConfig.headers.0.key". From and To are the JSON encoded values. From is empty if the field was added,
+// and To is empty if it was removed.
+type ManifestFieldChange struct {
+	Path string `json:"path"`
+	From string `json:"from,omitempty"`
+	To   string `json:"to,omitempty"`
+}
+
+// MCPServerVersionPin is the body of a call to pin an MCP server to a catalog entry version.
+// A version of 0 unpins the server.
+type MCPServerVersionPin struct {
+	Version int `json:"version"`
+}
@@ -19,4 +19,19 @@ type MCPServerDetails struct {
 	Replicas       int32            `json:"replicas"`
 	IsAvailable    bool             `json:"isAvailable"`
 	Events         []MCPServerEvent `json:"events"`
+	// Conditions report problems that keep the server from being deployed, like an image that fails the image policy.
+	Conditions []DeploymentCondition `json:"conditions,omitempty"`
+}
+
+// MCPServerHistory is the deployment events and recent logs of an MCP server that Obot has kept,
+// including those of pods or containers that are gone.
+type MCPServerHistory struct {
+	Events []MCPServerEvent   `json:"events"`
+	Logs   []MCPServerLogLine `json:"logs"`
+}
+
+type MCPServerLogLine struct {
+	Time    Time   `json:"time"`
+	Replica string `json:"replica,omitempty"`
+	Line    string `json:"line"`
 }
@@ -0,0 +1,42 @@
+package types
+
+// MCPServerHealth is the availability of a multi-user or remote MCP server, measured by the synthetic health probes that Obot runs against it.
+type MCPServerHealth struct {
+	MCPServerID   string `json:"mcpServerID"`
+	MCPServerName string `json:"mcpServerName"`
+	// SLOTarget is the percentage of probes that should succeed in the SLO window.
+	SLOTarget     float64 `json:"sloTarget"`
+	SLOWindowDays int     `json:"sloWindowDays"`
+	// ProbeCount and FailedProbeCount are the number of probes in the SLO window.
+	ProbeCount       int64 `json:"probeCount"`
+	FailedProbeCount int64 `json:"failedProbeCount"`
+	// Uptime is the percentage of probes in the SLO window that succeeded. It is 100 if there were no probes.
+	Uptime float64 `json:"uptime"`
+	// SLOMet is true if the uptime is at least the SLO target.
+	SLOMet bool `json:"sloMet"`
+	// ErrorBudgetRemaining is the percentage of the failures allowed by the SLO that haven't happened yet.
+	// It is negative when the SLO is not met.
+	ErrorBudgetRemaining float64 `json:"errorBudgetRemaining"`
+	// LatencyP50MS and LatencyP95MS are the latencies of the successful probes in Probes.
+	LatencyP50MS int64 `json:"latencyP50MS,omitempty"`
+	LatencyP95MS int64 `json:"latencyP95MS,omitempty"`
+	// Probes are the recent probe results, oldest first. They are only included when getting the health of one server.
+	Probes []MCPServerProbeResult `json:"probes,omitempty"`
+}
+
+type MCPServerHealthList List[MCPServerHealth]
+
+// MCPServerProbeResult is the result of one health probe. A probe initializes a session with the server, lists its tools,
+// and calls the probe's tool if one is configured. Latencies are in milliseconds.
+type MCPServerProbeResult struct {
+	Time    Time `json:"time"`
+	Success bool `json:"success"`
+	// AuthRequired is true if the server required OAuth, which counts as a success because the server responded.
+	AuthRequired        bool   `json:"authRequired,omitempty"`
+	LatencyMS           int64  `json:"latencyMS"`
+	InitializeLatencyMS int64  `json:"initializeLatencyMS"`
+	ListToolsLatencyMS  int64  `json:"listToolsLatencyMS"`
+	ToolCallLatencyMS   int64  `json:"toolCallLatencyMS,omitempty"`
+	ToolCount           int    `json:"toolCount"`
+	Error               string `json:"error,omitempty"`
+}
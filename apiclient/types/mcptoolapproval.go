@@ -0,0 +1,122 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+)
+
+// MCPToolApprovalPolicy pauses the MCP calls that match its selectors until they are approved.
+type MCPToolApprovalPolicy struct {
+	Metadata                      `json:",inline"`
+	MCPToolApprovalPolicyManifest `json:",inline"`
+}
+
+type MCPToolApprovalPolicyManifest struct {
+	Name string `json:"name,omitempty"`
+	// Resources are the MCP servers, catalog entries, and catalogs whose calls the policy applies to.
+	Resources []Resource `json:"resources,omitempty"`
+	// Selectors are the calls that need approval, like the tools/call method with the send_email identifier.
+	Selectors MCPSelectors `json:"selectors,omitempty"`
+	// Approvers are the users and groups that can decide on calls. If there are none, the user that made the call decides.
+	// Admins and owners can always decide.
+	Approvers []Subject `json:"approvers,omitempty"`
+	// TimeoutMinutes is how long a call waits for a decision before it is rejected. It defaults to 10 minutes.
+	TimeoutMinutes int `json:"timeoutMinutes,omitempty"`
+	// NotificationURL is sent a POST with the details of each call that is held, like the tool and the server, so that
+	// approvers find out about it. It can be a Slack incoming webhook.
+	NotificationURL string `json:"notificationURL,omitempty"`
+	Disabled        bool   `json:"disabled,omitempty"`
+}
+
+// MaxMCPToolApprovalTimeoutMinutes is the longest that a call can wait for a decision.
+const MaxMCPToolApprovalTimeoutMinutes = 24 * 60
+
+func (m *MCPToolApprovalPolicyManifest) Validate() error {
+	if len(m.Resources) == 0 {
+		return fmt.Errorf("at least one resource is required")
+	}
+	for _, resource := range m.Resources {
+		if err := resource.Validate(); err != nil {
+			return fmt.Errorf("invalid resource: %v", err)
+		}
+	}
+
+	// Unlike webhooks, no selectors doesn't mean every call, so that a policy can't pause initialize or tools/list by accident.
+	if len(m.Selectors) == 0 {
+		return fmt.Errorf("at least one selector is required")
+	}
+	for i, selector := range m.Selectors {
+		if selector.Method == "" {
+			return fmt.Errorf("selector %d: method is required", i+1)
+		}
+		if slices.Contains(selector.Identifiers, "*") {
+			m.Selectors[i].Identifiers = nil
+		}
+	}
+
+	for _, approver := range m.Approvers {
+		switch approver.Type {
+		case SubjectTypeUser, SubjectTypeGroup:
+			if approver.ID == "" {
+				return fmt.Errorf("approver ID is required")
+			}
+		default:
+			return fmt.Errorf("approvers must be users or groups")
+		}
+	}
+
+	if m.TimeoutMinutes < 0 || m.TimeoutMinutes > MaxMCPToolApprovalTimeoutMinutes {
+		return fmt.Errorf("timeout must be between 0 and %d minutes", MaxMCPToolApprovalTimeoutMinutes)
+	}
+
+	if m.NotificationURL != "" {
+		u, err := url.Parse(m.NotificationURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("notification URL must be an http or https URL")
+		}
+	}
+
+	return nil
+}
+
+type MCPToolApprovalPolicyList List[MCPToolApprovalPolicy]
+
+// MCPToolCallApproval is an MCP call that is paused until it is approved or rejected.
+type MCPToolCallApproval struct {
+	Metadata `json:",inline"`
+	// PolicyID is the policy that paused the call.
+	PolicyID string `json:"policyID,omitempty"`
+	// UserID is the user that made the call.
+	UserID               string `json:"userID,omitempty"`
+	MCPID                string `json:"mcpID,omitempty"`
+	MCPServerDisplayName string `json:"mcpServerDisplayName,omitempty"`
+	Method               string `json:"method,omitempty"`
+	Identifier           string `json:"identifier,omitempty"`
+	// Arguments are the arguments of the call, as JSON.
+	Arguments string `json:"arguments,omitempty"`
+	// Approvers are the users and groups that can decide on the call, from the policy. If there are none, the user that
+	// made the call decides.
+	Approvers      []Subject                `json:"approvers,omitempty"`
+	State          MCPToolCallApprovalState `json:"state,omitempty"`
+	ExpiresAt      *Time                    `json:"expiresAt,omitempty"`
+	DecidedBy      string                   `json:"decidedBy,omitempty"`
+	DecisionReason string                   `json:"decisionReason,omitempty"`
+	DecidedAt      *Time                    `json:"decidedAt,omitempty"`
+}
+
+type MCPToolCallApprovalList List[MCPToolCallApproval]
+
+// MCPToolCallApprovalDecision is the body of an approve or reject call.
+type MCPToolCallApprovalDecision struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+type MCPToolCallApprovalState string
+
+const (
+	MCPToolCallApprovalStatePending  MCPToolCallApprovalState = "pending"
+	MCPToolCallApprovalStateApproved MCPToolCallApprovalState = "approved"
+	MCPToolCallApprovalStateRejected MCPToolCallApprovalState = "rejected"
+	MCPToolCallApprovalStateExpired  MCPToolCallApprovalState = "expired"
+)
@@ -0,0 +1,64 @@
+package types
+
+import "encoding/json"
+
+// MCPToolDriftPolicy is the global policy for tools that MCP servers change after they were first listed. The gateway
+// pins the name, description, and input schema of each tool the first time it is listed, and compares later lists to
+// the pinned version.
+type MCPToolDriftPolicy struct {
+	Metadata                   Metadata `json:"metadata,omitempty"`
+	MCPToolDriftPolicyManifest `json:",inline"`
+}
+
+type MCPToolDriftPolicyManifest struct {
+	// Disabled turns drift detection off. Tools are neither pinned nor compared.
+	Disabled bool `json:"disabled,omitempty"`
+	// BlockChangedTools leaves changed tools out of tool lists, and refuses calls to them, until an admin approves the
+	// change. Without it, changes are only reported.
+	BlockChangedTools bool `json:"blockChangedTools,omitempty"`
+}
+
+// MCPToolPin is the approved version of a tool, and the version that its server lists now if the tool changed.
+type MCPToolPin struct {
+	ID        uint `json:"id"`
+	CreatedAt Time `json:"createdAt"`
+	// SourceID is the catalog entry that the servers with the tool were created from, or the MCP server for servers
+	// that weren't created from a catalog entry or whose users supply their URL or configuration.
+	SourceID             string `json:"sourceID"`
+	MCPServerDisplayName string `json:"mcpServerDisplayName,omitempty"`
+	ToolName             string `json:"toolName"`
+
+	// Hash is the hash of the name, description, and input schema of the approved version.
+	Hash        string          `json:"hash"`
+	Description string          `json:"description,omitempty"`
+	InputSchema json.RawMessage `json:"inputSchema,omitempty"`
+
+	// Changed is true if the server lists a version of the tool that hasn't been approved.
+	Changed            bool            `json:"changed"`
+	CurrentHash        string          `json:"currentHash"`
+	CurrentDescription string          `json:"currentDescription,omitempty"`
+	CurrentInputSchema json.RawMessage `json:"currentInputSchema,omitempty"`
+	// ChangedAt is when the server first listed the current version, if the tool changed.
+	ChangedAt *Time `json:"changedAt,omitempty"`
+	// Diff is a unified diff of the description and input schema, from the approved version to the current version.
+	Diff string `json:"diff,omitempty"`
+
+	ApprovedAt *Time  `json:"approvedAt,omitempty"`
+	ApprovedBy string `json:"approvedBy,omitempty"`
+}
+
+// MCPToolPinApproval is the body of an approve call.
+type MCPToolPinApproval struct {
+	// CurrentHash is the version that is approved. If it is set and the tool changed again in the meantime, the
+	// approval fails, so that a version that wasn't reviewed isn't approved.
+	CurrentHash string `json:"currentHash,omitempty"`
+}
+
+type MCPToolPinList List[MCPToolPin]
+
+type MCPToolPinResponse struct {
+	MCPToolPinList `json:",inline"`
+	Total          int64 `json:"total"`
+	Limit          int   `json:"limit"`
+	Offset         int   `json:"offset"`
+}
@@ -18,6 +18,8 @@ type MCPWebhookValidationManifest struct {
 	Secret    string       `json:"secret,omitempty"`
 	Selectors MCPSelectors `json:"selectors,omitempty"`
 	Disabled  bool         `json:"disabled,omitempty"`
+	// Shadow webhooks are called for the requests they select, but their verdicts are only recorded and never block a request.
+	Shadow bool `json:"shadow,omitempty"`
 }
 
 func (m *MCPWebhookValidationManifest) Validate() error {
@@ -108,3 +110,49 @@ func (f MCPSelector) Strings() []string {
 
 	return result
 }
+
+// MCPWebhookShadowVerdict is the verdict of a webhook in shadow mode on a request.
+type MCPWebhookShadowVerdict struct {
+	ID                   uint                        `json:"id"`
+	CreatedAt            Time                        `json:"createdAt"`
+	UserID               string                      `json:"userID,omitempty"`
+	MCPID                string                      `json:"mcpID,omitempty"`
+	MCPServerDisplayName string                      `json:"mcpServerDisplayName,omitempty"`
+	CallType             string                      `json:"callType,omitempty"`
+	CallIdentifier       string                      `json:"callIdentifier,omitempty"`
+	SessionID            string                      `json:"sessionID,omitempty"`
+	RequestID            string                      `json:"requestID,omitempty"`
+	Verdict              MCPWebhookShadowVerdictType `json:"verdict"`
+	Message              string                      `json:"message,omitempty"`
+}
+
+type MCPWebhookShadowVerdictType string
+
+const (
+	MCPWebhookShadowVerdictAllowed MCPWebhookShadowVerdictType = "allowed"
+	// MCPWebhookShadowVerdictBlocked is the verdict on requests that the webhook would have blocked.
+	MCPWebhookShadowVerdictBlocked MCPWebhookShadowVerdictType = "blocked"
+	// MCPWebhookShadowVerdictError is the verdict on requests that the webhook couldn't be called for.
+	MCPWebhookShadowVerdictError MCPWebhookShadowVerdictType = "error"
+)
+
+// MCPWebhookShadowReport summarizes what a webhook in shadow mode would have blocked in a time window.
+type MCPWebhookShadowReport struct {
+	Start      Time  `json:"start"`
+	End        Time  `json:"end"`
+	Evaluated  int64 `json:"evaluated"`
+	WouldBlock int64 `json:"wouldBlock"`
+	Errors     int64 `json:"errors"`
+	// BlockedByTool, BlockedByServer, and BlockedByUser are the requests that would have been blocked, by call identifier,
+	// MCP server, and user, most first.
+	BlockedByTool   []MCPWebhookShadowReportCount `json:"blockedByTool"`
+	BlockedByServer []MCPWebhookShadowReportCount `json:"blockedByServer"`
+	BlockedByUser   []MCPWebhookShadowReportCount `json:"blockedByUser"`
+	// RecentBlocked are the latest requests that would have been blocked.
+	RecentBlocked []MCPWebhookShadowVerdict `json:"recentBlocked"`
+}
+
+type MCPWebhookShadowReportCount struct {
+	Key   string `json:"key"`
+	Count int64  `json:"count"`
+}
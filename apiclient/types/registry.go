@@ -22,7 +22,7 @@ type RegistryServerResponse struct {
 }
 
 // RegistryServerDetail matches the Registry API RegistryServerDetail schema
-// For Obot, configured servers always use Remotes (never Packages)
+// For Obot, configured servers always use Remotes (never Packages). Packages are only set by upstream registries.
 type RegistryServerDetail struct {
 	Name        string                    `json:"name"`
 	Description string                    `json:"description"`
@@ -30,12 +30,54 @@ type RegistryServerDetail struct {
 	Version     string                    `json:"version"`
 	WebsiteURL  string                    `json:"websiteUrl,omitempty"`
 	Icons       []RegistryServerIcon      `json:"icons,omitempty"`
+	Packages    []RegistryServerPackage   `json:"packages,omitempty"`
 	Remotes     []RegistryServerRemote    `json:"remotes,omitempty"`
 	Repository  *RegistryServerRepository `json:"repository,omitempty"`
 	Schema      string                    `json:"$schema,omitempty"`
 	Meta        RegistryServerMeta        `json:"_meta"`
 }
 
+// RegistryServerPackage represents a package that runs the server locally
+type RegistryServerPackage struct {
+	RegistryType         string                   `json:"registryType"` // npm, pypi, or oci
+	RegistryBaseURL      string                   `json:"registryBaseUrl,omitempty"`
+	Identifier           string                   `json:"identifier"`
+	Version              string                   `json:"version,omitempty"`
+	RuntimeHint          string                   `json:"runtimeHint,omitempty"`
+	Transport            RegistryPackageTransport `json:"transport"`
+	RuntimeArguments     []RegistryArgument       `json:"runtimeArguments,omitempty"`
+	PackageArguments     []RegistryArgument       `json:"packageArguments,omitempty"`
+	EnvironmentVariables []RegistryKeyValueInput  `json:"environmentVariables,omitempty"`
+}
+
+// RegistryPackageTransport describes how to connect to a package once it is running
+type RegistryPackageTransport struct {
+	Type    string                  `json:"type"` // stdio, streamable-http, or sse
+	URL     string                  `json:"url,omitempty"`
+	Headers []RegistryKeyValueInput `json:"headers,omitempty"`
+}
+
+// RegistryArgument is a positional or named argument passed to a package
+type RegistryArgument struct {
+	Type        string `json:"type"` // positional or named
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Value       string `json:"value,omitempty"`
+	ValueHint   string `json:"valueHint,omitempty"`
+	Default     string `json:"default,omitempty"`
+	IsRequired  bool   `json:"isRequired,omitempty"`
+}
+
+// RegistryKeyValueInput is an environment variable or header, either with a fixed value or supplied by the user
+type RegistryKeyValueInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Value       string `json:"value,omitempty"`
+	Default     string `json:"default,omitempty"`
+	IsRequired  bool   `json:"isRequired,omitempty"`
+	IsSecret    bool   `json:"isSecret,omitempty"`
+}
+
 // RegistryServerIcon represents an icon for display
 type RegistryServerIcon struct {
 	Src      string   `json:"src"`
@@ -47,8 +89,9 @@ type RegistryServerIcon struct {
 // RegistryServerRemote represents a remote server configuration
 // All Obot servers are exposed as streamable-http remotes via mcp-connect
 type RegistryServerRemote struct {
-	Type string `json:"type"` // Always "streamable-http" for configured Obot servers
-	URL  string `json:"url"`  // The mcp-connect URL
+	Type    string                  `json:"type"`              // Always "streamable-http" for configured Obot servers
+	URL     string                  `json:"url"`               // The mcp-connect URL
+	Headers []RegistryKeyValueInput `json:"headers,omitempty"` // Only set by upstream registries
 }
 
 // RegistryServerRepository represents repository metadata
@@ -50,6 +50,10 @@ func (in *APIActivityList) DeepCopy() *APIActivityList {
 func (in *AccessControlRule) DeepCopyInto(out *AccessControlRule) {
 	*out = *in
 	in.Metadata.DeepCopyInto(&out.Metadata)
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
 	in.AccessControlRuleManifest.DeepCopyInto(&out.AccessControlRuleManifest)
 }
 
@@ -536,6 +540,46 @@ func (in *AuditLogExportResponse) DeepCopy() *AuditLogExportResponse {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogRedaction) DeepCopyInto(out *AuditLogRedaction) {
+	*out = *in
+	if in.DisabledDetectors != nil {
+		in, out := &in.DisabledDetectors, &out.DisabledDetectors
+		*out = make([]AuditLogDetector, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AuditLogRedactionRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogRedaction.
+func (in *AuditLogRedaction) DeepCopy() *AuditLogRedaction {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogRedaction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogRedactionRule) DeepCopyInto(out *AuditLogRedactionRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogRedactionRule.
+func (in *AuditLogRedactionRule) DeepCopy() *AuditLogRedactionRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogRedactionRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthProvider) DeepCopyInto(out *AuthProvider) {
 	*out = *in
@@ -989,6 +1033,74 @@ func (in *DeploymentCondition) DeepCopy() *DeploymentCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveGrant) DeepCopyInto(out *EffectiveGrant) {
+	*out = *in
+	out.Subject = in.Subject
+	out.Resource = in.Resource
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveGrant.
+func (in *EffectiveGrant) DeepCopy() *EffectiveGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveGrantList) DeepCopyInto(out *EffectiveGrantList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EffectiveGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveGrantList.
+func (in *EffectiveGrantList) DeepCopy() *EffectiveGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressPolicy) DeepCopyInto(out *EgressPolicy) {
+	*out = *in
+	if in.AllowedHosts != nil {
+		in, out := &in.AllowedHosts, &out.AllowedHosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedCIDRs != nil {
+		in, out := &in.AllowedCIDRs, &out.AllowedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressPolicy.
+func (in *EgressPolicy) DeepCopy() *EgressPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EmailReceiver) DeepCopyInto(out *EmailReceiver) {
 	*out = *in
@@ -1321,6 +1433,26 @@ func (in *GroupRoleAssignmentList) DeepCopy() *GroupRoleAssignmentList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthProbe) DeepCopyInto(out *HealthProbe) {
+	*out = *in
+	if in.ToolArguments != nil {
+		in, out := &in.ToolArguments, &out.ToolArguments
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthProbe.
+func (in *HealthProbe) DeepCopy() *HealthProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Item) DeepCopyInto(out *Item) {
 	*out = *in
@@ -1336,6 +1468,70 @@ func (in *Item) DeepCopy() *Item {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8sEmptyDirVolume) DeepCopyInto(out *K8sEmptyDirVolume) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8sEmptyDirVolume.
+func (in *K8sEmptyDirVolume) DeepCopy() *K8sEmptyDirVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(K8sEmptyDirVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8sOverrides) DeepCopyInto(out *K8sOverrides) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(K8sResourceOverrides)
+		**out = **in
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]K8sVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8sOverrides.
+func (in *K8sOverrides) DeepCopy() *K8sOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(K8sOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8sResourceOverrides) DeepCopyInto(out *K8sResourceOverrides) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8sResourceOverrides.
+func (in *K8sResourceOverrides) DeepCopy() *K8sResourceOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(K8sResourceOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *K8sSettings) DeepCopyInto(out *K8sSettings) {
 	*out = *in
@@ -1372,6 +1568,26 @@ func (in *K8sSettingsStatus) DeepCopy() *K8sSettingsStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8sVolume) DeepCopyInto(out *K8sVolume) {
+	*out = *in
+	if in.EmptyDir != nil {
+		in, out := &in.EmptyDir, &out.EmptyDir
+		*out = new(K8sEmptyDirVolume)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8sVolume.
+func (in *K8sVolume) DeepCopy() *K8sVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(K8sVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KnowledgeFile) DeepCopyInto(out *KnowledgeFile) {
 	*out = *in
@@ -1556,6 +1772,84 @@ func (in *LogoPreferences) DeepCopy() *LogoPreferences {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPAccessRequest) DeepCopyInto(out *MCPAccessRequest) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	out.MCPAccessRequestManifest = in.MCPAccessRequestManifest
+	if in.DecidedAt != nil {
+		in, out := &in.DecidedAt, &out.DecidedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAccessRequest.
+func (in *MCPAccessRequest) DeepCopy() *MCPAccessRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPAccessRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPAccessRequestDecision) DeepCopyInto(out *MCPAccessRequestDecision) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAccessRequestDecision.
+func (in *MCPAccessRequestDecision) DeepCopy() *MCPAccessRequestDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPAccessRequestDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPAccessRequestList) DeepCopyInto(out *MCPAccessRequestList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPAccessRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAccessRequestList.
+func (in *MCPAccessRequestList) DeepCopy() *MCPAccessRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPAccessRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPAccessRequestManifest) DeepCopyInto(out *MCPAccessRequestManifest) {
+	*out = *in
+	out.Resource = in.Resource
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAccessRequestManifest.
+func (in *MCPAccessRequestManifest) DeepCopy() *MCPAccessRequestManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPAccessRequestManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MCPAuditLog) DeepCopyInto(out *MCPAuditLog) {
 	*out = *in
@@ -1586,6 +1880,11 @@ func (in *MCPAuditLog) DeepCopyInto(out *MCPAuditLog) {
 		*out = make(json.RawMessage, len(*in))
 		copy(*out, *in)
 	}
+	if in.InjectionScanFindings != nil {
+		in, out := &in.InjectionScanFindings, &out.InjectionScanFindings
+		*out = make([]MCPInjectionScanFinding, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLog.
@@ -1599,636 +1898,1638 @@ func (in *MCPAuditLog) DeepCopy() *MCPAuditLog {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPAuditLogList) DeepCopyInto(out *MCPAuditLogList) {
+func (in *MCPAuditLogArchive) DeepCopyInto(out *MCPAuditLogArchive) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogArchive.
+func (in *MCPAuditLogArchive) DeepCopy() *MCPAuditLogArchive {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPAuditLogArchive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPAuditLogArchiveList) DeepCopyInto(out *MCPAuditLogArchiveList) {
 	*out = *in
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MCPAuditLog, len(*in))
+		*out = make([]MCPAuditLogArchive, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogList.
-func (in *MCPAuditLogList) DeepCopy() *MCPAuditLogList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogArchiveList.
+func (in *MCPAuditLogArchiveList) DeepCopy() *MCPAuditLogArchiveList {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPAuditLogList)
+	out := new(MCPAuditLogArchiveList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPAuditLogResponse) DeepCopyInto(out *MCPAuditLogResponse) {
+func (in *MCPAuditLogFinding) DeepCopyInto(out *MCPAuditLogFinding) {
 	*out = *in
-	in.MCPAuditLogList.DeepCopyInto(&out.MCPAuditLogList)
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	if in.AcknowledgedAt != nil {
+		in, out := &in.AcknowledgedAt, &out.AcknowledgedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogResponse.
-func (in *MCPAuditLogResponse) DeepCopy() *MCPAuditLogResponse {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogFinding.
+func (in *MCPAuditLogFinding) DeepCopy() *MCPAuditLogFinding {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPAuditLogResponse)
+	out := new(MCPAuditLogFinding)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPCatalog) DeepCopyInto(out *MCPCatalog) {
+func (in *MCPAuditLogFindingAcknowledgement) DeepCopyInto(out *MCPAuditLogFindingAcknowledgement) {
 	*out = *in
-	in.Metadata.DeepCopyInto(&out.Metadata)
-	in.MCPCatalogManifest.DeepCopyInto(&out.MCPCatalogManifest)
-	in.LastSynced.DeepCopyInto(&out.LastSynced)
-	if in.SyncErrors != nil {
-		in, out := &in.SyncErrors, &out.SyncErrors
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalog.
-func (in *MCPCatalog) DeepCopy() *MCPCatalog {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogFindingAcknowledgement.
+func (in *MCPAuditLogFindingAcknowledgement) DeepCopy() *MCPAuditLogFindingAcknowledgement {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPCatalog)
+	out := new(MCPAuditLogFindingAcknowledgement)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPCatalogList) DeepCopyInto(out *MCPCatalogList) {
+func (in *MCPAuditLogFindingList) DeepCopyInto(out *MCPAuditLogFindingList) {
 	*out = *in
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MCPCatalog, len(*in))
+		*out = make([]MCPAuditLogFinding, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogList.
-func (in *MCPCatalogList) DeepCopy() *MCPCatalogList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogFindingList.
+func (in *MCPAuditLogFindingList) DeepCopy() *MCPAuditLogFindingList {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPCatalogList)
+	out := new(MCPAuditLogFindingList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPCatalogManifest) DeepCopyInto(out *MCPCatalogManifest) {
+func (in *MCPAuditLogFindingResponse) DeepCopyInto(out *MCPAuditLogFindingResponse) {
 	*out = *in
-	if in.SourceURLs != nil {
-		in, out := &in.SourceURLs, &out.SourceURLs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	in.MCPAuditLogFindingList.DeepCopyInto(&out.MCPAuditLogFindingList)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogManifest.
-func (in *MCPCatalogManifest) DeepCopy() *MCPCatalogManifest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogFindingResponse.
+func (in *MCPAuditLogFindingResponse) DeepCopy() *MCPAuditLogFindingResponse {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPCatalogManifest)
+	out := new(MCPAuditLogFindingResponse)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPEnv) DeepCopyInto(out *MCPEnv) {
+func (in *MCPAuditLogList) DeepCopyInto(out *MCPAuditLogList) {
 	*out = *in
-	out.MCPHeader = in.MCPHeader
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPAuditLog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPEnv.
-func (in *MCPEnv) DeepCopy() *MCPEnv {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogList.
+func (in *MCPAuditLogList) DeepCopy() *MCPAuditLogList {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPEnv)
+	out := new(MCPAuditLogList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPHeader) DeepCopyInto(out *MCPHeader) {
+func (in *MCPAuditLogResponse) DeepCopyInto(out *MCPAuditLogResponse) {
 	*out = *in
+	in.MCPAuditLogList.DeepCopyInto(&out.MCPAuditLogList)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPHeader.
-func (in *MCPHeader) DeepCopy() *MCPHeader {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogResponse.
+func (in *MCPAuditLogResponse) DeepCopy() *MCPAuditLogResponse {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPHeader)
+	out := new(MCPAuditLogResponse)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPPromptReadStats) DeepCopyInto(out *MCPPromptReadStats) {
+func (in *MCPAuditLogRetentionOverride) DeepCopyInto(out *MCPAuditLogRetentionOverride) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPPromptReadStats.
-func (in *MCPPromptReadStats) DeepCopy() *MCPPromptReadStats {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogRetentionOverride.
+func (in *MCPAuditLogRetentionOverride) DeepCopy() *MCPAuditLogRetentionOverride {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPPromptReadStats)
+	out := new(MCPAuditLogRetentionOverride)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPResourceReadStats) DeepCopyInto(out *MCPResourceReadStats) {
+func (in *MCPAuditLogRetentionPolicy) DeepCopyInto(out *MCPAuditLogRetentionPolicy) {
 	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.MCPAuditLogRetentionPolicyManifest.DeepCopyInto(&out.MCPAuditLogRetentionPolicyManifest)
+	if in.LastRunAt != nil {
+		in, out := &in.LastRunAt, &out.LastRunAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPResourceReadStats.
-func (in *MCPResourceReadStats) DeepCopy() *MCPResourceReadStats {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogRetentionPolicy.
+func (in *MCPAuditLogRetentionPolicy) DeepCopy() *MCPAuditLogRetentionPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPResourceReadStats)
+	out := new(MCPAuditLogRetentionPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPSelector) DeepCopyInto(out *MCPSelector) {
+func (in *MCPAuditLogRetentionPolicyManifest) DeepCopyInto(out *MCPAuditLogRetentionPolicyManifest) {
 	*out = *in
-	if in.Identifiers != nil {
-		in, out := &in.Identifiers, &out.Identifiers
-		*out = make([]string, len(*in))
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]MCPAuditLogRetentionOverride, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPSelector.
-func (in *MCPSelector) DeepCopy() *MCPSelector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogRetentionPolicyManifest.
+func (in *MCPAuditLogRetentionPolicyManifest) DeepCopy() *MCPAuditLogRetentionPolicyManifest {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPSelector)
+	out := new(MCPAuditLogRetentionPolicyManifest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in MCPSelectors) DeepCopyInto(out *MCPSelectors) {
-	{
-		in := &in
-		*out = make(MCPSelectors, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+func (in *MCPAuditLogSavedSearch) DeepCopyInto(out *MCPAuditLogSavedSearch) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	out.MCPAuditLogSavedSearchManifest = in.MCPAuditLogSavedSearchManifest
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPSelectors.
-func (in MCPSelectors) DeepCopy() MCPSelectors {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogSavedSearch.
+func (in *MCPAuditLogSavedSearch) DeepCopy() *MCPAuditLogSavedSearch {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPSelectors)
+	out := new(MCPAuditLogSavedSearch)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServer) DeepCopyInto(out *MCPServer) {
+func (in *MCPAuditLogSavedSearchList) DeepCopyInto(out *MCPAuditLogSavedSearchList) {
 	*out = *in
-	in.Metadata.DeepCopyInto(&out.Metadata)
-	in.MCPServerManifest.DeepCopyInto(&out.MCPServerManifest)
-	if in.MissingRequiredEnvVars != nil {
-		in, out := &in.MissingRequiredEnvVars, &out.MissingRequiredEnvVars
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.MissingRequiredHeaders != nil {
-		in, out := &in.MissingRequiredHeaders, &out.MissingRequiredHeaders
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.MCPServerInstanceUserCount != nil {
-		in, out := &in.MCPServerInstanceUserCount, &out.MCPServerInstanceUserCount
-		*out = new(int)
-		**out = **in
-	}
-	if in.DeploymentAvailableReplicas != nil {
-		in, out := &in.DeploymentAvailableReplicas, &out.DeploymentAvailableReplicas
-		*out = new(int32)
-		**out = **in
-	}
-	if in.DeploymentReadyReplicas != nil {
-		in, out := &in.DeploymentReadyReplicas, &out.DeploymentReadyReplicas
-		*out = new(int32)
-		**out = **in
-	}
-	if in.DeploymentReplicas != nil {
-		in, out := &in.DeploymentReplicas, &out.DeploymentReplicas
-		*out = new(int32)
-		**out = **in
-	}
-	if in.DeploymentConditions != nil {
-		in, out := &in.DeploymentConditions, &out.DeploymentConditions
-		*out = make([]DeploymentCondition, len(*in))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPAuditLogSavedSearch, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServer.
-func (in *MCPServer) DeepCopy() *MCPServer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogSavedSearchList.
+func (in *MCPAuditLogSavedSearchList) DeepCopy() *MCPAuditLogSavedSearchList {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServer)
+	out := new(MCPAuditLogSavedSearchList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerCatalogEntry) DeepCopyInto(out *MCPServerCatalogEntry) {
+func (in *MCPAuditLogSavedSearchManifest) DeepCopyInto(out *MCPAuditLogSavedSearchManifest) {
 	*out = *in
-	in.Metadata.DeepCopyInto(&out.Metadata)
-	in.Manifest.DeepCopyInto(&out.Manifest)
-	if in.LastUpdated != nil {
-		in, out := &in.LastUpdated, &out.LastUpdated
-		*out = (*in).DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogSavedSearchManifest.
+func (in *MCPAuditLogSavedSearchManifest) DeepCopy() *MCPAuditLogSavedSearchManifest {
+	if in == nil {
+		return nil
 	}
-	if in.ToolPreviewsLastGenerated != nil {
-		in, out := &in.ToolPreviewsLastGenerated, &out.ToolPreviewsLastGenerated
-		*out = (*in).DeepCopy()
+	out := new(MCPAuditLogSavedSearchManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCatalog) DeepCopyInto(out *MCPCatalog) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.MCPCatalogManifest.DeepCopyInto(&out.MCPCatalogManifest)
+	in.LastSynced.DeepCopyInto(&out.LastSynced)
+	if in.SyncErrors != nil {
+		in, out := &in.SyncErrors, &out.SyncErrors
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntry.
-func (in *MCPServerCatalogEntry) DeepCopy() *MCPServerCatalogEntry {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalog.
+func (in *MCPCatalog) DeepCopy() *MCPCatalog {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerCatalogEntry)
+	out := new(MCPCatalog)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerCatalogEntryList) DeepCopyInto(out *MCPServerCatalogEntryList) {
+func (in *MCPCatalogList) DeepCopyInto(out *MCPCatalogList) {
 	*out = *in
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MCPServerCatalogEntry, len(*in))
+		*out = make([]MCPCatalog, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryList.
-func (in *MCPServerCatalogEntryList) DeepCopy() *MCPServerCatalogEntryList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogList.
+func (in *MCPCatalogList) DeepCopy() *MCPCatalogList {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerCatalogEntryList)
+	out := new(MCPCatalogList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerCatalogEntryManifest) DeepCopyInto(out *MCPServerCatalogEntryManifest) {
+func (in *MCPCatalogManifest) DeepCopyInto(out *MCPCatalogManifest) {
 	*out = *in
-	if in.Metadata != nil {
-		in, out := &in.Metadata, &out.Metadata
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.SourceURLs != nil {
+		in, out := &in.SourceURLs, &out.SourceURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.ToolPreview != nil {
-		in, out := &in.ToolPreview, &out.ToolPreview
-		*out = make([]MCPServerTool, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.SourceConfigs != nil {
+		in, out := &in.SourceConfigs, &out.SourceConfigs
+		*out = make(map[string]MCPCatalogSourceConfig, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
 		}
 	}
-	if in.UVXConfig != nil {
-		in, out := &in.UVXConfig, &out.UVXConfig
-		*out = new(UVXRuntimeConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.NPXConfig != nil {
-		in, out := &in.NPXConfig, &out.NPXConfig
-		*out = new(NPXRuntimeConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ContainerizedConfig != nil {
-		in, out := &in.ContainerizedConfig, &out.ContainerizedConfig
-		*out = new(ContainerizedRuntimeConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RemoteConfig != nil {
-		in, out := &in.RemoteConfig, &out.RemoteConfig
-		*out = new(RemoteCatalogConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CompositeConfig != nil {
-		in, out := &in.CompositeConfig, &out.CompositeConfig
-		*out = new(CompositeCatalogConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]MCPEnv, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryManifest.
-func (in *MCPServerCatalogEntryManifest) DeepCopy() *MCPServerCatalogEntryManifest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogManifest.
+func (in *MCPCatalogManifest) DeepCopy() *MCPCatalogManifest {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerCatalogEntryManifest)
+	out := new(MCPCatalogManifest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerDetails) DeepCopyInto(out *MCPServerDetails) {
+func (in *MCPCatalogSourceConfig) DeepCopyInto(out *MCPCatalogSourceConfig) {
 	*out = *in
-	in.LastRestart.DeepCopyInto(&out.LastRestart)
-	if in.Events != nil {
-		in, out := &in.Events, &out.Events
-		*out = make([]MCPServerEvent, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(MCPRegistrySourceConfig)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerDetails.
-func (in *MCPServerDetails) DeepCopy() *MCPServerDetails {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogSourceConfig.
+func (in *MCPCatalogSourceConfig) DeepCopy() *MCPCatalogSourceConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerDetails)
+	out := new(MCPCatalogSourceConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerEvent) DeepCopyInto(out *MCPServerEvent) {
+func (in *MCPCatalogSourceCredentials) DeepCopyInto(out *MCPCatalogSourceCredentials) {
 	*out = *in
-	in.Time.DeepCopyInto(&out.Time)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerEvent.
-func (in *MCPServerEvent) DeepCopy() *MCPServerEvent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogSourceCredentials.
+func (in *MCPCatalogSourceCredentials) DeepCopy() *MCPCatalogSourceCredentials {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerEvent)
+	out := new(MCPCatalogSourceCredentials)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerInstance) DeepCopyInto(out *MCPServerInstance) {
+func (in *MCPEnv) DeepCopyInto(out *MCPEnv) {
 	*out = *in
-	in.Metadata.DeepCopyInto(&out.Metadata)
+	out.MCPHeader = in.MCPHeader
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerInstance.
-func (in *MCPServerInstance) DeepCopy() *MCPServerInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPEnv.
+func (in *MCPEnv) DeepCopy() *MCPEnv {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerInstance)
+	out := new(MCPEnv)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerInstanceList) DeepCopyInto(out *MCPServerInstanceList) {
+func (in *MCPHeader) DeepCopyInto(out *MCPHeader) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]MCPServerInstance, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerInstanceList.
-func (in *MCPServerInstanceList) DeepCopy() *MCPServerInstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPHeader.
+func (in *MCPHeader) DeepCopy() *MCPHeader {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerInstanceList)
+	out := new(MCPHeader)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerList) DeepCopyInto(out *MCPServerList) {
+func (in *MCPImagePolicy) DeepCopyInto(out *MCPImagePolicy) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]MCPServer, len(*in))
-		for i := range *in {
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.MCPImagePolicyManifest.DeepCopyInto(&out.MCPImagePolicyManifest)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPImagePolicy.
+func (in *MCPImagePolicy) DeepCopy() *MCPImagePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPImagePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPImagePolicyManifest) DeepCopyInto(out *MCPImagePolicyManifest) {
+	*out = *in
+	if in.AllowedRegistries != nil {
+		in, out := &in.AllowedRegistries, &out.AllowedRegistries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CosignPublicKeys != nil {
+		in, out := &in.CosignPublicKeys, &out.CosignPublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPImagePolicyManifest.
+func (in *MCPImagePolicyManifest) DeepCopy() *MCPImagePolicyManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPImagePolicyManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPInjectionScanFinding) DeepCopyInto(out *MCPInjectionScanFinding) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPInjectionScanFinding.
+func (in *MCPInjectionScanFinding) DeepCopy() *MCPInjectionScanFinding {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPInjectionScanFinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPInjectionScanPattern) DeepCopyInto(out *MCPInjectionScanPattern) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPInjectionScanPattern.
+func (in *MCPInjectionScanPattern) DeepCopy() *MCPInjectionScanPattern {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPInjectionScanPattern)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPInjectionScanPolicy) DeepCopyInto(out *MCPInjectionScanPolicy) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.MCPInjectionScanPolicyManifest.DeepCopyInto(&out.MCPInjectionScanPolicyManifest)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPInjectionScanPolicy.
+func (in *MCPInjectionScanPolicy) DeepCopy() *MCPInjectionScanPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPInjectionScanPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPInjectionScanPolicyManifest) DeepCopyInto(out *MCPInjectionScanPolicyManifest) {
+	*out = *in
+	if in.DisabledRules != nil {
+		in, out := &in.DisabledRules, &out.DisabledRules
+		*out = make([]MCPInjectionScanRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.Patterns != nil {
+		in, out := &in.Patterns, &out.Patterns
+		*out = make([]MCPInjectionScanPattern, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPInjectionScanPolicyManifest.
+func (in *MCPInjectionScanPolicyManifest) DeepCopy() *MCPInjectionScanPolicyManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPInjectionScanPolicyManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPPromptReadStats) DeepCopyInto(out *MCPPromptReadStats) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPPromptReadStats.
+func (in *MCPPromptReadStats) DeepCopy() *MCPPromptReadStats {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPPromptReadStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPRegistrySourceConfig) DeepCopyInto(out *MCPRegistrySourceConfig) {
+	*out = *in
+	if in.AllowedServers != nil {
+		in, out := &in.AllowedServers, &out.AllowedServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedServers != nil {
+		in, out := &in.DeniedServers, &out.DeniedServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AutoImportPublishers != nil {
+		in, out := &in.AutoImportPublishers, &out.AutoImportPublishers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPRegistrySourceConfig.
+func (in *MCPRegistrySourceConfig) DeepCopy() *MCPRegistrySourceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPRegistrySourceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPResourceReadStats) DeepCopyInto(out *MCPResourceReadStats) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPResourceReadStats.
+func (in *MCPResourceReadStats) DeepCopy() *MCPResourceReadStats {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPResourceReadStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPSelector) DeepCopyInto(out *MCPSelector) {
+	*out = *in
+	if in.Identifiers != nil {
+		in, out := &in.Identifiers, &out.Identifiers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPSelector.
+func (in *MCPSelector) DeepCopy() *MCPSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in MCPSelectors) DeepCopyInto(out *MCPSelectors) {
+	{
+		in := &in
+		*out = make(MCPSelectors, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPSelectors.
+func (in MCPSelectors) DeepCopy() MCPSelectors {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPSelectors)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServer) DeepCopyInto(out *MCPServer) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.MCPServerManifest.DeepCopyInto(&out.MCPServerManifest)
+	if in.MissingRequiredEnvVars != nil {
+		in, out := &in.MissingRequiredEnvVars, &out.MissingRequiredEnvVars
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MissingRequiredHeaders != nil {
+		in, out := &in.MissingRequiredHeaders, &out.MissingRequiredHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MCPServerInstanceUserCount != nil {
+		in, out := &in.MCPServerInstanceUserCount, &out.MCPServerInstanceUserCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.IdleTimeoutMinutes != nil {
+		in, out := &in.IdleTimeoutMinutes, &out.IdleTimeoutMinutes
+		*out = new(int)
+		**out = **in
+	}
+	if in.Scaling != nil {
+		in, out := &in.Scaling, &out.Scaling
+		*out = new(MCPServerScaling)
+		**out = **in
+	}
+	if in.DeploymentAvailableReplicas != nil {
+		in, out := &in.DeploymentAvailableReplicas, &out.DeploymentAvailableReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeploymentReadyReplicas != nil {
+		in, out := &in.DeploymentReadyReplicas, &out.DeploymentReadyReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeploymentReplicas != nil {
+		in, out := &in.DeploymentReplicas, &out.DeploymentReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeploymentConditions != nil {
+		in, out := &in.DeploymentConditions, &out.DeploymentConditions
+		*out = make([]DeploymentCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServer.
+func (in *MCPServer) DeepCopy() *MCPServer {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntry) DeepCopyInto(out *MCPServerCatalogEntry) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.Manifest.DeepCopyInto(&out.Manifest)
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	if in.ToolPreviewsLastGenerated != nil {
+		in, out := &in.ToolPreviewsLastGenerated, &out.ToolPreviewsLastGenerated
+		*out = (*in).DeepCopy()
+	}
+	if in.ToolPreviewScanFindings != nil {
+		in, out := &in.ToolPreviewScanFindings, &out.ToolPreviewScanFindings
+		*out = make([]MCPInjectionScanFinding, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntry.
+func (in *MCPServerCatalogEntry) DeepCopy() *MCPServerCatalogEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryInput) DeepCopyInto(out *MCPServerCatalogEntryInput) {
+	*out = *in
+	in.MCPServerCatalogEntryManifest.DeepCopyInto(&out.MCPServerCatalogEntryManifest)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryInput.
+func (in *MCPServerCatalogEntryInput) DeepCopy() *MCPServerCatalogEntryInput {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryList) DeepCopyInto(out *MCPServerCatalogEntryList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServerCatalogEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryList.
+func (in *MCPServerCatalogEntryList) DeepCopy() *MCPServerCatalogEntryList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryManifest) DeepCopyInto(out *MCPServerCatalogEntryManifest) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ToolPreview != nil {
+		in, out := &in.ToolPreview, &out.ToolPreview
+		*out = make([]MCPServerTool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UVXConfig != nil {
+		in, out := &in.UVXConfig, &out.UVXConfig
+		*out = new(UVXRuntimeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NPXConfig != nil {
+		in, out := &in.NPXConfig, &out.NPXConfig
+		*out = new(NPXRuntimeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerizedConfig != nil {
+		in, out := &in.ContainerizedConfig, &out.ContainerizedConfig
+		*out = new(ContainerizedRuntimeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemoteConfig != nil {
+		in, out := &in.RemoteConfig, &out.RemoteConfig
+		*out = new(RemoteCatalogConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CompositeConfig != nil {
+		in, out := &in.CompositeConfig, &out.CompositeConfig
+		*out = new(CompositeCatalogConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]MCPEnv, len(*in))
+		copy(*out, *in)
+	}
+	if in.K8sOverrides != nil {
+		in, out := &in.K8sOverrides, &out.K8sOverrides
+		*out = new(K8sOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressPolicy != nil {
+		in, out := &in.EgressPolicy, &out.EgressPolicy
+		*out = new(EgressPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PersistentVolume != nil {
+		in, out := &in.PersistentVolume, &out.PersistentVolume
+		*out = new(PersistentVolume)
+		**out = **in
+	}
+	if in.HealthProbe != nil {
+		in, out := &in.HealthProbe, &out.HealthProbe
+		*out = new(HealthProbe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(Rollout)
+		**out = **in
+	}
+	if in.AuditLogRedaction != nil {
+		in, out := &in.AuditLogRedaction, &out.AuditLogRedaction
+		*out = new(AuditLogRedaction)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryManifest.
+func (in *MCPServerCatalogEntryManifest) DeepCopy() *MCPServerCatalogEntryManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryVersion) DeepCopyInto(out *MCPServerCatalogEntryVersion) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.Manifest.DeepCopyInto(&out.Manifest)
+	if in.RolloutUserIDs != nil {
+		in, out := &in.RolloutUserIDs, &out.RolloutUserIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryVersion.
+func (in *MCPServerCatalogEntryVersion) DeepCopy() *MCPServerCatalogEntryVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryVersionDiff) DeepCopyInto(out *MCPServerCatalogEntryVersionDiff) {
+	*out = *in
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]ManifestFieldChange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryVersionDiff.
+func (in *MCPServerCatalogEntryVersionDiff) DeepCopy() *MCPServerCatalogEntryVersionDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryVersionDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryVersionList) DeepCopyInto(out *MCPServerCatalogEntryVersionList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServerCatalogEntryVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryVersionList.
+func (in *MCPServerCatalogEntryVersionList) DeepCopy() *MCPServerCatalogEntryVersionList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryVersionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryVersionRollout) DeepCopyInto(out *MCPServerCatalogEntryVersionRollout) {
+	*out = *in
+	if in.UserIDs != nil {
+		in, out := &in.UserIDs, &out.UserIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryVersionRollout.
+func (in *MCPServerCatalogEntryVersionRollout) DeepCopy() *MCPServerCatalogEntryVersionRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryVersionRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerDetails) DeepCopyInto(out *MCPServerDetails) {
+	*out = *in
+	in.LastRestart.DeepCopyInto(&out.LastRestart)
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]MCPServerEvent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]DeploymentCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerDetails.
+func (in *MCPServerDetails) DeepCopy() *MCPServerDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerEvent) DeepCopyInto(out *MCPServerEvent) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerEvent.
+func (in *MCPServerEvent) DeepCopy() *MCPServerEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerHealth) DeepCopyInto(out *MCPServerHealth) {
+	*out = *in
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = make([]MCPServerProbeResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerHealth.
+func (in *MCPServerHealth) DeepCopy() *MCPServerHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerHealthList) DeepCopyInto(out *MCPServerHealthList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServerHealth, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerHealthList.
+func (in *MCPServerHealthList) DeepCopy() *MCPServerHealthList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerHealthList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerHistory) DeepCopyInto(out *MCPServerHistory) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]MCPServerEvent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Logs != nil {
+		in, out := &in.Logs, &out.Logs
+		*out = make([]MCPServerLogLine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerHistory.
+func (in *MCPServerHistory) DeepCopy() *MCPServerHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerIdleTimeout) DeepCopyInto(out *MCPServerIdleTimeout) {
+	*out = *in
+	if in.Minutes != nil {
+		in, out := &in.Minutes, &out.Minutes
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerIdleTimeout.
+func (in *MCPServerIdleTimeout) DeepCopy() *MCPServerIdleTimeout {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerIdleTimeout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerInstance) DeepCopyInto(out *MCPServerInstance) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerInstance.
+func (in *MCPServerInstance) DeepCopy() *MCPServerInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerInstanceList) DeepCopyInto(out *MCPServerInstanceList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServerInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerInstanceList.
+func (in *MCPServerInstanceList) DeepCopy() *MCPServerInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerList) DeepCopyInto(out *MCPServerList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerList.
+func (in *MCPServerList) DeepCopy() *MCPServerList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerLogLine) DeepCopyInto(out *MCPServerLogLine) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerLogLine.
+func (in *MCPServerLogLine) DeepCopy() *MCPServerLogLine {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerLogLine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerManifest) DeepCopyInto(out *MCPServerManifest) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ToolPreview != nil {
+		in, out := &in.ToolPreview, &out.ToolPreview
+		*out = make([]MCPServerTool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UVXConfig != nil {
+		in, out := &in.UVXConfig, &out.UVXConfig
+		*out = new(UVXRuntimeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NPXConfig != nil {
+		in, out := &in.NPXConfig, &out.NPXConfig
+		*out = new(NPXRuntimeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerizedConfig != nil {
+		in, out := &in.ContainerizedConfig, &out.ContainerizedConfig
+		*out = new(ContainerizedRuntimeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemoteConfig != nil {
+		in, out := &in.RemoteConfig, &out.RemoteConfig
+		*out = new(RemoteRuntimeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CompositeConfig != nil {
+		in, out := &in.CompositeConfig, &out.CompositeConfig
+		*out = new(CompositeRuntimeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]MCPEnv, len(*in))
+		copy(*out, *in)
+	}
+	if in.K8sOverrides != nil {
+		in, out := &in.K8sOverrides, &out.K8sOverrides
+		*out = new(K8sOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EgressPolicy != nil {
+		in, out := &in.EgressPolicy, &out.EgressPolicy
+		*out = new(EgressPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PersistentVolume != nil {
+		in, out := &in.PersistentVolume, &out.PersistentVolume
+		*out = new(PersistentVolume)
+		**out = **in
+	}
+	if in.HealthProbe != nil {
+		in, out := &in.HealthProbe, &out.HealthProbe
+		*out = new(HealthProbe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(Rollout)
+		**out = **in
+	}
+	if in.AuditLogRedaction != nil {
+		in, out := &in.AuditLogRedaction, &out.AuditLogRedaction
+		*out = new(AuditLogRedaction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]MCPHeader, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerManifest.
+func (in *MCPServerManifest) DeepCopy() *MCPServerManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerNeedingK8sUpdate) DeepCopyInto(out *MCPServerNeedingK8sUpdate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerNeedingK8sUpdate.
+func (in *MCPServerNeedingK8sUpdate) DeepCopy() *MCPServerNeedingK8sUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerNeedingK8sUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerProbeResult) DeepCopyInto(out *MCPServerProbeResult) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerProbeResult.
+func (in *MCPServerProbeResult) DeepCopy() *MCPServerProbeResult {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerProbeResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerScaling) DeepCopyInto(out *MCPServerScaling) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerScaling.
+func (in *MCPServerScaling) DeepCopy() *MCPServerScaling {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerScaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerTool) DeepCopyInto(out *MCPServerTool) {
+	*out = *in
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerTool.
+func (in *MCPServerTool) DeepCopy() *MCPServerTool {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerTool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerVersionPin) DeepCopyInto(out *MCPServerVersionPin) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerVersionPin.
+func (in *MCPServerVersionPin) DeepCopy() *MCPServerVersionPin {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerVersionPin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServersNeedingK8sUpdateList) DeepCopyInto(out *MCPServersNeedingK8sUpdateList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServerNeedingK8sUpdate, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServersNeedingK8sUpdateList.
+func (in *MCPServersNeedingK8sUpdateList) DeepCopy() *MCPServersNeedingK8sUpdateList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServersNeedingK8sUpdateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolApprovalPolicy) DeepCopyInto(out *MCPToolApprovalPolicy) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.MCPToolApprovalPolicyManifest.DeepCopyInto(&out.MCPToolApprovalPolicyManifest)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolApprovalPolicy.
+func (in *MCPToolApprovalPolicy) DeepCopy() *MCPToolApprovalPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolApprovalPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolApprovalPolicyList) DeepCopyInto(out *MCPToolApprovalPolicyList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPToolApprovalPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolApprovalPolicyList.
+func (in *MCPToolApprovalPolicyList) DeepCopy() *MCPToolApprovalPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolApprovalPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolApprovalPolicyManifest) DeepCopyInto(out *MCPToolApprovalPolicyManifest) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]Resource, len(*in))
+		copy(*out, *in)
+	}
+	if in.Selectors != nil {
+		in, out := &in.Selectors, &out.Selectors
+		*out = make(MCPSelectors, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Approvers != nil {
+		in, out := &in.Approvers, &out.Approvers
+		*out = make([]Subject, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolApprovalPolicyManifest.
+func (in *MCPToolApprovalPolicyManifest) DeepCopy() *MCPToolApprovalPolicyManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolApprovalPolicyManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolCallApproval) DeepCopyInto(out *MCPToolCallApproval) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	if in.Approvers != nil {
+		in, out := &in.Approvers, &out.Approvers
+		*out = make([]Subject, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.DecidedAt != nil {
+		in, out := &in.DecidedAt, &out.DecidedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolCallApproval.
+func (in *MCPToolCallApproval) DeepCopy() *MCPToolCallApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolCallApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolCallApprovalDecision) DeepCopyInto(out *MCPToolCallApprovalDecision) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolCallApprovalDecision.
+func (in *MCPToolCallApprovalDecision) DeepCopy() *MCPToolCallApprovalDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolCallApprovalDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolCallApprovalList) DeepCopyInto(out *MCPToolCallApprovalList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPToolCallApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolCallApprovalList.
+func (in *MCPToolCallApprovalList) DeepCopy() *MCPToolCallApprovalList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolCallApprovalList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolCallStats) DeepCopyInto(out *MCPToolCallStats) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPToolCallStatsItem, len(*in))
+		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerList.
-func (in *MCPServerList) DeepCopy() *MCPServerList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolCallStats.
+func (in *MCPToolCallStats) DeepCopy() *MCPToolCallStats {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolCallStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolCallStatsItem) DeepCopyInto(out *MCPToolCallStatsItem) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolCallStatsItem.
+func (in *MCPToolCallStatsItem) DeepCopy() *MCPToolCallStatsItem {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerList)
+	out := new(MCPToolCallStatsItem)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerManifest) DeepCopyInto(out *MCPServerManifest) {
+func (in *MCPToolDriftPolicy) DeepCopyInto(out *MCPToolDriftPolicy) {
 	*out = *in
-	if in.Metadata != nil {
-		in, out := &in.Metadata, &out.Metadata
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.ToolPreview != nil {
-		in, out := &in.ToolPreview, &out.ToolPreview
-		*out = make([]MCPServerTool, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.UVXConfig != nil {
-		in, out := &in.UVXConfig, &out.UVXConfig
-		*out = new(UVXRuntimeConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.NPXConfig != nil {
-		in, out := &in.NPXConfig, &out.NPXConfig
-		*out = new(NPXRuntimeConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ContainerizedConfig != nil {
-		in, out := &in.ContainerizedConfig, &out.ContainerizedConfig
-		*out = new(ContainerizedRuntimeConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RemoteConfig != nil {
-		in, out := &in.RemoteConfig, &out.RemoteConfig
-		*out = new(RemoteRuntimeConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CompositeConfig != nil {
-		in, out := &in.CompositeConfig, &out.CompositeConfig
-		*out = new(CompositeRuntimeConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]MCPEnv, len(*in))
-		copy(*out, *in)
-	}
-	if in.Args != nil {
-		in, out := &in.Args, &out.Args
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Headers != nil {
-		in, out := &in.Headers, &out.Headers
-		*out = make([]MCPHeader, len(*in))
-		copy(*out, *in)
-	}
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	out.MCPToolDriftPolicyManifest = in.MCPToolDriftPolicyManifest
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerManifest.
-func (in *MCPServerManifest) DeepCopy() *MCPServerManifest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolDriftPolicy.
+func (in *MCPToolDriftPolicy) DeepCopy() *MCPToolDriftPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerManifest)
+	out := new(MCPToolDriftPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerNeedingK8sUpdate) DeepCopyInto(out *MCPServerNeedingK8sUpdate) {
+func (in *MCPToolDriftPolicyManifest) DeepCopyInto(out *MCPToolDriftPolicyManifest) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerNeedingK8sUpdate.
-func (in *MCPServerNeedingK8sUpdate) DeepCopy() *MCPServerNeedingK8sUpdate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolDriftPolicyManifest.
+func (in *MCPToolDriftPolicyManifest) DeepCopy() *MCPToolDriftPolicyManifest {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerNeedingK8sUpdate)
+	out := new(MCPToolDriftPolicyManifest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerTool) DeepCopyInto(out *MCPServerTool) {
+func (in *MCPToolPin) DeepCopyInto(out *MCPToolPin) {
 	*out = *in
-	if in.Params != nil {
-		in, out := &in.Params, &out.Params
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	if in.InputSchema != nil {
+		in, out := &in.InputSchema, &out.InputSchema
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
 	}
-	if in.Credentials != nil {
-		in, out := &in.Credentials, &out.Credentials
-		*out = make([]string, len(*in))
+	if in.CurrentInputSchema != nil {
+		in, out := &in.CurrentInputSchema, &out.CurrentInputSchema
+		*out = make(json.RawMessage, len(*in))
 		copy(*out, *in)
 	}
+	if in.ChangedAt != nil {
+		in, out := &in.ChangedAt, &out.ChangedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ApprovedAt != nil {
+		in, out := &in.ApprovedAt, &out.ApprovedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerTool.
-func (in *MCPServerTool) DeepCopy() *MCPServerTool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolPin.
+func (in *MCPToolPin) DeepCopy() *MCPToolPin {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerTool)
+	out := new(MCPToolPin)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServersNeedingK8sUpdateList) DeepCopyInto(out *MCPServersNeedingK8sUpdateList) {
+func (in *MCPToolPinApproval) DeepCopyInto(out *MCPToolPinApproval) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]MCPServerNeedingK8sUpdate, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServersNeedingK8sUpdateList.
-func (in *MCPServersNeedingK8sUpdateList) DeepCopy() *MCPServersNeedingK8sUpdateList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolPinApproval.
+func (in *MCPToolPinApproval) DeepCopy() *MCPToolPinApproval {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServersNeedingK8sUpdateList)
+	out := new(MCPToolPinApproval)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPToolCallStats) DeepCopyInto(out *MCPToolCallStats) {
+func (in *MCPToolPinList) DeepCopyInto(out *MCPToolPinList) {
 	*out = *in
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MCPToolCallStatsItem, len(*in))
+		*out = make([]MCPToolPin, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolCallStats.
-func (in *MCPToolCallStats) DeepCopy() *MCPToolCallStats {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolPinList.
+func (in *MCPToolPinList) DeepCopy() *MCPToolPinList {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPToolCallStats)
+	out := new(MCPToolPinList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPToolCallStatsItem) DeepCopyInto(out *MCPToolCallStatsItem) {
+func (in *MCPToolPinResponse) DeepCopyInto(out *MCPToolPinResponse) {
 	*out = *in
-	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.MCPToolPinList.DeepCopyInto(&out.MCPToolPinList)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolCallStatsItem.
-func (in *MCPToolCallStatsItem) DeepCopy() *MCPToolCallStatsItem {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolPinResponse.
+func (in *MCPToolPinResponse) DeepCopy() *MCPToolPinResponse {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPToolCallStatsItem)
+	out := new(MCPToolPinResponse)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2311,6 +3612,76 @@ func (in *MCPUsageStatsList) DeepCopy() *MCPUsageStatsList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPWebhookShadowReport) DeepCopyInto(out *MCPWebhookShadowReport) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+	if in.BlockedByTool != nil {
+		in, out := &in.BlockedByTool, &out.BlockedByTool
+		*out = make([]MCPWebhookShadowReportCount, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlockedByServer != nil {
+		in, out := &in.BlockedByServer, &out.BlockedByServer
+		*out = make([]MCPWebhookShadowReportCount, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlockedByUser != nil {
+		in, out := &in.BlockedByUser, &out.BlockedByUser
+		*out = make([]MCPWebhookShadowReportCount, len(*in))
+		copy(*out, *in)
+	}
+	if in.RecentBlocked != nil {
+		in, out := &in.RecentBlocked, &out.RecentBlocked
+		*out = make([]MCPWebhookShadowVerdict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPWebhookShadowReport.
+func (in *MCPWebhookShadowReport) DeepCopy() *MCPWebhookShadowReport {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPWebhookShadowReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPWebhookShadowReportCount) DeepCopyInto(out *MCPWebhookShadowReportCount) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPWebhookShadowReportCount.
+func (in *MCPWebhookShadowReportCount) DeepCopy() *MCPWebhookShadowReportCount {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPWebhookShadowReportCount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPWebhookShadowVerdict) DeepCopyInto(out *MCPWebhookShadowVerdict) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPWebhookShadowVerdict.
+func (in *MCPWebhookShadowVerdict) DeepCopy() *MCPWebhookShadowVerdict {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPWebhookShadowVerdict)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MCPWebhookValidation) DeepCopyInto(out *MCPWebhookValidation) {
 	*out = *in
@@ -2377,6 +3748,21 @@ func (in *MCPWebhookValidationManifest) DeepCopy() *MCPWebhookValidationManifest
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestFieldChange) DeepCopyInto(out *ManifestFieldChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestFieldChange.
+func (in *ManifestFieldChange) DeepCopy() *ManifestFieldChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestFieldChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Memory) DeepCopyInto(out *Memory) {
 	*out = *in
@@ -2861,6 +4247,21 @@ func (in *OneDriveConfig) DeepCopy() *OneDriveConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolume) DeepCopyInto(out *PersistentVolume) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolume.
+func (in *PersistentVolume) DeepCopy() *PersistentVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PowerUserWorkspace) DeepCopyInto(out *PowerUserWorkspace) {
 	*out = *in
@@ -3364,6 +4765,21 @@ func (in *ProviderConfigurationParameter) DeepCopy() *ProviderConfigurationParam
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryArgument) DeepCopyInto(out *RegistryArgument) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryArgument.
+func (in *RegistryArgument) DeepCopy() *RegistryArgument {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryArgument)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegistryGitHubMeta) DeepCopyInto(out *RegistryGitHubMeta) {
 	*out = *in
@@ -3379,6 +4795,21 @@ func (in *RegistryGitHubMeta) DeepCopy() *RegistryGitHubMeta {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryKeyValueInput) DeepCopyInto(out *RegistryKeyValueInput) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryKeyValueInput.
+func (in *RegistryKeyValueInput) DeepCopy() *RegistryKeyValueInput {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryKeyValueInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegistryMeta) DeepCopyInto(out *RegistryMeta) {
 	*out = *in
@@ -3430,6 +4861,26 @@ func (in *RegistryOfficialMeta) DeepCopy() *RegistryOfficialMeta {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryPackageTransport) DeepCopyInto(out *RegistryPackageTransport) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]RegistryKeyValueInput, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryPackageTransport.
+func (in *RegistryPackageTransport) DeepCopy() *RegistryPackageTransport {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryPackageTransport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegistryPublisherProvidedMeta) DeepCopyInto(out *RegistryPublisherProvidedMeta) {
 	*out = *in
@@ -3460,10 +4911,19 @@ func (in *RegistryServerDetail) DeepCopyInto(out *RegistryServerDetail) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Packages != nil {
+		in, out := &in.Packages, &out.Packages
+		*out = make([]RegistryServerPackage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Remotes != nil {
 		in, out := &in.Remotes, &out.Remotes
 		*out = make([]RegistryServerRemote, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Repository != nil {
 		in, out := &in.Repository, &out.Repository
@@ -3565,9 +5025,45 @@ func (in *RegistryServerMeta) DeepCopy() *RegistryServerMeta {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryServerPackage) DeepCopyInto(out *RegistryServerPackage) {
+	*out = *in
+	in.Transport.DeepCopyInto(&out.Transport)
+	if in.RuntimeArguments != nil {
+		in, out := &in.RuntimeArguments, &out.RuntimeArguments
+		*out = make([]RegistryArgument, len(*in))
+		copy(*out, *in)
+	}
+	if in.PackageArguments != nil {
+		in, out := &in.PackageArguments, &out.PackageArguments
+		*out = make([]RegistryArgument, len(*in))
+		copy(*out, *in)
+	}
+	if in.EnvironmentVariables != nil {
+		in, out := &in.EnvironmentVariables, &out.EnvironmentVariables
+		*out = make([]RegistryKeyValueInput, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryServerPackage.
+func (in *RegistryServerPackage) DeepCopy() *RegistryServerPackage {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryServerPackage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegistryServerRemote) DeepCopyInto(out *RegistryServerRemote) {
 	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]RegistryKeyValueInput, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryServerRemote.
@@ -3702,6 +5198,21 @@ func (in *Resource) DeepCopy() *Resource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rollout) DeepCopyInto(out *Rollout) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rollout.
+func (in *Rollout) DeepCopy() *Rollout {
+	if in == nil {
+		return nil
+	}
+	out := new(Rollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Run) DeepCopyInto(out *Run) {
 	*out = *in
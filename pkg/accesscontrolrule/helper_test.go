@@ -0,0 +1,103 @@
+package accesscontrolrule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kuser "k8s.io/apiserver/pkg/authentication/user"
+	gocache "k8s.io/client-go/tools/cache"
+)
+
+// testIndexers are the indexes of the access control rule informer that the helper uses.
+var testIndexers = gocache.Indexers{
+	"user-ids": func(obj any) ([]string, error) {
+		var results []string
+		for _, subject := range obj.(*v1.AccessControlRule).Spec.Manifest.Subjects {
+			if subject.Type == types.SubjectTypeUser {
+				results = append(results, subject.ID)
+			}
+		}
+		return results, nil
+	},
+	"server-names": func(obj any) ([]string, error) {
+		var results []string
+		for _, resource := range obj.(*v1.AccessControlRule).Spec.Manifest.Resources {
+			if resource.Type == types.ResourceTypeMCPServer {
+				results = append(results, resource.ID)
+			}
+		}
+		return results, nil
+	},
+	"selectors": func(obj any) ([]string, error) {
+		var results []string
+		for _, resource := range obj.(*v1.AccessControlRule).Spec.Manifest.Resources {
+			if resource.Type == types.ResourceTypeSelector {
+				results = append(results, resource.ID)
+			}
+		}
+		return results, nil
+	},
+}
+
+func rule(name string, expiresAt *time.Time, subjects []types.Subject, resources ...types.Resource) *v1.AccessControlRule {
+	acr := &v1.AccessControlRule{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: system.DefaultNamespace},
+		Spec: v1.AccessControlRuleSpec{
+			MCPCatalogID: system.DefaultCatalog,
+			Manifest: types.AccessControlRuleManifest{
+				DisplayName: name,
+				Subjects:    subjects,
+				Resources:   resources,
+			},
+		},
+	}
+	if expiresAt != nil {
+		acr.Spec.ExpiresAt = &metav1.Time{Time: *expiresAt}
+	}
+	return acr
+}
+
+func newTestHelper(t *testing.T, rules ...*v1.AccessControlRule) *Helper {
+	t.Helper()
+	indexer := gocache.NewIndexer(gocache.MetaNamespaceKeyFunc, testIndexers)
+	for _, r := range rules {
+		require.NoError(t, indexer.Add(r))
+	}
+	return NewAccessControlRuleHelper(indexer, nil)
+}
+
+func TestExpiredRulesAreIgnored(t *testing.T) {
+	var (
+		past   = time.Now().Add(-time.Minute)
+		future = time.Now().Add(time.Hour)
+		user   = types.Subject{Type: types.SubjectTypeUser, ID: "u1"}
+		server = types.Resource{Type: types.ResourceTypeMCPServer, ID: "ms1"}
+	)
+
+	helper := newTestHelper(t,
+		rule("acr1expired", &past, []types.Subject{user}, server),
+		rule("acr2active", &future, []types.Subject{user}, server),
+	)
+
+	rules, err := helper.GetAccessControlRulesForUser(system.DefaultNamespace, "u1")
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	assert.Equal(t, "acr2active", rules[0].Name)
+
+	rules, err = helper.GetAccessControlRulesForMCPServer(system.DefaultNamespace, "ms1")
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	assert.Equal(t, "acr2active", rules[0].Name)
+
+	// Once the only grant has expired, the user loses access.
+	helper = newTestHelper(t, rule("acr1expired", &past, []types.Subject{user}, server))
+	hasAccess, err := helper.UserHasAccessToMCPServerInCatalog(&kuser.DefaultInfo{UID: "u1"}, "ms1", system.DefaultCatalog)
+	require.NoError(t, err)
+	assert.False(t, hasAccess)
+}
@@ -0,0 +1,244 @@
+package accesscontrolrule
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var allResources = types.Resource{
+	Type: types.ResourceTypeSelector,
+	ID:   "*",
+}
+
+// AccessReview returns every grant in the system: grants from access control rules, from the roles of the given users,
+// and from workspace ownership. It is used for periodic access review exports.
+func (h *Helper) AccessReview(ctx context.Context, namespace string, users []gatewaytypes.User) ([]types.EffectiveGrant, error) {
+	grants := h.AllGrants(namespace)
+	grants = append(grants, RoleGrants(users)...)
+
+	ownerGrants, err := h.WorkspaceOwnerGrants(ctx, namespace, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(grants, ownerGrants...), nil
+}
+
+// RoleGrants returns a grant to all resources for each of the given users that is an admin or owner.
+func RoleGrants(users []gatewaytypes.User) []types.EffectiveGrant {
+	var grants []types.EffectiveGrant
+	for _, user := range users {
+		if user.Role.HasRole(types.RoleAdmin) {
+			grants = append(grants, types.EffectiveGrant{
+				Subject: types.Subject{
+					Type: types.SubjectTypeUser,
+					ID:   strconv.FormatUint(uint64(user.ID), 10),
+				},
+				Resource: allResources,
+				Source:   types.GrantSourceRole,
+			})
+		}
+	}
+
+	return grants
+}
+
+// WorkspaceOwnerGrants returns a grant to all resources in each workspace for the workspace's owner.
+// If userID is not empty, only the grant for that user's workspace is returned.
+func (h *Helper) WorkspaceOwnerGrants(ctx context.Context, namespace, userID string) ([]types.EffectiveGrant, error) {
+	var workspaces v1.PowerUserWorkspaceList
+	if err := h.client.List(ctx, &workspaces, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list power user workspaces: %w", err)
+	}
+
+	var grants []types.EffectiveGrant
+	for _, workspace := range workspaces.Items {
+		if workspace.Spec.UserID == "" || userID != "" && workspace.Spec.UserID != userID {
+			continue
+		}
+		grants = append(grants, types.EffectiveGrant{
+			Subject: types.Subject{
+				Type: types.SubjectTypeUser,
+				ID:   workspace.Spec.UserID,
+			},
+			Resource:             allResources,
+			Source:               types.GrantSourceWorkspaceOwner,
+			PowerUserWorkspaceID: workspace.Name,
+		})
+	}
+
+	return grants, nil
+}
+
+// ListAccessControlRules returns all active access control rules in the namespace.
+func (h *Helper) ListAccessControlRules(namespace string) []v1.AccessControlRule {
+	objs := h.acrIndexer.List()
+	result := make([]v1.AccessControlRule, 0, len(objs))
+	for _, obj := range objs {
+		res, ok := obj.(*v1.AccessControlRule)
+		if ok && res.Namespace == namespace && res.DeletionTimestamp.IsZero() && !res.IsExpired() {
+			result = append(result, *res)
+		}
+	}
+
+	return result
+}
+
+// AllGrants returns a grant for every subject and resource pair on every active access control rule.
+func (h *Helper) AllGrants(namespace string) []types.EffectiveGrant {
+	var grants []types.EffectiveGrant
+	for _, rule := range h.ListAccessControlRules(namespace) {
+		for _, subject := range rule.Spec.Manifest.Subjects {
+			for _, resource := range rule.Spec.Manifest.Resources {
+				grants = append(grants, grantFromRule(rule, subject, resource))
+			}
+		}
+	}
+
+	return grants
+}
+
+// GrantsForUser returns the grants from access control rules that apply to the user with the given ID and auth provider groups.
+// Wildcard selectors are expanded into a grant for each catalog entry and multi-user server in the rule's catalog or workspace.
+// It does not include grants that come from the user's role or from owning a workspace.
+func (h *Helper) GrantsForUser(ctx context.Context, namespace, userID string, groupIDs []string) ([]types.EffectiveGrant, error) {
+	groups := make(map[string]struct{}, len(groupIDs))
+	for _, group := range groupIDs {
+		groups[group] = struct{}{}
+	}
+
+	var (
+		grants   []types.EffectiveGrant
+		selected = map[string][]types.Resource{}
+	)
+	for _, rule := range h.ListAccessControlRules(namespace) {
+		for _, subject := range rule.Spec.Manifest.Subjects {
+			if !subjectMatches(subject, userID, groups) {
+				continue
+			}
+			for _, resource := range rule.Spec.Manifest.Resources {
+				if resource != allResources {
+					grants = append(grants, grantFromRule(rule, subject, resource))
+					continue
+				}
+
+				scope := rule.Spec.MCPCatalogID + "/" + rule.Spec.PowerUserWorkspaceID
+				resources, ok := selected[scope]
+				if !ok {
+					var err error
+					if resources, err = h.selectedResources(ctx, namespace, rule.Spec.MCPCatalogID, rule.Spec.PowerUserWorkspaceID); err != nil {
+						return nil, err
+					}
+					selected[scope] = resources
+				}
+				for _, r := range resources {
+					grants = append(grants, grantFromRule(rule, subject, r))
+				}
+			}
+		}
+	}
+
+	return grants, nil
+}
+
+// selectedResources returns the catalog entries and multi-user servers in the given catalog or workspace
+// that a wildcard selector grants access to.
+func (h *Helper) selectedResources(ctx context.Context, namespace, catalogID, workspaceID string) ([]types.Resource, error) {
+	var entryFields, serverFields client.MatchingFields
+	if workspaceID != "" {
+		entryFields = client.MatchingFields{"spec.powerUserWorkspaceID": workspaceID}
+		serverFields = client.MatchingFields{"spec.powerUserWorkspaceID": workspaceID}
+	} else {
+		entryFields = client.MatchingFields{"spec.mcpCatalogName": catalogID}
+		serverFields = client.MatchingFields{"spec.mcpCatalogID": catalogID}
+	}
+
+	var entries v1.MCPServerCatalogEntryList
+	if err := h.client.List(ctx, &entries, client.InNamespace(namespace), entryFields); err != nil {
+		return nil, fmt.Errorf("failed to list MCP server catalog entries: %w", err)
+	}
+
+	var servers v1.MCPServerList
+	if err := h.client.List(ctx, &servers, client.InNamespace(namespace), serverFields); err != nil {
+		return nil, fmt.Errorf("failed to list MCP servers: %w", err)
+	}
+
+	resources := make([]types.Resource, 0, len(entries.Items)+len(servers.Items))
+	for _, entry := range entries.Items {
+		if entry.DeletionTimestamp.IsZero() {
+			resources = append(resources, types.Resource{
+				Type: types.ResourceTypeMCPServerCatalogEntry,
+				ID:   entry.Name,
+			})
+		}
+	}
+	for _, server := range servers.Items {
+		if server.DeletionTimestamp.IsZero() && !server.Spec.Template {
+			resources = append(resources, types.Resource{
+				Type: types.ResourceTypeMCPServer,
+				ID:   server.Name,
+			})
+		}
+	}
+
+	return resources, nil
+}
+
+// GrantsForResource returns the grants from access control rules in the given catalog or workspace that apply to the resource,
+// either directly or through a wildcard selector.
+func (h *Helper) GrantsForResource(namespace string, resource types.Resource, catalogID, workspaceID string) []types.EffectiveGrant {
+	var grants []types.EffectiveGrant
+	for _, rule := range h.ListAccessControlRules(namespace) {
+		if workspaceID != "" {
+			if rule.Spec.PowerUserWorkspaceID != workspaceID {
+				continue
+			}
+		} else if rule.Spec.MCPCatalogID != catalogID {
+			continue
+		}
+
+		for _, r := range rule.Spec.Manifest.Resources {
+			if r != resource && (r.Type != types.ResourceTypeSelector || r.ID != "*") {
+				continue
+			}
+			for _, subject := range rule.Spec.Manifest.Subjects {
+				grants = append(grants, grantFromRule(rule, subject, r))
+			}
+		}
+	}
+
+	return grants
+}
+
+func subjectMatches(subject types.Subject, userID string, groups map[string]struct{}) bool {
+	switch subject.Type {
+	case types.SubjectTypeUser:
+		return subject.ID == userID
+	case types.SubjectTypeGroup:
+		_, ok := groups[subject.ID]
+		return ok
+	case types.SubjectTypeSelector:
+		return subject.ID == "*"
+	}
+	return false
+}
+
+func grantFromRule(rule v1.AccessControlRule, subject types.Subject, resource types.Resource) types.EffectiveGrant {
+	return types.EffectiveGrant{
+		Subject:               subject,
+		Resource:              resource,
+		Source:                types.GrantSourceAccessControlRule,
+		MCPCatalogID:          rule.Spec.MCPCatalogID,
+		PowerUserWorkspaceID:  rule.Spec.PowerUserWorkspaceID,
+		AccessControlRuleID:   rule.Name,
+		AccessControlRuleName: rule.Spec.Manifest.DisplayName,
+		MCPAccessRequestID:    rule.Spec.MCPAccessRequestName,
+		ExpiresAt:             v1.NewTime(rule.Spec.ExpiresAt),
+	}
+}
@@ -0,0 +1,267 @@
+package accesscontrolrule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/storage/scheme"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gocache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var (
+	userSubject     = types.Subject{Type: types.SubjectTypeUser, ID: "u1"}
+	groupSubject    = types.Subject{Type: types.SubjectTypeGroup, ID: "g1"}
+	everyoneSubject = types.Subject{Type: types.SubjectTypeSelector, ID: "*"}
+
+	server1 = types.Resource{Type: types.ResourceTypeMCPServer, ID: "ms1"}
+	server2 = types.Resource{Type: types.ResourceTypeMCPServer, ID: "ms2"}
+	entry1  = types.Resource{Type: types.ResourceTypeMCPServerCatalogEntry, ID: "entry1"}
+)
+
+// newReviewTestHelper returns a helper whose client has the catalog entries and servers that wildcard selectors expand to.
+func newReviewTestHelper(t *testing.T, rules ...*v1.AccessControlRule) *Helper {
+	t.Helper()
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(
+			&v1.MCPServerCatalogEntry{
+				ObjectMeta: metav1.ObjectMeta{Name: "entry1", Namespace: system.DefaultNamespace},
+				Spec:       v1.MCPServerCatalogEntrySpec{MCPCatalogName: system.DefaultCatalog},
+			},
+			&v1.MCPServerCatalogEntry{
+				ObjectMeta: metav1.ObjectMeta{Name: "entry2", Namespace: system.DefaultNamespace},
+				Spec:       v1.MCPServerCatalogEntrySpec{PowerUserWorkspaceID: "puw1"},
+			},
+			&v1.MCPServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "ms1", Namespace: system.DefaultNamespace},
+				Spec:       v1.MCPServerSpec{MCPCatalogID: system.DefaultCatalog},
+			},
+			&v1.MCPServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "ms2", Namespace: system.DefaultNamespace},
+				Spec:       v1.MCPServerSpec{PowerUserWorkspaceID: "puw1"},
+			},
+			// Template servers are not granted by selectors.
+			&v1.MCPServer{
+				ObjectMeta: metav1.ObjectMeta{Name: "ms3", Namespace: system.DefaultNamespace},
+				Spec:       v1.MCPServerSpec{MCPCatalogID: system.DefaultCatalog, Template: true},
+			},
+		).
+		WithIndex(&v1.MCPServerCatalogEntry{}, "spec.mcpCatalogName", func(obj client.Object) []string {
+			return []string{obj.(*v1.MCPServerCatalogEntry).Spec.MCPCatalogName}
+		}).
+		WithIndex(&v1.MCPServerCatalogEntry{}, "spec.powerUserWorkspaceID", func(obj client.Object) []string {
+			return []string{obj.(*v1.MCPServerCatalogEntry).Spec.PowerUserWorkspaceID}
+		}).
+		WithIndex(&v1.MCPServer{}, "spec.mcpCatalogID", func(obj client.Object) []string {
+			return []string{obj.(*v1.MCPServer).Spec.MCPCatalogID}
+		}).
+		WithIndex(&v1.MCPServer{}, "spec.powerUserWorkspaceID", func(obj client.Object) []string {
+			return []string{obj.(*v1.MCPServer).Spec.PowerUserWorkspaceID}
+		}).
+		Build()
+
+	indexer := gocache.NewIndexer(gocache.MetaNamespaceKeyFunc, testIndexers)
+	for _, r := range rules {
+		require.NoError(t, indexer.Add(r))
+	}
+	return NewAccessControlRuleHelper(indexer, c)
+}
+
+func workspaceRule(name, workspaceID string, subjects []types.Subject, resources ...types.Resource) *v1.AccessControlRule {
+	acr := rule(name, nil, subjects, resources...)
+	acr.Spec.MCPCatalogID = ""
+	acr.Spec.PowerUserWorkspaceID = workspaceID
+	return acr
+}
+
+// grantKey identifies a grant by its rule, subject, and resource.
+type grantKey struct {
+	rule     string
+	subject  types.Subject
+	resource types.Resource
+}
+
+func grantKeys(grants []types.EffectiveGrant) []grantKey {
+	keys := make([]grantKey, 0, len(grants))
+	for _, grant := range grants {
+		keys = append(keys, grantKey{rule: grant.AccessControlRuleID, subject: grant.Subject, resource: grant.Resource})
+	}
+	return keys
+}
+
+func TestSubjectMatches(t *testing.T) {
+	groups := map[string]struct{}{"g1": {}}
+
+	for _, tt := range []struct {
+		name     string
+		subject  types.Subject
+		expected bool
+	}{
+		{name: "user", subject: userSubject, expected: true},
+		{name: "other user", subject: types.Subject{Type: types.SubjectTypeUser, ID: "u2"}},
+		{name: "group", subject: groupSubject, expected: true},
+		{name: "other group", subject: types.Subject{Type: types.SubjectTypeGroup, ID: "g2"}},
+		{name: "everyone", subject: everyoneSubject, expected: true},
+		{name: "other selector", subject: types.Subject{Type: types.SubjectTypeSelector, ID: "u1"}},
+		// A group with the user's ID is not the user.
+		{name: "group with user ID", subject: types.Subject{Type: types.SubjectTypeGroup, ID: "u1"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, subjectMatches(tt.subject, "u1", groups))
+		})
+	}
+}
+
+func TestGrantsForUser(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+
+	for _, tt := range []struct {
+		name     string
+		rules    []*v1.AccessControlRule
+		groupIDs []string
+		expected []grantKey
+	}{
+		{
+			name:     "user",
+			rules:    []*v1.AccessControlRule{rule("acr1", nil, []types.Subject{userSubject}, server1)},
+			expected: []grantKey{{rule: "acr1", subject: userSubject, resource: server1}},
+		},
+		{
+			name:  "other user",
+			rules: []*v1.AccessControlRule{rule("acr1", nil, []types.Subject{{Type: types.SubjectTypeUser, ID: "u2"}}, server1)},
+		},
+		{
+			name:     "group",
+			rules:    []*v1.AccessControlRule{rule("acr1", nil, []types.Subject{groupSubject}, entry1)},
+			groupIDs: []string{"g1"},
+			expected: []grantKey{{rule: "acr1", subject: groupSubject, resource: entry1}},
+		},
+		{
+			name:  "group the user is not in",
+			rules: []*v1.AccessControlRule{rule("acr1", nil, []types.Subject{groupSubject}, entry1)},
+		},
+		{
+			name:  "everyone",
+			rules: []*v1.AccessControlRule{rule("acr1", nil, []types.Subject{everyoneSubject}, server1, entry1)},
+			expected: []grantKey{
+				{rule: "acr1", subject: everyoneSubject, resource: server1},
+				{rule: "acr1", subject: everyoneSubject, resource: entry1},
+			},
+		},
+		{
+			name:  "catalog selector",
+			rules: []*v1.AccessControlRule{rule("acr1", nil, []types.Subject{userSubject}, allResources)},
+			expected: []grantKey{
+				{rule: "acr1", subject: userSubject, resource: entry1},
+				{rule: "acr1", subject: userSubject, resource: server1},
+			},
+		},
+		{
+			name:  "workspace selector",
+			rules: []*v1.AccessControlRule{workspaceRule("acr1", "puw1", []types.Subject{userSubject}, allResources)},
+			expected: []grantKey{
+				{rule: "acr1", subject: userSubject, resource: types.Resource{Type: types.ResourceTypeMCPServerCatalogEntry, ID: "entry2"}},
+				{rule: "acr1", subject: userSubject, resource: server2},
+			},
+		},
+		{
+			name:  "expired",
+			rules: []*v1.AccessControlRule{rule("acr1", &past, []types.Subject{userSubject}, server1, allResources)},
+		},
+		{
+			name: "user and group",
+			rules: []*v1.AccessControlRule{
+				rule("acr1", nil, []types.Subject{userSubject, groupSubject}, server1),
+				rule("acr2expired", &past, []types.Subject{groupSubject}, entry1),
+			},
+			groupIDs: []string{"g1"},
+			expected: []grantKey{
+				{rule: "acr1", subject: userSubject, resource: server1},
+				{rule: "acr1", subject: groupSubject, resource: server1},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			grants, err := newReviewTestHelper(t, tt.rules...).GrantsForUser(context.Background(), system.DefaultNamespace, "u1", tt.groupIDs)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.expected, grantKeys(grants))
+		})
+	}
+}
+
+func TestGrantsForResource(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+
+	for _, tt := range []struct {
+		name        string
+		rules       []*v1.AccessControlRule
+		resource    types.Resource
+		catalogID   string
+		workspaceID string
+		expected    []grantKey
+	}{
+		{
+			name:      "direct",
+			rules:     []*v1.AccessControlRule{rule("acr1", nil, []types.Subject{userSubject, groupSubject}, server1)},
+			resource:  server1,
+			catalogID: system.DefaultCatalog,
+			expected: []grantKey{
+				{rule: "acr1", subject: userSubject, resource: server1},
+				{rule: "acr1", subject: groupSubject, resource: server1},
+			},
+		},
+		{
+			name:      "other resource",
+			rules:     []*v1.AccessControlRule{rule("acr1", nil, []types.Subject{userSubject}, entry1)},
+			resource:  server1,
+			catalogID: system.DefaultCatalog,
+		},
+		{
+			name:      "selector",
+			rules:     []*v1.AccessControlRule{rule("acr1", nil, []types.Subject{everyoneSubject}, allResources)},
+			resource:  entry1,
+			catalogID: system.DefaultCatalog,
+			expected:  []grantKey{{rule: "acr1", subject: everyoneSubject, resource: allResources}},
+		},
+		{
+			name:      "other catalog",
+			rules:     []*v1.AccessControlRule{rule("acr1", nil, []types.Subject{userSubject}, server1, allResources)},
+			resource:  server1,
+			catalogID: "other",
+		},
+		{
+			name:        "workspace",
+			rules:       []*v1.AccessControlRule{workspaceRule("acr1", "puw1", []types.Subject{userSubject}, server2)},
+			resource:    server2,
+			workspaceID: "puw1",
+			expected:    []grantKey{{rule: "acr1", subject: userSubject, resource: server2}},
+		},
+		{
+			name:        "catalog rule for a workspace resource",
+			rules:       []*v1.AccessControlRule{rule("acr1", nil, []types.Subject{userSubject}, allResources)},
+			resource:    server2,
+			workspaceID: "puw1",
+		},
+		{
+			name:      "expired",
+			rules:     []*v1.AccessControlRule{rule("acr1", &past, []types.Subject{userSubject}, server1, allResources)},
+			resource:  server1,
+			catalogID: system.DefaultCatalog,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			grants := newReviewTestHelper(t, tt.rules...).GrantsForResource(system.DefaultNamespace, tt.resource, tt.catalogID, tt.workspaceID)
+			assert.ElementsMatch(t, tt.expected, grantKeys(grants))
+		})
+	}
+}
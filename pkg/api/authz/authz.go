@@ -56,6 +56,8 @@ var (
 		"/api/workspaces/",
 		"/api/mcp-webhook-validations",
 		"/api/mcp-webhook-validations/",
+		"/api/mcp-tool-approval-policies",
+		"/api/mcp-tool-approval-policies/",
 		"/api/system-mcp-servers",
 		"/api/system-mcp-servers/",
 		"GET /api/mcp-audit-logs",
@@ -64,9 +66,17 @@ var (
 		"GET /api/mcp-audit-logs/{mcp_id}",
 		"GET /api/mcp-stats",
 		"GET /api/mcp-stats/{mcp_id}",
+		"/api/mcp-audit-log-findings",
+		"/api/mcp-audit-log-findings/",
+		"/api/mcp-audit-log-retention-policy",
+		"GET /api/mcp-audit-log-archives",
+		"GET /api/mcp-audit-log-archives/{archive_id}/logs",
+		"/api/mcp-audit-log-searches",
+		"/api/mcp-audit-log-searches/",
 		"GET /debug/pprof/",
 		"GET /debug/triggers",
 		"GET /debug/metrics",
+		"GET /metrics",
 		"/api/auth-providers",
 		"/api/auth-providers/",
 		"/api/model-providers",
@@ -99,10 +109,19 @@ var (
 		"/api/user-default-role-settings",
 		"/api/setup/",
 		"/api/k8s-settings",
+		"/api/mcp-image-policy",
+		"/api/mcp-injection-scan-policy",
+		"/api/mcp-tool-drift-policy",
+		"/api/mcp-tool-pins",
+		"/api/mcp-tool-pins/",
+		"GET /api/mcp-server-health",
+		"GET /api/mcp-server-health/",
 		"/api/audit-log-exports",
 		"/api/audit-log-exports/{id}",
 		"/api/scheduled-audit-log-exports",
 		"/api/scheduled-audit-log-exports/{id}",
+		"GET /api/access-review",
+		"GET /api/access-review/",
 		"/api/storage-credentials",
 		"/api/storage-credentials/",
 		"/api/oauth-clients",
@@ -132,6 +151,14 @@ var (
 			"GET /api/mcp-audit-logs/{mcp_id}",
 			"GET /api/mcp-stats",
 			"GET /api/mcp-stats/{mcp_id}",
+			"GET /api/mcp-audit-log-findings",
+			"GET /api/mcp-audit-log-findings/{finding_id}",
+			"GET /api/mcp-audit-log-retention-policy",
+			"GET /api/mcp-audit-log-archives",
+			"GET /api/mcp-audit-log-archives/{archive_id}/logs",
+			// Users can only change their own saved searches. The authz logic is handled in the routes themselves.
+			"/api/mcp-audit-log-searches",
+			"/api/mcp-audit-log-searches/",
 			"GET /api/threads",
 			"GET /api/threads/",
 			"GET /api/runs",
@@ -143,6 +170,8 @@ var (
 			"GET /api/mcp-catalogs/",
 			"GET /api/mcp-webhook-validations",
 			"GET /api/mcp-webhook-validations/",
+			"GET /api/mcp-tool-approval-policies",
+			"GET /api/mcp-tool-approval-policies/",
 			"GET /api/mcp-servers/",
 			"GET /api/tasks",
 			"GET /api/tasks/",
@@ -150,6 +179,11 @@ var (
 			"GET /api/default-model-aliases",
 			"GET /api/user-default-role-settings",
 			"GET /api/k8s-settings",
+			"GET /api/mcp-image-policy",
+			"GET /api/mcp-injection-scan-policy",
+			"GET /api/mcp-tool-drift-policy",
+			"GET /api/mcp-tool-pins",
+			"GET /api/mcp-tool-pins/{pin_id}",
 			"POST /api/auth-providers/",
 			"GET /api/workspaces/",
 			"GET /api/projects/",
@@ -158,6 +192,8 @@ var (
 			"/api/audit-log-exports/{id}",
 			"/api/scheduled-audit-log-exports",
 			"/api/scheduled-audit-log-exports/{id}",
+			"GET /api/access-review",
+			"GET /api/access-review/",
 			"/api/storage-credentials",
 			"/api/storage-credentials/",
 		},
@@ -239,6 +275,17 @@ var (
 			"GET /api/all-mcps/entries/{entry_id}",
 			"GET /api/all-mcps/servers",
 			"GET /api/all-mcps/servers/{mcp_server_id}",
+
+			// Allow authenticated users to request access to MCP servers and catalog entries.
+			// Users can only see their own requests, and only approvers can decide on them.
+			// The authz logic is handled in the routes themselves.
+			"/api/mcp-access-requests",
+			"/api/mcp-access-requests/",
+
+			// Allow authenticated users to see and decide on MCP calls that are waiting for approval.
+			// Users only see the calls they made or can decide on. The authz logic is handled in the routes themselves.
+			"/api/mcp-tool-call-approvals",
+			"/api/mcp-tool-call-approvals/",
 		},
 
 		types.GroupPowerUserPlus: {
@@ -255,6 +302,8 @@ var (
 			"GET /api/mcp-audit-logs/{mcp_id}",
 			"GET /api/mcp-stats",
 			"GET /api/mcp-stats/{mcp_id}",
+			"/api/mcp-audit-log-searches",
+			"/api/mcp-audit-log-searches/",
 		},
 
 		types.GroupAuthenticated: {
@@ -270,6 +319,7 @@ var (
 
 		MetricsGroup: {
 			"/debug/metrics",
+			"/metrics",
 		},
 	}
 
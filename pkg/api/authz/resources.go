@@ -168,8 +168,13 @@ var apiResources = map[string][]string{
 		"DELETE /api/mcp-servers/{mcpserver_id}",
 		"DELETE /api/mcp-servers/{mcpserver_id}/oauth",
 		"GET    /api/mcp-servers/{mcpserver_id}/logs",
+		"GET    /api/mcp-servers/{mcpserver_id}/history",
+		"GET    /api/mcp-servers/{mcpserver_id}/persistent-volume",
+		"POST   /api/mcp-servers/{mcpserver_id}/persistent-volume/reset",
 		"PUT	/api/mcp-servers/{mcpserver_id}/alias",
+		"PUT    /api/mcp-servers/{mcpserver_id}/idle-timeout",
 		"POST   /api/mcp-servers/{mcpserver_id}/update-url",
+		"POST   /api/mcp-servers/{mcpserver_id}/pin-version",
 		"POST   /api/mcp-servers/{mcpserver_id}/configure",
 		"POST   /api/mcp-servers/{mcpserver_id}/deconfigure",
 		"POST   /api/mcp-servers/{mcpserver_id}/reveal",
@@ -241,8 +246,17 @@ var apiResources = map[string][]string{
 		"POST   /api/workspaces/{workspace_id}/entries/{entry_id}/generate-tool-previews/oauth-url",
 		"GET    /api/workspaces/{workspace_id}/entries/{entry_id}/servers/{mcpserver_id}/details",
 		"GET    /api/workspaces/{workspace_id}/entries/{entry_id}/servers/{mcpserver_id}/logs",
+		"GET    /api/workspaces/{workspace_id}/entries/{entry_id}/servers/{mcpserver_id}/history",
 		"POST   /api/workspaces/{workspace_id}/entries/{entry_id}/servers/{mcpserver_id}/restart",
+		"GET    /api/workspaces/{workspace_id}/entries/{entry_id}/servers/{mcpserver_id}/persistent-volume",
+		"POST   /api/workspaces/{workspace_id}/entries/{entry_id}/servers/{mcpserver_id}/persistent-volume/reset",
 		"POST   /api/workspaces/{workspace_id}/entries/{entry_id}/servers/{mcpserver_id}/trigger-update",
+		"GET    /api/workspaces/{workspace_id}/entries/{entry_id}/versions",
+		"GET    /api/workspaces/{workspace_id}/entries/{entry_id}/versions/{version}",
+		"GET    /api/workspaces/{workspace_id}/entries/{entry_id}/versions/{version}/diff",
+		"POST   /api/workspaces/{workspace_id}/entries/{entry_id}/versions/{version}/rollout",
+		"POST   /api/workspaces/{workspace_id}/entries/{entry_id}/versions/{version}/release",
+		"POST   /api/workspaces/{workspace_id}/entries/{entry_id}/versions/{version}/rollback",
 	},
 	types.GroupPowerUserPlus: {
 		"GET    /api/workspaces/{workspace_id}/servers",
@@ -250,6 +264,8 @@ var apiResources = map[string][]string{
 		"DELETE /api/workspaces/{workspace_id}/servers/{mcp_server_id}",
 		"GET    /api/workspaces/{workspace_id}/servers/{mcp_server_id}",
 		"PUT    /api/workspaces/{workspace_id}/servers/{mcp_server_id}",
+		"PUT    /api/workspaces/{workspace_id}/servers/{mcp_server_id}/idle-timeout",
+		"PUT    /api/workspaces/{workspace_id}/servers/{mcp_server_id}/scaling",
 		"POST   /api/workspaces/{workspace_id}/servers/{mcp_server_id}/launch",
 		"POST   /api/workspaces/{workspace_id}/servers/{mcp_server_id}/check-oauth",
 		"GET    /api/workspaces/{workspace_id}/servers/{mcp_server_id}/oauth-url",
@@ -260,7 +276,10 @@ var apiResources = map[string][]string{
 		"GET    /api/workspaces/{workspace_id}/servers/{mcp_server_id}/instances",
 		"GET    /api/workspaces/{workspace_id}/servers/{mcp_server_id}/details",
 		"GET    /api/workspaces/{workspace_id}/servers/{mcp_server_id}/logs",
+		"GET    /api/workspaces/{workspace_id}/servers/{mcp_server_id}/history",
 		"POST   /api/workspaces/{workspace_id}/servers/{mcp_server_id}/restart",
+		"GET    /api/workspaces/{workspace_id}/servers/{mcp_server_id}/persistent-volume",
+		"POST   /api/workspaces/{workspace_id}/servers/{mcp_server_id}/persistent-volume/reset",
 		"GET    /api/workspaces/{workspace_id}/access-control-rules",
 		"POST   /api/workspaces/{workspace_id}/access-control-rules",
 		"DELETE /api/workspaces/{workspace_id}/access-control-rules/{access_control_rule_id}",
@@ -368,6 +368,8 @@ func convertAccessControlRule(rule v1.AccessControlRule) types.AccessControlRule
 		MCPCatalogID:              rule.Spec.MCPCatalogID,
 		PowerUserWorkspaceID:      rule.Spec.PowerUserWorkspaceID,
 		Generated:                 rule.Spec.Generated,
+		MCPAccessRequestID:        rule.Spec.MCPAccessRequestName,
+		ExpiresAt:                 v1.NewTime(rule.Spec.ExpiresAt),
 		AccessControlRuleManifest: rule.Spec.Manifest,
 	}
 }
@@ -379,6 +381,8 @@ func convertAccessControlRuleWithPowerUserID(rule v1.AccessControlRule, powerUse
 		PowerUserWorkspaceID:      rule.Spec.PowerUserWorkspaceID,
 		PowerUserID:               powerUserID,
 		Generated:                 rule.Spec.Generated,
+		MCPAccessRequestID:        rule.Spec.MCPAccessRequestName,
+		ExpiresAt:                 v1.NewTime(rule.Spec.ExpiresAt),
 		AccessControlRuleManifest: rule.Spec.Manifest,
 	}
 }
@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/accesscontrolrule"
+	"github.com/obot-platform/obot/pkg/api"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+type AccessReviewHandler struct {
+	acrHelper *accesscontrolrule.Helper
+}
+
+func NewAccessReviewHandler(acrHelper *accesscontrolrule.Helper) *AccessReviewHandler {
+	return &AccessReviewHandler{
+		acrHelper: acrHelper,
+	}
+}
+
+// List returns every grant in the system, including grants from roles and workspace ownership.
+func (h *AccessReviewHandler) List(req api.Context) error {
+	users, err := req.GatewayClient.Users(req.Context(), gatewaytypes.UserQuery{})
+	if err != nil {
+		return fmt.Errorf("failed to list users: %w", err)
+	}
+
+	grants, err := h.acrHelper.AccessReview(req.Context(), req.Namespace(), users)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(types.EffectiveGrantList{
+		Items: grants,
+	})
+}
+
+// ForUser returns the grants that give a user access to MCP servers and catalog entries, and where each one comes from.
+func (h *AccessReviewHandler) ForUser(req api.Context) error {
+	userID := req.PathValue("user_id")
+	user, err := req.GatewayClient.UserByID(req.Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	groupIDs, err := req.GatewayClient.ListGroupIDsForUser(req.Context(), user.ID)
+	if err != nil {
+		return err
+	}
+
+	userID = strconv.FormatUint(uint64(user.ID), 10)
+	grants := accesscontrolrule.RoleGrants([]gatewaytypes.User{*user})
+	ruleGrants, err := h.acrHelper.GrantsForUser(req.Context(), req.Namespace(), userID, groupIDs)
+	if err != nil {
+		return err
+	}
+	grants = append(grants, ruleGrants...)
+
+	ownerGrants, err := h.acrHelper.WorkspaceOwnerGrants(req.Context(), req.Namespace(), userID)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(types.EffectiveGrantList{
+		Items: append(grants, ownerGrants...),
+	})
+}
+
+// ForMCPServer returns the grants that give users access to a multi-user MCP server, and where each one comes from.
+// Admins and owners always have access and are not listed.
+func (h *AccessReviewHandler) ForMCPServer(req api.Context) error {
+	var server v1.MCPServer
+	if err := req.Get(&server, req.PathValue("mcp_server_id")); err != nil {
+		return err
+	}
+
+	resource := types.Resource{
+		Type: types.ResourceTypeMCPServer,
+		ID:   server.Name,
+	}
+
+	if server.Spec.MCPCatalogID == "" && server.Spec.PowerUserWorkspaceID == "" {
+		// Single-user and remote servers are only accessible to the user that created them.
+		return req.Write(types.EffectiveGrantList{
+			Items: []types.EffectiveGrant{
+				{
+					Subject: types.Subject{
+						Type: types.SubjectTypeUser,
+						ID:   server.Spec.UserID,
+					},
+					Resource: resource,
+					Source:   types.GrantSourceOwner,
+				},
+			},
+		})
+	}
+
+	grants, err := h.grantsForResource(req, resource, server.Spec.MCPCatalogID, server.Spec.PowerUserWorkspaceID)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(types.EffectiveGrantList{
+		Items: grants,
+	})
+}
+
+// ForCatalogEntry returns the grants that give users access to a catalog entry, and where each one comes from.
+// Admins and owners always have access and are not listed.
+func (h *AccessReviewHandler) ForCatalogEntry(req api.Context) error {
+	var entry v1.MCPServerCatalogEntry
+	if err := req.Get(&entry, req.PathValue("entry_id")); err != nil {
+		return err
+	}
+
+	grants, err := h.grantsForResource(req, types.Resource{
+		Type: types.ResourceTypeMCPServerCatalogEntry,
+		ID:   entry.Name,
+	}, entry.Spec.MCPCatalogName, entry.Spec.PowerUserWorkspaceID)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(types.EffectiveGrantList{
+		Items: grants,
+	})
+}
+
+func (h *AccessReviewHandler) grantsForResource(req api.Context, resource types.Resource, catalogID, workspaceID string) ([]types.EffectiveGrant, error) {
+	grants := h.acrHelper.GrantsForResource(req.Namespace(), resource, catalogID, workspaceID)
+	if workspaceID == "" {
+		return grants, nil
+	}
+
+	var workspace v1.PowerUserWorkspace
+	if err := req.Get(&workspace, workspaceID); err != nil {
+		return nil, fmt.Errorf("failed to get power user workspace: %w", err)
+	}
+
+	return append(grants, types.EffectiveGrant{
+		Subject: types.Subject{
+			Type: types.SubjectTypeUser,
+			ID:   workspace.Spec.UserID,
+		},
+		Resource:             resource,
+		Source:               types.GrantSourceWorkspaceOwner,
+		PowerUserWorkspaceID: workspaceID,
+	}), nil
+}
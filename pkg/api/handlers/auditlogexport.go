@@ -35,6 +35,9 @@ func (h *AuditLogExportHandler) CreateAuditLogExport(req api.Context) error {
 	if err := h.validateExportRequest(&createReq); err != nil {
 		return types.NewErrBadRequest("validation failed: %v", err)
 	}
+	if _, err := ResolveMCPAuditLogSearch(req, createReq.Filters.Search, createReq.Filters.SavedSearchID); err != nil {
+		return err
+	}
 
 	// Create the AuditLogExport resource
 	export := &v1.AuditLogExport{
@@ -44,6 +47,7 @@ func (h *AuditLogExportHandler) CreateAuditLogExport(req api.Context) error {
 		},
 		Spec: v1.AuditLogExportSpec{
 			Name:                   createReq.Name,
+			Type:                   createReq.Type,
 			StartTime:              metav1.NewTime(createReq.StartTime.GetTime()),
 			EndTime:                metav1.NewTime(createReq.EndTime.GetTime()),
 			Filters:                createReq.Filters,
@@ -126,6 +130,9 @@ func (h *AuditLogExportHandler) CreateScheduledAuditLogExport(req api.Context) e
 	if err := h.validateScheduledExportRequest(&createReq); err != nil {
 		return types.NewErrBadRequest("validation failed: %v", err)
 	}
+	if _, err := ResolveMCPAuditLogSearch(req, createReq.Filters.Search, createReq.Filters.SavedSearchID); err != nil {
+		return err
+	}
 
 	// Create the ScheduledAuditLogExport resource
 	scheduledExport := &v1.ScheduledAuditLogExport{
@@ -135,6 +142,7 @@ func (h *AuditLogExportHandler) CreateScheduledAuditLogExport(req api.Context) e
 		},
 		Spec: v1.ScheduledAuditLogExportSpec{
 			Name:                   createReq.Name,
+			Type:                   createReq.Type,
 			Enabled:                true,
 			Schedule:               h.convertSchedule(createReq.Schedule),
 			RetentionPeriodInDays:  createReq.RetentionPeriodInDays,
@@ -226,6 +234,9 @@ func (h *AuditLogExportHandler) UpdateScheduledAuditLogExport(req api.Context) e
 		scheduledExport.Spec.RetentionPeriodInDays = *updateReq.RetentionPeriodInDays
 	}
 	if updateReq.Filters != nil {
+		if _, err := ResolveMCPAuditLogSearch(req, updateReq.Filters.Search, updateReq.Filters.SavedSearchID); err != nil {
+			return err
+		}
 		scheduledExport.Spec.Filters = *updateReq.Filters
 	}
 	if updateReq.Bucket != nil {
@@ -389,6 +400,9 @@ func (h *AuditLogExportHandler) validateExportRequest(req *types.AuditLogExportC
 	if req.Name == "" {
 		return fmt.Errorf("name is required")
 	}
+	if err := req.Type.Validate(); err != nil {
+		return err
+	}
 	if req.StartTime.GetTime().After(req.EndTime.GetTime()) {
 		return fmt.Errorf("start time must be before end time")
 	}
@@ -399,7 +413,7 @@ func (h *AuditLogExportHandler) validateScheduledExportRequest(req *types.Schedu
 	if req.Name == "" {
 		return fmt.Errorf("name is required")
 	}
-	return nil
+	return req.Type.Validate()
 }
 
 func (h *AuditLogExportHandler) convertSchedule(schedule types.Schedule) v1.Schedule {
@@ -417,6 +431,7 @@ func (h *AuditLogExportHandler) convertExportToAPI(export *v1.AuditLogExport) ty
 	result := types.AuditLogExportResponse{
 		ID:              export.Name,
 		Name:            export.Spec.Name,
+		Type:            export.Spec.Type,
 		StorageProvider: export.Status.StorageProvider,
 		Bucket:          export.Spec.Bucket,
 		KeyPrefix:       export.Spec.KeyPrefix,
@@ -446,6 +461,7 @@ func (h *AuditLogExportHandler) convertScheduledExportToAPI(export *v1.Scheduled
 		Bucket:                export.Spec.Bucket,
 		KeyPrefix:             export.Spec.KeyPrefix,
 		Name:                  export.Spec.Name,
+		Type:                  export.Spec.Type,
 		Enabled:               export.Spec.Enabled,
 		Schedule:              h.convertScheduleToAPI(export.Spec.Schedule),
 		RetentionPeriodInDays: export.Spec.RetentionPeriodInDays,
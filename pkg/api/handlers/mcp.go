@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -22,6 +24,7 @@ import (
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	"github.com/obot-platform/obot/pkg/validation"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -155,6 +158,10 @@ func ConvertMCPServerCatalogEntryWithWorkspace(entry v1.MCPServerCatalogEntry, p
 		PowerUserWorkspaceID:      powerUserWorkspaceID,
 		PowerUserID:               powerUserID,
 		NeedsUpdate:               entry.Status.NeedsUpdate,
+		LatestVersion:             entry.Status.LatestVersion,
+		ReleasedVersion:           entry.Status.ReleasedVersion,
+		StagedRollout:             entry.Spec.StagedRollout,
+		ToolPreviewScanFindings:   entry.Status.ToolPreviewScanFindings,
 	}
 }
 
@@ -1505,6 +1512,35 @@ func serverManifestFromCatalogEntryManifest(
 	return result, nil
 }
 
+// checkServiceAccountOverride makes sure that only admins can give an MCP server a new service account,
+// because the service account can grant the server access to cloud resources through workload identity.
+func checkServiceAccountOverride(req api.Context, existing, updated *types.K8sOverrides) error {
+	if updated == nil || updated.ServiceAccountName == "" || req.UserIsAdmin() {
+		return nil
+	}
+	if existing != nil && existing.ServiceAccountName == updated.ServiceAccountName {
+		return nil
+	}
+	return types.NewErrForbidden("only admins can set the service account for an MCP server")
+}
+
+// checkTrustLevel makes sure that only admins can mark an MCP server as trusted, because trusted servers don't run in the sandbox.
+func checkTrustLevel(req api.Context, existing, updated types.TrustLevel) error {
+	if updated != types.TrustLevelTrusted || existing == updated || req.UserIsAdmin() {
+		return nil
+	}
+	return types.NewErrForbidden("only admins can mark an MCP server as trusted")
+}
+
+// checkAuditLogRedaction makes sure that only admins can change the audit log redaction of an MCP server,
+// so that users can't turn off the redaction that the privacy settings require.
+func checkAuditLogRedaction(req api.Context, existing, updated *types.AuditLogRedaction) error {
+	if equality.Semantic.DeepEqual(existing, updated) || req.UserIsAdmin() {
+		return nil
+	}
+	return types.NewErrForbidden("only admins can change the audit log redaction for an MCP server")
+}
+
 func mergeMCPServerManifests(existing, override types.MCPServerManifest) types.MCPServerManifest {
 	if override.Name != "" {
 		existing.Name = override.Name
@@ -1535,6 +1571,27 @@ func mergeMCPServerManifests(existing, override types.MCPServerManifest) types.M
 	if override.ContainerizedConfig != nil {
 		existing.ContainerizedConfig = override.ContainerizedConfig
 	}
+	if override.K8sOverrides != nil {
+		existing.K8sOverrides = override.K8sOverrides
+	}
+	if override.EgressPolicy != nil {
+		existing.EgressPolicy = override.EgressPolicy
+	}
+	if override.TrustLevel != "" {
+		existing.TrustLevel = override.TrustLevel
+	}
+	if override.PersistentVolume != nil {
+		existing.PersistentVolume = override.PersistentVolume
+	}
+	if override.HealthProbe != nil {
+		existing.HealthProbe = override.HealthProbe
+	}
+	if override.Rollout != nil {
+		existing.Rollout = override.Rollout
+	}
+	if override.AuditLogRedaction != nil {
+		existing.AuditLogRedaction = override.AuditLogRedaction
+	}
 	if override.RemoteConfig != nil {
 		if existing.RemoteConfig == nil {
 			existing.RemoteConfig = override.RemoteConfig
@@ -1618,16 +1675,31 @@ func (m *MCPHandler) CreateServer(req api.Context) error {
 			return types.NewErrForbidden("user does not have access to MCP server catalog entry")
 		}
 
-		manifest, err := serverManifestFromCatalogEntryManifest(req.UserIsAdmin(), catalogEntry.Spec.Manifest, input.MCPServerManifest)
+		entryManifest, version, err := catalogEntryManifestForUser(req, catalogEntry, server.Spec.UserID)
+		if err != nil {
+			return err
+		}
+
+		manifest, err := serverManifestFromCatalogEntryManifest(req.UserIsAdmin(), entryManifest, input.MCPServerManifest)
 		if err != nil {
 			return err
 		}
 
 		server.Spec.Manifest = manifest
+		server.Spec.MCPServerCatalogEntryVersion = version
 		server.Spec.UnsupportedTools = catalogEntry.Spec.UnsupportedTools
 	} else if req.UserIsAdmin() || workspaceID != "" {
 		// If the user is an admin, or if this server is being created in a workspace by a PowerUserPlus,
 		// they can create a server with a manifest that is not in the catalog.
+		if err := checkServiceAccountOverride(req, nil, input.MCPServerManifest.K8sOverrides); err != nil {
+			return err
+		}
+		if err := checkTrustLevel(req, "", input.MCPServerManifest.TrustLevel); err != nil {
+			return err
+		}
+		if err := checkAuditLogRedaction(req, nil, input.MCPServerManifest.AuditLogRedaction); err != nil {
+			return err
+		}
 		server.Spec.Manifest = input.MCPServerManifest
 	} else {
 		return types.NewErrBadRequest("catalogEntryID is required")
@@ -1799,6 +1871,16 @@ func (m *MCPHandler) UpdateServer(req api.Context) error {
 		return types.NewErrBadRequest("validation failed: %v", err)
 	}
 
+	if err := checkServiceAccountOverride(req, existing.Spec.Manifest.K8sOverrides, updated.K8sOverrides); err != nil {
+		return err
+	}
+	if err := checkTrustLevel(req, existing.Spec.Manifest.TrustLevel, updated.TrustLevel); err != nil {
+		return err
+	}
+	if err := checkAuditLogRedaction(req, existing.Spec.Manifest.AuditLogRedaction, updated.AuditLogRedaction); err != nil {
+		return err
+	}
+
 	existing.Spec.Manifest = updated
 
 	// Add extracted env vars to the server definition
@@ -1850,6 +1932,84 @@ func (m *MCPHandler) UpdateServerAlias(req api.Context) error {
 	return nil
 }
 
+func (m *MCPHandler) UpdateServerIdleTimeout(req api.Context) error {
+	var (
+		id     = req.PathValue("mcp_server_id")
+		server v1.MCPServer
+	)
+
+	if err := req.Get(&server, id); err != nil {
+		return err
+	}
+
+	if server.Spec.MCPCatalogID != req.PathValue("catalog_id") || server.Spec.PowerUserWorkspaceID != req.PathValue("workspace_id") {
+		return types.NewErrNotFound("MCP server not found")
+	}
+
+	if server.Spec.CompositeName != "" {
+		return types.NewErrBadRequest("cannot set the idle timeout for a component server")
+	}
+
+	var input types.MCPServerIdleTimeout
+	if err := req.Read(&input); err != nil {
+		return err
+	}
+
+	if input.Minutes != nil && *input.Minutes < 0 {
+		return types.NewErrBadRequest("idle timeout must not be negative")
+	}
+
+	server.Spec.IdleTimeoutMinutes = input.Minutes
+	return req.Update(&server)
+}
+
+// maxMCPServerReplicas is the most replicas that a multi-user MCP server can be scaled to.
+const maxMCPServerReplicas = 20
+
+func (m *MCPHandler) UpdateServerScaling(req api.Context) error {
+	var (
+		id          = req.PathValue("mcp_server_id")
+		catalogID   = req.PathValue("catalog_id")
+		workspaceID = req.PathValue("workspace_id")
+		server      v1.MCPServer
+	)
+
+	if err := req.Get(&server, id); err != nil {
+		return err
+	}
+
+	if server.Spec.MCPCatalogID != catalogID || server.Spec.PowerUserWorkspaceID != workspaceID || catalogID == "" && workspaceID == "" {
+		return types.NewErrNotFound("MCP server not found")
+	}
+
+	if server.Spec.CompositeName != "" {
+		return types.NewErrBadRequest("cannot configure scaling for a component server")
+	}
+
+	var input types.MCPServerScaling
+	if err := req.Read(&input); err != nil {
+		return err
+	}
+
+	switch {
+	case input.MinReplicas == 0 && input.MaxReplicas == 0:
+		// Scaling is being removed, so the server goes back to a single replica.
+		server.Spec.Scaling = nil
+		return req.Update(&server)
+	case input.MinReplicas < 1:
+		return types.NewErrBadRequest("minReplicas must be at least 1")
+	case input.MaxReplicas < input.MinReplicas:
+		return types.NewErrBadRequest("maxReplicas must be greater than or equal to minReplicas")
+	case input.MaxReplicas > maxMCPServerReplicas:
+		return types.NewErrBadRequest("maxReplicas must not be greater than %d", maxMCPServerReplicas)
+	case input.TargetConcurrency < 0:
+		return types.NewErrBadRequest("targetConcurrency must not be negative")
+	}
+
+	server.Spec.Scaling = &input
+	return req.Update(&server)
+}
+
 func (m *MCPHandler) ConfigureServer(req api.Context) error {
 	catalogID := req.PathValue("catalog_id")
 	workspaceID := req.PathValue("workspace_id")
@@ -2563,29 +2723,34 @@ func ConvertMCPServer(server v1.MCPServer, credEnv map[string]string, serverURL,
 	}
 
 	converted := types.MCPServer{
-		Metadata:                    MetadataFrom(&server),
-		Alias:                       server.Spec.Alias,
-		MissingRequiredEnvVars:      missingEnvVars,
-		MissingRequiredHeaders:      missingHeaders,
-		UserID:                      server.Spec.UserID,
-		Configured:                  len(missingEnvVars) == 0 && len(missingHeaders) == 0 && !server.Spec.NeedsURL,
-		MCPServerManifest:           server.Spec.Manifest,
-		CatalogEntryID:              server.Spec.MCPServerCatalogEntryName,
-		PowerUserWorkspaceID:        server.Spec.PowerUserWorkspaceID,
-		MCPCatalogID:                server.Spec.MCPCatalogID,
-		ConnectURL:                  connectURL,
-		NeedsUpdate:                 server.Status.NeedsUpdate,
-		NeedsURL:                    server.Spec.NeedsURL,
-		PreviousURL:                 server.Spec.PreviousURL,
-		MCPServerInstanceUserCount:  server.Status.MCPServerInstanceUserCount,
-		DeploymentStatus:            server.Status.DeploymentStatus,
-		DeploymentAvailableReplicas: server.Status.DeploymentAvailableReplicas,
-		DeploymentReadyReplicas:     server.Status.DeploymentReadyReplicas,
-		DeploymentReplicas:          server.Status.DeploymentReplicas,
-		DeploymentConditions:        conditions,
-		K8sSettingsHash:             server.Status.K8sSettingsHash,
-		Template:                    server.Spec.Template,
-		CompositeName:               server.Spec.CompositeName,
+		Metadata:                     MetadataFrom(&server),
+		Alias:                        server.Spec.Alias,
+		MissingRequiredEnvVars:       missingEnvVars,
+		MissingRequiredHeaders:       missingHeaders,
+		UserID:                       server.Spec.UserID,
+		Configured:                   len(missingEnvVars) == 0 && len(missingHeaders) == 0 && !server.Spec.NeedsURL,
+		MCPServerManifest:            server.Spec.Manifest,
+		CatalogEntryID:               server.Spec.MCPServerCatalogEntryName,
+		PowerUserWorkspaceID:         server.Spec.PowerUserWorkspaceID,
+		MCPCatalogID:                 server.Spec.MCPCatalogID,
+		ConnectURL:                   connectURL,
+		NeedsUpdate:                  server.Status.NeedsUpdate,
+		CatalogEntryVersion:          server.Spec.MCPServerCatalogEntryVersion,
+		CatalogEntryVersionPinned:    server.Spec.MCPServerCatalogEntryVersionPinned,
+		AvailableCatalogEntryVersion: server.Status.AvailableCatalogEntryVersion,
+		NeedsURL:                     server.Spec.NeedsURL,
+		PreviousURL:                  server.Spec.PreviousURL,
+		IdleTimeoutMinutes:           server.Spec.IdleTimeoutMinutes,
+		Scaling:                      server.Spec.Scaling,
+		MCPServerInstanceUserCount:   server.Status.MCPServerInstanceUserCount,
+		DeploymentStatus:             server.Status.DeploymentStatus,
+		DeploymentAvailableReplicas:  server.Status.DeploymentAvailableReplicas,
+		DeploymentReadyReplicas:      server.Status.DeploymentReadyReplicas,
+		DeploymentReplicas:           server.Status.DeploymentReplicas,
+		DeploymentConditions:         conditions,
+		K8sSettingsHash:              server.Status.K8sSettingsHash,
+		Template:                     server.Spec.Template,
+		CompositeName:                server.Spec.CompositeName,
 	}
 
 	// For composite servers, also consider component configuration if provided
@@ -3372,31 +3537,8 @@ func (m *MCPHandler) StreamServerLogs(req api.Context) error {
 		return types.NewErrBadRequest("cannot stream logs for remote MCP server")
 	}
 
-	// If this is a single-user MCP server that belongs to the user, then let them access the logs.
-	if server.Spec.UserID != req.User.GetUID() || server.Spec.PowerUserWorkspaceID != "" || server.Spec.MCPCatalogID != "" {
-		// If the user doesn't own the server and is not an admin or auditor, check if they have access to the workspace.
-		if !req.UserIsAdmin() && !req.UserIsAuditor() {
-			workspaceID := req.PathValue("workspace_id")
-			if workspaceID == "" {
-				return types.NewErrNotFound("MCP server %s not found", server.Name)
-			} else if server.Spec.PowerUserWorkspaceID != "" && workspaceID != server.Spec.PowerUserWorkspaceID {
-				return types.NewErrNotFound("MCP server %s not found", server.Name)
-			} else if server.Spec.PowerUserWorkspaceID == "" {
-				if server.Spec.MCPServerCatalogEntryName == "" {
-					return types.NewErrNotFound("MCP server %s not found", server.Name)
-				}
-
-				// In this case, the server should correspond to a workspace catalog entry.
-				var entry v1.MCPServerCatalogEntry
-				if err := req.Get(&entry, server.Spec.MCPServerCatalogEntryName); err != nil {
-					return fmt.Errorf("failed to get MCP server catalog entry: %v", err)
-				}
-
-				if entry.Spec.PowerUserWorkspaceID != workspaceID {
-					return types.NewErrNotFound("MCP server %s not found", server.Name)
-				}
-			}
-		}
+	if err := checkServerLogsAccess(req, server); err != nil {
+		return err
 	}
 
 	// Use the user ID from the server rather than from the request.
@@ -3418,6 +3560,184 @@ func (m *MCPHandler) StreamServerLogs(req api.Context) error {
 	})
 }
 
+// GetServerHistory returns the deployment events and recent logs that Obot has kept for the server.
+// Unlike the details and logs, these are still available after the server's pods or containers are gone.
+func (m *MCPHandler) GetServerHistory(req api.Context) error {
+	var server v1.MCPServer
+	if err := req.Get(&server, req.PathValue("mcp_server_id")); err != nil {
+		return err
+	}
+
+	if server.Spec.Manifest.Runtime == types.RuntimeRemote {
+		return types.NewErrBadRequest("cannot get history for remote MCP server")
+	}
+
+	if err := checkServerLogsAccess(req, server); err != nil {
+		return err
+	}
+
+	limit := 1000
+	if l, err := strconv.Atoi(req.URL.Query().Get("limit")); err == nil && l > 0 {
+		limit = min(l, 10000)
+	}
+
+	events, err := req.GatewayClient.GetMCPServerDeploymentEvents(req.Context(), server.Name, limit)
+	if err != nil {
+		return fmt.Errorf("failed to get deployment events: %w", err)
+	}
+
+	lines, err := req.GatewayClient.GetMCPServerLogLines(req.Context(), server.Name, limit)
+	if err != nil {
+		return fmt.Errorf("failed to get logs: %w", err)
+	}
+
+	history := types.MCPServerHistory{
+		Events: make([]types.MCPServerEvent, 0, len(events)),
+		Logs:   make([]types.MCPServerLogLine, 0, len(lines)),
+	}
+	for _, event := range events {
+		history.Events = append(history.Events, types.MCPServerEvent{
+			Time:         *types.NewTime(event.Time),
+			Reason:       event.Reason,
+			Message:      event.Message,
+			EventType:    event.EventType,
+			Action:       event.Action,
+			Count:        event.Count,
+			ResourceName: event.ResourceName,
+			ResourceKind: event.ResourceKind,
+		})
+	}
+	for _, line := range lines {
+		history.Logs = append(history.Logs, types.MCPServerLogLine{
+			Time:    *types.NewTime(line.Time),
+			Replica: line.Replica,
+			Line:    line.Line,
+		})
+	}
+
+	return req.Write(history)
+}
+
+// checkServerLogsAccess returns an error if the user can't see the logs of the server. Users can see the logs of their own
+// single-user servers, and admins, auditors, and the users of the server's workspace can see the logs of any server.
+func checkServerLogsAccess(req api.Context, server v1.MCPServer) error {
+	if server.Spec.UserID == req.User.GetUID() && server.Spec.PowerUserWorkspaceID == "" && server.Spec.MCPCatalogID == "" {
+		return nil
+	}
+
+	// If the user doesn't own the server and is not an admin or auditor, check if they have access to the workspace.
+	if req.UserIsAdmin() || req.UserIsAuditor() {
+		return nil
+	}
+
+	workspaceID := req.PathValue("workspace_id")
+	if workspaceID == "" {
+		return types.NewErrNotFound("MCP server %s not found", server.Name)
+	} else if server.Spec.PowerUserWorkspaceID != "" && workspaceID != server.Spec.PowerUserWorkspaceID {
+		return types.NewErrNotFound("MCP server %s not found", server.Name)
+	} else if server.Spec.PowerUserWorkspaceID == "" {
+		if server.Spec.MCPServerCatalogEntryName == "" {
+			return types.NewErrNotFound("MCP server %s not found", server.Name)
+		}
+
+		// In this case, the server should correspond to a workspace catalog entry.
+		var entry v1.MCPServerCatalogEntry
+		if err := req.Get(&entry, server.Spec.MCPServerCatalogEntryName); err != nil {
+			return fmt.Errorf("failed to get MCP server catalog entry: %v", err)
+		}
+
+		if entry.Spec.PowerUserWorkspaceID != workspaceID {
+			return types.NewErrNotFound("MCP server %s not found", server.Name)
+		}
+	}
+
+	return nil
+}
+
+// DownloadPersistentVolume streams a gzipped tar archive of the contents of the server's persistent volume.
+func (m *MCPHandler) DownloadPersistentVolume(req api.Context) error {
+	server, serverConfig, err := m.persistentVolumeServer(req)
+	if err != nil {
+		return err
+	}
+
+	archive, err := m.mcpSessionManager.DownloadPersistentVolume(req.Context(), serverConfig)
+	if err != nil {
+		if nse := (*mcp.ErrNotSupportedByBackend)(nil); errors.As(err, &nse) {
+			return types.NewErrNotFound(nse.Error())
+		}
+		return err
+	}
+	defer archive.Close()
+
+	req.ResponseWriter.Header().Set("Content-Type", "application/gzip")
+	req.ResponseWriter.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", server.Name+"-workspace.tar.gz"))
+	_, err = io.Copy(req.ResponseWriter, archive)
+	return err
+}
+
+// ResetPersistentVolume deletes the contents of the server's persistent volume. The server is shut down,
+// and it starts with an empty volume the next time it is used.
+func (m *MCPHandler) ResetPersistentVolume(req api.Context) error {
+	_, serverConfig, err := m.persistentVolumeServer(req)
+	if err != nil {
+		return err
+	}
+
+	if err := m.mcpSessionManager.ResetPersistentVolume(req.Context(), serverConfig); err != nil {
+		return err
+	}
+
+	return req.Write(map[string]any{})
+}
+
+// persistentVolumeServer returns the server for a persistent volume request, if the user owns the server or
+// can manage it. Auditors can't access the volume, because it can hold data that isn't in the audit logs.
+func (m *MCPHandler) persistentVolumeServer(req api.Context) (v1.MCPServer, mcp.ServerConfig, error) {
+	jwks, err := m.jwks(req.Context())
+	if err != nil {
+		return v1.MCPServer{}, mcp.ServerConfig{}, fmt.Errorf("failed to get jwks: %w", err)
+	}
+
+	server, serverConfig, err := serverForAction(req, jwks)
+	if err != nil {
+		return v1.MCPServer{}, mcp.ServerConfig{}, err
+	}
+
+	if serverConfig.PersistentVolume == nil {
+		return v1.MCPServer{}, mcp.ServerConfig{}, types.NewErrBadRequest("MCP server %s does not have a persistent volume", server.Name)
+	}
+
+	// If this is a single-user MCP server that belongs to the user, then let them access the volume.
+	if (server.Spec.UserID != req.User.GetUID() || server.Spec.PowerUserWorkspaceID != "" || server.Spec.MCPCatalogID != "") && !req.UserIsAdmin() {
+		workspaceID := req.PathValue("workspace_id")
+		if workspaceID == "" {
+			return v1.MCPServer{}, mcp.ServerConfig{}, types.NewErrNotFound("MCP server %s not found", server.Name)
+		} else if server.Spec.PowerUserWorkspaceID != "" && workspaceID != server.Spec.PowerUserWorkspaceID {
+			return v1.MCPServer{}, mcp.ServerConfig{}, types.NewErrNotFound("MCP server %s not found", server.Name)
+		} else if server.Spec.PowerUserWorkspaceID == "" {
+			if server.Spec.MCPServerCatalogEntryName == "" {
+				return v1.MCPServer{}, mcp.ServerConfig{}, types.NewErrNotFound("MCP server %s not found", server.Name)
+			}
+
+			// In this case, the server should correspond to a workspace catalog entry.
+			var entry v1.MCPServerCatalogEntry
+			if err := req.Get(&entry, server.Spec.MCPServerCatalogEntryName); err != nil {
+				return v1.MCPServer{}, mcp.ServerConfig{}, fmt.Errorf("failed to get MCP server catalog entry: %v", err)
+			}
+
+			if entry.Spec.PowerUserWorkspaceID != workspaceID {
+				return v1.MCPServer{}, mcp.ServerConfig{}, types.NewErrNotFound("MCP server %s not found", server.Name)
+			}
+		}
+	}
+
+	// Use the user ID from the server rather than from the request.
+	serverConfig.UserID = server.Spec.UserID
+
+	return server, serverConfig, nil
+}
+
 func (m *MCPHandler) UpdateURL(req api.Context) error {
 	var mcpServer v1.MCPServer
 	if err := req.Get(&mcpServer, req.PathValue("mcp_server_id")); err != nil {
@@ -3537,32 +3857,61 @@ func (m *MCPHandler) TriggerUpdate(req api.Context) error {
 		return m.triggerCompositeUpdate(req, server, entry)
 	}
 
+	manifest, version, err := catalogEntryManifestForUser(req, entry, server.Spec.UserID)
+	if err != nil {
+		return err
+	}
+
 	oldServer := server.DeepCopy()
 
-	// Update the server manifest with the latest from the catalog entry
-	server.Spec.Manifest.Metadata = entry.Spec.Manifest.Metadata
-	server.Spec.Manifest.Name = entry.Spec.Manifest.Name
-	server.Spec.Manifest.ShortDescription = entry.Spec.Manifest.ShortDescription
-	server.Spec.Manifest.Description = entry.Spec.Manifest.Description
-	server.Spec.Manifest.Icon = entry.Spec.Manifest.Icon
-	server.Spec.Manifest.Env = entry.Spec.Manifest.Env
-	server.Spec.Manifest.Runtime = entry.Spec.Manifest.Runtime
-	server.Spec.Manifest.UVXConfig = entry.Spec.Manifest.UVXConfig
-	server.Spec.Manifest.NPXConfig = entry.Spec.Manifest.NPXConfig
-	server.Spec.Manifest.ContainerizedConfig = entry.Spec.Manifest.ContainerizedConfig
+	applyCatalogEntryManifest(&server, manifest)
+	server.Spec.MCPServerCatalogEntryVersion = version
+
+	// Shutdown the server, even if there is no credential
+	if err := m.removeMCPServer(req.Context(), *oldServer); err != nil {
+		return err
+	}
+
+	if err := req.Update(&server); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// applyCatalogEntryManifest updates a single-user server's manifest to match a catalog entry manifest,
+// keeping the user's URL when it still satisfies the entry's hostname requirement.
+func applyCatalogEntryManifest(server *v1.MCPServer, manifest types.MCPServerCatalogEntryManifest) {
+	server.Spec.Manifest.Metadata = manifest.Metadata
+	server.Spec.Manifest.Name = manifest.Name
+	server.Spec.Manifest.ShortDescription = manifest.ShortDescription
+	server.Spec.Manifest.Description = manifest.Description
+	server.Spec.Manifest.Icon = manifest.Icon
+	server.Spec.Manifest.Env = manifest.Env
+	server.Spec.Manifest.Runtime = manifest.Runtime
+	server.Spec.Manifest.UVXConfig = manifest.UVXConfig
+	server.Spec.Manifest.NPXConfig = manifest.NPXConfig
+	server.Spec.Manifest.ContainerizedConfig = manifest.ContainerizedConfig
+	server.Spec.Manifest.K8sOverrides = manifest.K8sOverrides
+	server.Spec.Manifest.EgressPolicy = manifest.EgressPolicy
+	server.Spec.Manifest.TrustLevel = manifest.TrustLevel
+	server.Spec.Manifest.PersistentVolume = manifest.PersistentVolume
+	server.Spec.Manifest.HealthProbe = manifest.HealthProbe
+	server.Spec.Manifest.Rollout = manifest.Rollout
+	server.Spec.Manifest.AuditLogRedaction = manifest.AuditLogRedaction
 
 	// Handle remote runtime URL updates
-	if entry.Spec.Manifest.Runtime == types.RuntimeRemote && entry.Spec.Manifest.RemoteConfig != nil {
-		if entry.Spec.Manifest.RemoteConfig.FixedURL != "" {
+	if manifest.Runtime == types.RuntimeRemote && manifest.RemoteConfig != nil {
+		if manifest.RemoteConfig.FixedURL != "" {
 			// Use the fixed URL from catalog entry
 			server.Spec.Manifest.RemoteConfig = &types.RemoteRuntimeConfig{
-				URL:     entry.Spec.Manifest.RemoteConfig.FixedURL,
-				Headers: entry.Spec.Manifest.RemoteConfig.Headers,
+				URL:     manifest.RemoteConfig.FixedURL,
+				Headers: manifest.RemoteConfig.Headers,
 			}
-		} else if entry.Spec.Manifest.RemoteConfig.Hostname != "" {
+		} else if manifest.RemoteConfig.Hostname != "" {
 			// Check if the server's current URL matches the new hostname requirement
 			if server.Spec.Manifest.RemoteConfig != nil && server.Spec.Manifest.RemoteConfig.URL != "" {
-				hostnameMismatchErr := types.ValidateURLHostname(server.Spec.Manifest.RemoteConfig.URL, entry.Spec.Manifest.RemoteConfig.Hostname)
+				hostnameMismatchErr := types.ValidateURLHostname(server.Spec.Manifest.RemoteConfig.URL, manifest.RemoteConfig.Hostname)
 
 				server.Spec.NeedsURL = hostnameMismatchErr != nil
 				if server.Spec.NeedsURL {
@@ -3571,13 +3920,13 @@ func (m *MCPHandler) TriggerUpdate(req api.Context) error {
 				}
 
 				server.Spec.Manifest.RemoteConfig = &types.RemoteRuntimeConfig{
-					Headers: entry.Spec.Manifest.RemoteConfig.Headers,
+					Headers: manifest.RemoteConfig.Headers,
 				}
 			} else {
 				// No current URL, needs one
 				server.Spec.NeedsURL = true
 				server.Spec.Manifest.RemoteConfig = &types.RemoteRuntimeConfig{
-					Headers: entry.Spec.Manifest.RemoteConfig.Headers,
+					Headers: manifest.RemoteConfig.Headers,
 				}
 			}
 		}
@@ -3585,17 +3934,6 @@ func (m *MCPHandler) TriggerUpdate(req api.Context) error {
 		// For non-remote runtimes, clear the remote config
 		server.Spec.Manifest.RemoteConfig = nil
 	}
-
-	// Shutdown the server, even if there is no credential
-	if err := m.removeMCPServer(req.Context(), *oldServer); err != nil {
-		return err
-	}
-
-	if err := req.Update(&server); err != nil {
-		return err
-	}
-
-	return nil
 }
 
 // triggerCompositeUpdate upgrades a composite server and all its component servers from the latest catalog entry
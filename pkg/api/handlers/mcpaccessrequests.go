@@ -0,0 +1,332 @@
+package handlers
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	gtime "github.com/obot-platform/obot/pkg/gateway/time"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/storage/selectors"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultMCPAccessRequestDuration = 7 * 24 * time.Hour
+	maxMCPAccessRequestDuration     = 365 * 24 * time.Hour
+)
+
+type MCPAccessRequestHandler struct{}
+
+func NewMCPAccessRequestHandler() *MCPAccessRequestHandler {
+	return &MCPAccessRequestHandler{}
+}
+
+// List returns the access requests the user can see. Admins and owners see all requests, workspace owners see the requests
+// for their workspace, and everyone sees their own requests.
+func (*MCPAccessRequestHandler) List(req api.Context) error {
+	var list v1.MCPAccessRequestList
+	if err := req.List(&list, &kclient.ListOptions{
+		FieldSelector: fields.SelectorFromSet(selectors.RemoveEmpty(map[string]string{
+			"spec.state": req.URL.Query().Get("state"),
+		})),
+	}); err != nil {
+		return fmt.Errorf("failed to list access requests: %w", err)
+	}
+
+	var workspaceID string
+	if !req.UserIsAdmin() && !req.UserIsOwner() {
+		var workspace v1.PowerUserWorkspace
+		if err := req.Get(&workspace, system.GetPowerUserWorkspaceID(req.User.GetUID())); err == nil && workspace.Spec.UserID == req.User.GetUID() {
+			workspaceID = workspace.Name
+		}
+	}
+
+	items := make([]types.MCPAccessRequest, 0, len(list.Items))
+	for _, item := range list.Items {
+		if req.UserIsAdmin() || req.UserIsOwner() ||
+			item.Spec.UserID == req.User.GetUID() ||
+			(workspaceID != "" && item.Spec.PowerUserWorkspaceID == workspaceID) {
+			items = append(items, convertMCPAccessRequest(item))
+		}
+	}
+
+	return req.Write(types.MCPAccessRequestList{
+		Items: items,
+	})
+}
+
+// Get returns a specific access request if the user made it or can decide on it.
+func (h *MCPAccessRequestHandler) Get(req api.Context) error {
+	request, err := h.getVisible(req)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(convertMCPAccessRequest(*request))
+}
+
+// Create submits a new access request for the current user.
+func (*MCPAccessRequestHandler) Create(req api.Context) error {
+	var manifest types.MCPAccessRequestManifest
+	if err := req.Read(&manifest); err != nil {
+		return types.NewErrBadRequest("failed to read access request manifest: %v", err)
+	}
+
+	if err := manifest.Validate(); err != nil {
+		return types.NewErrBadRequest("invalid access request: %v", err)
+	}
+
+	if manifest.Duration != "" {
+		if _, err := parseMCPAccessRequestDuration(manifest.Duration); err != nil {
+			return types.NewErrBadRequest("invalid duration: %v", err)
+		}
+	}
+
+	catalogID, workspaceID, err := mcpAccessRequestScope(req, manifest.Resource)
+	if err != nil {
+		return err
+	}
+
+	// Only allow one open request per user and resource.
+	var existing v1.MCPAccessRequestList
+	if err := req.List(&existing, &kclient.ListOptions{
+		FieldSelector: fields.SelectorFromSet(map[string]string{
+			"spec.userID": req.User.GetUID(),
+		}),
+	}); err != nil {
+		return fmt.Errorf("failed to list access requests: %w", err)
+	}
+	for _, item := range existing.Items {
+		if item.Spec.Manifest.Resource == manifest.Resource && !item.Spec.State.IsFinal() {
+			return types.NewErrAlreadyExists("an access request for %s %s already exists: %s", manifest.Resource.Type, manifest.Resource.ID, item.Name)
+		}
+	}
+
+	request := v1.MCPAccessRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.MCPAccessRequestPrefix,
+			Namespace:    req.Namespace(),
+		},
+		Spec: v1.MCPAccessRequestSpec{
+			Manifest:             manifest,
+			UserID:               req.User.GetUID(),
+			MCPCatalogID:         catalogID,
+			PowerUserWorkspaceID: workspaceID,
+			State:                types.MCPAccessRequestStatePending,
+		},
+	}
+
+	if err := req.Create(&request); err != nil {
+		return fmt.Errorf("failed to create access request: %w", err)
+	}
+
+	return req.WriteCreated(convertMCPAccessRequest(request))
+}
+
+// Delete withdraws an access request. Users can delete their own requests, and approvers can delete any request they can decide on.
+// Deleting an approved request also removes the access it granted.
+func (h *MCPAccessRequestHandler) Delete(req api.Context) error {
+	request, err := h.getVisible(req)
+	if err != nil {
+		return err
+	}
+
+	return req.Delete(request)
+}
+
+// Approve grants the requested access until the request expires.
+func (h *MCPAccessRequestHandler) Approve(req api.Context) error {
+	request, decision, err := h.readDecision(req)
+	if err != nil {
+		return err
+	}
+
+	if request.Spec.State != types.MCPAccessRequestStatePending {
+		return types.NewErrBadRequest("access request %s is %s, only pending requests can be approved", request.Name, request.Spec.State)
+	}
+
+	duration := defaultMCPAccessRequestDuration
+	if d := cmp.Or(decision.Duration, request.Spec.Manifest.Duration); d != "" {
+		if duration, err = parseMCPAccessRequestDuration(d); err != nil {
+			return types.NewErrBadRequest("invalid duration: %v", err)
+		}
+	}
+
+	now := time.Now()
+	request.Spec.State = types.MCPAccessRequestStateApproved
+	request.Spec.DecidedBy = req.User.GetUID()
+	request.Spec.DecisionReason = decision.Reason
+	request.Spec.DecidedAt = &metav1.Time{Time: now}
+	request.Spec.ExpiresAt = &metav1.Time{Time: now.Add(duration)}
+
+	if err := req.Update(request); err != nil {
+		return fmt.Errorf("failed to approve access request: %w", err)
+	}
+
+	return req.Write(convertMCPAccessRequest(*request))
+}
+
+// Deny rejects a pending access request.
+func (h *MCPAccessRequestHandler) Deny(req api.Context) error {
+	return h.decide(req, types.MCPAccessRequestStatePending, types.MCPAccessRequestStateDenied)
+}
+
+// Revoke removes the access granted by an approved request before it expires.
+func (h *MCPAccessRequestHandler) Revoke(req api.Context) error {
+	return h.decide(req, types.MCPAccessRequestStateApproved, types.MCPAccessRequestStateRevoked)
+}
+
+func (h *MCPAccessRequestHandler) decide(req api.Context, from, to types.MCPAccessRequestState) error {
+	request, decision, err := h.readDecision(req)
+	if err != nil {
+		return err
+	}
+
+	if request.Spec.State != from {
+		return types.NewErrBadRequest("access request %s is %s, only %s requests can be %s", request.Name, request.Spec.State, from, to)
+	}
+
+	if to == types.MCPAccessRequestStateDenied && decision.Reason == "" {
+		return types.NewErrBadRequest("a reason is required to deny an access request")
+	}
+
+	request.Spec.State = to
+	request.Spec.DecidedBy = req.User.GetUID()
+	request.Spec.DecisionReason = decision.Reason
+	request.Spec.DecidedAt = &metav1.Time{Time: time.Now()}
+
+	if err := req.Update(request); err != nil {
+		return fmt.Errorf("failed to update access request: %w", err)
+	}
+
+	return req.Write(convertMCPAccessRequest(*request))
+}
+
+func (h *MCPAccessRequestHandler) readDecision(req api.Context) (*v1.MCPAccessRequest, types.MCPAccessRequestDecision, error) {
+	var decision types.MCPAccessRequestDecision
+	request, err := h.getVisible(req)
+	if err != nil {
+		return nil, decision, err
+	}
+
+	if !canDecideMCPAccessRequest(req, request) {
+		return nil, decision, types.NewErrForbidden("user cannot decide on access request %s", request.Name)
+	}
+
+	// The decision body is optional.
+	if err := req.Read(&decision); err != nil && !errors.Is(err, io.EOF) {
+		return nil, decision, types.NewErrBadRequest("failed to read decision: %v", err)
+	}
+
+	return request, decision, nil
+}
+
+// getVisible returns the access request from the path if the user made it or can decide on it.
+func (*MCPAccessRequestHandler) getVisible(req api.Context) (*v1.MCPAccessRequest, error) {
+	var request v1.MCPAccessRequest
+	if err := req.Get(&request, req.PathValue("mcp_access_request_id")); err != nil {
+		return nil, err
+	}
+
+	if request.Spec.UserID != req.User.GetUID() && !canDecideMCPAccessRequest(req, &request) {
+		return nil, types.NewErrNotFound("access request %s not found", request.Name)
+	}
+
+	return &request, nil
+}
+
+// canDecideMCPAccessRequest returns true if the user is an admin or owner, or owns the workspace the request is for.
+func canDecideMCPAccessRequest(req api.Context, request *v1.MCPAccessRequest) bool {
+	if req.UserIsAdmin() || req.UserIsOwner() {
+		return true
+	}
+
+	if request.Spec.PowerUserWorkspaceID == "" {
+		return false
+	}
+
+	var workspace v1.PowerUserWorkspace
+	if err := req.Get(&workspace, request.Spec.PowerUserWorkspaceID); err != nil {
+		return false
+	}
+
+	return workspace.Spec.UserID == req.User.GetUID()
+}
+
+// mcpAccessRequestScope returns the catalog or workspace that the requested resource belongs to. Users can request access
+// to the entries and multi-user servers of the default catalog and of workspaces. Any other resource is reported as not
+// found, like one that doesn't exist, so that requests can't be used to find out what exists.
+func mcpAccessRequestScope(req api.Context, resource types.Resource) (string, string, error) {
+	switch resource.Type {
+	case types.ResourceTypeMCPServerCatalogEntry:
+		var entry v1.MCPServerCatalogEntry
+		if err := req.Get(&entry, resource.ID); apierrors.IsNotFound(err) {
+			return "", "", types.NewErrNotFound("MCP catalog entry not found")
+		} else if err != nil {
+			return "", "", fmt.Errorf("failed to get catalog entry: %w", err)
+		}
+		if !canRequestMCPAccess(&entry, entry.Spec.MCPCatalogName, entry.Spec.PowerUserWorkspaceID) {
+			return "", "", types.NewErrNotFound("MCP catalog entry not found")
+		}
+		return entry.Spec.MCPCatalogName, entry.Spec.PowerUserWorkspaceID, nil
+	case types.ResourceTypeMCPServer:
+		var server v1.MCPServer
+		if err := req.Get(&server, resource.ID); apierrors.IsNotFound(err) {
+			return "", "", types.NewErrNotFound("MCP server not found")
+		} else if err != nil {
+			return "", "", fmt.Errorf("failed to get MCP server: %w", err)
+		}
+		// Single-user servers have neither a catalog nor a workspace.
+		if !canRequestMCPAccess(&server, server.Spec.MCPCatalogID, server.Spec.PowerUserWorkspaceID) {
+			return "", "", types.NewErrNotFound("MCP server not found")
+		}
+		return server.Spec.MCPCatalogID, server.Spec.PowerUserWorkspaceID, nil
+	}
+
+	return "", "", types.NewErrBadRequest("unsupported resource type: %s", resource.Type)
+}
+
+// canRequestMCPAccess returns true if access to the resource can be requested, because it isn't being deleted and
+// belongs to the default catalog or to a workspace.
+func canRequestMCPAccess(obj metav1.Object, catalogID, workspaceID string) bool {
+	return obj.GetDeletionTimestamp().IsZero() && (catalogID == system.DefaultCatalog || workspaceID != "")
+}
+
+func parseMCPAccessRequestDuration(s string) (time.Duration, error) {
+	d, err := gtime.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("duration must be positive")
+	}
+	if d > maxMCPAccessRequestDuration {
+		return 0, fmt.Errorf("duration cannot be longer than %s", maxMCPAccessRequestDuration)
+	}
+	return d, nil
+}
+
+func convertMCPAccessRequest(request v1.MCPAccessRequest) types.MCPAccessRequest {
+	return types.MCPAccessRequest{
+		Metadata:                 MetadataFrom(&request),
+		MCPAccessRequestManifest: request.Spec.Manifest,
+		UserID:                   request.Spec.UserID,
+		MCPCatalogID:             request.Spec.MCPCatalogID,
+		PowerUserWorkspaceID:     request.Spec.PowerUserWorkspaceID,
+		State:                    request.Spec.State,
+		DecidedBy:                request.Spec.DecidedBy,
+		DecisionReason:           request.Spec.DecisionReason,
+		DecidedAt:                v1.NewTime(request.Spec.DecidedAt),
+		ExpiresAt:                v1.NewTime(request.Spec.ExpiresAt),
+		AccessControlRuleID:      request.Status.AccessControlRuleName,
+	}
+}
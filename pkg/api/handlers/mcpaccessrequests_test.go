@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/storage/scheme"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newMCPAccessRequestStorage(objs ...kclient.Object) kclient.WithWatch {
+	builder := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objs...)
+	for _, field := range []string{"spec.userID", "spec.state"} {
+		builder = builder.WithIndex(&v1.MCPAccessRequest{}, field, func(obj kclient.Object) []string {
+			return []string{obj.(*v1.MCPAccessRequest).Get(field)}
+		})
+	}
+	return builder.Build()
+}
+
+func newMCPAccessRequestContext(storage kclient.WithWatch, requestID, body, userID string, groups ...string) api.Context {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	r.SetPathValue("mcp_access_request_id", requestID)
+	return api.Context{
+		ResponseWriter: httptest.NewRecorder(),
+		Request:        r,
+		Storage:        storage,
+		User:           &user.DefaultInfo{UID: userID, Groups: groups},
+	}
+}
+
+func pendingMCPAccessRequest(name, workspaceID string) *v1.MCPAccessRequest {
+	return &v1.MCPAccessRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: system.DefaultNamespace},
+		Spec: v1.MCPAccessRequestSpec{
+			Manifest: types.MCPAccessRequestManifest{
+				Resource: types.Resource{Type: types.ResourceTypeMCPServerCatalogEntry, ID: "entry1"},
+			},
+			UserID:               "requester",
+			PowerUserWorkspaceID: workspaceID,
+			State:                types.MCPAccessRequestStatePending,
+		},
+	}
+}
+
+func TestCreateMCPAccessRequestScope(t *testing.T) {
+	storage := newMCPAccessRequestStorage(
+		&v1.MCPServerCatalogEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "entry1", Namespace: system.DefaultNamespace},
+			Spec:       v1.MCPServerCatalogEntrySpec{MCPCatalogName: system.DefaultCatalog},
+		},
+		&v1.MCPServerCatalogEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "entry2", Namespace: system.DefaultNamespace},
+			Spec:       v1.MCPServerCatalogEntrySpec{MCPCatalogName: "other"},
+		},
+		&v1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "ms1", Namespace: system.DefaultNamespace},
+			Spec:       v1.MCPServerSpec{PowerUserWorkspaceID: "puw1"},
+		},
+		// A single-user server.
+		&v1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "ms2", Namespace: system.DefaultNamespace},
+			Spec:       v1.MCPServerSpec{UserID: "someone"},
+		},
+	)
+	handler := NewMCPAccessRequestHandler()
+
+	for _, tt := range []struct {
+		name         string
+		resource     string
+		catalogID    string
+		workspaceID  string
+		expectedCode int
+	}{
+		{name: "default catalog entry", resource: `{"type":"mcpServerCatalogEntry","id":"entry1"}`, catalogID: system.DefaultCatalog},
+		{name: "workspace server", resource: `{"type":"mcpServer","id":"ms1"}`, workspaceID: "puw1"},
+		{name: "missing entry", resource: `{"type":"mcpServerCatalogEntry","id":"missing"}`, expectedCode: http.StatusNotFound},
+		{name: "entry of another catalog", resource: `{"type":"mcpServerCatalogEntry","id":"entry2"}`, expectedCode: http.StatusNotFound},
+		{name: "missing server", resource: `{"type":"mcpServer","id":"missing"}`, expectedCode: http.StatusNotFound},
+		{name: "single-user server", resource: `{"type":"mcpServer","id":"ms2"}`, expectedCode: http.StatusNotFound},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := handler.Create(newMCPAccessRequestContext(storage, "", `{"resource":`+tt.resource+`}`, "u-"+strings.ReplaceAll(tt.name, " ", "-")))
+			if tt.expectedCode != 0 {
+				var errHTTP *types.ErrHTTP
+				require.ErrorAs(t, err, &errHTTP)
+				assert.Equal(t, tt.expectedCode, errHTTP.Code)
+				// Resources that can't be requested look the same as ones that don't exist.
+				assert.NotContains(t, errHTTP.Message, "missing")
+				assert.NotContains(t, errHTTP.Message, "entry2")
+				assert.NotContains(t, errHTTP.Message, "ms2")
+				return
+			}
+			require.NoError(t, err)
+
+			var list v1.MCPAccessRequestList
+			require.NoError(t, storage.List(t.Context(), &list))
+			var found bool
+			for _, item := range list.Items {
+				if item.Spec.UserID == "u-"+strings.ReplaceAll(tt.name, " ", "-") {
+					found = true
+					assert.Equal(t, tt.catalogID, item.Spec.MCPCatalogID)
+					assert.Equal(t, tt.workspaceID, item.Spec.PowerUserWorkspaceID)
+					assert.Equal(t, types.MCPAccessRequestStatePending, item.Spec.State)
+				}
+			}
+			assert.True(t, found)
+		})
+	}
+}
+
+func TestApproveMCPAccessRequestApprovers(t *testing.T) {
+	workspace := &v1.PowerUserWorkspace{
+		ObjectMeta: metav1.ObjectMeta{Name: "puw1", Namespace: system.DefaultNamespace},
+		Spec:       v1.PowerUserWorkspaceSpec{UserID: "workspace-owner"},
+	}
+	handler := NewMCPAccessRequestHandler()
+
+	for _, tt := range []struct {
+		name         string
+		workspaceID  string
+		userID       string
+		groups       []string
+		expectedCode int
+	}{
+		{name: "admin", userID: "admin", groups: []string{types.GroupAdmin}},
+		{name: "owner", userID: "owner", groups: []string{types.GroupOwner}},
+		{name: "workspace owner", workspaceID: "puw1", userID: "workspace-owner"},
+		// Other users don't see the request at all.
+		{name: "owner of another workspace", userID: "workspace-owner", expectedCode: http.StatusNotFound},
+		{name: "other user", workspaceID: "puw1", userID: "other", groups: []string{types.GroupPowerUserPlus}, expectedCode: http.StatusNotFound},
+		// The requester can see the request, but can't approve it.
+		{name: "requester", workspaceID: "puw1", userID: "requester", expectedCode: http.StatusForbidden},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := newMCPAccessRequestStorage(workspace, pendingMCPAccessRequest("mar1", tt.workspaceID))
+
+			err := handler.Approve(newMCPAccessRequestContext(storage, "mar1", "", tt.userID, tt.groups...))
+			if tt.expectedCode != 0 {
+				var errHTTP *types.ErrHTTP
+				require.ErrorAs(t, err, &errHTTP)
+				assert.Equal(t, tt.expectedCode, errHTTP.Code)
+				return
+			}
+			require.NoError(t, err)
+
+			var request v1.MCPAccessRequest
+			require.NoError(t, storage.Get(t.Context(), kclient.ObjectKey{Namespace: system.DefaultNamespace, Name: "mar1"}, &request))
+			assert.Equal(t, types.MCPAccessRequestStateApproved, request.Spec.State)
+			assert.Equal(t, tt.userID, request.Spec.DecidedBy)
+		})
+	}
+}
+
+func TestApproveMCPAccessRequestExpiresAt(t *testing.T) {
+	handler := NewMCPAccessRequestHandler()
+
+	for _, tt := range []struct {
+		name      string
+		requested string
+		decision  string
+		expected  time.Duration
+		wantErr   bool
+	}{
+		{name: "default", expected: defaultMCPAccessRequestDuration},
+		{name: "requested", requested: "8h", expected: 8 * time.Hour},
+		{name: "decision overrides request", requested: "8h", decision: `{"duration":"2d"}`, expected: 48 * time.Hour},
+		{name: "maximum", decision: `{"duration":"365d"}`, expected: maxMCPAccessRequestDuration},
+		{name: "longer than maximum", decision: `{"duration":"366d"}`, wantErr: true},
+		{name: "not positive", decision: `{"duration":"0h"}`, wantErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			request := pendingMCPAccessRequest("mar1", "")
+			request.Spec.Manifest.Duration = tt.requested
+			storage := newMCPAccessRequestStorage(request)
+
+			before := time.Now()
+			err := handler.Approve(newMCPAccessRequestContext(storage, "mar1", tt.decision, "admin", types.GroupAdmin))
+			if tt.wantErr {
+				var errHTTP *types.ErrHTTP
+				require.ErrorAs(t, err, &errHTTP)
+				assert.Equal(t, http.StatusBadRequest, errHTTP.Code)
+				return
+			}
+			require.NoError(t, err)
+
+			require.NoError(t, storage.Get(t.Context(), kclient.ObjectKey{Namespace: system.DefaultNamespace, Name: "mar1"}, request))
+			require.NotNil(t, request.Spec.ExpiresAt)
+			assert.WithinRange(t, request.Spec.ExpiresAt.Time, before.Add(tt.expected).Add(-time.Second), time.Now().Add(tt.expected).Add(time.Second))
+		})
+	}
+}
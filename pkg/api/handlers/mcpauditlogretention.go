@@ -0,0 +1,294 @@
+package handlers
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/auditlogexport"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type MCPAuditLogRetentionHandler struct {
+	credProvider *auditlogexport.GPTScriptCredentialProvider
+}
+
+func NewMCPAuditLogRetentionHandler(gptClient *gptscript.GPTScript) *MCPAuditLogRetentionHandler {
+	return &MCPAuditLogRetentionHandler{
+		credProvider: auditlogexport.NewGPTScriptCredentialProvider(gptClient),
+	}
+}
+
+func (h *MCPAuditLogRetentionHandler) GetPolicy(req api.Context) error {
+	var policy v1.MCPAuditLogRetentionPolicy
+	if err := req.Get(&policy, system.MCPAuditLogRetentionPolicyName); apierrors.IsNotFound(err) {
+		// No policy has been configured, so audit logs are kept forever.
+		return req.Write(types.MCPAuditLogRetentionPolicy{})
+	} else if err != nil {
+		return err
+	}
+
+	return req.Write(convertMCPAuditLogRetentionPolicy(policy))
+}
+
+func (h *MCPAuditLogRetentionHandler) UpdatePolicy(req api.Context) error {
+	var input types.MCPAuditLogRetentionPolicyManifest
+	if err := req.Read(&input); err != nil {
+		return err
+	}
+
+	if err := validateMCPAuditLogRetentionPolicy(&input); err != nil {
+		return err
+	}
+
+	var policy v1.MCPAuditLogRetentionPolicy
+	if err := req.Get(&policy, system.MCPAuditLogRetentionPolicyName); apierrors.IsNotFound(err) {
+		policy = v1.MCPAuditLogRetentionPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      system.MCPAuditLogRetentionPolicyName,
+				Namespace: req.Namespace(),
+			},
+			Spec: v1.MCPAuditLogRetentionPolicySpec{
+				Manifest: input,
+			},
+		}
+
+		if err := req.Create(&policy); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	} else {
+		policy.Spec.Manifest = input
+		if err := req.Update(&policy); err != nil {
+			return err
+		}
+	}
+
+	return req.Write(convertMCPAuditLogRetentionPolicy(policy))
+}
+
+func validateMCPAuditLogRetentionPolicy(manifest *types.MCPAuditLogRetentionPolicyManifest) error {
+	if manifest.HotDays < 0 {
+		return types.NewErrBadRequest("hot days must not be negative")
+	}
+
+	manifest.Bucket = strings.TrimSpace(manifest.Bucket)
+	manifest.KeyPrefix = strings.Trim(strings.TrimSpace(manifest.KeyPrefix), "/")
+	if manifest.Archive && manifest.Bucket == "" {
+		return types.NewErrBadRequest("a bucket is required to archive audit logs")
+	}
+
+	seen := make(map[string]struct{}, len(manifest.Overrides))
+	for i, override := range manifest.Overrides {
+		if (override.MCPCatalogID == "") == (override.PowerUserWorkspaceID == "") {
+			return types.NewErrBadRequest("override %d must set exactly one of mcpCatalogID and powerUserWorkspaceID", i+1)
+		}
+		if override.HotDays < 0 {
+			return types.NewErrBadRequest("override %d: hot days must not be negative", i+1)
+		}
+
+		key := "mcpCatalog/" + override.MCPCatalogID
+		if override.PowerUserWorkspaceID != "" {
+			key = "powerUserWorkspace/" + override.PowerUserWorkspaceID
+		}
+		if _, ok := seen[key]; ok {
+			return types.NewErrBadRequest("override %d: %s already has an override", i+1, key)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+func convertMCPAuditLogRetentionPolicy(policy v1.MCPAuditLogRetentionPolicy) types.MCPAuditLogRetentionPolicy {
+	var lastRunAt *types.Time
+	if policy.Status.LastRunAt != nil {
+		lastRunAt = types.NewTime(policy.Status.LastRunAt.Time)
+	}
+
+	return types.MCPAuditLogRetentionPolicy{
+		Metadata:                           MetadataFrom(&policy),
+		MCPAuditLogRetentionPolicyManifest: policy.Spec.Manifest,
+		LastRunAt:                          lastRunAt,
+		LastError:                          policy.Status.LastError,
+		ArchivedLogCount:                   policy.Status.ArchivedLogCount,
+		DeletedLogCount:                    policy.Status.DeletedLogCount,
+	}
+}
+
+// ListArchives handles GET /api/mcp-audit-log-archives
+func (h *MCPAuditLogRetentionHandler) ListArchives(req api.Context) error {
+	query := req.URL.Query()
+
+	var start, end time.Time
+	if startTime := query.Get("start_time"); startTime != "" {
+		t, err := time.Parse(time.RFC3339, startTime)
+		if err != nil {
+			return types.NewErrBadRequest("invalid start_time %q", startTime)
+		}
+		start = t
+	}
+	if endTime := query.Get("end_time"); endTime != "" {
+		t, err := time.Parse(time.RFC3339, endTime)
+		if err != nil {
+			return types.NewErrBadRequest("invalid end_time %q", endTime)
+		}
+		end = t
+	}
+
+	archives, err := req.GatewayClient.GetMCPAuditLogArchives(req.Context(), query.Get("scope"), start, end)
+	if err != nil {
+		return err
+	}
+
+	items := make([]types.MCPAuditLogArchive, 0, len(archives))
+	for _, a := range archives {
+		items = append(items, gatewaytypes.ConvertMCPAuditLogArchive(a))
+	}
+
+	return req.Write(types.MCPAuditLogArchiveList{Items: items})
+}
+
+// ListArchivedLogs handles GET /api/mcp-audit-log-archives/{archive_id}/logs. It downloads the archive from the storage
+// provider and returns the audit logs in it that match the filters.
+func (h *MCPAuditLogRetentionHandler) ListArchivedLogs(req api.Context) error {
+	id, err := strconv.ParseUint(req.PathValue("archive_id"), 10, 64)
+	if err != nil {
+		return types.NewErrBadRequest("invalid archive id %q", req.PathValue("archive_id"))
+	}
+
+	archive, err := req.GatewayClient.GetMCPAuditLogArchive(req.Context(), uint(id))
+	if err != nil {
+		return err
+	}
+
+	query := req.URL.Query()
+	filter := archivedLogFilter{
+		userIDs:          queryValues(query["user_id"]),
+		mcpIDs:           queryValues(query["mcp_id"]),
+		callTypes:        queryValues(query["call_type"]),
+		callIdentifiers:  queryValues(query["call_identifier"]),
+		responseStatuses: queryValues(query["response_status"]),
+	}
+
+	limit, offset := 100, 0
+	if l, err := strconv.Atoi(query.Get("limit")); err == nil && l > 0 {
+		limit = l
+	}
+	if o, err := strconv.Atoi(query.Get("offset")); err == nil && o >= 0 {
+		offset = o
+	}
+
+	storageConfig, err := h.credProvider.GetStorageConfig(req.Context())
+	if err != nil {
+		return fmt.Errorf("failed to get storage config: %w", err)
+	}
+	if storageConfig == nil {
+		return types.NewErrBadRequest("no storage provider is configured")
+	}
+	if providerType, err := auditlogexport.ProviderTypeOf(*storageConfig); err != nil {
+		return err
+	} else if providerType != types.StorageProviderType(archive.StorageProvider) {
+		return types.NewErrBadRequest("the archive is stored in %s, but the configured storage provider is %s", archive.StorageProvider, providerType)
+	}
+
+	provider, err := auditlogexport.NewStorageProvider(types.StorageProviderType(archive.StorageProvider), h.credProvider)
+	if err != nil {
+		return err
+	}
+
+	body, err := provider.Download(req.Context(), *storageConfig, archive.Bucket, archive.Key)
+	if err != nil {
+		return fmt.Errorf("failed to download archive: %w", err)
+	}
+	defer body.Close()
+
+	gz, err := gzip.NewReader(body)
+	if err != nil {
+		return fmt.Errorf("failed to read archive: %w", err)
+	}
+	defer gz.Close()
+
+	var (
+		items   = make([]types.MCPAuditLog, 0)
+		total   int64
+		decoder = json.NewDecoder(bufio.NewReader(gz))
+	)
+	for {
+		var entry types.MCPAuditLog
+		if err := decoder.Decode(&entry); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to read archive: %w", err)
+		}
+
+		if !filter.matches(entry) {
+			continue
+		}
+		total++
+		if total <= int64(offset) || len(items) == limit {
+			continue
+		}
+
+		if !req.UserIsAuditor() {
+			entry.RequestBody = nil
+			entry.ResponseBody = nil
+			entry.RequestHeaders = nil
+			entry.ResponseHeaders = nil
+		}
+		items = append(items, entry)
+	}
+
+	return req.Write(types.MCPAuditLogResponse{
+		MCPAuditLogList: types.MCPAuditLogList{
+			Items: items,
+		},
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	})
+}
+
+type archivedLogFilter struct {
+	userIDs, mcpIDs, callTypes, callIdentifiers, responseStatuses []string
+}
+
+func (f archivedLogFilter) matches(log types.MCPAuditLog) bool {
+	return matchesAny(f.userIDs, log.UserID) &&
+		matchesAny(f.mcpIDs, log.MCPID) &&
+		matchesAny(f.callTypes, log.CallType) &&
+		matchesAny(f.callIdentifiers, log.CallIdentifier) &&
+		matchesAny(f.responseStatuses, strconv.Itoa(log.ResponseStatus))
+}
+
+func matchesAny(values []string, value string) bool {
+	return len(values) == 0 || slices.Contains(values, value)
+}
+
+// queryValues splits comma-separated query values.
+func queryValues(values []string) []string {
+	var result []string
+	for _, v := range values {
+		for part := range strings.SplitSeq(v, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				result = append(result, part)
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMCPAuditLogRetentionPolicy(t *testing.T) {
+	manifest := types.MCPAuditLogRetentionPolicyManifest{
+		HotDays:   30,
+		Archive:   true,
+		Bucket:    " audit ",
+		KeyPrefix: "/archives/",
+		Overrides: []types.MCPAuditLogRetentionOverride{
+			{MCPCatalogID: "default", HotDays: 7},
+			{PowerUserWorkspaceID: "puw1", HotDays: 90},
+		},
+	}
+	assert.NoError(t, validateMCPAuditLogRetentionPolicy(&manifest))
+	assert.Equal(t, "audit", manifest.Bucket)
+	assert.Equal(t, "archives", manifest.KeyPrefix)
+
+	for name, manifest := range map[string]types.MCPAuditLogRetentionPolicyManifest{
+		"negative hot days":   {HotDays: -1},
+		"archive, no bucket":  {HotDays: 30, Archive: true},
+		"override, no target": {Overrides: []types.MCPAuditLogRetentionOverride{{HotDays: 7}}},
+		"override, two targets": {Overrides: []types.MCPAuditLogRetentionOverride{
+			{MCPCatalogID: "default", PowerUserWorkspaceID: "puw1", HotDays: 7},
+		}},
+		"duplicate override": {Overrides: []types.MCPAuditLogRetentionOverride{
+			{MCPCatalogID: "default", HotDays: 7},
+			{MCPCatalogID: "default", HotDays: 14},
+		}},
+	} {
+		assert.Error(t, validateMCPAuditLogRetentionPolicy(&manifest), name)
+	}
+}
+
+func TestArchivedLogFilter(t *testing.T) {
+	log := types.MCPAuditLog{UserID: "1", MCPID: "ms1", CallType: "tools/call", CallIdentifier: "search", ResponseStatus: 500}
+
+	assert.True(t, archivedLogFilter{}.matches(log))
+	assert.True(t, archivedLogFilter{
+		userIDs:          queryValues([]string{"1,2"}),
+		responseStatuses: queryValues([]string{"500"}),
+	}.matches(log))
+	assert.False(t, archivedLogFilter{mcpIDs: []string{"ms2"}}.matches(log))
+	assert.False(t, archivedLogFilter{userIDs: []string{"1"}, callTypes: []string{"resources/read"}}.matches(log))
+}
@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/auditlogquery"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type MCPAuditLogSavedSearchHandler struct{}
+
+func NewMCPAuditLogSavedSearchHandler() *MCPAuditLogSavedSearchHandler {
+	return &MCPAuditLogSavedSearchHandler{}
+}
+
+// List returns the user's saved searches and the shared searches of other users.
+func (*MCPAuditLogSavedSearchHandler) List(req api.Context) error {
+	var list v1.MCPAuditLogSavedSearchList
+	if err := req.List(&list); err != nil {
+		return fmt.Errorf("failed to list saved searches: %w", err)
+	}
+
+	items := make([]types.MCPAuditLogSavedSearch, 0, len(list.Items))
+	for _, item := range list.Items {
+		if canSeeMCPAuditLogSavedSearch(req, &item) {
+			items = append(items, convertMCPAuditLogSavedSearch(item))
+		}
+	}
+
+	return req.Write(types.MCPAuditLogSavedSearchList{
+		Items: items,
+	})
+}
+
+// Get returns a saved search if the user created it or it is shared.
+func (*MCPAuditLogSavedSearchHandler) Get(req api.Context) error {
+	search, err := getMCPAuditLogSavedSearch(req, req.PathValue("saved_search_id"))
+	if err != nil {
+		return err
+	}
+
+	return req.Write(convertMCPAuditLogSavedSearch(*search))
+}
+
+// Create saves a search for the current user.
+func (*MCPAuditLogSavedSearchHandler) Create(req api.Context) error {
+	var manifest types.MCPAuditLogSavedSearchManifest
+	if err := req.Read(&manifest); err != nil {
+		return types.NewErrBadRequest("failed to read saved search: %v", err)
+	}
+
+	if err := validateMCPAuditLogSavedSearch(&manifest); err != nil {
+		return err
+	}
+
+	search := v1.MCPAuditLogSavedSearch{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.MCPAuditLogSavedSearchPrefix,
+			Namespace:    req.Namespace(),
+		},
+		Spec: v1.MCPAuditLogSavedSearchSpec{
+			Manifest: manifest,
+			UserID:   req.User.GetUID(),
+		},
+	}
+
+	if err := req.Create(&search); err != nil {
+		return fmt.Errorf("failed to create saved search: %w", err)
+	}
+
+	return req.WriteCreated(convertMCPAuditLogSavedSearch(search))
+}
+
+// Update changes a saved search. Only the user that created it can change it.
+func (*MCPAuditLogSavedSearchHandler) Update(req api.Context) error {
+	search, err := getMCPAuditLogSavedSearch(req, req.PathValue("saved_search_id"))
+	if err != nil {
+		return err
+	}
+
+	if search.Spec.UserID != req.User.GetUID() {
+		return types.NewErrForbidden("only the user that created saved search %s can change it", search.Name)
+	}
+
+	var manifest types.MCPAuditLogSavedSearchManifest
+	if err := req.Read(&manifest); err != nil {
+		return types.NewErrBadRequest("failed to read saved search: %v", err)
+	}
+
+	if err := validateMCPAuditLogSavedSearch(&manifest); err != nil {
+		return err
+	}
+
+	search.Spec.Manifest = manifest
+	if err := req.Update(search); err != nil {
+		return fmt.Errorf("failed to update saved search: %w", err)
+	}
+
+	return req.Write(convertMCPAuditLogSavedSearch(*search))
+}
+
+// Delete removes a saved search. The user that created it, and admins and owners, can delete it.
+func (*MCPAuditLogSavedSearchHandler) Delete(req api.Context) error {
+	search, err := getMCPAuditLogSavedSearch(req, req.PathValue("saved_search_id"))
+	if err != nil {
+		return err
+	}
+
+	if search.Spec.UserID != req.User.GetUID() && !req.UserIsAdmin() && !req.UserIsOwner() {
+		return types.NewErrForbidden("only the user that created saved search %s can delete it", search.Name)
+	}
+
+	return req.Delete(search)
+}
+
+func validateMCPAuditLogSavedSearch(manifest *types.MCPAuditLogSavedSearchManifest) error {
+	manifest.Name = strings.TrimSpace(manifest.Name)
+	if manifest.Name == "" {
+		return types.NewErrBadRequest("name is required")
+	}
+
+	manifest.Query = strings.TrimSpace(manifest.Query)
+	if _, err := auditlogquery.Parse(manifest.Query); err != nil {
+		return types.NewErrBadRequest("invalid query: %v", err)
+	}
+
+	return nil
+}
+
+func canSeeMCPAuditLogSavedSearch(req api.Context, search *v1.MCPAuditLogSavedSearch) bool {
+	return search.Spec.Manifest.Shared || search.Spec.UserID == req.User.GetUID()
+}
+
+// getMCPAuditLogSavedSearch returns the saved search if the user created it or it is shared.
+func getMCPAuditLogSavedSearch(req api.Context, id string) (*v1.MCPAuditLogSavedSearch, error) {
+	var search v1.MCPAuditLogSavedSearch
+	if err := req.Get(&search, id); err != nil {
+		return nil, err
+	}
+
+	if !canSeeMCPAuditLogSavedSearch(req, &search) {
+		return nil, types.NewErrNotFound("saved search %s not found", id)
+	}
+
+	return &search, nil
+}
+
+// ResolveMCPAuditLogSearch parses the search, combined with the query of the saved search if there is one, for the user.
+// It returns nil if there is nothing to search for. The arguments of requests are part of their bodies, so only auditors
+// can compare them, and free text is only searched for in them for auditors.
+func ResolveMCPAuditLogSearch(req api.Context, search, savedSearchID string) (*auditlogquery.Query, error) {
+	if savedSearchID != "" {
+		saved, err := getMCPAuditLogSavedSearch(req, savedSearchID)
+		if err != nil {
+			return nil, err
+		}
+		search = auditlogquery.Combine(saved.Spec.Manifest.Query, search)
+	}
+
+	if strings.TrimSpace(search) == "" {
+		return nil, nil
+	}
+
+	query, err := auditlogquery.Parse(search)
+	if err != nil {
+		return nil, types.NewErrBadRequest("invalid search: %v", err)
+	}
+
+	if !req.UserIsAuditor() {
+		if query = query.WithoutArgumentText(); query.UsesArguments() {
+			return nil, types.NewErrForbidden("only auditors can search the arguments of requests")
+		}
+	}
+
+	return query, nil
+}
+
+func convertMCPAuditLogSavedSearch(search v1.MCPAuditLogSavedSearch) types.MCPAuditLogSavedSearch {
+	return types.MCPAuditLogSavedSearch{
+		Metadata:                       MetadataFrom(&search),
+		MCPAuditLogSavedSearchManifest: search.Spec.Manifest,
+		UserID:                         search.Spec.UserID,
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"path"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 
@@ -16,8 +18,10 @@ import (
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/pkg/accesscontrolrule"
 	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/controller/handlers/mcpcatalog"
 	gclient "github.com/obot-platform/obot/pkg/gateway/client"
 	"github.com/obot-platform/obot/pkg/mcp"
+	"github.com/obot-platform/obot/pkg/oci"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	"github.com/obot-platform/obot/pkg/validation"
@@ -111,7 +115,7 @@ func (h *MCPCatalogHandler) Update(req api.Context) error {
 		return fmt.Errorf("failed to get catalog: %w", err)
 	}
 
-	// The only field that can be updated is the source URLs.
+	// The only fields that can be updated are the source URLs, their configs, and staged rollouts.
 	for _, urlStr := range manifest.SourceURLs {
 		if urlStr != "" && urlStr != h.defaultCatalogPath {
 			u, err := url.Parse(urlStr)
@@ -119,8 +123,10 @@ func (h *MCPCatalogHandler) Update(req api.Context) error {
 				return types.NewErrBadRequest("invalid URL: %v", err)
 			}
 
-			if u.Scheme != "https" {
-				return types.NewErrBadRequest("only HTTPS URLs are supported")
+			switch u.Scheme {
+			case "https", "oci", "git+https", "git+ssh", "registry+https":
+			default:
+				return types.NewErrBadRequest("only https://, oci://, git+https://, git+ssh://, and registry+https:// URLs are supported")
 			}
 		}
 	}
@@ -136,7 +142,66 @@ func (h *MCPCatalogHandler) Update(req api.Context) error {
 		}
 	}
 
+	// Keep the existing source configs if none were provided.
+	configs := manifest.SourceConfigs
+	if configs == nil {
+		configs = catalog.Spec.SourceConfigs
+	}
+
+	sourceConfigs := make(map[string]types.MCPCatalogSourceConfig, len(configs))
+	for urlStr, config := range configs {
+		if _, ok := seen[urlStr]; !ok {
+			if manifest.SourceConfigs == nil {
+				continue
+			}
+			return types.NewErrBadRequest("source config found for unknown URL: %s", urlStr)
+		}
+
+		isRegistry := strings.HasPrefix(urlStr, "registry+")
+		if config.PublicKey != "" {
+			if isRegistry {
+				return types.NewErrBadRequest("registry sources cannot be signed: %s", urlStr)
+			}
+			if _, err := oci.ParsePublicKey(config.PublicKey); err != nil {
+				return types.NewErrBadRequest("invalid public key for %s: %v", urlStr, err)
+			}
+		}
+
+		if config.Registry != nil {
+			if !isRegistry {
+				return types.NewErrBadRequest("registry config is only supported for registry+https:// URLs: %s", urlStr)
+			}
+			for _, pattern := range slices.Concat(config.Registry.AllowedServers, config.Registry.DeniedServers) {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return types.NewErrBadRequest("invalid server pattern %q for %s: %v", pattern, urlStr, err)
+				}
+			}
+		}
+
+		// Credentials can only be changed through the source credentials endpoints.
+		config.HasCredentials = catalog.Spec.SourceConfigs[urlStr].HasCredentials
+		sourceConfigs[urlStr] = config
+	}
+
+	// Keep the credentials for the remaining URLs, and remove them for the URLs that were removed.
+	for urlStr, config := range catalog.Spec.SourceConfigs {
+		if !config.HasCredentials {
+			continue
+		}
+
+		if _, ok := seen[urlStr]; !ok {
+			credCtx, credName := mcpcatalog.SourceCredentialContext(catalog.Name, urlStr)
+			if err := req.GPTClient.DeleteCredential(req.Context(), credCtx, credName); err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+				return fmt.Errorf("failed to delete credentials for %s: %w", urlStr, err)
+			}
+		} else if _, ok := sourceConfigs[urlStr]; !ok {
+			sourceConfigs[urlStr] = types.MCPCatalogSourceConfig{HasCredentials: true}
+		}
+	}
+
 	catalog.Spec.SourceURLs = manifest.SourceURLs
+	catalog.Spec.SourceConfigs = sourceConfigs
+	catalog.Spec.StagedRollout = manifest.StagedRollout
 
 	if err := req.Update(&catalog); err != nil {
 		return fmt.Errorf("failed to update catalog: %w", err)
@@ -145,6 +210,95 @@ func (h *MCPCatalogHandler) Update(req api.Context) error {
 	return req.Write(convertMCPCatalog(catalog))
 }
 
+// ConfigureSourceCredentials stores the credentials used to read a private source of a catalog.
+func (h *MCPCatalogHandler) ConfigureSourceCredentials(req api.Context) error {
+	var creds types.MCPCatalogSourceCredentials
+	if err := req.Read(&creds); err != nil {
+		return types.NewErrBadRequest("failed to read source credentials: %v", err)
+	}
+
+	if creds.Token == "" && creds.SSHPrivateKey == "" {
+		return types.NewErrBadRequest("a token or SSH private key is required")
+	}
+
+	var catalog v1.MCPCatalog
+	if err := req.Get(&catalog, req.PathValue("catalog_id")); err != nil {
+		return fmt.Errorf("failed to get catalog: %w", err)
+	}
+
+	if !slices.Contains(catalog.Spec.SourceURLs, creds.SourceURL) {
+		return types.NewErrBadRequest("catalog %s does not have source URL %s", catalog.Name, creds.SourceURL)
+	}
+
+	// The only way to update a credential is to delete the existing one and recreate it.
+	credCtx, credName := mcpcatalog.SourceCredentialContext(catalog.Name, creds.SourceURL)
+	if err := req.GPTClient.DeleteCredential(req.Context(), credCtx, credName); err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+		return fmt.Errorf("failed to remove existing credentials: %w", err)
+	}
+
+	if err := req.GPTClient.CreateCredential(req.Context(), gptscript.Credential{
+		Context:  credCtx,
+		ToolName: credName,
+		Type:     gptscript.CredentialTypeTool,
+		Env: map[string]string{
+			"username":        creds.Username,
+			"token":           creds.Token,
+			"ssh_private_key": creds.SSHPrivateKey,
+			"ssh_known_hosts": creds.SSHKnownHosts,
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to create credentials: %w", err)
+	}
+
+	return h.setSourceHasCredentials(req, &catalog, creds.SourceURL, true)
+}
+
+// DeleteSourceCredentials removes the credentials for a source of a catalog.
+func (h *MCPCatalogHandler) DeleteSourceCredentials(req api.Context) error {
+	sourceURL := req.URL.Query().Get("sourceURL")
+	if sourceURL == "" {
+		return types.NewErrBadRequest("sourceURL is required")
+	}
+
+	var catalog v1.MCPCatalog
+	if err := req.Get(&catalog, req.PathValue("catalog_id")); err != nil {
+		return fmt.Errorf("failed to get catalog: %w", err)
+	}
+
+	credCtx, credName := mcpcatalog.SourceCredentialContext(catalog.Name, sourceURL)
+	if err := req.GPTClient.DeleteCredential(req.Context(), credCtx, credName); err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+		return fmt.Errorf("failed to delete credentials: %w", err)
+	}
+
+	return h.setSourceHasCredentials(req, &catalog, sourceURL, false)
+}
+
+func (h *MCPCatalogHandler) setSourceHasCredentials(req api.Context, catalog *v1.MCPCatalog, sourceURL string, hasCredentials bool) error {
+	if catalog.Spec.SourceConfigs == nil {
+		catalog.Spec.SourceConfigs = make(map[string]types.MCPCatalogSourceConfig, 1)
+	}
+
+	config := catalog.Spec.SourceConfigs[sourceURL]
+	config.HasCredentials = hasCredentials
+	if config == (types.MCPCatalogSourceConfig{}) {
+		delete(catalog.Spec.SourceConfigs, sourceURL)
+	} else {
+		catalog.Spec.SourceConfigs[sourceURL] = config
+	}
+
+	// Sync the catalog so the new credentials take effect.
+	if catalog.Annotations == nil {
+		catalog.Annotations = make(map[string]string, 1)
+	}
+	catalog.Annotations[v1.MCPCatalogSyncAnnotation] = "true"
+
+	if err := req.Update(catalog); err != nil {
+		return fmt.Errorf("failed to update catalog: %w", err)
+	}
+
+	return req.Write(convertMCPCatalog(*catalog))
+}
+
 // ListEntries lists all entries for a catalog or workspace.
 func (h *MCPCatalogHandler) ListEntries(req api.Context) error {
 	catalogName := req.PathValue("catalog_id")
@@ -277,10 +431,11 @@ func (h *MCPCatalogHandler) CreateEntry(req api.Context) error {
 		return types.NewErrBadRequest("either catalog_id or workspace_id is required")
 	}
 
-	var manifest types.MCPServerCatalogEntryManifest
-	if err := req.Read(&manifest); err != nil {
+	var input types.MCPServerCatalogEntryInput
+	if err := req.Read(&input); err != nil {
 		return types.NewErrBadRequest("failed to read entry manifest: %v", err)
 	}
+	manifest := input.MCPServerCatalogEntryManifest
 
 	// Handle composite catalog entries
 	if manifest.Runtime == types.RuntimeComposite && manifest.CompositeConfig != nil {
@@ -293,6 +448,16 @@ func (h *MCPCatalogHandler) CreateEntry(req api.Context) error {
 		return types.NewErrBadRequest("failed to validate entry manifest: %v", err)
 	}
 
+	if err := checkServiceAccountOverride(req, nil, manifest.K8sOverrides); err != nil {
+		return err
+	}
+	if err := checkTrustLevel(req, "", manifest.TrustLevel); err != nil {
+		return err
+	}
+	if err := checkAuditLogRedaction(req, nil, manifest.AuditLogRedaction); err != nil {
+		return err
+	}
+
 	cleanName := normalizeMCPCatalogEntryName(manifest.Name)
 
 	entry := v1.MCPServerCatalogEntry{
@@ -300,8 +465,9 @@ func (h *MCPCatalogHandler) CreateEntry(req api.Context) error {
 			Namespace: req.Namespace(),
 		},
 		Spec: v1.MCPServerCatalogEntrySpec{
-			Editable: true,
-			Manifest: manifest,
+			Editable:      true,
+			Manifest:      manifest,
+			StagedRollout: input.StagedRollout,
 			// TODO(g-linville): add support for unsupportedTools field?
 		},
 	}
@@ -356,20 +522,32 @@ func (h *MCPCatalogHandler) UpdateEntry(req api.Context) error {
 		return types.NewErrBadRequest("entry is not editable")
 	}
 
-	var manifest types.MCPServerCatalogEntryManifest
-	if err := req.Read(&manifest); err != nil {
+	var input types.MCPServerCatalogEntryInput
+	if err := req.Read(&input); err != nil {
 		return types.NewErrBadRequest("failed to read entry manifest: %v", err)
 	}
+	manifest := input.MCPServerCatalogEntryManifest
 
 	if err := validation.ValidateCatalogEntryManifest(manifest); err != nil {
 		return types.NewErrBadRequest("failed to validate entry manifest: %v", err)
 	}
 
+	if err := checkServiceAccountOverride(req, entry.Spec.Manifest.K8sOverrides, manifest.K8sOverrides); err != nil {
+		return err
+	}
+	if err := checkTrustLevel(req, entry.Spec.Manifest.TrustLevel, manifest.TrustLevel); err != nil {
+		return err
+	}
+	if err := checkAuditLogRedaction(req, entry.Spec.Manifest.AuditLogRedaction, manifest.AuditLogRedaction); err != nil {
+		return err
+	}
+
 	// Copy the tool previews over so that they don't get wiped out when updating the manifest
 	manifest.ToolPreview = entry.Spec.Manifest.ToolPreview
 
 	// Update the manifest
 	entry.Spec.Manifest = manifest
+	entry.Spec.StagedRollout = input.StagedRollout
 
 	if err := req.Update(&entry); err != nil {
 		return fmt.Errorf("failed to update entry: %w", err)
@@ -1485,8 +1663,10 @@ func convertMCPCatalog(catalog v1.MCPCatalog) types.MCPCatalog {
 	return types.MCPCatalog{
 		Metadata: MetadataFrom(&catalog),
 		MCPCatalogManifest: types.MCPCatalogManifest{
-			DisplayName: catalog.Spec.DisplayName,
-			SourceURLs:  catalog.Spec.SourceURLs,
+			DisplayName:   catalog.Spec.DisplayName,
+			SourceURLs:    catalog.Spec.SourceURLs,
+			SourceConfigs: catalog.Spec.SourceConfigs,
+			StagedRollout: catalog.Spec.StagedRollout,
 		},
 		LastSynced: *types.NewTime(catalog.Status.LastSyncTime.Time),
 		SyncErrors: catalog.Status.SyncErrors,
@@ -0,0 +1,84 @@
+package mcpgateway
+
+import (
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/obot-platform/obot/pkg/mcp"
+)
+
+const (
+	sessionIDHeader = "Mcp-Session-Id"
+	// replicaSeparator separates the replica name from the session ID that the replica returned.
+	// Pod names can't contain dots, so the first dot always ends the replica name.
+	replicaSeparator = "."
+)
+
+var errSessionReplicaGone = errors.New("the replica that owned this session is no longer running")
+
+// routeToReplica picks the replica of a multi-replica server that should handle the request. Requests in a streamable HTTP
+// session always go to the replica that created the session, because MCP sessions are only valid on that replica.
+// To keep this stateless, the session IDs returned to clients are prefixed with the name of the replica, and the prefix
+// is removed from the request here. New sessions are spread across the replicas randomly.
+//
+// New sessions only go to serving replicas, but the sessions on the draining replicas of a server's old version
+// keep going to them after a blue/green rollout. There must be at least one serving replica.
+//
+// An empty replica is returned if the request has a session ID that wasn't created through a replica, in which case
+// the request should be sent to the server as usual.
+func routeToReplica(r *http.Request, replicas mcp.Replicas) (replica, replicaURL string, err error) {
+	sessionID := r.Header.Get(sessionIDHeader)
+	if sessionID == "" {
+		names := make([]string, 0, len(replicas.Serving))
+		for name := range replicas.Serving {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+
+		replica = names[rand.IntN(len(names))]
+		return replica, replicas.Serving[replica], nil
+	}
+
+	replica, upstreamSessionID, ok := strings.Cut(sessionID, replicaSeparator)
+	if !ok {
+		return "", "", nil
+	}
+
+	replicaURL, ok = replicas.Serving[replica]
+	if !ok {
+		replicaURL, ok = replicas.Draining[replica]
+	}
+	if !ok {
+		return "", "", errSessionReplicaGone
+	}
+
+	r.Header.Set(sessionIDHeader, upstreamSessionID)
+	return replica, replicaURL, nil
+}
+
+// routeToDrainingReplica returns the draining replica that created the request's session, if there is one.
+// These requests don't have to wait for the server's new version to be rolled out.
+func routeToDrainingReplica(r *http.Request, replicas mcp.Replicas) (replica, replicaURL string, ok bool) {
+	replica, upstreamSessionID, tagged := strings.Cut(r.Header.Get(sessionIDHeader), replicaSeparator)
+	if !tagged {
+		return "", "", false
+	}
+
+	replicaURL, ok = replicas.Draining[replica]
+	if !ok {
+		return "", "", false
+	}
+
+	r.Header.Set(sessionIDHeader, upstreamSessionID)
+	return replica, replicaURL, true
+}
+
+// tagSessionWithReplica prefixes the session ID in a response from a replica with the replica's name.
+func tagSessionWithReplica(resp *http.Response, replica string) {
+	if sessionID := resp.Header.Get(sessionIDHeader); sessionID != "" {
+		resp.Header.Set(sessionIDHeader, replica+replicaSeparator+sessionID)
+	}
+}
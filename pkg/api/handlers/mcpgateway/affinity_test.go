@@ -0,0 +1,87 @@
+package mcpgateway
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/obot-platform/obot/pkg/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouteToReplica(t *testing.T) {
+	replicas := mcp.Replicas{
+		Serving: map[string]string{
+			"server-abc-1": "http://10.0.0.1:8099",
+			"server-abc-2": "http://10.0.0.2:8099",
+		},
+	}
+
+	// New sessions go to any replica, and the session ID from the response is tagged with it.
+	req, err := http.NewRequest(http.MethodPost, "/mcp-connect/server", nil)
+	require.NoError(t, err)
+	replica, replicaURL, err := routeToReplica(req, replicas)
+	require.NoError(t, err)
+	assert.Equal(t, replicas.Serving[replica], replicaURL)
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set(sessionIDHeader, "session-1")
+	tagSessionWithReplica(resp, replica)
+	taggedSessionID := resp.Header.Get(sessionIDHeader)
+	assert.Equal(t, replica+".session-1", taggedSessionID)
+
+	// Requests in the session go back to the same replica with the original session ID.
+	req.Header.Set(sessionIDHeader, taggedSessionID)
+	pinnedReplica, pinnedURL, err := routeToReplica(req, replicas)
+	require.NoError(t, err)
+	assert.Equal(t, replica, pinnedReplica)
+	assert.Equal(t, replicaURL, pinnedURL)
+	assert.Equal(t, "session-1", req.Header.Get(sessionIDHeader))
+
+	// Sessions on replicas that are gone are rejected so the client starts a new one.
+	req.Header.Set(sessionIDHeader, "server-abc-3.session-2")
+	_, _, err = routeToReplica(req, replicas)
+	assert.ErrorIs(t, err, errSessionReplicaGone)
+
+	// Sessions that weren't created through a replica are left alone.
+	req.Header.Set(sessionIDHeader, "session-3")
+	replica, _, err = routeToReplica(req, replicas)
+	require.NoError(t, err)
+	assert.Empty(t, replica)
+	assert.Equal(t, "session-3", req.Header.Get(sessionIDHeader))
+}
+
+func TestRouteToDrainingReplica(t *testing.T) {
+	replicas := mcp.Replicas{
+		Serving:  map[string]string{"server-def-1": "http://10.0.0.3:8099"},
+		Draining: map[string]string{"server-abc-1": "http://10.0.0.1:8099"},
+	}
+
+	// New sessions only go to the new version.
+	req, err := http.NewRequest(http.MethodPost, "/mcp-connect/server", nil)
+	require.NoError(t, err)
+	replica, replicaURL, err := routeToReplica(req, replicas)
+	require.NoError(t, err)
+	assert.Equal(t, "server-def-1", replica)
+	assert.Equal(t, "http://10.0.0.3:8099", replicaURL)
+
+	// Sessions on the old version keep going to it.
+	req.Header.Set(sessionIDHeader, "server-abc-1.session-1")
+	replica, replicaURL, ok := routeToDrainingReplica(req, replicas)
+	require.True(t, ok)
+	assert.Equal(t, "server-abc-1", replica)
+	assert.Equal(t, "http://10.0.0.1:8099", replicaURL)
+	assert.Equal(t, "session-1", req.Header.Get(sessionIDHeader))
+
+	req.Header.Set(sessionIDHeader, "server-abc-1.session-1")
+	replica, replicaURL, err = routeToReplica(req, replicas)
+	require.NoError(t, err)
+	assert.Equal(t, "server-abc-1", replica)
+	assert.Equal(t, "http://10.0.0.1:8099", replicaURL)
+
+	// Sessions on the new version aren't draining.
+	req.Header.Set(sessionIDHeader, "server-def-1.session-2")
+	_, _, ok = routeToDrainingReplica(req, replicas)
+	assert.False(t, ok)
+	assert.Equal(t, "server-def-1.session-2", req.Header.Get(sessionIDHeader))
+}
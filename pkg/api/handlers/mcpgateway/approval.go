@@ -0,0 +1,107 @@
+package mcpgateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/api/server/requestinfo"
+	"github.com/obot-platform/obot/pkg/mcp"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/obot-platform/obot/pkg/toolapproval"
+	"github.com/tidwall/gjson"
+)
+
+// approvalRejectedCode is the JSON-RPC error code of calls, other than tool calls, that were not approved.
+const approvalRejectedCode = -32001
+
+// awaitApproval holds a JSON-RPC call until it is approved, if a policy requires approval for it. If the call was not
+// approved, then the response is written and the status of the response is returned.
+func (h *Handler) awaitApproval(req api.Context, serverConfig mcp.ServerConfig) (int, error) {
+	if h.toolApprovals == nil || req.Method != http.MethodPost || req.Request.Body == nil {
+		return 0, nil
+	}
+
+	body, err := readRequestBody(req)
+	if err != nil {
+		return 0, err
+	}
+
+	var powerUserWorkspaceID string
+	if system.IsPowerUserWorkspaceID(serverConfig.MCPCatalogName) {
+		powerUserWorkspaceID = serverConfig.MCPCatalogName
+	}
+
+	call := toolapproval.Call{
+		Namespace:                 serverConfig.MCPServerNamespace,
+		MCPServerName:             serverConfig.MCPServerName,
+		MCPServerDisplayName:      serverConfig.MCPServerDisplayName,
+		MCPServerCatalogEntryName: serverConfig.MCPCatalogEntryName,
+		MCPCatalogName:            serverConfig.MCPCatalogName,
+		PowerUserWorkspaceID:      powerUserWorkspaceID,
+		UserID:                    req.User.GetUID(),
+		SessionID:                 req.Request.Header.Get(sessionIDHeader),
+		ClientIP:                  requestinfo.GetSourceIP(req.Request),
+		UserAgent:                 req.Request.UserAgent(),
+		RequestBody:               body,
+	}
+
+	message := gjson.ParseBytes(body)
+	if message.IsArray() {
+		// A batch can't be held for some of its calls, so batches with calls that need approval are refused.
+		for _, m := range message.Array() {
+			batched := call
+			batched.Method = m.Get("method").String()
+			batched.Identifier = toolapproval.Identifier(batched.Method, m.Get("params"))
+			if batched.Method == "" || !m.Get("id").Exists() {
+				continue
+			}
+
+			policy, err := h.toolApprovals.Policy(req.Context(), batched)
+			if err != nil {
+				return 0, err
+			}
+			if policy != nil {
+				http.Error(req.ResponseWriter, fmt.Sprintf("%s needs approval and can't be called in a batch", batched.Identifier), http.StatusBadRequest)
+				return http.StatusBadRequest, nil
+			}
+		}
+		return 0, nil
+	}
+
+	// Notifications and responses don't need approval.
+	call.Method = message.Get("method").String()
+	if call.Method == "" || !message.Get("id").Exists() {
+		return 0, nil
+	}
+	call.Identifier = toolapproval.Identifier(call.Method, message.Get("params"))
+	call.RequestID = message.Get("id").String()
+
+	_, err = h.toolApprovals.Await(req.Context(), call)
+	if err == nil {
+		return 0, nil
+	}
+	if _, rejected := toolapproval.IsRejected(err); !rejected {
+		return 0, err
+	}
+
+	// The call is answered like the MCP server would, so that the client and its LLM see why it failed.
+	response := map[string]any{
+		"jsonrpc": "2.0",
+		"id":      json.RawMessage(message.Get("id").Raw),
+	}
+	if call.Method == "tools/call" {
+		response["result"] = map[string]any{
+			"content": []map[string]any{{"type": "text", "text": err.Error()}},
+			"isError": true,
+		}
+	} else {
+		response["error"] = map[string]any{
+			"code":    approvalRejectedCode,
+			"message": err.Error(),
+		}
+	}
+
+	return http.StatusOK, req.Write(response)
+}
@@ -1,6 +1,8 @@
 package mcpgateway
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"net/http"
 	"sort"
@@ -10,19 +12,35 @@ import (
 
 	"github.com/gptscript-ai/gptscript/pkg/hash"
 	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/logger"
 	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/api/handlers"
+	"github.com/obot-platform/obot/pkg/auditlogredact"
 	gateway "github.com/obot-platform/obot/pkg/gateway/client"
 	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/obot-platform/obot/pkg/injectionscan"
+	"github.com/obot-platform/obot/pkg/mcp"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
+	"github.com/obot-platform/obot/pkg/toolapproval"
 	"k8s.io/apimachinery/pkg/fields"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-type AuditLogHandler struct{}
+var log = logger.Package()
 
-func NewAuditLogHandler() *AuditLogHandler {
-	return &AuditLogHandler{}
+type AuditLogHandler struct {
+	toolApprovals    *toolapproval.Gate
+	webhookHelper    *mcp.WebhookHelper
+	injectionScanner *injectionscan.Provider
+}
+
+func NewAuditLogHandler(toolApprovals *toolapproval.Gate, webhookHelper *mcp.WebhookHelper, injectionScanner *injectionscan.Provider) *AuditLogHandler {
+	return &AuditLogHandler{
+		toolApprovals:    toolApprovals,
+		webhookHelper:    webhookHelper,
+		injectionScanner: injectionScanner,
+	}
 }
 
 // parseMultiValueParam parses query parameters that can have multiple values
@@ -80,11 +98,19 @@ func (h *AuditLogHandler) SubmitAuditLogs(req api.Context) error {
 
 	mcpServerName := mcpServers.Items[0].Name
 
+	redactor, err := auditlogredact.New(mcpServers.Items[0].Spec.Manifest.AuditLogRedaction)
+	if err != nil {
+		// Validation should prevent this. Don't store anything that the redaction rules were meant to remove.
+		log.Errorf("invalid audit log redaction for MCP server %s, storing metadata only: %v", mcpServerName, err)
+		redactor = auditlogredact.MetadataOnly()
+	}
+
 	var auditLogs []auditLogInput
 	if err := req.Read(&auditLogs); err != nil {
 		return types.NewErrBadRequest("failed to read input: %v", err)
 	}
 
+	logs := make([]gatewaytypes.MCPAuditLog, 0, len(auditLogs))
 	for _, auditLog := range auditLogs {
 		if auditLog.MCPID == "" {
 			auditLog.MCPID = auditLog.Metadata["mcpID"]
@@ -105,12 +131,114 @@ func (h *AuditLogHandler) SubmitAuditLogs(req api.Context) error {
 			auditLog.MCPServerDisplayName = auditLog.Metadata["mcpServerDisplayName"]
 		}
 
-		req.GatewayClient.LogMCPAuditEntry(auditLog.MCPAuditLog)
+		logs = append(logs, auditLog.MCPAuditLog)
+	}
+
+	// Webhooks in shadow mode see the requests before they are redacted, like the webhooks that the shim calls.
+	h.callShadowWebhooks(req, mcpServers.Items[0], logs)
+
+	// The scanner is deterministic, so scanning the responses again finds what the gateway found when it proxied them.
+	// This also has to happen before redaction, which could remove what was flagged.
+	h.scanAuditLogs(req, logs)
+
+	for i := range logs {
+		redactor.Redact(&logs[i])
+	}
+
+	// Only the gate knows which calls were approved, so the approval of the logs is never taken from the input.
+	if err := h.toolApprovals.AnnotateAuditLogs(req.Context(), mcpServers.Items[0].Namespace, mcpServerName, logs); err != nil {
+		log.Errorf("failed to add approvals to audit logs of MCP server %s: %v", mcpServerName, err)
+	}
+
+	for _, auditLog := range logs {
+		req.GatewayClient.LogMCPAuditEntry(auditLog)
 	}
 
 	return nil
 }
 
+// callShadowWebhooks calls the webhooks in shadow mode for the server with the requests in the logs and records their verdicts.
+// The webhooks are called in the background, so that slow webhooks don't hold up the shim. Failures are only logged, so that
+// the audit logs are stored regardless.
+func (h *AuditLogHandler) callShadowWebhooks(req api.Context, mcpServer v1.MCPServer, logs []gatewaytypes.MCPAuditLog) {
+	if h.webhookHelper == nil {
+		return
+	}
+
+	catalogName := mcpServer.Spec.MCPCatalogID
+	if catalogName == "" {
+		catalogName = mcpServer.Status.MCPCatalogID
+	}
+	if catalogName == "" && mcpServer.Spec.MCPServerCatalogEntryName != "" {
+		var entry v1.MCPServerCatalogEntry
+		if err := req.Get(&entry, mcpServer.Spec.MCPServerCatalogEntryName); err != nil {
+			log.Errorf("failed to get catalog entry of MCP server %s for webhooks in shadow mode: %v", mcpServer.Name, err)
+			return
+		}
+		catalogName = entry.Spec.MCPCatalogName
+	}
+
+	webhooks, err := h.webhookHelper.GetShadowWebhooksForMCPServer(req.Context(), req.GPTClient, mcp.ServerConfig{
+		MCPServerNamespace:  mcpServer.Namespace,
+		MCPServerName:       mcpServer.Name,
+		MCPCatalogName:      catalogName,
+		MCPCatalogEntryName: mcpServer.Spec.MCPServerCatalogEntryName,
+	})
+	if err != nil {
+		log.Errorf("failed to get webhooks in shadow mode for MCP server %s: %v", mcpServer.Name, err)
+		return
+	}
+
+	if len(webhooks) == 0 {
+		return
+	}
+
+	// The logs are redacted once this returns, so the webhooks get their own copy of the requests.
+	requests := make([]gatewaytypes.MCPAuditLog, len(logs))
+	for i, auditLog := range logs {
+		auditLog.RequestBody = bytes.Clone(auditLog.RequestBody)
+		requests[i] = auditLog
+	}
+
+	ctx, gatewayClient := context.WithoutCancel(req.Context()), req.GatewayClient
+	go func() {
+		verdicts := mcp.CallShadowWebhooks(ctx, webhooks, requests)
+		if len(verdicts) == 0 {
+			return
+		}
+
+		if err := gatewayClient.InsertMCPWebhookShadowVerdicts(ctx, verdicts); err != nil {
+			log.Errorf("failed to store verdicts of webhooks in shadow mode for MCP server %s: %v", mcpServer.Name, err)
+		}
+	}()
+}
+
+// scanAuditLogs records what the injection scanner finds in the responses of the logs, and the action of the policy for it.
+// Excerpts are left out, because they aren't encrypted or redacted like the bodies.
+func (h *AuditLogHandler) scanAuditLogs(req api.Context, logs []gatewaytypes.MCPAuditLog) {
+	scanner, err := h.injectionScanner.Scanner(req.Context())
+	if err != nil {
+		log.Errorf("failed to get injection scanner for audit logs: %v", err)
+		return
+	}
+	if scanner == nil {
+		return
+	}
+
+	for i := range logs {
+		result := scanner.Scan(logs[i].CallType, logs[i].ResponseBody)
+		if len(result.Findings) == 0 {
+			continue
+		}
+
+		for j := range result.Findings {
+			result.Findings[j].Excerpt = ""
+		}
+		logs[i].InjectionScanFindings = result.Findings
+		logs[i].InjectionScanAction = string(result.Action)
+	}
+}
+
 // ListAuditLogs handles GET /api/mcp-audit-logs and /api/mcp-audit-logs/{mcp_id}
 func (h *AuditLogHandler) ListAuditLogs(req api.Context) error {
 	query := req.URL.Query()
@@ -134,9 +262,17 @@ func (h *AuditLogHandler) ListAuditLogs(req api.Context) error {
 		ClientVersion:             parseMultiValueParam(query, "client_version"),
 		ResponseStatus:            parseMultiValueParam(query, "response_status"),
 		ClientIP:                  parseMultiValueParam(query, "client_ip"),
+		InjectionScanAction:       parseMultiValueParam(query, "injection_scan_action"),
 		Query:                     strings.TrimSpace(query.Get("query")),
 	}
 
+	// q is a query in the audit log query language, and saved_search the ID of a saved search to run.
+	search, err := handlers.ResolveMCPAuditLogSearch(req, query.Get("q"), query.Get("saved_search"))
+	if err != nil {
+		return err
+	}
+	opts.Search = search
+
 	// Apply workspace filtering for Power Users
 	if req.UserIsPowerUser() && !req.UserIsAdmin() {
 		opts.PowerUserWorkspaceID = []string{system.GetPowerUserWorkspaceID(req.User.GetUID())}
@@ -253,6 +389,7 @@ var filterOptions = map[string]any{
 	"client_version":                "",
 	"response_status":               0,
 	"client_ip":                     "",
+	"injection_scan_action":         "",
 }
 
 // defaultFilterOptions will always be present of the given filter, regardless of what is in the database.
@@ -280,6 +417,7 @@ func (h *AuditLogHandler) ListAuditLogFilterOptions(req api.Context) error {
 		ClientVersion:             parseMultiValueParam(query, "client_version"),
 		ResponseStatus:            parseMultiValueParam(query, "response_status"),
 		ClientIP:                  parseMultiValueParam(query, "client_ip"),
+		InjectionScanAction:       parseMultiValueParam(query, "injection_scan_action"),
 	}
 
 	// Apply workspace filtering for Power Users
@@ -0,0 +1,118 @@
+package mcpgateway
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	gateway "github.com/obot-platform/obot/pkg/gateway/client"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+// ListFindings handles GET /api/mcp-audit-log-findings
+func (h *AuditLogHandler) ListFindings(req api.Context) error {
+	query := req.URL.Query()
+
+	opts := gateway.MCPAuditLogFindingOptions{
+		Type:     parseMultiValueParam(query, "type"),
+		Severity: parseMultiValueParam(query, "severity"),
+		UserID:   parseMultiValueParam(query, "user_id"),
+		MCPID:    parseMultiValueParam(query, "mcp_id"),
+		Limit:    100,
+	}
+
+	if acknowledged := query.Get("acknowledged"); acknowledged != "" {
+		a, err := strconv.ParseBool(acknowledged)
+		if err != nil {
+			return types.NewErrBadRequest("invalid acknowledged value %q", acknowledged)
+		}
+		opts.Acknowledged = &a
+	}
+
+	if startTime := query.Get("start_time"); startTime != "" {
+		if t, err := time.Parse(time.RFC3339, startTime); err == nil {
+			opts.StartTime = t
+		}
+	}
+	if endTime := query.Get("end_time"); endTime != "" {
+		if t, err := time.Parse(time.RFC3339, endTime); err == nil {
+			opts.EndTime = t
+		}
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil && l > 0 {
+			opts.Limit = l
+		}
+	}
+	if offset := query.Get("offset"); offset != "" {
+		if o, err := strconv.Atoi(offset); err == nil && o >= 0 {
+			opts.Offset = o
+		}
+	}
+
+	findings, total, err := req.GatewayClient.GetMCPAuditLogFindings(req.Context(), opts)
+	if err != nil {
+		return err
+	}
+
+	items := make([]types.MCPAuditLogFinding, 0, len(findings))
+	for _, f := range findings {
+		items = append(items, gatewaytypes.ConvertMCPAuditLogFinding(f))
+	}
+
+	return req.Write(types.MCPAuditLogFindingResponse{
+		MCPAuditLogFindingList: types.MCPAuditLogFindingList{
+			Items: items,
+		},
+		Total:  total,
+		Limit:  opts.Limit,
+		Offset: opts.Offset,
+	})
+}
+
+// GetFinding handles GET /api/mcp-audit-log-findings/{finding_id}
+func (h *AuditLogHandler) GetFinding(req api.Context) error {
+	id, err := findingID(req)
+	if err != nil {
+		return err
+	}
+
+	finding, err := req.GatewayClient.GetMCPAuditLogFinding(req.Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(gatewaytypes.ConvertMCPAuditLogFinding(*finding))
+}
+
+// AcknowledgeFinding handles POST /api/mcp-audit-log-findings/{finding_id}/acknowledge
+func (h *AuditLogHandler) AcknowledgeFinding(req api.Context) error {
+	id, err := findingID(req)
+	if err != nil {
+		return err
+	}
+
+	var ack types.MCPAuditLogFindingAcknowledgement
+	if err := req.Read(&ack); err != nil && !errors.Is(err, io.EOF) {
+		return types.NewErrBadRequest("failed to read acknowledgement: %v", err)
+	}
+
+	finding, err := req.GatewayClient.AcknowledgeMCPAuditLogFinding(req.Context(), id, req.User.GetUID(), ack.Note)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(gatewaytypes.ConvertMCPAuditLogFinding(*finding))
+}
+
+func findingID(req api.Context) (uint, error) {
+	id, err := strconv.ParseUint(req.PathValue("finding_id"), 10, 64)
+	if err != nil {
+		return 0, types.NewErrBadRequest("invalid finding id %q", req.PathValue("finding_id"))
+	}
+	return uint(id), nil
+}
@@ -1,16 +1,29 @@
 package mcpgateway
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/pkg/api"
 	"github.com/obot-platform/obot/pkg/api/handlers"
+	"github.com/obot-platform/obot/pkg/injectionscan"
 	"github.com/obot-platform/obot/pkg/mcp"
+	"github.com/obot-platform/obot/pkg/metrics"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
+	"github.com/obot-platform/obot/pkg/toolapproval"
+	"github.com/obot-platform/obot/pkg/tooldrift"
+	"github.com/obot-platform/obot/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -20,28 +33,91 @@ type Handler struct {
 	storageClient     kclient.Client
 	mcpSessionManager *mcp.SessionManager
 	webhookHelper     *mcp.WebhookHelper
+	toolApprovals     *toolapproval.Gate
+	injectionScanner  *injectionscan.Provider
+	toolDrift         *tooldrift.Detector
 	jwks              system.EncodedJWKS
 }
 
-func NewHandler(storageClient kclient.Client, mcpSessionManager *mcp.SessionManager, webhookHelper *mcp.WebhookHelper, jwks system.EncodedJWKS) *Handler {
+func NewHandler(storageClient kclient.Client, mcpSessionManager *mcp.SessionManager, webhookHelper *mcp.WebhookHelper, toolApprovals *toolapproval.Gate, injectionScanner *injectionscan.Provider, toolDrift *tooldrift.Detector, jwks system.EncodedJWKS) *Handler {
 	return &Handler{
 		storageClient:     storageClient,
 		mcpSessionManager: mcpSessionManager,
 		webhookHelper:     webhookHelper,
+		toolApprovals:     toolApprovals,
+		injectionScanner:  injectionScanner,
+		toolDrift:         toolDrift,
 		jwks:              jwks,
 	}
 }
 
-func (h *Handler) Proxy(req api.Context) error {
+func (h *Handler) Proxy(req api.Context) (err error) {
 	if req.User.GetUID() == "anonymous" {
 		req.ResponseWriter.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer error="invalid_request", error_description="Invalid access token", resource_metadata="%s/.well-known/oauth-protected-resource%s"`, strings.TrimSuffix(req.APIBaseURL, "/api"), req.URL.Path))
 		return apierrors.NewUnauthorized("user is not authenticated")
 	}
 
-	mcpURL, err := h.ensureServerIsDeployed(req)
+	span, method, err := startSpan(&req)
+	if err != nil {
+		return types.NewErrBadRequest("%v", err)
+	}
+
+	var (
+		start = time.Now()
+		// The server is unknown until the ID in the path is resolved, so that clients can't add arbitrary label values.
+		server = "unknown"
+		status int
+	)
+	defer func() {
+		code := metrics.ErrorCode(err)
+		if err == nil {
+			code = strconv.Itoa(status)
+		}
+		method := methodLabel(req.Method, method)
+		metrics.MCPGatewayRequests.WithLabelValues(server, method, code).Inc()
+		metrics.MCPGatewayRequestDuration.WithLabelValues(server, method).Observe(time.Since(start).Seconds())
+
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
+	mcpServer, serverConfig, done, err := h.trackServer(req)
 	if err != nil {
 		return fmt.Errorf("failed to ensure server is deployed: %v", err)
 	}
+	defer done()
+	server = serverLabel(mcpServer)
+	span.SetAttributes(attribute.String("mcp.server.name", serverConfig.MCPServerDisplayName))
+
+	// Calls to changed tools that are blocked, and calls that need approval, are answered before the server is launched,
+	// and the ones that aren't allowed never reach it.
+	if status, err = h.refuseChangedTools(req, serverConfig); err != nil || status != 0 {
+		return err
+	}
+	if status, err = h.awaitApproval(req, serverConfig); err != nil || status != 0 {
+		return err
+	}
+
+	driftResponse, err := h.detectToolDrift(req, serverConfig)
+	if err != nil {
+		return err
+	}
+	scanResponse, err := h.scanResponses(req)
+	if err != nil {
+		return err
+	}
+	// Drift is detected first, so that pins have the descriptions that the server sent, not the annotated ones.
+	modifyResponse := chainResponseModifiers(driftResponse, scanResponse)
+
+	replica, mcpURL, err := h.serverURL(req, serverConfig)
+	if errors.Is(err, errSessionReplicaGone) {
+		// Per the MCP spec, a 404 tells the client to start a new session.
+		http.Error(req.ResponseWriter, err.Error(), http.StatusNotFound)
+		status = http.StatusNotFound
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to ensure server is deployed: %v", err)
+	}
 
 	u, err := url.Parse(mcpURL)
 	if err != nil {
@@ -49,7 +125,20 @@ func (h *Handler) Proxy(req api.Context) error {
 	}
 
 	(&httputil.ReverseProxy{
+		ModifyResponse: func(resp *http.Response) error {
+			status = resp.StatusCode
+			if replica != "" {
+				tagSessionWithReplica(resp, replica)
+			}
+			if modifyResponse != nil {
+				return modifyResponse(resp)
+			}
+			return nil
+		},
 		Director: func(r *http.Request) {
+			// Pass the trace context on to the MCP server.
+			otel.GetTextMapPropagator().Inject(r.Context(), propagation.HeaderCarrier(r.Header))
+
 			r.Header.Set("X-Forwarded-Host", r.Host)
 			scheme := "https"
 			if strings.HasPrefix(r.Host, "localhost") || strings.HasPrefix(r.Host, "127.0.0.1") {
@@ -63,23 +152,84 @@ func (h *Handler) Proxy(req api.Context) error {
 		},
 	}).ServeHTTP(req.ResponseWriter, req.Request)
 
+	if status == 0 {
+		// The reverse proxy couldn't reach the server and responded with a bad gateway error.
+		status = http.StatusBadGateway
+	}
+	tracing.RecordHTTPStatus(span, status)
+
 	return nil
 }
 
-func (h *Handler) ensureServerIsDeployed(req api.Context) (string, error) {
+// trackServer returns the configuration of the server and starts tracking the request, so the server isn't scaled down
+// while it is in use. The returned function must be called when the request is done.
+func (h *Handler) trackServer(req api.Context) (v1.MCPServer, mcp.ServerConfig, func(), error) {
 	jwks, err := h.jwks(req.Context())
 	if err != nil {
-		return "", fmt.Errorf("failed to get jwks: %v", err)
+		return v1.MCPServer{}, mcp.ServerConfig{}, nil, fmt.Errorf("failed to get jwks: %v", err)
 	}
 
 	mcpID, mcpServer, mcpServerConfig, err := handlers.ServerForActionWithConnectID(req, req.PathValue("mcp_id"), jwks)
 	if err != nil {
-		return "", fmt.Errorf("failed to get mcp server config: %w", err)
+		return v1.MCPServer{}, mcp.ServerConfig{}, nil, fmt.Errorf("failed to get mcp server config: %w", err)
 	}
 
 	if mcpServer.Spec.Template {
-		return "", apierrors.NewNotFound(schema.GroupResource{Group: "obot.obot.ai", Resource: "mcpserver"}, mcpID)
+		return v1.MCPServer{}, mcp.ServerConfig{}, nil, apierrors.NewNotFound(schema.GroupResource{Group: "obot.obot.ai", Resource: "mcpserver"}, mcpID)
+	}
+
+	var idleTimeout *time.Duration
+	if mcpServer.Spec.IdleTimeoutMinutes != nil {
+		idleTimeout = new(time.Duration)
+		*idleTimeout = time.Duration(*mcpServer.Spec.IdleTimeoutMinutes) * time.Minute
+	}
+
+	// Start tracking the request before launching the server so that the server isn't scaled down while it is waking up.
+	done, err := h.mcpSessionManager.TrackRequest(req.Context(), mcpServerConfig, idleTimeout)
+	if err != nil {
+		return v1.MCPServer{}, mcp.ServerConfig{}, nil, err
+	}
+
+	return mcpServer, mcpServerConfig, done, nil
+}
+
+// serverURL launches the server, waking it up if it was scaled to zero, and returns the URL that the request should be
+// sent to. If the server pins sessions to replicas, then the replica that handles the request is returned too.
+// Requests in sessions on the old version of a server after a blue/green rollout go straight to it, so that they don't
+// wait for a rollout that is in progress.
+func (h *Handler) serverURL(req api.Context, serverConfig mcp.ServerConfig) (string, string, error) {
+	var (
+		pinned   = mcp.PinsSessionsToReplicas(serverConfig)
+		replicas mcp.Replicas
+		err      error
+	)
+	if pinned && req.Request.Header.Get(sessionIDHeader) != "" {
+		if replicas, err = h.mcpSessionManager.ServerReplicas(req.Context(), serverConfig.MCPServerName); err != nil {
+			return "", "", fmt.Errorf("failed to get server replicas: %v", err)
+		}
+		if replica, replicaURL, ok := routeToDrainingReplica(req.Request, replicas); ok {
+			return replica, replicaURL, nil
+		}
+	}
+
+	mcpURL, err := h.mcpSessionManager.LaunchServer(req.Context(), serverConfig)
+	if err != nil || !pinned {
+		return "", mcpURL, err
+	}
+
+	if len(replicas.Serving) == 0 {
+		// The server might have just been woken up, so look again.
+		if replicas, err = h.mcpSessionManager.ServerReplicas(req.Context(), serverConfig.MCPServerName); err != nil {
+			return "", "", fmt.Errorf("failed to get server replicas: %v", err)
+		} else if len(replicas.Serving) == 0 {
+			return "", mcpURL, nil
+		}
+	}
+
+	replica, replicaURL, err := routeToReplica(req.Request, replicas)
+	if err != nil || replica == "" {
+		return "", mcpURL, err
 	}
 
-	return h.mcpSessionManager.LaunchServer(req.Context(), mcpServerConfig)
+	return replica, replicaURL, nil
 }
@@ -0,0 +1,202 @@
+package mcpgateway
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/tidwall/gjson"
+)
+
+// scanResponses returns a function that scans the MCP server's responses to the request with the injection scanner and
+// applies its action to them, or nil if there is nothing to scan. Findings are recorded when the audit logs of the
+// responses are submitted.
+func (h *Handler) scanResponses(req api.Context) (func(*http.Response) error, error) {
+	if req.Method != http.MethodPost || req.Request.Body == nil {
+		return nil, nil
+	}
+
+	scanner, err := h.injectionScanner.Scanner(req.Context())
+	if err != nil || scanner == nil {
+		return nil, err
+	}
+
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Responses only have the ID of their request, so the methods of the requests are kept to know what they are.
+	methods := map[string]string{}
+	// Array returns the message itself if it isn't a batch.
+	for _, m := range gjson.ParseBytes(body).Array() {
+		if id, method := m.Get("id"), m.Get("method").String(); id.Exists() && method != "" {
+			methods[id.Raw] = method
+		}
+	}
+	if len(methods) == 0 {
+		return nil, nil
+	}
+
+	apply := func(message []byte) []byte {
+		method := methods[gjson.GetBytes(message, "id").Raw]
+		if method == "" {
+			return message
+		}
+
+		changed, result := scanner.Apply(method, message)
+		if len(result.Findings) > 0 {
+			log.Infof("injection scanner flagged the response to %s of MCP server %s, action %s", method, req.PathValue("mcp_id"), result.Action)
+		}
+		return changed
+	}
+
+	return func(resp *http.Response) error {
+		if resp.StatusCode != http.StatusOK {
+			return nil
+		}
+
+		mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+		switch mediaType {
+		case "application/json":
+			data, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return fmt.Errorf("failed to read response: %w", err)
+			}
+
+			data = applyToMessages(data, apply)
+			resp.Body = io.NopCloser(bytes.NewReader(data))
+			resp.ContentLength = int64(len(data))
+			resp.Header.Set("Content-Length", strconv.Itoa(len(data)))
+		case "text/event-stream":
+			resp.Body = newEventStreamScanner(resp.Body, apply)
+			resp.ContentLength = -1
+			resp.Header.Del("Content-Length")
+		}
+		return nil
+	}, nil
+}
+
+// applyToMessages applies the function to the JSON-RPC message in data, or to each message of a batch.
+func applyToMessages(data []byte, apply func([]byte) []byte) []byte {
+	if !gjson.ValidBytes(data) {
+		return data
+	}
+	if !gjson.ParseBytes(data).IsArray() {
+		return apply(data)
+	}
+
+	var batch []json.RawMessage
+	if err := json.Unmarshal(data, &batch); err != nil {
+		return data
+	}
+	for i, message := range batch {
+		batch[i] = apply(message)
+	}
+
+	changed, err := json.Marshal(batch)
+	if err != nil {
+		return data
+	}
+	return changed
+}
+
+// eventStreamScanner applies a function to the JSON-RPC messages in the data of the events of a server-sent event stream.
+// Events are passed on as soon as they are complete, so that streaming isn't held up.
+type eventStreamScanner struct {
+	body   io.ReadCloser
+	reader *bufio.Reader
+	apply  func([]byte) []byte
+
+	event [][]byte
+	out   bytes.Buffer
+	err   error
+}
+
+func newEventStreamScanner(body io.ReadCloser, apply func([]byte) []byte) *eventStreamScanner {
+	return &eventStreamScanner{
+		body:   body,
+		reader: bufio.NewReader(body),
+		apply:  apply,
+	}
+}
+
+func (e *eventStreamScanner) Read(p []byte) (int, error) {
+	for e.out.Len() == 0 && e.err == nil {
+		line, err := e.reader.ReadBytes('\n')
+		if len(line) > 0 {
+			e.event = append(e.event, line)
+			if len(bytes.TrimRight(line, "\r\n")) == 0 {
+				e.flush()
+			}
+		}
+		if err != nil {
+			// Pass on an incomplete event as it is.
+			for _, line := range e.event {
+				e.out.Write(line)
+			}
+			e.event = nil
+			e.err = err
+		}
+	}
+
+	if e.out.Len() > 0 {
+		return e.out.Read(p)
+	}
+	return 0, e.err
+}
+
+// flush writes the current event, with the function applied to its data.
+func (e *eventStreamScanner) flush() {
+	defer func() {
+		e.event = nil
+	}()
+
+	var data [][]byte
+	for _, line := range e.event {
+		if value, ok := bytes.CutPrefix(bytes.TrimRight(line, "\r\n"), []byte("data:")); ok {
+			data = append(data, bytes.TrimPrefix(value, []byte(" ")))
+		}
+	}
+
+	if len(data) > 0 {
+		message := bytes.Join(data, []byte("\n"))
+		if changed := e.apply(message); !bytes.Equal(changed, message) {
+			for _, line := range e.event {
+				if !bytes.HasPrefix(line, []byte("data:")) && len(bytes.TrimRight(line, "\r\n")) > 0 {
+					e.out.Write(line)
+				}
+			}
+			e.out.WriteString("data: ")
+			e.out.Write(changed)
+			e.out.WriteString("\n\n")
+			return
+		}
+	}
+
+	for _, line := range e.event {
+		e.out.Write(line)
+	}
+}
+
+func (e *eventStreamScanner) Close() error {
+	return e.body.Close()
+}
+
+// readRequestBody reads the body of the request and puts it back, so that it can still be proxied.
+func readRequestBody(req api.Context) ([]byte, error) {
+	body, err := io.ReadAll(req.Request.Body)
+	req.Request.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	req.Request.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
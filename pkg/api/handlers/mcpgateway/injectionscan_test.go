@@ -0,0 +1,43 @@
+package mcpgateway
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventStreamScanner(t *testing.T) {
+	stream := "event: message\nid: 1\ndata: {\"id\":1,\"result\":\"flagged\"}\n\n" +
+		": keep-alive\n\n" +
+		"event: message\ndata: {\"id\":2,\n" +
+		"data: \"result\":\"clean\"}\n\n" +
+		"data: {\"id\":3"
+
+	apply := func(message []byte) []byte {
+		return bytes.ReplaceAll(message, []byte("flagged"), []byte("annotated"))
+	}
+
+	out, err := io.ReadAll(newEventStreamScanner(io.NopCloser(strings.NewReader(stream)), apply))
+	require.NoError(t, err)
+
+	// Changed events are rewritten with one data line, and everything else is passed on as it is.
+	assert.Equal(t, "event: message\nid: 1\ndata: {\"id\":1,\"result\":\"annotated\"}\n\n"+
+		": keep-alive\n\n"+
+		"event: message\ndata: {\"id\":2,\n"+
+		"data: \"result\":\"clean\"}\n\n"+
+		"data: {\"id\":3", string(out))
+}
+
+func TestApplyToMessages(t *testing.T) {
+	apply := func(message []byte) []byte {
+		return bytes.ReplaceAll(message, []byte("flagged"), []byte("annotated"))
+	}
+
+	assert.Equal(t, `{"id":1,"result":"annotated"}`, string(applyToMessages([]byte(`{"id":1,"result":"flagged"}`), apply)))
+	assert.JSONEq(t, `[{"id":1,"result":"annotated"},{"id":2,"result":"clean"}]`, string(applyToMessages([]byte(`[{"id":1,"result":"flagged"},{"id":2,"result":"clean"}]`), apply)))
+	assert.Equal(t, "not json", string(applyToMessages([]byte("not json"), apply)))
+}
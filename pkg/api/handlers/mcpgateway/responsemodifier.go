@@ -0,0 +1,76 @@
+package mcpgateway
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/tidwall/gjson"
+)
+
+// chainResponseModifiers returns a function that applies the modifiers to the MCP server's response in order, or nil if
+// there are none. Nil modifiers are skipped.
+func chainResponseModifiers(modifiers ...func(*http.Response) error) func(*http.Response) error {
+	var chain []func(*http.Response) error
+	for _, modify := range modifiers {
+		if modify != nil {
+			chain = append(chain, modify)
+		}
+	}
+	if len(chain) == 0 {
+		return nil
+	}
+
+	return func(resp *http.Response) error {
+		for _, modify := range chain {
+			if err := modify(resp); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// requestMethods returns the methods of the JSON-RPC requests in the body, by the raw ID of the request. Responses only
+// have the ID of their request, so this is how their method is found.
+func requestMethods(body []byte) map[string]string {
+	methods := map[string]string{}
+	// Array returns the message itself if it isn't a batch.
+	for _, m := range gjson.ParseBytes(body).Array() {
+		if id, method := m.Get("id"), m.Get("method").String(); id.Exists() && method != "" {
+			methods[id.Raw] = method
+		}
+	}
+	return methods
+}
+
+// applyToResponse applies the function to the JSON-RPC messages of a successful response, which is either JSON or a
+// server-sent event stream.
+func applyToResponse(resp *http.Response, apply func([]byte) []byte) error {
+	if resp.StatusCode != http.StatusOK {
+		return nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/json":
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read response: %w", err)
+		}
+
+		data = applyToMessages(data, apply)
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		resp.ContentLength = int64(len(data))
+		resp.Header.Set("Content-Length", strconv.Itoa(len(data)))
+	case "text/event-stream":
+		resp.Body = newEventStreamScanner(resp.Body, apply)
+		resp.ContentLength = -1
+		resp.Header.Del("Content-Length")
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package mcpgateway
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainResponseModifiers(t *testing.T) {
+	assert.Nil(t, chainResponseModifiers(nil, nil))
+
+	methods := requestMethods([]byte(`[{"jsonrpc":"2.0","id":1,"method":"tools/list"},{"jsonrpc":"2.0","method":"notifications/initialized"}]`))
+	assert.Equal(t, map[string]string{"1": "tools/list"}, methods)
+
+	replace := func(old, replacement string) func(*http.Response) error {
+		return func(resp *http.Response) error {
+			return applyToResponse(resp, func(message []byte) []byte {
+				return bytes.ReplaceAll(message, []byte(old), []byte(replacement))
+			})
+		}
+	}
+
+	// The second modifier sees what the first one changed.
+	modify := chainResponseModifiers(replace("a", "b"), nil, replace("b", "c"))
+	require.NotNil(t, modify)
+
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader([]byte(`{"id":1,"result":"a"}`))),
+	}
+	require.NoError(t, modify(resp))
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":1,"result":"c"}`, string(data))
+	assert.Equal(t, int64(len(data)), resp.ContentLength)
+}
@@ -0,0 +1,115 @@
+package mcpgateway
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/mcp"
+	"github.com/obot-platform/obot/pkg/tooldrift"
+	"github.com/tidwall/gjson"
+)
+
+// detectToolDrift returns a function that compares the tools in the MCP server's responses to tools/list to their pins,
+// and leaves out the changed tools that are blocked, or nil if there is nothing to compare.
+func (h *Handler) detectToolDrift(req api.Context, serverConfig mcp.ServerConfig) (func(*http.Response) error, error) {
+	if h.toolDrift == nil || req.Method != http.MethodPost || req.Request.Body == nil {
+		return nil, nil
+	}
+
+	policy, err := h.toolDrift.Policy(req.Context())
+	if err != nil || policy.Disabled {
+		return nil, err
+	}
+
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	methods := requestMethods(body)
+	if !slices.Contains(slices.Collect(maps.Values(methods)), "tools/list") {
+		return nil, nil
+	}
+
+	sourceID, err := h.toolDrift.SourceID(req.Context(), serverConfig.MCPCatalogEntryName, serverConfig.MCPServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	source := tooldrift.Source{
+		ID:                   sourceID,
+		MCPID:                serverConfig.MCPServerName,
+		MCPServerDisplayName: serverConfig.MCPServerDisplayName,
+		UserID:               req.User.GetUID(),
+	}
+
+	apply := func(message []byte) []byte {
+		if methods[gjson.GetBytes(message, "id").Raw] != "tools/list" {
+			return message
+		}
+
+		changed, err := h.toolDrift.Apply(req.Context(), source, message)
+		if err != nil {
+			log.Errorf("failed to check tools of MCP server %s for drift: %v", serverConfig.MCPServerName, err)
+		}
+		return changed
+	}
+
+	return func(resp *http.Response) error {
+		return applyToResponse(resp, apply)
+	}, nil
+}
+
+// refuseChangedTools answers calls to tools that changed and are blocked until the change is approved, instead of
+// sending them to the MCP server. If a call was refused, then the status of the response is returned.
+func (h *Handler) refuseChangedTools(req api.Context, serverConfig mcp.ServerConfig) (int, error) {
+	if h.toolDrift == nil || req.Method != http.MethodPost || req.Request.Body == nil {
+		return 0, nil
+	}
+
+	body, err := readRequestBody(req)
+	if err != nil {
+		return 0, err
+	}
+
+	var sourceID string
+	message := gjson.ParseBytes(body)
+	for _, m := range message.Array() {
+		if m.Get("method").String() != "tools/call" || !m.Get("id").Exists() {
+			continue
+		}
+
+		if sourceID == "" {
+			if sourceID, err = h.toolDrift.SourceID(req.Context(), serverConfig.MCPCatalogEntryName, serverConfig.MCPServerName); err != nil {
+				return 0, err
+			}
+		}
+
+		err := h.toolDrift.CheckCall(req.Context(), sourceID, m.Get("params.name").String())
+		if changedErr := (*tooldrift.ChangedError)(nil); errors.As(err, &changedErr) {
+			if message.IsArray() {
+				http.Error(req.ResponseWriter, fmt.Sprintf("%v, so it can't be called in a batch", err), http.StatusBadRequest)
+				return http.StatusBadRequest, nil
+			}
+
+			// The call is answered like a failed tool call, so that the client and its LLM see why it failed.
+			return http.StatusOK, req.Write(map[string]any{
+				"jsonrpc": "2.0",
+				"id":      json.RawMessage(m.Get("id").Raw),
+				"result": map[string]any{
+					"content": []map[string]any{{"type": "text", "text": err.Error()}},
+					"isError": true,
+				},
+			})
+		} else if err != nil {
+			return 0, err
+		}
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,108 @@
+package mcpgateway
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/tidwall/gjson"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("obot/mcpgateway")
+
+// mcpMethods are the JSON-RPC methods that are used as metric labels. Other methods are counted together,
+// so that clients can't add arbitrary label values.
+var mcpMethods = []string{
+	"initialize",
+	"ping",
+	"tools/list",
+	"tools/call",
+	"resources/list",
+	"resources/templates/list",
+	"resources/read",
+	"resources/subscribe",
+	"resources/unsubscribe",
+	"prompts/list",
+	"prompts/get",
+	"completion/complete",
+	"logging/setLevel",
+}
+
+// methodLabel returns the method label for the metrics of a request. Requests that aren't JSON-RPC calls, like the ones that
+// open an event stream or end a session, are labeled with their HTTP method.
+func methodLabel(httpMethod, method string) string {
+	switch {
+	case method == "":
+		return httpMethod
+	case slices.Contains(mcpMethods, method):
+		return method
+	case strings.HasPrefix(method, "notifications/"):
+		return "notifications"
+	default:
+		return "other"
+	}
+}
+
+// serverLabel returns the server label for the metrics of a request. Multi-user servers are labeled by name, and single-user
+// servers by the catalog entry they were created from, so that the number of label values doesn't grow with the number of
+// users. Single-user servers that weren't created from an entry share one label.
+func serverLabel(server v1.MCPServer) string {
+	switch {
+	case server.Spec.MCPCatalogID != "" || server.Spec.PowerUserWorkspaceID != "":
+		return server.Name
+	case server.Spec.MCPServerCatalogEntryName != "":
+		return server.Spec.MCPServerCatalogEntryName
+	default:
+		return "single-user"
+	}
+}
+
+// startSpan starts a span for a request to an MCP server and sets it on the request. For JSON-RPC requests, the span is named
+// for the method and includes the tool, prompt, or resource that it is for, and the method is returned.
+// The body is read and replaced so it can still be proxied.
+func startSpan(req *api.Context) (trace.Span, string, error) {
+	name := "mcp " + req.Method
+	var method string
+	attrs := []attribute.KeyValue{
+		attribute.String("mcp.server.id", req.PathValue("mcp_id")),
+		attribute.String("mcp.session.id", req.Request.Header.Get("Mcp-Session-Id")),
+	}
+
+	if req.Method == http.MethodPost && req.Request.Body != nil {
+		body, err := io.ReadAll(req.Request.Body)
+		req.Request.Body.Close()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read request body: %w", err)
+		}
+		req.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		// Batches are named for the HTTP method, because they can contain many calls.
+		if method = gjson.GetBytes(body, "method").String(); method != "" {
+			name = method
+			attrs = append(attrs, attribute.String("mcp.method.name", method))
+
+			params := gjson.GetBytes(body, "params")
+			switch method {
+			case "tools/call":
+				attrs = append(attrs, attribute.String("gen_ai.tool.name", params.Get("name").String()))
+				name += " " + params.Get("name").String()
+			case "prompts/get":
+				attrs = append(attrs, attribute.String("mcp.prompt.name", params.Get("name").String()))
+			case "resources/read":
+				attrs = append(attrs, attribute.String("mcp.resource.uri", params.Get("uri").String()))
+			}
+		}
+	}
+
+	ctx, span := tracer.Start(req.Context(), name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+	req.Request = req.Request.WithContext(ctx)
+	return span, method, nil
+}
@@ -0,0 +1,26 @@
+package mcpgateway
+
+import (
+	"testing"
+
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestServerLabel(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		spec     v1.MCPServerSpec
+		expected string
+	}{
+		{name: "catalog server", spec: v1.MCPServerSpec{MCPCatalogID: "default", MCPServerCatalogEntryName: "entry1"}, expected: "ms1"},
+		{name: "workspace server", spec: v1.MCPServerSpec{PowerUserWorkspaceID: "puw1"}, expected: "ms1"},
+		{name: "single-user server from an entry", spec: v1.MCPServerSpec{UserID: "u1", MCPServerCatalogEntryName: "entry1"}, expected: "entry1"},
+		{name: "single-user server", spec: v1.MCPServerSpec{UserID: "u1"}, expected: "single-user"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, serverLabel(v1.MCPServer{ObjectMeta: metav1.ObjectMeta{Name: "ms1"}, Spec: tt.spec}))
+		})
+	}
+}
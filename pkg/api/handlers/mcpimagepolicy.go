@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/oci"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type MCPImagePolicyHandler struct{}
+
+func NewMCPImagePolicyHandler() *MCPImagePolicyHandler {
+	return &MCPImagePolicyHandler{}
+}
+
+func (h *MCPImagePolicyHandler) Get(req api.Context) error {
+	var policy v1.MCPImagePolicy
+	if err := req.Get(&policy, system.MCPImagePolicyName); apierrors.IsNotFound(err) {
+		// No policy has been configured, so every image is allowed.
+		return req.Write(types.MCPImagePolicy{})
+	} else if err != nil {
+		return err
+	}
+
+	return req.Write(convertMCPImagePolicy(policy))
+}
+
+func (h *MCPImagePolicyHandler) Update(req api.Context) error {
+	var input types.MCPImagePolicyManifest
+	if err := req.Read(&input); err != nil {
+		return err
+	}
+
+	for i, registry := range input.AllowedRegistries {
+		registry = strings.TrimSuffix(strings.TrimSpace(registry), "/")
+		if registry == "" || strings.Contains(registry, "://") {
+			return types.NewErrBadRequest("invalid allowed registry %q, expected a registry or registry/repository prefix like ghcr.io/myorg", input.AllowedRegistries[i])
+		}
+		input.AllowedRegistries[i] = registry
+	}
+
+	for i, key := range input.CosignPublicKeys {
+		if _, err := oci.ParsePublicKey(key); err != nil {
+			return types.NewErrBadRequest("invalid cosign public key %d: %v", i+1, err)
+		}
+	}
+
+	var policy v1.MCPImagePolicy
+	if err := req.Get(&policy, system.MCPImagePolicyName); apierrors.IsNotFound(err) {
+		policy = v1.MCPImagePolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      system.MCPImagePolicyName,
+				Namespace: req.Namespace(),
+			},
+			Spec: v1.MCPImagePolicySpec{
+				Manifest: input,
+			},
+		}
+
+		if err := req.Create(&policy); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	} else {
+		policy.Spec.Manifest = input
+		if err := req.Update(&policy); err != nil {
+			return err
+		}
+	}
+
+	return req.Write(convertMCPImagePolicy(policy))
+}
+
+func convertMCPImagePolicy(policy v1.MCPImagePolicy) types.MCPImagePolicy {
+	return types.MCPImagePolicy{
+		Metadata:               MetadataFrom(&policy),
+		MCPImagePolicyManifest: policy.Spec.Manifest,
+	}
+}
@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/injectionscan"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type MCPInjectionScanPolicyHandler struct{}
+
+func NewMCPInjectionScanPolicyHandler() *MCPInjectionScanPolicyHandler {
+	return &MCPInjectionScanPolicyHandler{}
+}
+
+func (h *MCPInjectionScanPolicyHandler) Get(req api.Context) error {
+	var policy v1.MCPInjectionScanPolicy
+	if err := req.Get(&policy, system.MCPInjectionScanPolicyName); apierrors.IsNotFound(err) {
+		// No policy has been configured, so all built-in rules run and findings are only recorded.
+		return req.Write(types.MCPInjectionScanPolicy{
+			MCPInjectionScanPolicyManifest: types.MCPInjectionScanPolicyManifest{
+				DescriptionAction: types.MCPInjectionScanActionWarn,
+				ResponseAction:    types.MCPInjectionScanActionWarn,
+			},
+		})
+	} else if err != nil {
+		return err
+	}
+
+	return req.Write(convertMCPInjectionScanPolicy(policy))
+}
+
+func (h *MCPInjectionScanPolicyHandler) Update(req api.Context) error {
+	var input types.MCPInjectionScanPolicyManifest
+	if err := req.Read(&input); err != nil {
+		return err
+	}
+
+	if err := validateMCPInjectionScanPolicy(&input); err != nil {
+		return err
+	}
+
+	var policy v1.MCPInjectionScanPolicy
+	if err := req.Get(&policy, system.MCPInjectionScanPolicyName); apierrors.IsNotFound(err) {
+		policy = v1.MCPInjectionScanPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      system.MCPInjectionScanPolicyName,
+				Namespace: req.Namespace(),
+			},
+			Spec: v1.MCPInjectionScanPolicySpec{
+				Manifest: input,
+			},
+		}
+
+		if err := req.Create(&policy); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	} else {
+		policy.Spec.Manifest = input
+		if err := req.Update(&policy); err != nil {
+			return err
+		}
+	}
+
+	return req.Write(convertMCPInjectionScanPolicy(policy))
+}
+
+func validateMCPInjectionScanPolicy(manifest *types.MCPInjectionScanPolicyManifest) error {
+	if manifest.DescriptionAction == "" {
+		manifest.DescriptionAction = types.MCPInjectionScanActionWarn
+	}
+	if manifest.ResponseAction == "" {
+		manifest.ResponseAction = types.MCPInjectionScanActionWarn
+	}
+	for _, action := range []types.MCPInjectionScanAction{manifest.DescriptionAction, manifest.ResponseAction} {
+		switch action {
+		case types.MCPInjectionScanActionWarn, types.MCPInjectionScanActionAnnotate, types.MCPInjectionScanActionBlock:
+		default:
+			return types.NewErrBadRequest("invalid action %q, expected warn, annotate, or block", action)
+		}
+	}
+
+	for _, rule := range manifest.DisabledRules {
+		if !injectionscan.IsRule(rule) {
+			return types.NewErrBadRequest("unknown rule %q", rule)
+		}
+	}
+
+	seen := make(map[string]struct{}, len(manifest.Patterns))
+	for i, pattern := range manifest.Patterns {
+		name := strings.TrimSpace(pattern.Name)
+		if name == "" || strings.TrimSpace(pattern.Pattern) == "" {
+			return types.NewErrBadRequest("pattern %d must have a name and a pattern", i+1)
+		}
+		if injectionscan.IsRule(types.MCPInjectionScanRule(name)) {
+			return types.NewErrBadRequest("pattern %d: %s is the name of a built-in rule", i+1, name)
+		}
+		if _, ok := seen[name]; ok {
+			return types.NewErrBadRequest("pattern %d: there is already a pattern named %s", i+1, name)
+		}
+		seen[name] = struct{}{}
+		manifest.Patterns[i].Name = name
+	}
+
+	// The patterns are compiled even if scanning is disabled, so that enabling it later can't fail.
+	enabled := *manifest
+	enabled.Disabled = false
+	if _, err := injectionscan.New(enabled); err != nil {
+		return types.NewErrBadRequest("%v", err)
+	}
+
+	return nil
+}
+
+func convertMCPInjectionScanPolicy(policy v1.MCPInjectionScanPolicy) types.MCPInjectionScanPolicy {
+	return types.MCPInjectionScanPolicy{
+		Metadata:                       MetadataFrom(&policy),
+		MCPInjectionScanPolicyManifest: policy.Spec.Manifest,
+	}
+}
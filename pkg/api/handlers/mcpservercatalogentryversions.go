@@ -0,0 +1,453 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListEntryVersions returns all recorded versions of a catalog entry, newest first.
+func (h *MCPCatalogHandler) ListEntryVersions(req api.Context) error {
+	entry, err := getScopedEntry(req)
+	if err != nil {
+		return err
+	}
+
+	versions, err := listEntryVersions(req, entry)
+	if err != nil {
+		return err
+	}
+
+	items := make([]types.MCPServerCatalogEntryVersion, 0, len(versions.Items))
+	for _, version := range versions.Items {
+		items = append(items, convertMCPServerCatalogEntryVersion(version, entry))
+	}
+
+	return req.Write(types.MCPServerCatalogEntryVersionList{Items: items})
+}
+
+// GetEntryVersion returns a single version of a catalog entry.
+func (h *MCPCatalogHandler) GetEntryVersion(req api.Context) error {
+	entry, version, _, err := getScopedEntryVersion(req)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(convertMCPServerCatalogEntryVersion(version, entry))
+}
+
+// DiffEntryVersions returns the manifest changes between two versions of a catalog entry.
+// The version is compared with the "from" query parameter, which defaults to the previous version.
+func (h *MCPCatalogHandler) DiffEntryVersions(req api.Context) error {
+	_, to, versions, err := getScopedEntryVersion(req)
+	if err != nil {
+		return err
+	}
+
+	fromVersion := to.Spec.Version - 1
+	if from := req.URL.Query().Get("from"); from != "" {
+		fromVersion, err = strconv.Atoi(from)
+		if err != nil {
+			return types.NewErrBadRequest("invalid from version %q", from)
+		}
+	}
+
+	var fromManifest types.MCPServerCatalogEntryManifest
+	if fromVersion != 0 {
+		from := versions.Version(fromVersion)
+		if from == nil {
+			return types.NewErrNotFound("version %d not found", fromVersion)
+		}
+		fromManifest = from.Spec.Manifest
+	}
+
+	changes, err := diffManifests(fromManifest, to.Spec.Manifest)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(types.MCPServerCatalogEntryVersionDiff{
+		FromVersion: fromVersion,
+		ToVersion:   to.Spec.Version,
+		Changes:     changes,
+	})
+}
+
+// RolloutEntryVersion offers a version only to the given users, instead of to everyone.
+// Other users keep getting the newest earlier released version until this version is released.
+func (h *MCPCatalogHandler) RolloutEntryVersion(req api.Context) error {
+	entry, version, versions, err := getScopedEntryVersion(req)
+	if err != nil {
+		return err
+	}
+
+	if entry.Spec.Manifest.Runtime == types.RuntimeComposite {
+		return types.NewErrBadRequest("composite catalog entries cannot be rolled out gradually")
+	}
+
+	var rollout types.MCPServerCatalogEntryVersionRollout
+	if err := req.Read(&rollout); err != nil {
+		return err
+	}
+
+	if len(rollout.UserIDs) == 0 {
+		return types.NewErrBadRequest("at least one user ID is required")
+	}
+
+	if !slices.ContainsFunc(versions.Items, func(v v1.MCPServerCatalogEntryVersion) bool {
+		return v.Spec.Released && v.Spec.Version < version.Spec.Version
+	}) {
+		return types.NewErrBadRequest("version %d cannot be rolled out gradually because there is no earlier released version", version.Spec.Version)
+	}
+
+	version.Spec.Released = false
+	version.Spec.RolloutUserIDs = rollout.UserIDs
+	if err := req.Update(&version); err != nil {
+		return fmt.Errorf("failed to update version: %w", err)
+	}
+
+	return req.Write(convertMCPServerCatalogEntryVersion(version, entry))
+}
+
+// ReleaseEntryVersion offers a version to all users.
+func (h *MCPCatalogHandler) ReleaseEntryVersion(req api.Context) error {
+	entry, version, _, err := getScopedEntryVersion(req)
+	if err != nil {
+		return err
+	}
+
+	version.Spec.Released = true
+	version.Spec.RolloutUserIDs = nil
+	if err := req.Update(&version); err != nil {
+		return fmt.Errorf("failed to update version: %w", err)
+	}
+
+	return req.Write(convertMCPServerCatalogEntryVersion(version, entry))
+}
+
+// RollbackEntryVersion makes the given version the newest available version of the entry by withdrawing all newer versions.
+// Unpinned single-user servers that were updated to a withdrawn version are moved back to this version and restarted.
+func (h *MCPCatalogHandler) RollbackEntryVersion(req api.Context) error {
+	entry, version, versions, err := getScopedEntryVersion(req)
+	if err != nil {
+		return err
+	}
+
+	if entry.Spec.Manifest.Runtime == types.RuntimeComposite {
+		return types.NewErrBadRequest("composite catalog entries cannot be rolled back")
+	}
+
+	for _, newer := range versions.Items {
+		if newer.Spec.Version <= version.Spec.Version || (!newer.Spec.Released && len(newer.Spec.RolloutUserIDs) == 0) {
+			continue
+		}
+
+		newer.Spec.Released = false
+		newer.Spec.RolloutUserIDs = nil
+		if err := req.Update(&newer); err != nil {
+			return fmt.Errorf("failed to withdraw version %d: %w", newer.Spec.Version, err)
+		}
+	}
+
+	if !version.Spec.Released {
+		version.Spec.Released = true
+		version.Spec.RolloutUserIDs = nil
+		if err := req.Update(&version); err != nil {
+			return fmt.Errorf("failed to release version %d: %w", version.Spec.Version, err)
+		}
+	}
+
+	var servers v1.MCPServerList
+	if err := req.List(&servers, &kclient.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.mcpServerCatalogEntryName", entry.Name),
+		Namespace:     entry.Namespace,
+	}); err != nil {
+		return fmt.Errorf("failed to list servers for entry: %w", err)
+	}
+
+	for _, server := range servers.Items {
+		// Multi-user servers are updated by their owners, and component servers are updated with their composite server.
+		if server.Spec.MCPCatalogID != "" || server.Spec.PowerUserWorkspaceID != "" || server.Spec.CompositeName != "" ||
+			server.Spec.MCPServerCatalogEntryVersionPinned || server.Spec.MCPServerCatalogEntryVersion <= version.Spec.Version {
+			continue
+		}
+
+		if err := moveServerToVersion(req, h.sessionManager, &server, version, false); err != nil {
+			return err
+		}
+	}
+
+	return req.Write(convertMCPServerCatalogEntryVersion(version, entry))
+}
+
+// PinVersion pins a single-user MCP server to a version of its catalog entry, so that it is not offered updates.
+// If the server is on a different version, it is moved to that version and restarted. Pinning to version 0 unpins the server.
+func (m *MCPHandler) PinVersion(req api.Context) error {
+	var server v1.MCPServer
+	if err := req.Get(&server, req.PathValue("mcp_server_id")); err != nil {
+		return err
+	}
+
+	if server.Spec.MCPCatalogID != "" || server.Spec.PowerUserWorkspaceID != "" {
+		return types.NewErrBadRequest("cannot pin the version of a multi-user MCP server")
+	}
+
+	if server.Spec.CompositeName != "" {
+		return types.NewErrBadRequest("cannot pin the version of a component server")
+	}
+
+	if server.Spec.MCPServerCatalogEntryName == "" {
+		return types.NewErrBadRequest("MCP server was not created from a catalog entry")
+	}
+
+	var pin types.MCPServerVersionPin
+	if err := req.Read(&pin); err != nil {
+		return err
+	}
+
+	if pin.Version == 0 {
+		server.Spec.MCPServerCatalogEntryVersionPinned = false
+		return req.Update(&server)
+	}
+
+	var entry v1.MCPServerCatalogEntry
+	if err := req.Get(&entry, server.Spec.MCPServerCatalogEntryName); err != nil {
+		return err
+	}
+
+	if entry.Spec.Manifest.Runtime == types.RuntimeComposite {
+		return types.NewErrBadRequest("cannot pin the version of a composite server")
+	}
+
+	versions, err := listEntryVersions(req, entry)
+	if err != nil {
+		return err
+	}
+
+	version := versions.Version(pin.Version)
+	if version == nil || !version.AvailableTo(server.Spec.UserID) {
+		return types.NewErrNotFound("version %d of catalog entry %s not found", pin.Version, entry.Name)
+	}
+
+	if server.Spec.MCPServerCatalogEntryVersion != version.Spec.Version {
+		return moveServerToVersion(req, m.mcpSessionManager, &server, *version, true)
+	}
+
+	server.Spec.MCPServerCatalogEntryVersionPinned = true
+	return req.Update(&server)
+}
+
+// serverShutdowner shuts down running MCP servers, so that they start again with their current configuration.
+type serverShutdowner interface {
+	ShutdownServer(ctx context.Context, serverName string) error
+}
+
+// moveServerToVersion moves a single-user server to a version of its catalog entry and shuts down the running server,
+// so that it starts again with that version. The server is updated before it is shut down, so that it can't start again
+// with the old version, and it is moved back to its previous version if it can't be shut down.
+func moveServerToVersion(req api.Context, shutdowner serverShutdowner, server *v1.MCPServer, version v1.MCPServerCatalogEntryVersion, pinned bool) error {
+	previous := server.Spec.DeepCopy()
+
+	applyCatalogEntryManifest(server, version.Spec.Manifest)
+	server.Spec.MCPServerCatalogEntryVersion = version.Spec.Version
+	server.Spec.MCPServerCatalogEntryVersionPinned = pinned
+	if err := req.Update(server); err != nil {
+		return fmt.Errorf("failed to move server %s to version %d: %w", server.Name, version.Spec.Version, err)
+	}
+
+	if err := shutdowner.ShutdownServer(req.Context(), server.Name); err != nil {
+		server.Spec = *previous
+		if restoreErr := req.Update(server); restoreErr != nil {
+			return fmt.Errorf("failed to shutdown server %s: %w, and failed to restore its previous version: %w", server.Name, err, restoreErr)
+		}
+		return fmt.Errorf("failed to shutdown server %s: %w", server.Name, err)
+	}
+
+	return nil
+}
+
+// catalogEntryManifestForUser returns the manifest and version of a catalog entry that should be used for the user's servers.
+// Entries that don't have any recorded versions yet use their current manifest, with version 0.
+func catalogEntryManifestForUser(req api.Context, entry v1.MCPServerCatalogEntry, userID string) (types.MCPServerCatalogEntryManifest, int, error) {
+	versions, err := listEntryVersions(req, entry)
+	if err != nil {
+		return types.MCPServerCatalogEntryManifest{}, 0, err
+	}
+
+	if version := versions.VersionFor(userID); version != nil {
+		return version.Spec.Manifest, version.Spec.Version, nil
+	}
+
+	return entry.Spec.Manifest, 0, nil
+}
+
+func listEntryVersions(req api.Context, entry v1.MCPServerCatalogEntry) (v1.MCPServerCatalogEntryVersionList, error) {
+	var versions v1.MCPServerCatalogEntryVersionList
+	if err := req.List(&versions, &kclient.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.mcpServerCatalogEntryName", entry.Name),
+		Namespace:     entry.Namespace,
+	}); err != nil {
+		return versions, fmt.Errorf("failed to list versions of catalog entry %s: %w", entry.Name, err)
+	}
+
+	sort.Slice(versions.Items, func(i, j int) bool {
+		return versions.Items[i].Spec.Version > versions.Items[j].Spec.Version
+	})
+
+	return versions, nil
+}
+
+// getScopedEntry gets the entry from the request path and verifies that it belongs to the catalog or workspace in the path.
+func getScopedEntry(req api.Context) (v1.MCPServerCatalogEntry, error) {
+	var (
+		catalogName = req.PathValue("catalog_id")
+		workspaceID = req.PathValue("workspace_id")
+		entry       v1.MCPServerCatalogEntry
+	)
+
+	if catalogName == "" && workspaceID == "" {
+		return entry, types.NewErrBadRequest("either catalog_id or workspace_id is required")
+	}
+
+	if err := req.Get(&entry, req.PathValue("entry_id")); err != nil {
+		return entry, fmt.Errorf("failed to get entry: %w", err)
+	}
+
+	if catalogName != "" && entry.Spec.MCPCatalogName != catalogName {
+		return entry, types.NewErrNotFound("entry %s not found in catalog %s", entry.Name, catalogName)
+	} else if workspaceID != "" && entry.Spec.PowerUserWorkspaceID != workspaceID {
+		return entry, types.NewErrNotFound("entry %s not found in workspace %s", entry.Name, workspaceID)
+	}
+
+	return entry, nil
+}
+
+func getScopedEntryVersion(req api.Context) (v1.MCPServerCatalogEntry, v1.MCPServerCatalogEntryVersion, v1.MCPServerCatalogEntryVersionList, error) {
+	entry, err := getScopedEntry(req)
+	if err != nil {
+		return entry, v1.MCPServerCatalogEntryVersion{}, v1.MCPServerCatalogEntryVersionList{}, err
+	}
+
+	number, err := strconv.Atoi(req.PathValue("version"))
+	if err != nil {
+		return entry, v1.MCPServerCatalogEntryVersion{}, v1.MCPServerCatalogEntryVersionList{}, types.NewErrBadRequest("invalid version %q", req.PathValue("version"))
+	}
+
+	versions, err := listEntryVersions(req, entry)
+	if err != nil {
+		return entry, v1.MCPServerCatalogEntryVersion{}, versions, err
+	}
+
+	version := versions.Version(number)
+	if version == nil {
+		return entry, v1.MCPServerCatalogEntryVersion{}, versions, types.NewErrNotFound("version %d of catalog entry %s not found", number, entry.Name)
+	}
+
+	return entry, *version, versions, nil
+}
+
+func convertMCPServerCatalogEntryVersion(version v1.MCPServerCatalogEntryVersion, entry v1.MCPServerCatalogEntry) types.MCPServerCatalogEntryVersion {
+	return types.MCPServerCatalogEntryVersion{
+		Metadata:                MetadataFrom(&version),
+		MCPServerCatalogEntryID: version.Spec.MCPServerCatalogEntryName,
+		Version:                 version.Spec.Version,
+		Manifest:                version.Spec.Manifest,
+		Released:                version.Spec.Released,
+		RolloutUserIDs:          version.Spec.RolloutUserIDs,
+		Latest:                  version.Spec.Version == entry.Status.LatestVersion,
+	}
+}
+
+// diffManifests compares two manifests field by field and returns the fields that changed, sorted by path.
+func diffManifests(from, to types.MCPServerCatalogEntryManifest) ([]types.ManifestFieldChange, error) {
+	fromFields, err := flattenJSON(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toFields, err := flattenJSON(to)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []types.ManifestFieldChange
+	for path, value := range toFields {
+		if fromFields[path] != value {
+			changes = append(changes, types.ManifestFieldChange{Path: path, From: fromFields[path], To: value})
+		}
+	}
+	for path, value := range fromFields {
+		if _, ok := toFields[path]; !ok {
+			changes = append(changes, types.ManifestFieldChange{Path: path, From: value})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes, nil
+}
+
+// flattenJSON returns the JSON encoded value of every leaf in obj, keyed by its dot-separated path.
+func flattenJSON(obj any) (map[string]string, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string)
+	return result, flattenValue(result, "", value)
+}
+
+func flattenValue(result map[string]string, prefix string, value any) error {
+	join := func(key string) string {
+		if prefix == "" {
+			return key
+		}
+		return prefix + "." + key
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			if err := flattenValue(result, join(key), child); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for i, child := range v {
+			if err := flattenValue(result, join(strconv.Itoa(i)), child); err != nil {
+				return err
+			}
+		}
+	case nil:
+	case string:
+		// Empty strings are treated the same as missing fields.
+		if v != "" {
+			result[prefix] = strconv.Quote(v)
+		}
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		result[prefix] = string(data)
+	}
+
+	return nil
+}
@@ -0,0 +1,310 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/storage/scheme"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestDiffManifests(t *testing.T) {
+	from := types.MCPServerCatalogEntryManifest{
+		Name:    "Test",
+		Runtime: types.RuntimeUVX,
+		UVXConfig: &types.UVXRuntimeConfig{
+			Package: "test-server==1.0.0",
+			Args:    []string{"--verbose"},
+		},
+	}
+	to := types.MCPServerCatalogEntryManifest{
+		Name:        "Test",
+		Description: "A test server",
+		Runtime:     types.RuntimeUVX,
+		UVXConfig: &types.UVXRuntimeConfig{
+			Package: "test-server==1.1.0",
+		},
+	}
+
+	changes, err := diffManifests(from, to)
+	require.NoError(t, err)
+
+	assert.Equal(t, []types.ManifestFieldChange{
+		{Path: "description", To: `"A test server"`},
+		{Path: "uvxConfig.args.0", From: `"--verbose"`},
+		{Path: "uvxConfig.package", From: `"test-server==1.0.0"`, To: `"test-server==1.1.0"`},
+	}, changes)
+
+	changes, err = diffManifests(to, to)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func newEntryVersionStorage(objs ...kclient.Object) kclient.WithWatch {
+	return fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(objs...).
+		WithIndex(&v1.MCPServerCatalogEntryVersion{}, "spec.mcpServerCatalogEntryName", func(obj kclient.Object) []string {
+			return []string{obj.(*v1.MCPServerCatalogEntryVersion).Spec.MCPServerCatalogEntryName}
+		}).
+		WithIndex(&v1.MCPServer{}, "spec.mcpServerCatalogEntryName", func(obj kclient.Object) []string {
+			return []string{obj.(*v1.MCPServer).Spec.MCPServerCatalogEntryName}
+		}).
+		Build()
+}
+
+func newEntryVersionContext(storage kclient.WithWatch, version, body string) api.Context {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	r.SetPathValue("catalog_id", system.DefaultCatalog)
+	r.SetPathValue("entry_id", "entry1")
+	r.SetPathValue("version", version)
+	return api.Context{
+		ResponseWriter: httptest.NewRecorder(),
+		Request:        r,
+		Storage:        storage,
+		User:           &user.DefaultInfo{UID: "admin", Groups: []string{types.GroupAdmin}},
+	}
+}
+
+func versionedEntry() *v1.MCPServerCatalogEntry {
+	return &v1.MCPServerCatalogEntry{
+		ObjectMeta: metav1.ObjectMeta{Name: "entry1", Namespace: system.DefaultNamespace},
+		Spec: v1.MCPServerCatalogEntrySpec{
+			MCPCatalogName: system.DefaultCatalog,
+			Manifest:       types.MCPServerCatalogEntryManifest{Name: "entry1", Runtime: types.RuntimeUVX},
+		},
+	}
+}
+
+func testEntryVersion(number int, released bool, rolloutUserIDs ...string) *v1.MCPServerCatalogEntryVersion {
+	return &v1.MCPServerCatalogEntryVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "entry1-v" + strconv.Itoa(number), Namespace: system.DefaultNamespace},
+		Spec: v1.MCPServerCatalogEntryVersionSpec{
+			MCPServerCatalogEntryName: "entry1",
+			Version:                   number,
+			Manifest: types.MCPServerCatalogEntryManifest{
+				Name:      "entry1",
+				Runtime:   types.RuntimeUVX,
+				UVXConfig: &types.UVXRuntimeConfig{Package: "test-server==1." + strconv.Itoa(number)},
+			},
+			Released:       released,
+			RolloutUserIDs: rolloutUserIDs,
+		},
+	}
+}
+
+func singleUserServer(name string, version int, pinned bool) *v1.MCPServer {
+	return &v1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: system.DefaultNamespace},
+		Spec: v1.MCPServerSpec{
+			UserID:                             "u1",
+			MCPServerCatalogEntryName:          "entry1",
+			MCPServerCatalogEntryVersion:       version,
+			MCPServerCatalogEntryVersionPinned: pinned,
+			Manifest: types.MCPServerManifest{
+				Name:      "entry1",
+				Runtime:   types.RuntimeUVX,
+				UVXConfig: &types.UVXRuntimeConfig{Package: "test-server==1." + strconv.Itoa(version)},
+			},
+		},
+	}
+}
+
+func getEntryVersion(t *testing.T, storage kclient.Client, number int) v1.MCPServerCatalogEntryVersion {
+	t.Helper()
+	var version v1.MCPServerCatalogEntryVersion
+	require.NoError(t, storage.Get(t.Context(), kclient.ObjectKey{Namespace: system.DefaultNamespace, Name: "entry1-v" + strconv.Itoa(number)}, &version))
+	return version
+}
+
+func getServer(t *testing.T, storage kclient.Client, name string) v1.MCPServer {
+	t.Helper()
+	var server v1.MCPServer
+	require.NoError(t, storage.Get(t.Context(), kclient.ObjectKey{Namespace: system.DefaultNamespace, Name: name}, &server))
+	return server
+}
+
+func requireHTTPError(t *testing.T, err error, code int) {
+	t.Helper()
+	var errHTTP *types.ErrHTTP
+	require.ErrorAs(t, err, &errHTTP)
+	assert.Equal(t, code, errHTTP.Code)
+}
+
+func TestRolloutEntryVersion(t *testing.T) {
+	handler := &MCPCatalogHandler{}
+
+	for _, tt := range []struct {
+		name         string
+		version      string
+		body         string
+		expectedCode int
+	}{
+		{name: "rollout", version: "2", body: `{"userIDs":["u1"]}`},
+		{name: "no users", version: "2", body: `{"userIDs":[]}`, expectedCode: http.StatusBadRequest},
+		{name: "no earlier released version", version: "1", body: `{"userIDs":["u1"]}`, expectedCode: http.StatusBadRequest},
+		{name: "missing version", version: "3", body: `{"userIDs":["u1"]}`, expectedCode: http.StatusNotFound},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := newEntryVersionStorage(versionedEntry(), testEntryVersion(1, true), testEntryVersion(2, true))
+
+			err := handler.RolloutEntryVersion(newEntryVersionContext(storage, tt.version, tt.body))
+			if tt.expectedCode != 0 {
+				requireHTTPError(t, err, tt.expectedCode)
+				return
+			}
+			require.NoError(t, err)
+
+			// The version is withdrawn from everyone except the given users.
+			version := getEntryVersion(t, storage, 2)
+			assert.False(t, version.Spec.Released)
+			assert.Equal(t, []string{"u1"}, version.Spec.RolloutUserIDs)
+		})
+	}
+}
+
+func TestReleaseEntryVersion(t *testing.T) {
+	storage := newEntryVersionStorage(versionedEntry(), testEntryVersion(1, true), testEntryVersion(2, false, "u1"))
+
+	require.NoError(t, (&MCPCatalogHandler{}).ReleaseEntryVersion(newEntryVersionContext(storage, "2", "")))
+
+	version := getEntryVersion(t, storage, 2)
+	assert.True(t, version.Spec.Released)
+	assert.Empty(t, version.Spec.RolloutUserIDs)
+}
+
+func TestRollbackEntryVersion(t *testing.T) {
+	multiUser := singleUserServer("ms-multi", 3, false)
+	multiUser.Spec.UserID = ""
+	multiUser.Spec.MCPCatalogID = system.DefaultCatalog
+
+	storage := newEntryVersionStorage(
+		versionedEntry(),
+		testEntryVersion(1, true),
+		testEntryVersion(2, false),
+		testEntryVersion(3, true),
+		testEntryVersion(4, false, "u1"),
+		// None of these servers are moved, so none are shut down.
+		singleUserServer("ms-pinned", 3, true),
+		singleUserServer("ms-older", 1, false),
+		multiUser,
+	)
+
+	require.NoError(t, (&MCPCatalogHandler{}).RollbackEntryVersion(newEntryVersionContext(storage, "2", "")))
+
+	// The version is released, and every newer version is withdrawn.
+	assert.True(t, getEntryVersion(t, storage, 1).Spec.Released)
+	assert.True(t, getEntryVersion(t, storage, 2).Spec.Released)
+	for _, number := range []int{3, 4} {
+		version := getEntryVersion(t, storage, number)
+		assert.False(t, version.Spec.Released)
+		assert.Empty(t, version.Spec.RolloutUserIDs)
+	}
+
+	for _, name := range []string{"ms-pinned", "ms-multi"} {
+		assert.Equal(t, 3, getServer(t, storage, name).Spec.MCPServerCatalogEntryVersion)
+	}
+	assert.Equal(t, 1, getServer(t, storage, "ms-older").Spec.MCPServerCatalogEntryVersion)
+
+	// Composite entries can't be rolled back.
+	composite := versionedEntry()
+	composite.Spec.Manifest.Runtime = types.RuntimeComposite
+	storage = newEntryVersionStorage(composite, testEntryVersion(1, true))
+	requireHTTPError(t, (&MCPCatalogHandler{}).RollbackEntryVersion(newEntryVersionContext(storage, "1", "")), http.StatusBadRequest)
+}
+
+func TestPinVersion(t *testing.T) {
+	handler := &MCPHandler{}
+
+	for _, tt := range []struct {
+		name            string
+		server          *v1.MCPServer
+		body            string
+		expectedCode    int
+		expectedVersion int
+		expectedPinned  bool
+	}{
+		{name: "pin the current version", server: singleUserServer("ms1", 1, false), body: `{"version":1}`, expectedVersion: 1, expectedPinned: true},
+		{name: "unpin", server: singleUserServer("ms1", 1, true), body: `{"version":0}`, expectedVersion: 1},
+		{name: "version being rolled out to another user", server: singleUserServer("ms1", 1, false), body: `{"version":3}`, expectedCode: http.StatusNotFound},
+		{name: "missing version", server: singleUserServer("ms1", 1, false), body: `{"version":4}`, expectedCode: http.StatusNotFound},
+		{name: "multi-user server", server: func() *v1.MCPServer {
+			server := singleUserServer("ms1", 1, false)
+			server.Spec.MCPCatalogID = system.DefaultCatalog
+			return server
+		}(), body: `{"version":1}`, expectedCode: http.StatusBadRequest},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := newEntryVersionStorage(versionedEntry(), testEntryVersion(1, true), testEntryVersion(2, true), testEntryVersion(3, false, "u2"), tt.server)
+
+			ctx := newEntryVersionContext(storage, "", tt.body)
+			ctx.SetPathValue("mcp_server_id", "ms1")
+			err := handler.PinVersion(ctx)
+			if tt.expectedCode != 0 {
+				requireHTTPError(t, err, tt.expectedCode)
+				return
+			}
+			require.NoError(t, err)
+
+			server := getServer(t, storage, "ms1")
+			assert.Equal(t, tt.expectedVersion, server.Spec.MCPServerCatalogEntryVersion)
+			assert.Equal(t, tt.expectedPinned, server.Spec.MCPServerCatalogEntryVersionPinned)
+		})
+	}
+}
+
+type shutdowner struct {
+	storage kclient.Client
+	err     error
+	// version is the version the server was stored with when it was shut down.
+	version int
+}
+
+func (s *shutdowner) ShutdownServer(ctx context.Context, serverName string) error {
+	var server v1.MCPServer
+	if err := s.storage.Get(ctx, kclient.ObjectKey{Namespace: system.DefaultNamespace, Name: serverName}, &server); err != nil {
+		return err
+	}
+	s.version = server.Spec.MCPServerCatalogEntryVersion
+	return s.err
+}
+
+func TestMoveServerToVersion(t *testing.T) {
+	// The server is stored with the new version before it is shut down, so that it starts again with that version.
+	storage := newEntryVersionStorage(singleUserServer("ms1", 2, false))
+	server := getServer(t, storage, "ms1")
+	s := &shutdowner{storage: storage}
+	require.NoError(t, moveServerToVersion(newEntryVersionContext(storage, "", ""), s, &server, *testEntryVersion(1, true), true))
+	assert.Equal(t, 1, s.version)
+
+	server = getServer(t, storage, "ms1")
+	assert.Equal(t, 1, server.Spec.MCPServerCatalogEntryVersion)
+	assert.True(t, server.Spec.MCPServerCatalogEntryVersionPinned)
+	assert.Equal(t, "test-server==1.1", server.Spec.Manifest.UVXConfig.Package)
+
+	// If the server can't be shut down, it is moved back to its previous version.
+	storage = newEntryVersionStorage(singleUserServer("ms1", 2, false))
+	server = getServer(t, storage, "ms1")
+	s = &shutdowner{storage: storage, err: errors.New("shutdown failed")}
+	require.ErrorContains(t, moveServerToVersion(newEntryVersionContext(storage, "", ""), s, &server, *testEntryVersion(1, true), true), "shutdown failed")
+	assert.Equal(t, 1, s.version)
+
+	server = getServer(t, storage, "ms1")
+	assert.Equal(t, 2, server.Spec.MCPServerCatalogEntryVersion)
+	assert.False(t, server.Spec.MCPServerCatalogEntryVersionPinned)
+	assert.Equal(t, "test-server==1.2", server.Spec.Manifest.UVXConfig.Package)
+}
@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	gtypes "github.com/obot-platform/obot/pkg/gateway/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+const (
+	defaultSLOTarget     = 99.0
+	defaultSLOWindowDays = 30
+)
+
+type MCPServerHealthHandler struct{}
+
+func NewMCPServerHealthHandler() *MCPServerHealthHandler {
+	return &MCPServerHealthHandler{}
+}
+
+// List returns the health of every MCP server that has been probed in its SLO window, without the probe results.
+func (*MCPServerHealthHandler) List(req api.Context) error {
+	var servers v1.MCPServerList
+	if err := req.List(&servers); err != nil {
+		return err
+	}
+
+	// Servers with the same SLO window share a query.
+	byWindow := map[int][]v1.MCPServer{}
+	for _, server := range servers.Items {
+		_, windowDays := sloForServer(server)
+		byWindow[windowDays] = append(byWindow[windowDays], server)
+	}
+
+	items := make([]types.MCPServerHealth, 0, len(servers.Items))
+	for windowDays, windowServers := range byWindow {
+		stats, err := req.GatewayClient.GetMCPServerProbeStats(req.Context(), sloWindowStart(windowDays))
+		if err != nil {
+			return fmt.Errorf("failed to get probe stats: %w", err)
+		}
+
+		for _, server := range windowServers {
+			if serverStats, ok := stats[server.Name]; ok {
+				items = append(items, mcpServerHealth(server, serverStats, nil))
+			}
+		}
+	}
+
+	slices.SortFunc(items, func(a, b types.MCPServerHealth) int {
+		// Servers with the least uptime come first.
+		if c := cmp.Compare(a.Uptime, b.Uptime); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.MCPServerID, b.MCPServerID)
+	})
+
+	return req.Write(types.MCPServerHealthList{Items: items})
+}
+
+// Get returns the health of an MCP server, with its probe results since the time in the since query parameter.
+// By default, the probe results from the last day are returned.
+func (*MCPServerHealthHandler) Get(req api.Context) error {
+	var server v1.MCPServer
+	if err := req.Get(&server, req.PathValue("mcp_server_id")); err != nil {
+		return err
+	}
+
+	_, windowDays := sloForServer(server)
+	windowStart := sloWindowStart(windowDays)
+
+	since := time.Now().Add(-24 * time.Hour)
+	if s := req.URL.Query().Get("since"); s != "" {
+		var err error
+		if since, err = time.Parse(time.RFC3339, s); err != nil {
+			return types.NewErrBadRequest("invalid since time %q, must be RFC 3339", s)
+		}
+	}
+	if since.Before(windowStart) {
+		since = windowStart
+	}
+
+	stats, err := req.GatewayClient.GetMCPServerProbeStats(req.Context(), windowStart, server.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get probe stats: %w", err)
+	}
+
+	probes, err := req.GatewayClient.GetMCPServerProbeResults(req.Context(), server.Name, since)
+	if err != nil {
+		return fmt.Errorf("failed to get probe results: %w", err)
+	}
+
+	return req.Write(mcpServerHealth(server, stats[server.Name], probes))
+}
+
+// sloForServer returns the SLO target and window of the server, using the defaults for anything that isn't set.
+func sloForServer(server v1.MCPServer) (float64, int) {
+	target, windowDays := defaultSLOTarget, defaultSLOWindowDays
+	if probe := server.Spec.Manifest.HealthProbe; probe != nil {
+		if probe.SLOTarget > 0 {
+			target = probe.SLOTarget
+		}
+		if probe.SLOWindowDays > 0 {
+			windowDays = probe.SLOWindowDays
+		}
+	}
+	return target, windowDays
+}
+
+func sloWindowStart(windowDays int) time.Time {
+	return time.Now().Add(-time.Duration(windowDays) * 24 * time.Hour)
+}
+
+func mcpServerHealth(server v1.MCPServer, stats gtypes.MCPServerProbeStats, probes []gtypes.MCPServerProbeResult) types.MCPServerHealth {
+	target, windowDays := sloForServer(server)
+
+	health := types.MCPServerHealth{
+		MCPServerID:          server.Name,
+		MCPServerName:        server.Spec.Manifest.Name,
+		SLOTarget:            target,
+		SLOWindowDays:        windowDays,
+		ProbeCount:           stats.Total,
+		FailedProbeCount:     stats.Total - stats.Succeeded,
+		Uptime:               100,
+		SLOMet:               true,
+		ErrorBudgetRemaining: 100,
+	}
+
+	if stats.Total > 0 {
+		health.Uptime = 100 * float64(stats.Succeeded) / float64(stats.Total)
+		health.SLOMet = health.Uptime >= target
+
+		allowedFailures := float64(stats.Total) * (100 - target) / 100
+		health.ErrorBudgetRemaining = 100 * (allowedFailures - float64(health.FailedProbeCount)) / allowedFailures
+	}
+
+	var latencies []int64
+	for _, probe := range probes {
+		health.Probes = append(health.Probes, types.MCPServerProbeResult{
+			Time:                *types.NewTime(probe.Time),
+			Success:             probe.Success,
+			AuthRequired:        probe.AuthRequired,
+			LatencyMS:           probe.LatencyMS,
+			InitializeLatencyMS: probe.InitializeLatencyMS,
+			ListToolsLatencyMS:  probe.ListToolsLatencyMS,
+			ToolCallLatencyMS:   probe.ToolCallLatencyMS,
+			ToolCount:           probe.ToolCount,
+			Error:               probe.Error,
+		})
+		if probe.Success {
+			latencies = append(latencies, probe.LatencyMS)
+		}
+	}
+
+	if len(latencies) > 0 {
+		slices.Sort(latencies)
+		health.LatencyP50MS = percentile(latencies, 50)
+		health.LatencyP95MS = percentile(latencies, 95)
+	}
+
+	return health
+}
+
+// percentile returns the nearest-rank percentile of sorted values.
+func percentile(sorted []int64, p int) int64 {
+	rank := (p*len(sorted) + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	gtypes "github.com/obot-platform/obot/pkg/gateway/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMCPServerHealth(t *testing.T) {
+	server := v1.MCPServer{}
+	server.Name = "ms1abc"
+	server.Spec.Manifest.Name = "Search"
+
+	// Without probes, the server is healthy and uses the default SLO.
+	health := mcpServerHealth(server, gtypes.MCPServerProbeStats{}, nil)
+	assert.Equal(t, defaultSLOTarget, health.SLOTarget)
+	assert.Equal(t, defaultSLOWindowDays, health.SLOWindowDays)
+	assert.Equal(t, 100.0, health.Uptime)
+	assert.True(t, health.SLOMet)
+	assert.Equal(t, 100.0, health.ErrorBudgetRemaining)
+
+	// 1 failure out of 1000 probes uses half of the error budget of a 99.8% SLO.
+	server.Spec.Manifest.HealthProbe = &types.HealthProbe{SLOTarget: 99.8, SLOWindowDays: 7}
+	health = mcpServerHealth(server, gtypes.MCPServerProbeStats{Total: 1000, Succeeded: 999}, nil)
+	assert.Equal(t, 7, health.SLOWindowDays)
+	assert.Equal(t, int64(1), health.FailedProbeCount)
+	assert.InDelta(t, 99.9, health.Uptime, 0.0001)
+	assert.True(t, health.SLOMet)
+	assert.InDelta(t, 50, health.ErrorBudgetRemaining, 0.0001)
+
+	// Missing the SLO makes the remaining error budget negative.
+	health = mcpServerHealth(server, gtypes.MCPServerProbeStats{Total: 1000, Succeeded: 996}, nil)
+	assert.False(t, health.SLOMet)
+	assert.InDelta(t, -100, health.ErrorBudgetRemaining, 0.0001)
+
+	now := time.Now()
+	var probes []gtypes.MCPServerProbeResult
+	for i := range 20 {
+		probes = append(probes, gtypes.MCPServerProbeResult{Time: now.Add(time.Duration(i) * time.Minute), Success: true, LatencyMS: int64(20 - i)})
+	}
+	probes = append(probes, gtypes.MCPServerProbeResult{Time: now.Add(time.Hour), LatencyMS: 30000, Error: "initialize: timeout"})
+
+	health = mcpServerHealth(server, gtypes.MCPServerProbeStats{Total: 21, Succeeded: 20}, probes)
+	require.Len(t, health.Probes, 21)
+	assert.Equal(t, "initialize: timeout", health.Probes[20].Error)
+	// Failed probes don't count toward latency.
+	assert.Equal(t, int64(10), health.LatencyP50MS)
+	assert.Equal(t, int64(19), health.LatencyP95MS)
+}
@@ -0,0 +1,264 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/storage/selectors"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type MCPToolApprovalPolicyHandler struct{}
+
+func NewMCPToolApprovalPolicyHandler() *MCPToolApprovalPolicyHandler {
+	return &MCPToolApprovalPolicyHandler{}
+}
+
+func (*MCPToolApprovalPolicyHandler) List(req api.Context) error {
+	var list v1.MCPToolApprovalPolicyList
+	if err := req.List(&list); err != nil {
+		return fmt.Errorf("failed to list tool approval policies: %w", err)
+	}
+
+	items := make([]types.MCPToolApprovalPolicy, 0, len(list.Items))
+	for _, item := range list.Items {
+		items = append(items, convertMCPToolApprovalPolicy(item))
+	}
+
+	return req.Write(types.MCPToolApprovalPolicyList{Items: items})
+}
+
+func (*MCPToolApprovalPolicyHandler) Get(req api.Context) error {
+	var policy v1.MCPToolApprovalPolicy
+	if err := req.Get(&policy, req.PathValue("policy_id")); err != nil {
+		return err
+	}
+
+	return req.Write(convertMCPToolApprovalPolicy(policy))
+}
+
+func (*MCPToolApprovalPolicyHandler) Create(req api.Context) error {
+	var manifest types.MCPToolApprovalPolicyManifest
+	if err := req.Read(&manifest); err != nil {
+		return types.NewErrBadRequest("failed to read manifest: %v", err)
+	}
+
+	if err := manifest.Validate(); err != nil {
+		return types.NewErrBadRequest("invalid manifest: %v", err)
+	}
+
+	policy := v1.MCPToolApprovalPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.MCPToolApprovalPolicyPrefix,
+			Namespace:    req.Namespace(),
+		},
+		Spec: v1.MCPToolApprovalPolicySpec{
+			Manifest: manifest,
+		},
+	}
+
+	if err := req.Create(&policy); err != nil {
+		return fmt.Errorf("failed to create tool approval policy: %w", err)
+	}
+
+	return req.WriteCreated(convertMCPToolApprovalPolicy(policy))
+}
+
+func (*MCPToolApprovalPolicyHandler) Update(req api.Context) error {
+	var policy v1.MCPToolApprovalPolicy
+	if err := req.Get(&policy, req.PathValue("policy_id")); err != nil {
+		return err
+	}
+
+	var manifest types.MCPToolApprovalPolicyManifest
+	if err := req.Read(&manifest); err != nil {
+		return types.NewErrBadRequest("failed to read manifest: %v", err)
+	}
+
+	if err := manifest.Validate(); err != nil {
+		return types.NewErrBadRequest("invalid manifest: %v", err)
+	}
+
+	policy.Spec.Manifest = manifest
+	if err := req.Update(&policy); err != nil {
+		return fmt.Errorf("failed to update tool approval policy: %w", err)
+	}
+
+	return req.Write(convertMCPToolApprovalPolicy(policy))
+}
+
+// Delete removes the policy. Calls that it already paused still need a decision.
+func (*MCPToolApprovalPolicyHandler) Delete(req api.Context) error {
+	var policy v1.MCPToolApprovalPolicy
+	if err := req.Get(&policy, req.PathValue("policy_id")); err != nil {
+		return err
+	}
+
+	if err := req.Delete(&policy); err != nil {
+		return fmt.Errorf("failed to delete tool approval policy: %w", err)
+	}
+
+	return req.Write(convertMCPToolApprovalPolicy(policy))
+}
+
+func convertMCPToolApprovalPolicy(policy v1.MCPToolApprovalPolicy) types.MCPToolApprovalPolicy {
+	return types.MCPToolApprovalPolicy{
+		Metadata:                      MetadataFrom(&policy),
+		MCPToolApprovalPolicyManifest: policy.Spec.Manifest,
+	}
+}
+
+type MCPToolCallApprovalHandler struct{}
+
+func NewMCPToolCallApprovalHandler() *MCPToolCallApprovalHandler {
+	return &MCPToolCallApprovalHandler{}
+}
+
+// List returns the paused calls the user can see: the calls they made and the calls they can decide on.
+func (*MCPToolCallApprovalHandler) List(req api.Context) error {
+	var list v1.MCPToolCallApprovalList
+	if err := req.List(&list, &kclient.ListOptions{
+		FieldSelector: fields.SelectorFromSet(selectors.RemoveEmpty(map[string]string{
+			"spec.state": req.URL.Query().Get("state"),
+			"spec.mcpID": req.URL.Query().Get("mcp_id"),
+		})),
+	}); err != nil {
+		return fmt.Errorf("failed to list tool call approvals: %w", err)
+	}
+
+	items := make([]types.MCPToolCallApproval, 0, len(list.Items))
+	for _, item := range list.Items {
+		if item.Spec.UserID == req.User.GetUID() || canDecideMCPToolCallApproval(req, &item) {
+			items = append(items, convertMCPToolCallApproval(item))
+		}
+	}
+
+	return req.Write(types.MCPToolCallApprovalList{Items: items})
+}
+
+// Get returns a paused call if the user made it or can decide on it.
+func (h *MCPToolCallApprovalHandler) Get(req api.Context) error {
+	approval, err := h.getVisible(req)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(convertMCPToolCallApproval(*approval))
+}
+
+// Approve lets a paused call continue to the MCP server.
+func (h *MCPToolCallApprovalHandler) Approve(req api.Context) error {
+	return h.decide(req, types.MCPToolCallApprovalStateApproved)
+}
+
+// Reject fails a paused call without sending it to the MCP server.
+func (h *MCPToolCallApprovalHandler) Reject(req api.Context) error {
+	return h.decide(req, types.MCPToolCallApprovalStateRejected)
+}
+
+func (h *MCPToolCallApprovalHandler) decide(req api.Context, to types.MCPToolCallApprovalState) error {
+	approval, err := h.getVisible(req)
+	if err != nil {
+		return err
+	}
+
+	if !canDecideMCPToolCallApproval(req, approval) {
+		return types.NewErrForbidden("user cannot decide on tool call approval %s", approval.Name)
+	}
+
+	// The decision body is optional.
+	var decision types.MCPToolCallApprovalDecision
+	if err := req.Read(&decision); err != nil && !errors.Is(err, io.EOF) {
+		return types.NewErrBadRequest("failed to read decision: %v", err)
+	}
+
+	if approval.Spec.State != types.MCPToolCallApprovalStatePending {
+		return types.NewErrBadRequest("tool call approval %s is %s, only pending calls can be %s", approval.Name, approval.Spec.State, to)
+	}
+	if time.Now().After(approval.Spec.ExpiresAt.Time) {
+		return types.NewErrBadRequest("tool call approval %s has expired", approval.Name)
+	}
+
+	now := metav1.Now()
+	approval.Spec.State = to
+	approval.Spec.DecidedBy = req.User.GetUID()
+	approval.Spec.DecisionReason = decision.Reason
+	approval.Spec.DecidedAt = &now
+
+	// The update fails on a conflict if the call expired or was decided on in the meantime.
+	if err := req.Update(approval); err != nil {
+		return fmt.Errorf("failed to update tool call approval: %w", err)
+	}
+
+	return req.Write(convertMCPToolCallApproval(*approval))
+}
+
+// getVisible returns the paused call from the path if the user made it or can decide on it.
+func (*MCPToolCallApprovalHandler) getVisible(req api.Context) (*v1.MCPToolCallApproval, error) {
+	var approval v1.MCPToolCallApproval
+	if err := req.Get(&approval, req.PathValue("approval_id")); err != nil {
+		return nil, err
+	}
+
+	if approval.Spec.UserID != req.User.GetUID() && !canDecideMCPToolCallApproval(req, &approval) {
+		return nil, types.NewErrNotFound("tool call approval %s not found", approval.Name)
+	}
+
+	return &approval, nil
+}
+
+// canDecideMCPToolCallApproval returns true if the user is an admin or owner, or is one of the approvers of the call.
+// Calls without approvers are decided on by the user that made them.
+func canDecideMCPToolCallApproval(req api.Context, approval *v1.MCPToolCallApproval) bool {
+	if req.UserIsAdmin() || req.UserIsOwner() {
+		return true
+	}
+
+	if len(approval.Spec.Approvers) == 0 {
+		return approval.Spec.UserID == req.User.GetUID()
+	}
+
+	groups := req.User.GetExtra()["auth_provider_groups"]
+	for _, approver := range approval.Spec.Approvers {
+		switch approver.Type {
+		case types.SubjectTypeUser:
+			if approver.ID == req.User.GetUID() {
+				return true
+			}
+		case types.SubjectTypeGroup:
+			if slices.Contains(groups, approver.ID) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func convertMCPToolCallApproval(approval v1.MCPToolCallApproval) types.MCPToolCallApproval {
+	return types.MCPToolCallApproval{
+		Metadata:             MetadataFrom(&approval),
+		PolicyID:             approval.Spec.PolicyName,
+		UserID:               approval.Spec.UserID,
+		MCPID:                approval.Spec.MCPID,
+		MCPServerDisplayName: approval.Spec.MCPServerDisplayName,
+		Method:               approval.Spec.Method,
+		Identifier:           approval.Spec.Identifier,
+		Arguments:            approval.Spec.Arguments,
+		Approvers:            approval.Spec.Approvers,
+		State:                approval.Spec.State,
+		ExpiresAt:            v1.NewTime(&approval.Spec.ExpiresAt),
+		DecidedBy:            approval.Spec.DecidedBy,
+		DecisionReason:       approval.Spec.DecisionReason,
+		DecidedAt:            v1.NewTime(approval.Spec.DecidedAt),
+	}
+}
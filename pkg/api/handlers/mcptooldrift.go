@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	gateway "github.com/obot-platform/obot/pkg/gateway/client"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/obot-platform/obot/pkg/tooldrift"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type MCPToolDriftHandler struct{}
+
+func NewMCPToolDriftHandler() *MCPToolDriftHandler {
+	return &MCPToolDriftHandler{}
+}
+
+func (h *MCPToolDriftHandler) GetPolicy(req api.Context) error {
+	var policy v1.MCPToolDriftPolicy
+	if err := req.Get(&policy, system.MCPToolDriftPolicyName); apierrors.IsNotFound(err) {
+		// No policy has been configured, so changes are reported but not blocked.
+		return req.Write(types.MCPToolDriftPolicy{})
+	} else if err != nil {
+		return err
+	}
+
+	return req.Write(convertMCPToolDriftPolicy(policy))
+}
+
+func (h *MCPToolDriftHandler) UpdatePolicy(req api.Context) error {
+	var input types.MCPToolDriftPolicyManifest
+	if err := req.Read(&input); err != nil {
+		return err
+	}
+
+	var policy v1.MCPToolDriftPolicy
+	if err := req.Get(&policy, system.MCPToolDriftPolicyName); apierrors.IsNotFound(err) {
+		policy = v1.MCPToolDriftPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      system.MCPToolDriftPolicyName,
+				Namespace: req.Namespace(),
+			},
+			Spec: v1.MCPToolDriftPolicySpec{
+				Manifest: input,
+			},
+		}
+
+		if err := req.Create(&policy); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	} else {
+		policy.Spec.Manifest = input
+		if err := req.Update(&policy); err != nil {
+			return err
+		}
+	}
+
+	return req.Write(convertMCPToolDriftPolicy(policy))
+}
+
+// ListPins handles GET /api/mcp-tool-pins
+func (h *MCPToolDriftHandler) ListPins(req api.Context) error {
+	query := req.URL.Query()
+
+	opts := gateway.MCPToolPinOptions{
+		SourceID: query["source_id"],
+		ToolName: query["tool_name"],
+		Limit:    100,
+	}
+
+	if changed := query.Get("changed"); changed != "" {
+		c, err := strconv.ParseBool(changed)
+		if err != nil {
+			return types.NewErrBadRequest("invalid changed value %q", changed)
+		}
+		opts.Changed = &c
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil && l > 0 {
+			opts.Limit = l
+		}
+	}
+	if offset := query.Get("offset"); offset != "" {
+		if o, err := strconv.Atoi(offset); err == nil && o >= 0 {
+			opts.Offset = o
+		}
+	}
+
+	pins, total, err := req.GatewayClient.GetMCPToolPins(req.Context(), opts)
+	if err != nil {
+		return err
+	}
+
+	items := make([]types.MCPToolPin, 0, len(pins))
+	for _, pin := range pins {
+		items = append(items, convertMCPToolPin(pin))
+	}
+
+	return req.Write(types.MCPToolPinResponse{
+		MCPToolPinList: types.MCPToolPinList{
+			Items: items,
+		},
+		Total:  total,
+		Limit:  opts.Limit,
+		Offset: opts.Offset,
+	})
+}
+
+// GetPin handles GET /api/mcp-tool-pins/{pin_id}
+func (h *MCPToolDriftHandler) GetPin(req api.Context) error {
+	id, err := toolPinID(req)
+	if err != nil {
+		return err
+	}
+
+	pin, err := req.GatewayClient.GetMCPToolPin(req.Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(convertMCPToolPin(*pin))
+}
+
+// ApprovePin handles POST /api/mcp-tool-pins/{pin_id}/approve. The current version of the tool becomes its pinned
+// version, and calls to it are no longer blocked.
+func (h *MCPToolDriftHandler) ApprovePin(req api.Context) error {
+	id, err := toolPinID(req)
+	if err != nil {
+		return err
+	}
+
+	// The approval body is optional.
+	var approval types.MCPToolPinApproval
+	if err := req.Read(&approval); err != nil && !errors.Is(err, io.EOF) {
+		return types.NewErrBadRequest("failed to read approval: %v", err)
+	}
+
+	pin, err := req.GatewayClient.ApproveMCPToolPin(req.Context(), id, req.User.GetUID(), approval.CurrentHash)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(convertMCPToolPin(*pin))
+}
+
+func toolPinID(req api.Context) (uint, error) {
+	id, err := strconv.ParseUint(req.PathValue("pin_id"), 10, 64)
+	if err != nil {
+		return 0, types.NewErrBadRequest("invalid tool pin id %q", req.PathValue("pin_id"))
+	}
+	return uint(id), nil
+}
+
+func convertMCPToolPin(pin gatewaytypes.MCPToolPin) types.MCPToolPin {
+	result := gatewaytypes.ConvertMCPToolPin(pin)
+	result.Diff = tooldrift.Diff(pin)
+	return result
+}
+
+func convertMCPToolDriftPolicy(policy v1.MCPToolDriftPolicy) types.MCPToolDriftPolicy {
+	return types.MCPToolDriftPolicy{
+		Metadata:                   MetadataFrom(&policy),
+		MCPToolDriftPolicyManifest: policy.Spec.Manifest,
+	}
+}
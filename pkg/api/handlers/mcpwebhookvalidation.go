@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gptscript-ai/go-gptscript"
 	"github.com/obot-platform/obot/apiclient/types"
@@ -192,6 +193,44 @@ func (m *MCPWebhookValidationHandler) RemoveSecret(req api.Context) error {
 	return nil
 }
 
+// ShadowReport summarizes what the webhook validation would have blocked while in shadow mode. The report covers the last
+// seven days, unless the start and end query parameters are set.
+func (m *MCPWebhookValidationHandler) ShadowReport(req api.Context) error {
+	var validation v1.MCPWebhookValidation
+	if err := req.Get(&validation, req.PathValue("mcp_webhook_validation_id")); err != nil {
+		return err
+	}
+
+	end := time.Now()
+	if e := req.URL.Query().Get("end"); e != "" {
+		t, err := time.Parse(time.RFC3339, e)
+		if err != nil {
+			return types.NewErrBadRequest("invalid end format, expected RFC3339")
+		}
+		end = t
+	}
+
+	start := end.Add(-7 * 24 * time.Hour)
+	if s := req.URL.Query().Get("start"); s != "" {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return types.NewErrBadRequest("invalid start format, expected RFC3339")
+		}
+		start = t
+	}
+
+	if !start.Before(end) {
+		return types.NewErrBadRequest("start must be before end")
+	}
+
+	report, err := req.GatewayClient.GetMCPWebhookShadowReport(req.Context(), validation.Name, start, end)
+	if err != nil {
+		return fmt.Errorf("failed to get shadow report: %w", err)
+	}
+
+	return req.Write(report)
+}
+
 func convertMCPWebhookValidation(validation v1.MCPWebhookValidation, hasSecret bool) types.MCPWebhookValidation {
 	return types.MCPWebhookValidation{
 		Metadata:                     MetadataFrom(&validation),
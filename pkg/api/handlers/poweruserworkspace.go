@@ -279,6 +279,8 @@ func convertAccessControlRuleWithWorkspace(rule v1.AccessControlRule, powerUserI
 		PowerUserWorkspaceID:      rule.Spec.PowerUserWorkspaceID,
 		PowerUserID:               powerUserID,
 		Generated:                 rule.Spec.Generated,
+		MCPAccessRequestID:        rule.Spec.MCPAccessRequestName,
+		ExpiresAt:                 v1.NewTime(rule.Spec.ExpiresAt),
 		AccessControlRuleManifest: rule.Spec.Manifest,
 	}
 }
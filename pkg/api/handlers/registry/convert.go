@@ -3,6 +3,7 @@ package registry
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -241,6 +242,31 @@ func ConvertMCPServerCatalogEntryToRegistry(
 	}, nil
 }
 
+// ConvertMCPServerCatalogEntryVersionToRegistry converts a recorded version of a catalog entry to Registry format
+func ConvertMCPServerCatalogEntryVersionToRegistry(
+	ctx context.Context,
+	entry v1.MCPServerCatalogEntry,
+	version v1.MCPServerCatalogEntryVersion,
+	isLatest bool,
+	serverURL string,
+	reverseDNS string,
+	mimeFetcher *mimeFetcher,
+) (obottypes.RegistryServerResponse, error) {
+	entry.Spec.Manifest = version.Spec.Manifest
+
+	response, err := ConvertMCPServerCatalogEntryToRegistry(ctx, entry, serverURL, reverseDNS, mimeFetcher)
+	if err != nil {
+		return response, err
+	}
+
+	response.Server.Version = strconv.Itoa(version.Spec.Version)
+	response.Meta.Official.IsLatest = isLatest
+	response.Meta.Official.CreatedAt = version.CreationTimestamp.Format(time.RFC3339)
+	response.CreatedAtUnix = version.CreationTimestamp.Unix()
+
+	return response, nil
+}
+
 // Helper functions
 
 func guessRepoSource(repoURL string) string {
@@ -666,11 +666,20 @@ func (h *Handler) ListServerVersions(req api.Context) error {
 		return h.notFoundError("Server not found")
 	}
 
-	// Return as a ServerList with single item (only "latest" version exists)
+	versions, err := h.listCatalogEntryVersions(req, actualServerName, reverseDNS)
+	if err != nil {
+		return err
+	}
+
+	// MCP servers, and catalog entries without recorded versions, only have the "latest" version
+	if len(versions) == 0 {
+		versions = []types.RegistryServerResponse{server}
+	}
+
 	response := types.RegistryServerList{
-		Servers: []types.RegistryServerResponse{server},
+		Servers: versions,
 		Metadata: &types.RegistryServerListMetadata{
-			Count: 1,
+			Count: len(versions),
 		},
 	}
 
@@ -690,11 +699,6 @@ func (h *Handler) GetServerVersion(req api.Context) error {
 		return fmt.Errorf("version is required")
 	}
 
-	// Only support "latest" version
-	if version != "latest" {
-		return h.notFoundError("Version not found. Only 'latest' is supported.")
-	}
-
 	// Parse reverse DNS and actual server name
 	parts := strings.SplitN(serverName, "/", 2)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
@@ -708,7 +712,69 @@ func (h *Handler) GetServerVersion(req api.Context) error {
 		return h.notFoundError("Server not found")
 	}
 
-	return req.Write(server)
+	versions, err := h.listCatalogEntryVersions(req, actualServerName, reverseDNS)
+	if err != nil {
+		return err
+	}
+
+	if len(versions) == 0 {
+		if version != "latest" {
+			return h.notFoundError("Version not found")
+		}
+		return req.Write(server)
+	}
+
+	for _, v := range versions {
+		if v.Server.Version == version || (version == "latest" && v.Meta.Official.IsLatest) {
+			return req.Write(v)
+		}
+	}
+
+	return h.notFoundError("Version not found")
+}
+
+// listCatalogEntryVersions returns the recorded versions of a catalog entry that are available to the user, newest first.
+// It returns nothing for MCP servers. The caller must have already checked that the user has access to the entry.
+func (h *Handler) listCatalogEntryVersions(req api.Context, serverName, reverseDNS string) ([]types.RegistryServerResponse, error) {
+	if system.IsMCPServerID(serverName) {
+		return nil, nil
+	}
+
+	var entry v1.MCPServerCatalogEntry
+	if err := req.Get(&entry, serverName); err != nil {
+		return nil, h.notFoundError("Server not found")
+	}
+
+	var versions v1.MCPServerCatalogEntryVersionList
+	if err := req.List(&versions, &kclient.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.mcpServerCatalogEntryName", entry.Name),
+		Namespace:     entry.Namespace,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list versions of catalog entry %s: %w", entry.Name, err)
+	}
+
+	slices.SortFunc(versions.Items, func(a, b v1.MCPServerCatalogEntryVersion) int {
+		return b.Spec.Version - a.Spec.Version
+	})
+
+	var (
+		userID = req.User.GetUID()
+		latest = versions.VersionFor(userID)
+		result []types.RegistryServerResponse
+	)
+	for _, version := range versions.Items {
+		if !version.AvailableTo(userID) {
+			continue
+		}
+
+		converted, err := ConvertMCPServerCatalogEntryVersionToRegistry(req.Context(), entry, version, version.Spec.Version == latest.Spec.Version, h.serverURL, reverseDNS, h.mimeFetcher)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, converted)
+	}
+
+	return result, nil
 }
 
 // findServerByName searches for a server by name and checks user access
@@ -10,6 +10,7 @@ import (
 	"github.com/obot-platform/obot/pkg/api/handlers/registry"
 	"github.com/obot-platform/obot/pkg/api/handlers/setup"
 	"github.com/obot-platform/obot/pkg/api/handlers/wellknown"
+	"github.com/obot-platform/obot/pkg/metrics"
 	"github.com/obot-platform/obot/pkg/services"
 	"github.com/obot-platform/obot/ui"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -45,8 +46,11 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	models := handlers.NewModelHandler()
 	mcpCatalogs := handlers.NewMCPCatalogHandler(services.DefaultMCPCatalogPath, services.ServerURL, services.MCPLoader, oauthChecker, services.GatewayClient, services.AccessControlRuleHelper, services.PersistentTokenServer.EncodedJWKS)
 	accessControlRules := handlers.NewAccessControlRuleHandler()
+	mcpAccessRequests := handlers.NewMCPAccessRequestHandler()
 	powerUserWorkspaces := handlers.NewPowerUserWorkspaceHandler(services.ServerURL, services.AccessControlRuleHelper)
 	mcpWebhookValidations := handlers.NewMCPWebhookValidationHandler()
+	mcpToolApprovalPolicies := handlers.NewMCPToolApprovalPolicyHandler()
+	mcpToolCallApprovals := handlers.NewMCPToolCallApprovalHandler()
 	availableModels := handlers.NewAvailableModelsHandler(services.ProviderDispatcher)
 	modelProviders := handlers.NewModelProviderHandler(services.ProviderDispatcher, services.Invoker)
 	authProviders := handlers.NewAuthProviderHandler(services.ProviderDispatcher, services.PostgresDSN)
@@ -63,9 +67,12 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mcp := handlers.NewMCPHandler(services.MCPLoader, services.AccessControlRuleHelper, oauthChecker, services.PersistentTokenServer.EncodedJWKS, services.ServerURL)
 	projectMCP := handlers.NewProjectMCPHandler(services.MCPLoader, services.AccessControlRuleHelper, oauthChecker, services.PersistentTokenServer.EncodedJWKS, services.ServerURL, services.InternalServerURL)
 	projectInvitations := handlers.NewProjectInvitationHandler()
-	mcpGateway := mcpgateway.NewHandler(services.StorageClient, services.MCPLoader, services.WebhookHelper, services.PersistentTokenServer.EncodedJWKS)
-	mcpAuditLogs := mcpgateway.NewAuditLogHandler()
+	mcpGateway := mcpgateway.NewHandler(services.StorageClient, services.MCPLoader, services.WebhookHelper, services.ToolApprovals, services.InjectionScanner, services.ToolDrift, services.PersistentTokenServer.EncodedJWKS)
+	mcpAuditLogs := mcpgateway.NewAuditLogHandler(services.ToolApprovals, services.WebhookHelper, services.InjectionScanner)
 	auditLogExports := handlers.NewAuditLogExportHandler(services.GPTClient)
+	mcpAuditLogRetention := handlers.NewMCPAuditLogRetentionHandler(services.GPTClient)
+	mcpAuditLogSavedSearches := handlers.NewMCPAuditLogSavedSearchHandler()
+	accessReview := handlers.NewAccessReviewHandler(services.AccessControlRuleHelper)
 	serverInstances := handlers.NewServerInstancesHandler(services.AccessControlRuleHelper, services.ServerURL)
 	systemMCPServers := handlers.NewSystemMCPServerHandler(services.MCPLoader)
 	userDefaultRoleSettings := handlers.NewUserDefaultRoleSettingHandler()
@@ -400,6 +407,7 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("POST /api/mcp-servers", mcp.CreateServer)
 	mux.HandleFunc("PUT /api/mcp-servers/{mcp_server_id}", mcp.UpdateServer)
 	mux.HandleFunc("PUT /api/mcp-servers/{mcp_server_id}/alias", mcp.UpdateServerAlias)
+	mux.HandleFunc("PUT /api/mcp-servers/{mcp_server_id}/idle-timeout", mcp.UpdateServerIdleTimeout)
 	mux.HandleFunc("DELETE /api/mcp-servers/{mcp_server_id}", mcp.DeleteServer)
 	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/launch", mcp.LaunchServer)
 	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/check-oauth", mcp.CheckOAuth)
@@ -407,7 +415,10 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("DELETE /api/mcp-servers/{mcp_server_id}/oauth", mcp.ClearOAuthCredentials)
 	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/details", mcp.GetServerDetails)
 	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/logs", mcp.StreamServerLogs)
+	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/history", mcp.GetServerHistory)
 	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/restart", mcp.RestartServerDeployment)
+	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/persistent-volume", mcp.DownloadPersistentVolume)
+	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/persistent-volume/reset", mcp.ResetPersistentVolume)
 	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/configure", mcp.ConfigureServer)
 	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/deconfigure", mcp.DeconfigureServer)
 	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/reveal", mcp.Reveal)
@@ -418,6 +429,7 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("GET /api/mcp-servers/{mcp_server_id}/prompts/{prompt_name}", mcp.GetPrompt)
 	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/update-url", mcp.UpdateURL)
 	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/trigger-update", mcp.TriggerUpdate)
+	mux.HandleFunc("POST /api/mcp-servers/{mcp_server_id}/pin-version", mcp.PinVersion)
 
 	// MCPServerInstances
 	mux.HandleFunc("GET /api/mcp-server-instances", serverInstances.ListServerInstances)
@@ -432,6 +444,8 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/categories", mcpCatalogs.ListCategoriesForCatalog)
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/refresh", mcpCatalogs.Refresh)
 	mux.HandleFunc("PUT /api/mcp-catalogs/{catalog_id}", mcpCatalogs.Update)
+	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/source-credentials", mcpCatalogs.ConfigureSourceCredentials)
+	mux.HandleFunc("DELETE /api/mcp-catalogs/{catalog_id}/source-credentials", mcpCatalogs.DeleteSourceCredentials)
 
 	// MCPServerCatalogEntries (admin only, for single-user and remote MCP servers)
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/entries", mcpCatalogs.ListEntries)
@@ -448,12 +462,20 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/entries/{entry_id}/{component_id}/generate-tool-previews", mcpCatalogs.GenerateComponentToolPreviews)
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/entries/{entry_id}/{component_id}/generate-tool-previews/oauth-url", mcpCatalogs.GenerateComponentToolPreviewsOAuthURL)
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/entries/{entry_id}/refresh-components", mcpCatalogs.RefreshCompositeComponents)
+	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/entries/{entry_id}/versions", mcpCatalogs.ListEntryVersions)
+	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/entries/{entry_id}/versions/{version}", mcpCatalogs.GetEntryVersion)
+	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/entries/{entry_id}/versions/{version}/diff", mcpCatalogs.DiffEntryVersions)
+	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/entries/{entry_id}/versions/{version}/rollout", mcpCatalogs.RolloutEntryVersion)
+	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/entries/{entry_id}/versions/{version}/release", mcpCatalogs.ReleaseEntryVersion)
+	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/entries/{entry_id}/versions/{version}/rollback", mcpCatalogs.RollbackEntryVersion)
 
 	// MCPServers within the catalog (admin only, for multi-user MCP servers)
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/servers", mcp.ListServer)
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}", mcp.GetServer)
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/servers", mcp.CreateServer)
 	mux.HandleFunc("PUT /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}", mcp.UpdateServer)
+	mux.HandleFunc("PUT /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/idle-timeout", mcp.UpdateServerIdleTimeout)
+	mux.HandleFunc("PUT /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/scaling", mcp.UpdateServerScaling)
 	mux.HandleFunc("DELETE /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}", mcp.DeleteServer)
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/launch", mcp.LaunchServer)
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/check-oauth", mcp.CheckOAuth)
@@ -485,6 +507,15 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("GET /api/workspaces/all-access-control-rules", powerUserWorkspaces.ListAllAccessControlRules)
 	mux.HandleFunc("GET /api/workspaces/all-servers/all-instances", powerUserWorkspaces.ListAllServerInstances)
 
+	// MCP Access Requests (authz for deciding on requests is handled in the handler)
+	mux.HandleFunc("GET /api/mcp-access-requests", mcpAccessRequests.List)
+	mux.HandleFunc("GET /api/mcp-access-requests/{mcp_access_request_id}", mcpAccessRequests.Get)
+	mux.HandleFunc("POST /api/mcp-access-requests", mcpAccessRequests.Create)
+	mux.HandleFunc("DELETE /api/mcp-access-requests/{mcp_access_request_id}", mcpAccessRequests.Delete)
+	mux.HandleFunc("POST /api/mcp-access-requests/{mcp_access_request_id}/approve", mcpAccessRequests.Approve)
+	mux.HandleFunc("POST /api/mcp-access-requests/{mcp_access_request_id}/deny", mcpAccessRequests.Deny)
+	mux.HandleFunc("POST /api/mcp-access-requests/{mcp_access_request_id}/revoke", mcpAccessRequests.Revoke)
+
 	// Workspace-scoped Access Control Rules (PowerUserPlus only)
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/access-control-rules", accessControlRules.List)
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/access-control-rules/{access_control_rule_id}", accessControlRules.Get)
@@ -502,18 +533,29 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/entries/{entry_id}/servers/{mcp_server_id}", mcpCatalogs.GetServerFromEntry)
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/entries/{entry_id}/servers/{mcp_server_id}/details", mcp.GetServerDetails)
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/entries/{entry_id}/servers/{mcp_server_id}/logs", mcp.StreamServerLogs)
+	mux.HandleFunc("GET /api/workspaces/{workspace_id}/entries/{entry_id}/servers/{mcp_server_id}/history", mcp.GetServerHistory)
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/entries/{entry_id}/servers/{mcp_server_id}/restart", mcp.RestartServerDeployment)
+	mux.HandleFunc("GET /api/workspaces/{workspace_id}/entries/{entry_id}/servers/{mcp_server_id}/persistent-volume", mcp.DownloadPersistentVolume)
+	mux.HandleFunc("POST /api/workspaces/{workspace_id}/entries/{entry_id}/servers/{mcp_server_id}/persistent-volume/reset", mcp.ResetPersistentVolume)
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/entries/{entry_id}/servers/{mcp_server_id}/trigger-update", mcp.TriggerUpdate)
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/entries/{entry_id}/servers/{mcp_server_id}/k8s-settings-status", mcp.CheckK8sSettingsStatus)
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/entries/{entry_id}/servers/{mcp_server_id}/redeploy-with-k8s-settings", mcp.RedeployWithK8sSettings)
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/entries/{entry_id}/generate-tool-previews", mcpCatalogs.GenerateToolPreviews)
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/entries/{entry_id}/generate-tool-previews/oauth-url", mcpCatalogs.GenerateToolPreviewsOAuthURL)
+	mux.HandleFunc("GET /api/workspaces/{workspace_id}/entries/{entry_id}/versions", mcpCatalogs.ListEntryVersions)
+	mux.HandleFunc("GET /api/workspaces/{workspace_id}/entries/{entry_id}/versions/{version}", mcpCatalogs.GetEntryVersion)
+	mux.HandleFunc("GET /api/workspaces/{workspace_id}/entries/{entry_id}/versions/{version}/diff", mcpCatalogs.DiffEntryVersions)
+	mux.HandleFunc("POST /api/workspaces/{workspace_id}/entries/{entry_id}/versions/{version}/rollout", mcpCatalogs.RolloutEntryVersion)
+	mux.HandleFunc("POST /api/workspaces/{workspace_id}/entries/{entry_id}/versions/{version}/release", mcpCatalogs.ReleaseEntryVersion)
+	mux.HandleFunc("POST /api/workspaces/{workspace_id}/entries/{entry_id}/versions/{version}/rollback", mcpCatalogs.RollbackEntryVersion)
 
 	// Workspace-scoped MCP Servers (PowerUserPlus and higher only)
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/servers", mcp.ListServer)
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/servers/{mcp_server_id}", mcp.GetServer)
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/servers", mcp.CreateServer)
 	mux.HandleFunc("PUT /api/workspaces/{workspace_id}/servers/{mcp_server_id}", mcp.UpdateServer)
+	mux.HandleFunc("PUT /api/workspaces/{workspace_id}/servers/{mcp_server_id}/idle-timeout", mcp.UpdateServerIdleTimeout)
+	mux.HandleFunc("PUT /api/workspaces/{workspace_id}/servers/{mcp_server_id}/scaling", mcp.UpdateServerScaling)
 	mux.HandleFunc("DELETE /api/workspaces/{workspace_id}/servers/{mcp_server_id}", mcp.DeleteServer)
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/servers/{mcp_server_id}/launch", mcp.LaunchServer)
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/servers/{mcp_server_id}/check-oauth", mcp.CheckOAuth)
@@ -524,7 +566,10 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/servers/{mcp_server_id}/reveal", mcp.Reveal)
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/servers/{mcp_server_id}/details", mcp.GetServerDetails)
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/servers/{mcp_server_id}/logs", mcp.StreamServerLogs)
+	mux.HandleFunc("GET /api/workspaces/{workspace_id}/servers/{mcp_server_id}/history", mcp.GetServerHistory)
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/servers/{mcp_server_id}/restart", mcp.RestartServerDeployment)
+	mux.HandleFunc("GET /api/workspaces/{workspace_id}/servers/{mcp_server_id}/persistent-volume", mcp.DownloadPersistentVolume)
+	mux.HandleFunc("POST /api/workspaces/{workspace_id}/servers/{mcp_server_id}/persistent-volume/reset", mcp.ResetPersistentVolume)
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/servers/{mcp_server_id}/k8s-settings-status", mcp.CheckK8sSettingsStatus)
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/servers/{mcp_server_id}/redeploy-with-k8s-settings", mcp.RedeployWithK8sSettings)
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/servers/{mcp_server_id}/instances", serverInstances.ListServerInstancesForServer)
@@ -537,6 +582,20 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("PUT /api/mcp-webhook-validations/{mcp_webhook_validation_id}", mcpWebhookValidations.Update)
 	mux.HandleFunc("DELETE /api/mcp-webhook-validations/{mcp_webhook_validation_id}", mcpWebhookValidations.Delete)
 	mux.HandleFunc("DELETE /api/mcp-webhook-validations/{mcp_webhook_validation_id}/secret", mcpWebhookValidations.RemoveSecret)
+	mux.HandleFunc("GET /api/mcp-webhook-validations/{mcp_webhook_validation_id}/shadow-report", mcpWebhookValidations.ShadowReport)
+
+	// MCP Tool Approval Policies (admin only)
+	mux.HandleFunc("GET /api/mcp-tool-approval-policies", mcpToolApprovalPolicies.List)
+	mux.HandleFunc("GET /api/mcp-tool-approval-policies/{policy_id}", mcpToolApprovalPolicies.Get)
+	mux.HandleFunc("POST /api/mcp-tool-approval-policies", mcpToolApprovalPolicies.Create)
+	mux.HandleFunc("PUT /api/mcp-tool-approval-policies/{policy_id}", mcpToolApprovalPolicies.Update)
+	mux.HandleFunc("DELETE /api/mcp-tool-approval-policies/{policy_id}", mcpToolApprovalPolicies.Delete)
+
+	// MCP Tool Call Approvals
+	mux.HandleFunc("GET /api/mcp-tool-call-approvals", mcpToolCallApprovals.List)
+	mux.HandleFunc("GET /api/mcp-tool-call-approvals/{approval_id}", mcpToolCallApprovals.Get)
+	mux.HandleFunc("POST /api/mcp-tool-call-approvals/{approval_id}/approve", mcpToolCallApprovals.Approve)
+	mux.HandleFunc("POST /api/mcp-tool-call-approvals/{approval_id}/reject", mcpToolCallApprovals.Reject)
 
 	// System MCP Servers (admin only)
 	mux.HandleFunc("GET /api/system-mcp-servers", systemMCPServers.List)
@@ -569,6 +628,24 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("GET /api/mcp-stats", mcpAuditLogs.GetUsageStats)
 	mux.HandleFunc("GET /api/mcp-stats/{mcp_id}", mcpAuditLogs.GetUsageStats)
 
+	// MCP Audit Log Findings
+	mux.HandleFunc("GET /api/mcp-audit-log-findings", mcpAuditLogs.ListFindings)
+	mux.HandleFunc("GET /api/mcp-audit-log-findings/{finding_id}", mcpAuditLogs.GetFinding)
+	mux.HandleFunc("POST /api/mcp-audit-log-findings/{finding_id}/acknowledge", mcpAuditLogs.AcknowledgeFinding)
+
+	// MCP Audit Log Retention
+	mux.HandleFunc("GET /api/mcp-audit-log-retention-policy", mcpAuditLogRetention.GetPolicy)
+	mux.HandleFunc("PUT /api/mcp-audit-log-retention-policy", mcpAuditLogRetention.UpdatePolicy)
+	mux.HandleFunc("GET /api/mcp-audit-log-archives", mcpAuditLogRetention.ListArchives)
+	mux.HandleFunc("GET /api/mcp-audit-log-archives/{archive_id}/logs", mcpAuditLogRetention.ListArchivedLogs)
+
+	// MCP Audit Log Saved Searches
+	mux.HandleFunc("GET /api/mcp-audit-log-searches", mcpAuditLogSavedSearches.List)
+	mux.HandleFunc("GET /api/mcp-audit-log-searches/{saved_search_id}", mcpAuditLogSavedSearches.Get)
+	mux.HandleFunc("POST /api/mcp-audit-log-searches", mcpAuditLogSavedSearches.Create)
+	mux.HandleFunc("PUT /api/mcp-audit-log-searches/{saved_search_id}", mcpAuditLogSavedSearches.Update)
+	mux.HandleFunc("DELETE /api/mcp-audit-log-searches/{saved_search_id}", mcpAuditLogSavedSearches.Delete)
+
 	// Audit Log Exports
 	mux.HandleFunc("POST /api/audit-log-exports", auditLogExports.CreateAuditLogExport)
 	mux.HandleFunc("GET /api/audit-log-exports", auditLogExports.ListAuditLogExports)
@@ -582,6 +659,12 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("PATCH /api/scheduled-audit-log-exports/{id}", auditLogExports.UpdateScheduledAuditLogExport)
 	mux.HandleFunc("DELETE /api/scheduled-audit-log-exports/{id}", auditLogExports.DeleteScheduledAuditLogExport)
 
+	// Access Review
+	mux.HandleFunc("GET /api/access-review", accessReview.List)
+	mux.HandleFunc("GET /api/access-review/users/{user_id}", accessReview.ForUser)
+	mux.HandleFunc("GET /api/access-review/mcp-servers/{mcp_server_id}", accessReview.ForMCPServer)
+	mux.HandleFunc("GET /api/access-review/catalog-entries/{entry_id}", accessReview.ForCatalogEntry)
+
 	// Storage Credentials Management
 	mux.HandleFunc("POST /api/storage-credentials", auditLogExports.ConfigureStorageCredentials)
 	mux.HandleFunc("GET /api/storage-credentials", auditLogExports.GetStorageCredentials)
@@ -622,6 +705,29 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("GET /api/k8s-settings", k8sSettingsHandler.Get)
 	mux.HandleFunc("PUT /api/k8s-settings", k8sSettingsHandler.Update)
 
+	// MCP Image Policy
+	mcpImagePolicyHandler := handlers.NewMCPImagePolicyHandler()
+	mux.HandleFunc("GET /api/mcp-image-policy", mcpImagePolicyHandler.Get)
+	mux.HandleFunc("PUT /api/mcp-image-policy", mcpImagePolicyHandler.Update)
+
+	// MCP Injection Scan Policy
+	mcpInjectionScanPolicyHandler := handlers.NewMCPInjectionScanPolicyHandler()
+	mux.HandleFunc("GET /api/mcp-injection-scan-policy", mcpInjectionScanPolicyHandler.Get)
+	mux.HandleFunc("PUT /api/mcp-injection-scan-policy", mcpInjectionScanPolicyHandler.Update)
+
+	// MCP Tool Drift
+	mcpToolDrift := handlers.NewMCPToolDriftHandler()
+	mux.HandleFunc("GET /api/mcp-tool-drift-policy", mcpToolDrift.GetPolicy)
+	mux.HandleFunc("PUT /api/mcp-tool-drift-policy", mcpToolDrift.UpdatePolicy)
+	mux.HandleFunc("GET /api/mcp-tool-pins", mcpToolDrift.ListPins)
+	mux.HandleFunc("GET /api/mcp-tool-pins/{pin_id}", mcpToolDrift.GetPin)
+	mux.HandleFunc("POST /api/mcp-tool-pins/{pin_id}/approve", mcpToolDrift.ApprovePin)
+
+	// MCP server health (admin only)
+	mcpServerHealthHandler := handlers.NewMCPServerHealthHandler()
+	mux.HandleFunc("GET /api/mcp-server-health", mcpServerHealthHandler.List)
+	mux.HandleFunc("GET /api/mcp-server-health/{mcp_server_id}", mcpServerHealthHandler.Get)
+
 	// EULA
 	eulaHandler := handlers.NewEulaHandler()
 	mux.HandleFunc("GET /api/eula", eulaHandler.Get)
@@ -647,6 +753,9 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HTTPHandle("GET /debug/metrics", promhttp.HandlerFor(legacyregistry.DefaultGatherer, promhttp.HandlerOpts{
 		ErrorHandling: promhttp.HTTPErrorOnError,
 	}))
+	mux.HTTPHandle("GET /metrics", promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{
+		ErrorHandling: promhttp.ContinueOnError,
+	}))
 
 	// Model providers
 	mux.HandleFunc("GET /api/model-providers", modelProviders.List)
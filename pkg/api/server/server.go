@@ -22,6 +22,7 @@ import (
 	"github.com/obot-platform/obot/pkg/proxy"
 	"github.com/obot-platform/obot/pkg/storage"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
@@ -73,7 +74,9 @@ func (s *Server) HTTPHandle(pattern string, f http.Handler) {
 }
 
 func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	ctx, span := tracer.Start(r.Context(), "server")
+	// Continue the trace of callers that send a W3C trace context, like MCP servers calling back into Obot.
+	ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+	ctx, span := tracer.Start(ctx, "server")
 	defer span.End()
 	s.mux.ServeHTTP(w, r.WithContext(ctx))
 }
@@ -0,0 +1,118 @@
+// Package auditloganomaly finds unusual activity in MCP audit logs, like bulk data reads or calls from new IP ranges,
+// by comparing each log to the baselines that it keeps for every user and MCP server.
+package auditloganomaly
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/obot-platform/obot/logger"
+	gatewayclient "github.com/obot-platform/obot/pkg/gateway/client"
+	gtypes "github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm"
+)
+
+var log = logger.Package()
+
+const (
+	// cursorProperty is the property that holds the ID of the last audit log that was analyzed.
+	cursorProperty = "mcp-audit-log-anomaly-cursor"
+	// batchSize is the number of audit logs that are analyzed, and whose baselines are saved, at a time.
+	batchSize = 5000
+	// analysisDelay is how long to wait before analyzing an audit log, so that its response has usually been recorded.
+	analysisDelay = 2 * time.Minute
+)
+
+// Analyzer periodically analyzes the audit logs that were written since its last pass.
+type Analyzer struct {
+	gatewayClient *gatewayclient.Client
+	interval      time.Duration
+}
+
+func NewAnalyzer(gatewayClient *gatewayclient.Client, interval time.Duration) *Analyzer {
+	return &Analyzer{
+		gatewayClient: gatewayClient,
+		interval:      interval,
+	}
+}
+
+// Run analyzes the audit logs until the context is canceled. It should only run on the leader.
+func (a *Analyzer) Run(ctx context.Context) {
+	if a.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := a.analyze(ctx); err != nil {
+				log.Warnf("failed to analyze MCP audit logs for anomalies: %v", err)
+			}
+		}
+	}
+}
+
+func (a *Analyzer) analyze(ctx context.Context) error {
+	var cursor uint
+	if p, err := a.gatewayClient.GetProperty(ctx, cursorProperty); err == nil {
+		id, _ := strconv.ParseUint(p.Value, 10, 64)
+		cursor = uint(id)
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	for {
+		now := time.Now()
+		logs, err := a.gatewayClient.GetMCPAuditLogsForAnalysis(ctx, cursor, now.Add(-analysisDelay), batchSize)
+		if err != nil || len(logs) == 0 {
+			return err
+		}
+
+		keys := make(map[string]struct{}, 2*len(logs))
+		for _, l := range logs {
+			if l.UserID != "" {
+				keys[userKey(l.UserID)] = struct{}{}
+			}
+			if l.MCPID != "" {
+				keys[serverKey(l.MCPID)] = struct{}{}
+			}
+		}
+
+		baselines, err := a.gatewayClient.GetMCPAuditLogBaselines(ctx, slices.Collect(maps.Keys(keys)))
+		if err != nil {
+			return err
+		}
+
+		d := newDetector(now, baselines)
+		for _, l := range logs {
+			d.observe(l)
+		}
+
+		toSave := make([]gtypes.MCPAuditLogBaseline, 0, len(d.baselines))
+		for _, b := range d.baselines {
+			toSave = append(toSave, *b)
+		}
+		findings := slices.Collect(maps.Values(d.findings))
+
+		cursor = logs[len(logs)-1].ID
+		if err := a.gatewayClient.SaveMCPAuditLogAnalysis(ctx, toSave, findings, cursorProperty, strconv.FormatUint(uint64(cursor), 10)); err != nil {
+			return err
+		}
+		if len(findings) > 0 {
+			log.Infof("Raised %d MCP audit log findings", len(findings))
+		}
+
+		if len(logs) < batchSize {
+			return nil
+		}
+	}
+}
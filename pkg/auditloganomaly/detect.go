@@ -0,0 +1,358 @@
+package auditloganomaly
+
+import (
+	"fmt"
+	"math"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	gtypes "github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+const (
+	// learningPeriod is how long a user is watched before anything they do for the first time is unusual.
+	learningPeriod = 7 * 24 * time.Hour
+	// minBaselineHours is the number of active hours that are needed before volumes and error rates are compared to the baseline.
+	minBaselineHours = 24
+	// spikeStdDevs is how many standard deviations above the mean an hour's volume has to be to be a spike.
+	spikeStdDevs = 4
+	// minSpikeCalls is the smallest number of calls in an hour that can be a spike, so that quiet users aren't flagged for small bursts.
+	minSpikeCalls = 50
+	// minErrorRateCalls is the smallest number of calls in an hour that the error rate is checked for.
+	minErrorRateCalls = 20
+	// minErrorRate is the smallest error rate in an hour that can be a spike.
+	minErrorRate = 0.5
+	// minUnusualHourCalls is the number of calls a user has to have made before an hour of the day without activity is unusual.
+	minUnusualHourCalls = 200
+	// findingMaxAge is how old an audit log can be and still raise findings. Older logs only update the baselines,
+	// so that catching up on a backlog, or on the logs from before anomaly detection was turned on, doesn't raise stale findings.
+	findingMaxAge = 24 * time.Hour
+
+	maxKnownTools    = 1000
+	maxKnownIPRanges = 200
+)
+
+var (
+	// destructiveWords are the words in a tool name that mean that the tool deletes or overwrites data.
+	destructiveWords = []string{"delete", "del", "remove", "rm", "drop", "destroy", "purge", "truncate", "wipe", "erase", "terminate", "kill", "revoke", "overwrite", "reset"}
+	// readWords are the words in a tool name that mean that the tool reads data.
+	readWords = []string{"get", "list", "read", "search", "query", "fetch", "find", "export", "download", "dump", "select", "retrieve", "lookup"}
+	// perCallFindingTypes are the findings that are raised by a single call, rather than by the calls in an hour.
+	perCallFindingTypes = []types.MCPAuditLogFindingType{
+		types.MCPAuditLogFindingTypeNewDestructiveTool,
+		types.MCPAuditLogFindingTypeNewIPRange,
+		types.MCPAuditLogFindingTypeUnusualHour,
+	}
+)
+
+// detector updates baselines with audit logs, in order, and collects the findings that the logs raise.
+type detector struct {
+	now       time.Time
+	baselines map[string]*gtypes.MCPAuditLogBaseline
+	findings  map[string]gtypes.MCPAuditLogFinding
+}
+
+func newDetector(now time.Time, baselines map[string]*gtypes.MCPAuditLogBaseline) *detector {
+	return &detector{
+		now:       now,
+		baselines: baselines,
+		findings:  map[string]gtypes.MCPAuditLogFinding{},
+	}
+}
+
+func userKey(userID string) string {
+	return "user:" + userID
+}
+
+func serverKey(mcpID string) string {
+	return "server:" + mcpID
+}
+
+// observe compares an audit log to the baselines of its user and server, and then adds it to them.
+func (d *detector) observe(log gtypes.MCPAuditLog) {
+	var (
+		raise  = d.now.Sub(log.CreatedAt) < findingMaxAge
+		read   = isRead(log)
+		failed = log.ResponseStatus >= 400 || log.Error != ""
+		tool   string
+	)
+	if log.CallType == "tools/call" && log.CallIdentifier != "" {
+		tool = log.MCPID + "/" + log.CallIdentifier
+	}
+	ipRange := ipRangeOf(log.ClientIP)
+
+	if log.UserID != "" {
+		b := d.baseline(userKey(log.UserID), log.CreatedAt)
+		if raise && log.CreatedAt.Sub(b.FirstSeen) >= learningPeriod {
+			d.checkNewDestructiveTool(b, log, tool)
+			d.checkNewIPRange(b, log, ipRange)
+			d.checkUnusualHour(b, log)
+		}
+		record(b, log.CreatedAt, read, failed, tool, ipRange)
+		if raise {
+			d.checkCallVolume(b, log, "User "+log.UserID)
+			d.checkBulkRead(b, log)
+			d.checkErrorRate(b, log, "User "+log.UserID)
+		}
+	}
+
+	if log.MCPID != "" {
+		b := d.baseline(serverKey(log.MCPID), log.CreatedAt)
+		record(b, log.CreatedAt, read, failed, tool, "")
+		if raise {
+			d.checkCallVolume(b, log, "MCP server "+serverName(log))
+			d.checkErrorRate(b, log, "MCP server "+serverName(log))
+		}
+	}
+}
+
+func (d *detector) baseline(key string, t time.Time) *gtypes.MCPAuditLogBaseline {
+	b := d.baselines[key]
+	if b == nil {
+		b = &gtypes.MCPAuditLogBaseline{
+			Key:       key,
+			FirstSeen: t,
+		}
+		d.baselines[key] = b
+	}
+	if len(b.HourOfDayCalls) != 24 {
+		b.HourOfDayCalls = make([]int64, 24)
+	}
+	return b
+}
+
+// record adds a call to a baseline. A call in a later hour than the current one moves the current hour into the means.
+func record(b *gtypes.MCPAuditLogBaseline, t time.Time, read, failed bool, tool, ipRange string) {
+	hour := t.UTC().Truncate(time.Hour)
+	if hour.After(b.CurrentHour) {
+		if b.CurrentCalls > 0 {
+			b.Hours++
+			b.CallMean, b.CallM2 = addSample(b.Hours, b.CallMean, b.CallM2, float64(b.CurrentCalls))
+			b.ReadMean, b.ReadM2 = addSample(b.Hours, b.ReadMean, b.ReadM2, float64(b.CurrentReads))
+		}
+		b.CurrentHour = hour
+		b.CurrentCalls, b.CurrentReads, b.CurrentErrors = 0, 0, 0
+	}
+
+	b.CurrentCalls++
+	b.TotalCalls++
+	if read {
+		b.CurrentReads++
+	}
+	if failed {
+		b.CurrentErrors++
+		b.TotalErrors++
+	}
+	b.HourOfDayCalls[hour.Hour()]++
+
+	if tool != "" && len(b.Tools) < maxKnownTools && !slices.Contains(b.Tools, tool) {
+		b.Tools = append(b.Tools, tool)
+	}
+	if ipRange != "" && len(b.IPRanges) < maxKnownIPRanges && !slices.Contains(b.IPRanges, ipRange) {
+		b.IPRanges = append(b.IPRanges, ipRange)
+	}
+	if t.After(b.LastSeen) {
+		b.LastSeen = t
+	}
+}
+
+// addSample adds x, the nth sample, to a running mean and sum of squared differences from the mean (Welford's algorithm).
+func addSample(n int64, mean, m2, x float64) (float64, float64) {
+	delta := x - mean
+	mean += delta / float64(n)
+	return mean, m2 + delta*(x-mean)
+}
+
+func stdDev(n int64, m2 float64) float64 {
+	if n < 2 {
+		return 0
+	}
+	return math.Sqrt(m2 / float64(n-1))
+}
+
+// spikeThreshold is the number of calls in an hour above which the hour is a spike.
+func spikeThreshold(n int64, mean, m2 float64) float64 {
+	return max(minSpikeCalls, mean+spikeStdDevs*stdDev(n, m2))
+}
+
+func (d *detector) checkCallVolume(b *gtypes.MCPAuditLogBaseline, log gtypes.MCPAuditLog, subject string) {
+	if b.Hours < minBaselineHours {
+		return
+	}
+	if float64(b.CurrentCalls) > spikeThreshold(b.Hours, b.CallMean, b.CallM2) {
+		f := d.finding(b, log, types.MCPAuditLogFindingTypeCallVolumeSpike, types.MCPAuditLogFindingSeverityMedium,
+			fmt.Sprintf("%s made %d calls in the hour starting %s, compared to %.1f in an average active hour.",
+				subject, b.CurrentCalls, b.CurrentHour.Format(time.RFC3339), b.CallMean),
+			b.CurrentHour.Format(time.RFC3339))
+		f.Count, f.Baseline = int(b.CurrentCalls), b.CallMean
+		d.add(f)
+	}
+}
+
+func (d *detector) checkBulkRead(b *gtypes.MCPAuditLogBaseline, log gtypes.MCPAuditLog) {
+	if b.Hours < minBaselineHours || !isRead(log) {
+		return
+	}
+	if float64(b.CurrentReads) > spikeThreshold(b.Hours, b.ReadMean, b.ReadM2) {
+		f := d.finding(b, log, types.MCPAuditLogFindingTypeBulkDataRead, types.MCPAuditLogFindingSeverityHigh,
+			fmt.Sprintf("User %s read data %d times in the hour starting %s, compared to %.1f in an average active hour.",
+				log.UserID, b.CurrentReads, b.CurrentHour.Format(time.RFC3339), b.ReadMean),
+			b.CurrentHour.Format(time.RFC3339))
+		f.Count, f.Baseline = int(b.CurrentReads), b.ReadMean
+		d.add(f)
+	}
+}
+
+func (d *detector) checkErrorRate(b *gtypes.MCPAuditLogBaseline, log gtypes.MCPAuditLog, subject string) {
+	if b.Hours < minBaselineHours || b.CurrentCalls < minErrorRateCalls {
+		return
+	}
+
+	var baseRate float64
+	if calls := b.TotalCalls - b.CurrentCalls; calls > 0 {
+		baseRate = float64(b.TotalErrors-b.CurrentErrors) / float64(calls)
+	}
+	rate := float64(b.CurrentErrors) / float64(b.CurrentCalls)
+	if rate >= minErrorRate && rate >= 3*baseRate {
+		f := d.finding(b, log, types.MCPAuditLogFindingTypeErrorRateSpike, types.MCPAuditLogFindingSeverityLow,
+			fmt.Sprintf("%s had %d failed calls out of %d in the hour starting %s, a %.0f%% error rate compared to %.1f%% usually.",
+				subject, b.CurrentErrors, b.CurrentCalls, b.CurrentHour.Format(time.RFC3339), rate*100, baseRate*100),
+			b.CurrentHour.Format(time.RFC3339))
+		f.Count, f.Baseline = int(b.CurrentErrors), baseRate*float64(b.CurrentCalls)
+		d.add(f)
+	}
+}
+
+func (d *detector) checkNewDestructiveTool(b *gtypes.MCPAuditLogBaseline, log gtypes.MCPAuditLog, tool string) {
+	if tool == "" || len(b.Tools) >= maxKnownTools || slices.Contains(b.Tools, tool) || !hasWord(log.CallIdentifier, destructiveWords) {
+		return
+	}
+	d.add(d.finding(b, log, types.MCPAuditLogFindingTypeNewDestructiveTool, types.MCPAuditLogFindingSeverityHigh,
+		fmt.Sprintf("User %s called %s on MCP server %s for the first time. The tool looks like it deletes or overwrites data.",
+			log.UserID, log.CallIdentifier, serverName(log)),
+		tool))
+}
+
+func (d *detector) checkNewIPRange(b *gtypes.MCPAuditLogBaseline, log gtypes.MCPAuditLog, ipRange string) {
+	// Users that have only been seen without an IP have nothing to compare to.
+	if ipRange == "" || len(b.IPRanges) == 0 || len(b.IPRanges) >= maxKnownIPRanges || slices.Contains(b.IPRanges, ipRange) {
+		return
+	}
+	d.add(d.finding(b, log, types.MCPAuditLogFindingTypeNewIPRange, types.MCPAuditLogFindingSeverityMedium,
+		fmt.Sprintf("User %s made a call from %s, which is in %s. They have only used %d other IP ranges before.",
+			log.UserID, log.ClientIP, ipRange, len(b.IPRanges)),
+		ipRange))
+}
+
+func (d *detector) checkUnusualHour(b *gtypes.MCPAuditLogBaseline, log gtypes.MCPAuditLog) {
+	hour := log.CreatedAt.UTC().Truncate(time.Hour)
+	if b.TotalCalls < minUnusualHourCalls || b.HourOfDayCalls[hour.Hour()] > 0 {
+		return
+	}
+	d.add(d.finding(b, log, types.MCPAuditLogFindingTypeUnusualHour, types.MCPAuditLogFindingSeverityLow,
+		fmt.Sprintf("User %s made a call at %02d:00 UTC. None of their %d earlier calls were made in that hour of the day.",
+			log.UserID, hour.Hour(), b.TotalCalls),
+		hour.Format(time.RFC3339)))
+}
+
+// finding returns a finding for a log. The dedupe key is made of the type, the baseline, and the given key, so the same
+// finding is only raised once.
+func (d *detector) finding(b *gtypes.MCPAuditLogBaseline, log gtypes.MCPAuditLog, findingType types.MCPAuditLogFindingType, severity types.MCPAuditLogFindingSeverity, description, key string) gtypes.MCPAuditLogFinding {
+	f := gtypes.MCPAuditLogFinding{
+		CreatedAt:   d.now,
+		DedupeKey:   strings.Join([]string{string(findingType), b.Key, key}, "/"),
+		Type:        string(findingType),
+		Severity:    string(severity),
+		Description: description,
+		AuditLogID:  log.ID,
+	}
+
+	switch {
+	case strings.HasPrefix(b.Key, "server:"):
+		f.MCPID = log.MCPID
+		f.MCPServerDisplayName = log.MCPServerDisplayName
+	case slices.Contains(perCallFindingTypes, findingType):
+		f.UserID = log.UserID
+		f.ClientIP = log.ClientIP
+		f.MCPID = log.MCPID
+		f.MCPServerDisplayName = log.MCPServerDisplayName
+		f.CallIdentifier = log.CallIdentifier
+	default:
+		// The other findings for a user are about their calls to all servers in an hour.
+		f.UserID = log.UserID
+	}
+	return f
+}
+
+// add adds a finding, unless a finding with the same dedupe key was already raised in this pass.
+func (d *detector) add(f gtypes.MCPAuditLogFinding) {
+	if _, ok := d.findings[f.DedupeKey]; !ok {
+		d.findings[f.DedupeKey] = f
+	}
+}
+
+func serverName(log gtypes.MCPAuditLog) string {
+	if log.MCPServerDisplayName != "" {
+		return log.MCPServerDisplayName
+	}
+	return log.MCPID
+}
+
+// isRead returns true for resource reads and for calls to tools with names that say that they read data.
+func isRead(log gtypes.MCPAuditLog) bool {
+	return log.CallType == "resources/read" || (log.CallType == "tools/call" && hasWord(log.CallIdentifier, readWords))
+}
+
+// hasWord returns true if any of the words in a name, like delete_file, deleteFile, or delete-file, are in words.
+func hasWord(name string, words []string) bool {
+	var (
+		word strings.Builder
+		prev rune
+	)
+	check := func() bool {
+		found := slices.Contains(words, word.String())
+		word.Reset()
+		return found
+	}
+
+	for _, r := range name {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			if check() {
+				return true
+			}
+		case unicode.IsUpper(r) && unicode.IsLower(prev):
+			if check() {
+				return true
+			}
+			word.WriteRune(unicode.ToLower(r))
+		default:
+			word.WriteRune(unicode.ToLower(r))
+		}
+		prev = r
+	}
+	return check()
+}
+
+// ipRangeOf returns the /24 of an IPv4 address or the /48 of an IPv6 address, or an empty string if ip isn't an IP address.
+func ipRangeOf(ip string) string {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return ""
+	}
+	addr = addr.Unmap()
+
+	bits := 48
+	if addr.Is4() {
+		bits = 24
+	}
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return ""
+	}
+	return prefix.String()
+}
@@ -0,0 +1,142 @@
+package auditloganomaly
+
+import (
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	gtypes "github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasWord(t *testing.T) {
+	for _, name := range []string{"delete_file", "deleteFile", "delete-file", "repo.delete", "DropTable", "rm"} {
+		assert.True(t, hasWord(name, destructiveWords), name)
+	}
+	for _, name := range []string{"deleted_items_count", "model", "dropdown", "create_file", ""} {
+		assert.False(t, hasWord(name, destructiveWords), name)
+	}
+}
+
+func TestIPRangeOf(t *testing.T) {
+	assert.Equal(t, "203.0.113.0/24", ipRangeOf("203.0.113.45"))
+	assert.Equal(t, "203.0.113.0/24", ipRangeOf("::ffff:203.0.113.45"))
+	assert.Equal(t, "2001:db8:1::/48", ipRangeOf("2001:db8:1:2::1"))
+	assert.Equal(t, "", ipRangeOf("not an ip"))
+}
+
+// learn has user 1 call a read tool on server s1 five times an hour, from 10:00 to 17:00 UTC on each of the days before now.
+func learn(d *detector, now time.Time, days int) uint {
+	var id uint
+	start := now.UTC().Truncate(24*time.Hour).AddDate(0, 0, -days)
+	for day := range days {
+		for hour := 10; hour < 18; hour++ {
+			for i := range 5 {
+				id++
+				d.observe(gtypes.MCPAuditLog{
+					ID:             id,
+					CreatedAt:      start.AddDate(0, 0, day).Add(time.Duration(hour)*time.Hour + time.Duration(i)*time.Minute),
+					UserID:         "1",
+					MCPID:          "s1",
+					ClientIP:       "203.0.113.45",
+					CallType:       "tools/call",
+					CallIdentifier: "get_issue",
+					ResponseStatus: 200,
+				})
+			}
+		}
+	}
+	return id
+}
+
+func findingTypes(d *detector) []types.MCPAuditLogFindingType {
+	var result []types.MCPAuditLogFindingType
+	for _, f := range d.findings {
+		result = append(result, types.MCPAuditLogFindingType(f.Type))
+	}
+	return result
+}
+
+func TestDetectorQuietWhileLearning(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 30, 0, 0, time.UTC)
+	d := newDetector(now, map[string]*gtypes.MCPAuditLogBaseline{})
+	learn(d, now, 2)
+
+	d.observe(gtypes.MCPAuditLog{ID: 1000, CreatedAt: now, UserID: "1", MCPID: "s1", ClientIP: "198.51.100.7", CallType: "tools/call", CallIdentifier: "delete_repo"})
+	assert.Empty(t, d.findings)
+}
+
+func TestDetector(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 30, 0, 0, time.UTC)
+	d := newDetector(now, map[string]*gtypes.MCPAuditLogBaseline{})
+	id := learn(d, now, 10)
+	assert.Empty(t, d.findings, "the usual activity doesn't raise findings")
+
+	user := d.baselines[userKey("1")]
+	assert.EqualValues(t, 79, user.Hours)
+	assert.InDelta(t, 5, user.CallMean, 0.001)
+	assert.Equal(t, []string{"203.0.113.0/24"}, []string(user.IPRanges))
+	assert.Equal(t, []string{"s1/get_issue"}, []string(user.Tools))
+
+	call := func(at time.Time, ip, tool string, status int) {
+		id++
+		d.observe(gtypes.MCPAuditLog{ID: id, CreatedAt: at, UserID: "1", MCPID: "s1", ClientIP: ip, CallType: "tools/call", CallIdentifier: tool, ResponseStatus: status})
+	}
+
+	// A new IP range, a destructive tool, and an hour of the day that the user is never active in.
+	call(now.Add(-10*time.Hour), "198.51.100.7", "delete_repo", 200)
+	assert.ElementsMatch(t, []types.MCPAuditLogFindingType{
+		types.MCPAuditLogFindingTypeNewIPRange,
+		types.MCPAuditLogFindingTypeNewDestructiveTool,
+		types.MCPAuditLogFindingTypeUnusualHour,
+	}, findingTypes(d))
+
+	// The same tool from the same range isn't new anymore.
+	clear(d.findings)
+	call(now.Add(-time.Hour), "198.51.100.8", "delete_repo", 200)
+	assert.Empty(t, d.findings)
+
+	// Bulk reads in the current hour raise a single finding for the user, and a volume spike for the user and server.
+	for range 60 {
+		call(now, "203.0.113.45", "get_issue", 200)
+	}
+	assert.ElementsMatch(t, []types.MCPAuditLogFindingType{
+		types.MCPAuditLogFindingTypeBulkDataRead,
+		types.MCPAuditLogFindingTypeCallVolumeSpike,
+		types.MCPAuditLogFindingTypeCallVolumeSpike,
+	}, findingTypes(d))
+	for _, f := range d.findings {
+		if f.Type == string(types.MCPAuditLogFindingTypeBulkDataRead) {
+			assert.Equal(t, "1", f.UserID)
+			assert.Empty(t, f.MCPID, "bulk reads are counted across servers")
+			assert.Equal(t, 51, f.Count)
+			assert.InDelta(t, 4.9, f.Baseline, 0.05)
+		}
+	}
+
+	// Old logs only update the baselines.
+	clear(d.findings)
+	call(now.Add(-48*time.Hour), "192.0.2.1", "drop_table", 500)
+	assert.Empty(t, d.findings)
+}
+
+func TestDetectorErrorRate(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 30, 0, 0, time.UTC)
+	d := newDetector(now, map[string]*gtypes.MCPAuditLogBaseline{})
+	id := learn(d, now, 10)
+
+	for i := range 20 {
+		id++
+		status := 200
+		if i%2 == 0 {
+			status = 502
+		}
+		d.observe(gtypes.MCPAuditLog{ID: id, CreatedAt: now, UserID: "2", MCPID: "s1", CallType: "tools/call", CallIdentifier: "get_issue", ResponseStatus: status})
+	}
+
+	f, ok := d.findings["errorRateSpike/server:s1/2026-03-20T12:00:00Z"]
+	assert.True(t, ok)
+	assert.Equal(t, "s1", f.MCPID)
+	assert.Equal(t, 10, f.Count)
+	assert.Len(t, d.findings, 1, "user 2 has no baseline yet")
+}
@@ -39,6 +39,20 @@ func (a *AzureProvider) Upload(ctx context.Context, config types.StorageConfig,
 	return nil
 }
 
+func (a *AzureProvider) Download(ctx context.Context, config types.StorageConfig, bucket, key string) (io.ReadCloser, error) {
+	client, err := a.createClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.DownloadStream(ctx, bucket, key, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download from Azure Blob Storage: %w", err)
+	}
+
+	return resp.Body, nil
+}
+
 func (a *AzureProvider) Test(ctx context.Context, config types.StorageConfig) error {
 	client, err := a.createClient(config)
 	if err != nil {
@@ -45,6 +45,23 @@ func (c *CustomS3Provider) Upload(ctx context.Context, config apitypes.StorageCo
 }
 
 // Test is a no-op for custom S3 storage as there is no way to test it without uploading a files
+func (c *CustomS3Provider) Download(ctx context.Context, config apitypes.StorageConfig, bucket, key string) (io.ReadCloser, error) {
+	client, err := c.createClient(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to download from custom S3: %w", err)
+	}
+
+	return output.Body, nil
+}
+
 func (c *CustomS3Provider) Test(context.Context, apitypes.StorageConfig) error {
 	return nil
 }
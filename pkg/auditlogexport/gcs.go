@@ -2,6 +2,7 @@ package auditlogexport
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 
@@ -42,6 +43,31 @@ func (g *GCSProvider) Upload(ctx context.Context, config types.StorageConfig, bu
 	return writer.Close()
 }
 
+func (g *GCSProvider) Download(ctx context.Context, config types.StorageConfig, bucket, key string) (io.ReadCloser, error) {
+	client, err := g.createClient(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := client.Bucket(bucket).Object(key).NewReader(ctx)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	// The client has to stay open until the object has been read.
+	return &gcsReader{Reader: reader, client: client}, nil
+}
+
+type gcsReader struct {
+	*storage.Reader
+	client *storage.Client
+}
+
+func (r *gcsReader) Close() error {
+	return errors.Join(r.Reader.Close(), r.client.Close())
+}
+
 func (g *GCSProvider) Test(ctx context.Context, config types.StorageConfig) error {
 	client, err := g.createClient(ctx, config)
 	if err != nil {
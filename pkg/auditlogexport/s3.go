@@ -43,6 +43,23 @@ func (s *S3Provider) Upload(ctx context.Context, config apitypes.StorageConfig,
 	return err
 }
 
+func (s *S3Provider) Download(ctx context.Context, config apitypes.StorageConfig, bucket, key string) (io.ReadCloser, error) {
+	client, err := s.createClient(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Body, nil
+}
+
 func (s *S3Provider) Test(ctx context.Context, storageConfig apitypes.StorageConfig) error {
 	cfg, err := config.LoadDefaultConfig(ctx)
 	if err != nil {
@@ -16,6 +16,9 @@ type StorageProvider interface {
 
 	// Upload uploads the given data to the storage provider
 	Upload(ctx context.Context, config types.StorageConfig, bucket, key string, data io.Reader) error
+
+	// Download returns the contents of a file that was uploaded to the storage provider. The caller must close it.
+	Download(ctx context.Context, config types.StorageConfig, bucket, key string) (io.ReadCloser, error)
 }
 
 // FileMetadata contains information about a stored file
@@ -59,6 +62,22 @@ type R2Config struct {
 	SecretAccessKey string
 }
 
+// ProviderTypeOf returns the type of the storage provider that the config is for.
+func ProviderTypeOf(config types.StorageConfig) (types.StorageProviderType, error) {
+	switch {
+	case config.S3Config != nil:
+		return types.StorageProviderS3, nil
+	case config.GCSConfig != nil:
+		return types.StorageProviderGCS, nil
+	case config.AzureConfig != nil:
+		return types.StorageProviderAzureBlob, nil
+	case config.CustomS3Config != nil:
+		return types.StorageProviderCustomS3, nil
+	default:
+		return "", fmt.Errorf("invalid storage config, no storage provider found")
+	}
+}
+
 // NewStorageProvider creates a storage provider instance based on the provider type
 func NewStorageProvider(providerType types.StorageProviderType, credProvider CredentialProvider) (StorageProvider, error) {
 	switch providerType {
@@ -0,0 +1,258 @@
+package auditlogquery
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+// Arguments returns the arguments of a JSON-RPC request body: the arguments in its params, like those of a tool call,
+// or the params themselves for requests without arguments, like the uri of a resources/read. It returns nil if the body
+// isn't a request with params.
+func Arguments(requestBody json.RawMessage) json.RawMessage {
+	if len(requestBody) == 0 {
+		return nil
+	}
+
+	var request struct {
+		Params json.RawMessage `json:"params"`
+	}
+	if err := json.Unmarshal(requestBody, &request); err != nil || !isObject(request.Params) {
+		return nil
+	}
+
+	var params struct {
+		Arguments json.RawMessage `json:"arguments"`
+	}
+	if err := json.Unmarshal(request.Params, &params); err == nil && isObject(params.Arguments) {
+		return params.Arguments
+	}
+	return request.Params
+}
+
+func isObject(data json.RawMessage) bool {
+	s := strings.TrimSpace(string(data))
+	return strings.HasPrefix(s, "{")
+}
+
+// Match returns whether the log matches the query. The arguments are the decoded arguments of the log's request, as
+// returned by Arguments, or nil if it has none.
+func (q *Query) Match(log *types.MCPAuditLog, arguments any) bool {
+	return q.match(q.expr, log, arguments)
+}
+
+func (q *Query) match(e expr, log *types.MCPAuditLog, arguments any) bool {
+	switch e := e.(type) {
+	case andExpr:
+		return q.match(e.left, log, arguments) && q.match(e.right, log, arguments)
+	case orExpr:
+		return q.match(e.left, log, arguments) || q.match(e.right, log, arguments)
+	case notExpr:
+		return !q.match(e.expr, log, arguments)
+	case textExpr:
+		term := strings.ToLower(e.term)
+		for _, column := range textColumns {
+			if strings.Contains(strings.ToLower(columnValue(log, column).(string)), term) {
+				return true
+			}
+		}
+		return q.textInArguments && containsText(arguments, term)
+	case comparison:
+		if e.field.kind == argumentField {
+			return matchArgument(e, arguments)
+		}
+		return matchColumn(e, columnValue(log, e.field.column))
+	}
+	return false
+}
+
+func columnValue(log *types.MCPAuditLog, column string) any {
+	switch column {
+	case "user_id":
+		return log.UserID
+	case "mcp_id":
+		return log.MCPID
+	case "mcp_server_display_name":
+		return log.MCPServerDisplayName
+	case "mcp_server_catalog_entry_name":
+		return log.MCPServerCatalogEntryName
+	case "power_user_workspace_id":
+		return log.PowerUserWorkspaceID
+	case "client_name":
+		return log.ClientName
+	case "client_version":
+		return log.ClientVersion
+	case "client_ip":
+		return log.ClientIP
+	case "call_type":
+		return log.CallType
+	case "call_identifier":
+		return log.CallIdentifier
+	case "session_id":
+		return log.SessionID
+	case "request_id":
+		return log.RequestID
+	case "user_agent":
+		return log.UserAgent
+	case "error":
+		return log.Error
+	case "injection_scan_action":
+		return log.InjectionScanAction
+	case "response_status":
+		return log.ResponseStatus
+	case "processing_time_ms":
+		return int(log.ProcessingTimeMs)
+	case "created_at":
+		return log.CreatedAt
+	}
+	return ""
+}
+
+func matchColumn(e comparison, value any) bool {
+	switch v := value.(type) {
+	case string:
+		switch e.op {
+		case "=":
+			return v == e.value.(string)
+		case "!=":
+			return v != e.value.(string)
+		case "~":
+			return strings.Contains(strings.ToLower(v), strings.ToLower(e.value.(string)))
+		}
+	case int:
+		return compare(e.op, float64(v), float64(e.value.(int)))
+	case time.Time:
+		want := e.value.(time.Time)
+		switch {
+		case v.Before(want):
+			return compareResult(e.op, -1)
+		case v.After(want):
+			return compareResult(e.op, 1)
+		default:
+			return compareResult(e.op, 0)
+		}
+	}
+	return false
+}
+
+func compare(op string, a, b float64) bool {
+	switch {
+	case a < b:
+		return compareResult(op, -1)
+	case a > b:
+		return compareResult(op, 1)
+	default:
+		return compareResult(op, 0)
+	}
+}
+
+func compareResult(op string, c int) bool {
+	switch op {
+	case "=":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
+
+// matchArgument compares the argument at the path to the value. If the argument is an array, the comparison matches if
+// it matches any of its elements.
+func matchArgument(e comparison, arguments any) bool {
+	if e.op == "!=" {
+		return !matchArgument(comparison{field: e.field, path: e.path, op: "=", value: e.value}, arguments)
+	}
+
+	value := arguments
+	for _, segment := range e.path {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return false
+		}
+		if value, ok = object[segment]; !ok {
+			return false
+		}
+	}
+
+	values := []any{value}
+	if array, ok := value.([]any); ok {
+		values = array
+	}
+
+	for _, v := range values {
+		switch e.op {
+		case "=":
+			if v == e.value {
+				return true
+			}
+		case "~":
+			if s, ok := scalarText(v); ok && strings.Contains(strings.ToLower(s), strings.ToLower(e.value.(string))) {
+				return true
+			}
+		default:
+			if f, ok := v.(float64); ok && compare(e.op, f, e.value.(float64)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func scalarText(v any) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	}
+	return "", false
+}
+
+// containsText returns whether any value in the arguments contains the lowercase term.
+func containsText(v any, term string) bool {
+	switch v := v.(type) {
+	case map[string]any:
+		for _, value := range v {
+			if containsText(value, term) {
+				return true
+			}
+		}
+	case []any:
+		for _, value := range v {
+			if containsText(value, term) {
+				return true
+			}
+		}
+	default:
+		if s, ok := scalarText(v); ok {
+			return strings.Contains(strings.ToLower(s), term)
+		}
+	}
+	return false
+}
+
+// DecodeArguments decodes arguments returned by Arguments for Match.
+func DecodeArguments(arguments json.RawMessage) (any, error) {
+	if len(arguments) == 0 {
+		return nil, nil
+	}
+	var result any
+	if err := json.Unmarshal(arguments, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode arguments: %w", err)
+	}
+	return result, nil
+}
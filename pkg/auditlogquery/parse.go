@@ -0,0 +1,482 @@
+// Package auditlogquery implements the query language for searching MCP audit logs. A query combines field
+// comparisons, like `call_identifier = create_issue` or `created_at >= -24h`, comparisons on the arguments of the
+// request, like `args.repo = "obot-platform/obot"`, and free text terms with AND, OR, NOT, and parentheses. Terms
+// without an operator between them are combined with AND.
+package auditlogquery
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// maxQueryLength is the longest query that is parsed, to bound the size of the SQL that it compiles to.
+const maxQueryLength = 4096
+
+// Query is a parsed audit log query.
+type Query struct {
+	raw  string
+	expr expr
+	// textInArguments is whether free text terms are searched for in the arguments of requests.
+	textInArguments bool
+}
+
+// String returns the query as it was written.
+func (q *Query) String() string {
+	return q.raw
+}
+
+// UsesArguments returns whether the query looks at the arguments of requests, either with an args comparison or a
+// free text term.
+func (q *Query) UsesArguments() bool {
+	return usesArguments(q.expr, q.textInArguments)
+}
+
+// WithoutArgumentText returns a copy of the query that only searches for free text terms in the fields of logs, and not
+// in the arguments of their requests.
+func (q *Query) WithoutArgumentText() *Query {
+	c := *q
+	c.textInArguments = false
+	return &c
+}
+
+// Parse parses a query. Relative times, like -24h, are relative to the current time.
+func Parse(query string) (*Query, error) {
+	return parse(query, time.Now())
+}
+
+// Combine returns a query that matches the logs that match all the non-empty queries.
+func Combine(queries ...string) string {
+	var parts []string
+	for _, q := range queries {
+		if q = strings.TrimSpace(q); q != "" {
+			parts = append(parts, "("+q+")")
+		}
+	}
+	if len(parts) == 1 {
+		return strings.TrimSuffix(strings.TrimPrefix(parts[0], "("), ")")
+	}
+	return strings.Join(parts, " AND ")
+}
+
+func parse(query string, now time.Time) (*Query, error) {
+	if len(query) > maxQueryLength {
+		return nil, fmt.Errorf("query is longer than %d characters", maxQueryLength)
+	}
+
+	tokens, err := lex(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens, now: now}
+	if p.peek().kind == tokenEOF {
+		return nil, fmt.Errorf("query is empty")
+	}
+
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %s at position %d", t, t.pos)
+	}
+
+	return &Query{raw: query, expr: e, textInArguments: true}, nil
+}
+
+type expr interface {
+	isExpr()
+}
+
+type andExpr struct{ left, right expr }
+
+type orExpr struct{ left, right expr }
+
+type notExpr struct{ expr expr }
+
+// textExpr matches logs that contain the term in one of their text fields, or in the values of their arguments.
+type textExpr struct{ term string }
+
+// comparison compares a field, or the argument at path, to a value.
+type comparison struct {
+	field field
+	path  []string
+	op    string
+	value any
+}
+
+func (andExpr) isExpr()    {}
+func (orExpr) isExpr()     {}
+func (notExpr) isExpr()    {}
+func (textExpr) isExpr()   {}
+func (comparison) isExpr() {}
+
+func usesArguments(e expr, textInArguments bool) bool {
+	switch e := e.(type) {
+	case andExpr:
+		return usesArguments(e.left, textInArguments) || usesArguments(e.right, textInArguments)
+	case orExpr:
+		return usesArguments(e.left, textInArguments) || usesArguments(e.right, textInArguments)
+	case notExpr:
+		return usesArguments(e.expr, textInArguments)
+	case textExpr:
+		return textInArguments
+	case comparison:
+		return e.field.kind == argumentField
+	}
+	return false
+}
+
+type fieldKind int
+
+const (
+	stringField fieldKind = iota
+	intField
+	timeField
+	argumentField
+)
+
+type field struct {
+	column string
+	kind   fieldKind
+}
+
+// fields are the audit log columns that can be compared, by the name of their filter in the audit log API.
+var fields = map[string]field{
+	"user_id":                       {"user_id", stringField},
+	"mcp_id":                        {"mcp_id", stringField},
+	"mcp_server_display_name":       {"mcp_server_display_name", stringField},
+	"mcp_server_catalog_entry_name": {"mcp_server_catalog_entry_name", stringField},
+	"power_user_workspace_id":       {"power_user_workspace_id", stringField},
+	"client_name":                   {"client_name", stringField},
+	"client_version":                {"client_version", stringField},
+	"client_ip":                     {"client_ip", stringField},
+	"call_type":                     {"call_type", stringField},
+	"call_identifier":               {"call_identifier", stringField},
+	"session_id":                    {"session_id", stringField},
+	"request_id":                    {"request_id", stringField},
+	"user_agent":                    {"user_agent", stringField},
+	"error":                         {"error", stringField},
+	"injection_scan_action":         {"injection_scan_action", stringField},
+	"response_status":               {"response_status", intField},
+	"processing_time_ms":            {"processing_time_ms", intField},
+	"created_at":                    {"created_at", timeField},
+}
+
+// aliases are shorter names for the most used fields.
+var aliases = map[string]string{
+	"user":   "user_id",
+	"server": "mcp_server_display_name",
+	"tool":   "call_identifier",
+	"status": "response_status",
+	"ip":     "client_ip",
+	"time":   "created_at",
+}
+
+// textColumns are the columns that free text terms are searched in.
+var textColumns = []string{
+	"mcp_id", "mcp_server_display_name", "mcp_server_catalog_entry_name", "client_name", "client_version", "client_ip",
+	"call_type", "call_identifier", "error", "session_id", "request_id", "user_agent",
+}
+
+var argumentPathSegment = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOp
+	tokenLParen
+	tokenRParen
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of query"
+	case tokenString:
+		return strconv.Quote(t.value)
+	default:
+		return fmt.Sprintf("%q", t.value)
+	}
+}
+
+func isOpChar(r rune) bool {
+	return r == '=' || r == '!' || r == '<' || r == '>' || r == '~'
+}
+
+func lex(query string) ([]token, error) {
+	var (
+		tokens []token
+		runes  = []rune(query)
+	)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLParen, value: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRParen, value: ")", pos: i})
+			i++
+		case r == '"':
+			start := i
+			var sb strings.Builder
+			for i++; ; i++ {
+				if i >= len(runes) {
+					return nil, fmt.Errorf("unterminated string at position %d", start)
+				}
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+					sb.WriteRune(runes[i])
+					continue
+				}
+				if runes[i] == '"' {
+					i++
+					break
+				}
+				sb.WriteRune(runes[i])
+			}
+			tokens = append(tokens, token{kind: tokenString, value: sb.String(), pos: start})
+		case isOpChar(r):
+			start := i
+			for i < len(runes) && isOpChar(runes[i]) {
+				i++
+			}
+			op := string(runes[start:i])
+			switch op {
+			case "=", "!=", "~", "<", "<=", ">", ">=":
+			default:
+				return nil, fmt.Errorf("unknown operator %q at position %d", op, start)
+			}
+			tokens = append(tokens, token{kind: tokenOp, value: op, pos: start})
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !isOpChar(runes[i]) && runes[i] != '(' && runes[i] != ')' && runes[i] != '"' {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenWord, value: string(runes[start:i]), pos: start})
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, pos: len(runes)}), nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	now    time.Time
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.kind == tokenWord && t.value == keyword
+}
+
+func (p *parser) parseOr() (expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isKeyword("OR") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orExpr{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		if p.isKeyword("AND") {
+			p.next()
+		} else if t := p.peek(); t.kind == tokenEOF || t.kind == tokenRParen || p.isKeyword("OR") {
+			return left, nil
+		}
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andExpr{left: left, right: right}
+	}
+}
+
+func (p *parser) parseUnary() (expr, error) {
+	if p.isKeyword("NOT") {
+		p.next()
+		e, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{expr: e}, nil
+	}
+
+	t := p.next()
+	switch t.kind {
+	case tokenLParen:
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRParen {
+			return nil, fmt.Errorf("expected \")\" at position %d, found %s", closing.pos, closing)
+		}
+		return e, nil
+	case tokenString:
+		return textExpr{term: t.value}, nil
+	case tokenWord:
+		if p.peek().kind == tokenOp {
+			return p.parseComparison(t)
+		}
+		if t.value == "AND" || t.value == "OR" {
+			return nil, fmt.Errorf("unexpected %s at position %d", t, t.pos)
+		}
+		return textExpr{term: t.value}, nil
+	default:
+		return nil, fmt.Errorf("unexpected %s at position %d", t, t.pos)
+	}
+}
+
+func (p *parser) parseComparison(name token) (expr, error) {
+	op := p.next().value
+	value := p.next()
+	if value.kind != tokenWord && value.kind != tokenString {
+		return nil, fmt.Errorf("expected a value after %s at position %d, found %s", op, value.pos, value)
+	}
+
+	c := comparison{op: op}
+	if path, ok := strings.CutPrefix(name.value, "args."); ok {
+		c.field = field{kind: argumentField}
+		c.path = strings.Split(path, ".")
+		for _, segment := range c.path {
+			if !argumentPathSegment.MatchString(segment) {
+				return nil, fmt.Errorf("invalid argument path %q at position %d", name.value, name.pos)
+			}
+		}
+	} else {
+		fieldName := name.value
+		if alias, ok := aliases[fieldName]; ok {
+			fieldName = alias
+		}
+		f, ok := fields[fieldName]
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q at position %d", name.value, name.pos)
+		}
+		c.field = f
+	}
+
+	var err error
+	switch c.field.kind {
+	case stringField:
+		if op != "=" && op != "!=" && op != "~" {
+			return nil, fmt.Errorf("operator %s can't be used with %s at position %d", op, name.value, name.pos)
+		}
+		c.value = value.value
+	case intField:
+		if op == "~" {
+			return nil, fmt.Errorf("operator ~ can't be used with %s at position %d", name.value, name.pos)
+		}
+		if c.value, err = strconv.Atoi(value.value); err != nil {
+			return nil, fmt.Errorf("expected a number for %s at position %d, found %s", name.value, value.pos, value)
+		}
+	case timeField:
+		if op == "~" {
+			return nil, fmt.Errorf("operator ~ can't be used with %s at position %d", name.value, name.pos)
+		}
+		if c.value, err = parseTime(value.value, p.now); err != nil {
+			return nil, fmt.Errorf("invalid time for %s at position %d: %w", name.value, value.pos, err)
+		}
+	case argumentField:
+		switch op {
+		case "<", "<=", ">", ">=":
+			if c.value, err = strconv.ParseFloat(value.value, 64); err != nil {
+				return nil, fmt.Errorf("expected a number for %s at position %d, found %s", name.value, value.pos, value)
+			}
+		case "~":
+			c.value = value.value
+		default:
+			c.value = argumentValue(value)
+		}
+	}
+
+	return c, nil
+}
+
+// argumentValue returns the JSON value of an unquoted number or boolean, and a string otherwise.
+func argumentValue(t token) any {
+	if t.kind == tokenString {
+		return t.value
+	}
+	if t.value == "true" || t.value == "false" {
+		return t.value == "true"
+	}
+	if f, err := strconv.ParseFloat(t.value, 64); err == nil {
+		return f
+	}
+	return t.value
+}
+
+var relativeTime = regexp.MustCompile(`^-(\d+)([smhdw])$`)
+
+// parseTime parses an RFC 3339 time, a date, which is midnight UTC, or a time relative to now, like -30m, -24h or -7d.
+func parseTime(value string, now time.Time) (time.Time, error) {
+	if m := relativeTime.FindStringSubmatch(value); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return time.Time{}, err
+		}
+		unit := map[string]time.Duration{
+			"s": time.Second,
+			"m": time.Minute,
+			"h": time.Hour,
+			"d": 24 * time.Hour,
+			"w": 7 * 24 * time.Hour,
+		}[m[2]]
+		return now.Add(-time.Duration(n) * unit), nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("expected an RFC 3339 time, a date like 2006-01-02, or a relative time like -24h, found %q", value)
+}
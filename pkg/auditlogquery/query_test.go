@@ -0,0 +1,167 @@
+package auditlogquery
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func TestParse(t *testing.T) {
+	for _, query := range []string{
+		"create_issue",
+		`tool = create_issue AND args.repo = "obot-platform/obot"`,
+		"status >= 400 OR error ~ timeout",
+		"NOT (user = alice OR user = bob) created_at >= -24h",
+		"time < 2026-03-01 time >= 2026-02-01T00:00:00Z",
+		"args.options.limit > 10",
+		`args.labels = "bug"`,
+		"args.draft = true",
+		`"multiple words"`,
+	} {
+		_, err := Parse(query)
+		assert.NoError(t, err, query)
+	}
+
+	for _, query := range []string{
+		"",
+		"   ",
+		"(create_issue",
+		"create_issue)",
+		"tool =",
+		"unknown_field = x",
+		"status = abc",
+		"processing_time_ms > slow",
+		"time > yesterday",
+		"args. = x",
+		"args.a.b$c = x",
+		"args.limit > ten",
+		"AND tool = x",
+		`"unterminated`,
+	} {
+		_, err := Parse(query)
+		assert.Error(t, err, query)
+	}
+}
+
+func TestCombine(t *testing.T) {
+	assert.Equal(t, "", Combine("", " "))
+	assert.Equal(t, "a OR b", Combine("a OR b", ""))
+	assert.Equal(t, "(a OR b) AND (c)", Combine("a OR b", "c"))
+}
+
+func TestArguments(t *testing.T) {
+	assert.JSONEq(t, `{"repo":"obot"}`, string(Arguments(json.RawMessage(`{"method":"tools/call","params":{"name":"x","arguments":{"repo":"obot"}}}`))))
+	assert.JSONEq(t, `{"uri":"file:///a"}`, string(Arguments(json.RawMessage(`{"method":"resources/read","params":{"uri":"file:///a"}}`))))
+	assert.Nil(t, Arguments(json.RawMessage(`{"method":"tools/list"}`)))
+	assert.Nil(t, Arguments(json.RawMessage(`not json`)))
+	assert.Nil(t, Arguments(nil))
+}
+
+// TestWhereSQLite checks that the SQL conditions select the same logs as Match, both when the arguments are searched in
+// the database and when the condition is a superset that is filtered with Match.
+func TestWhereSQLite(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&types.MCPAuditLog{}))
+
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	logs := []types.MCPAuditLog{
+		{UserID: "alice", CallType: "tools/call", CallIdentifier: "create_issue", ResponseStatus: 200, ProcessingTimeMs: 50, CreatedAt: now.Add(-time.Hour),
+			RequestBody: json.RawMessage(`{"params":{"name":"create_issue","arguments":{"repo":"obot-platform/obot","labels":["bug","ui"],"limit":5}}}`)},
+		{UserID: "bob", CallType: "tools/call", CallIdentifier: "create_issue", ResponseStatus: 500, ProcessingTimeMs: 900, Error: "request timeout", CreatedAt: now.Add(-48 * time.Hour),
+			RequestBody: json.RawMessage(`{"params":{"name":"create_issue","arguments":{"repo":"other/repo","limit":20,"draft":true}}}`)},
+		{UserID: "alice", CallType: "tools/list", ResponseStatus: 200, ProcessingTimeMs: 10, CreatedAt: now.Add(-2 * time.Hour)},
+		{UserID: "carol", CallType: "resources/read", CallIdentifier: "file:///obot/README.md", ResponseStatus: 404, CreatedAt: now.Add(-3 * time.Hour),
+			RequestBody: json.RawMessage(`{"params":{"uri":"file:///obot/README.md"}}`)},
+		// % and _ in search terms aren't wildcards.
+		{UserID: "dave", CallType: "tools/call", CallIdentifier: "rename_repo", Error: "100% of retries failed", CreatedAt: now.Add(-72 * time.Hour),
+			RequestBody: json.RawMessage(`{"params":{"name":"rename_repo","arguments":{"repo":"foo_bar"}}}`)},
+		{UserID: "erin", CallType: "tools/call", CallIdentifier: "renameXrepo", Error: "1000 retries failed", CreatedAt: now.Add(-72 * time.Hour),
+			RequestBody: json.RawMessage(`{"params":{"name":"renameXrepo","arguments":{"repo":"fooXbar"}}}`)},
+	}
+	for i := range logs {
+		logs[i].RequestArguments = datatypes.JSON(Arguments(logs[i].RequestBody))
+		require.NoError(t, db.Create(&logs[i]).Error)
+	}
+
+	tests := []struct {
+		query    string
+		expected []string
+	}{
+		{query: `args.repo = "obot-platform/obot"`, expected: []string{"alice"}},
+		{query: `args.repo != "obot-platform/obot"`, expected: []string{"bob", "alice", "carol", "dave", "erin"}},
+		{query: `args.labels = bug`, expected: []string{"alice"}},
+		{query: `args.repo ~ OTHER`, expected: []string{"bob"}},
+		{query: `args.limit > 10`, expected: []string{"bob"}},
+		{query: `args.limit <= 10`, expected: []string{"alice"}},
+		{query: `args.draft = true`, expected: []string{"bob"}},
+		{query: `tool = create_issue AND status >= 500`, expected: []string{"bob"}},
+		{query: `user = alice OR user = carol`, expected: []string{"alice", "alice", "carol"}},
+		{query: `NOT user = alice`, expected: []string{"bob", "carol", "dave", "erin"}},
+		{query: `error ~ timeout`, expected: []string{"bob"}},
+		{query: `time >= -24h`, expected: []string{"alice", "alice", "carol"}},
+		{query: `processing_time_ms > 100`, expected: []string{"bob"}},
+		{query: `readme`, expected: []string{"carol"}},
+		{query: `obot-platform`, expected: []string{"alice"}},
+		{query: `NOT obot-platform tool = create_issue`, expected: []string{"bob"}},
+		{query: `args.repo ~ foo_bar`, expected: []string{"dave"}},
+		{query: `tool ~ rename_repo`, expected: []string{"dave"}},
+		{query: `error ~ "100%"`, expected: []string{"dave"}},
+		{query: `rename_repo`, expected: []string{"dave"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			q, err := parse(tt.query, now)
+			require.NoError(t, err)
+
+			condition, args := q.Where("sqlite", true)
+			var found []types.MCPAuditLog
+			require.NoError(t, db.Where(condition, args...).Order("id").Find(&found).Error)
+			assert.ElementsMatch(t, tt.expected, userIDs(found), "database")
+
+			condition, args = q.Where("sqlite", false)
+			var superset []types.MCPAuditLog
+			require.NoError(t, db.Where(condition, args...).Order("id").Find(&superset).Error)
+
+			var matched []types.MCPAuditLog
+			for _, l := range superset {
+				arguments, err := DecodeArguments(Arguments(l.RequestBody))
+				require.NoError(t, err)
+				if q.Match(&l, arguments) {
+					matched = append(matched, l)
+				}
+			}
+			assert.ElementsMatch(t, tt.expected, userIDs(matched), "match")
+		})
+	}
+}
+
+func TestWithoutArgumentText(t *testing.T) {
+	q, err := Parse("obot-platform")
+	require.NoError(t, err)
+	assert.True(t, q.UsesArguments())
+
+	q = q.WithoutArgumentText()
+	assert.False(t, q.UsesArguments())
+	assert.False(t, q.Match(&types.MCPAuditLog{}, map[string]any{"repo": "obot-platform/obot"}))
+
+	q, err = Parse("obot args.repo = x")
+	require.NoError(t, err)
+	assert.True(t, q.WithoutArgumentText().UsesArguments())
+}
+
+func userIDs(logs []types.MCPAuditLog) []string {
+	result := make([]string, 0, len(logs))
+	for _, l := range logs {
+		result = append(result, l.UserID)
+	}
+	return result
+}
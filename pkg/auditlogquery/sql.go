@@ -0,0 +1,190 @@
+package auditlogquery
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Where compiles the query to a SQL condition on the mcp_audit_logs table for the given gorm dialect, "postgres" or
+// "sqlite", and its arguments.
+//
+// Arguments are searched in the request_arguments column, which is only set when request bodies are not encrypted.
+// When withArguments is false, the condition is instead a superset of the query, with the parts that look at arguments
+// left out, and the logs that it returns have to be checked with Match.
+func (q *Query) Where(dialect string, withArguments bool) (string, []any) {
+	c := compiler{postgres: dialect == "postgres", withArguments: withArguments, textInArguments: q.textInArguments}
+	return c.compile(q.expr, true)
+}
+
+type compiler struct {
+	postgres        bool
+	withArguments   bool
+	textInArguments bool
+	args            []any
+}
+
+// unknown is the condition for a part of the query that can't be checked in SQL. It matches every log where the
+// query expects a match, and none where it expects no match, so the result contains every log that matches the query.
+func unknown(positive bool) string {
+	if positive {
+		return "1 = 1"
+	}
+	return "1 = 0"
+}
+
+func (c *compiler) compile(e expr, positive bool) (string, []any) {
+	c.args = nil
+	return c.condition(e, positive), c.args
+}
+
+func (c *compiler) condition(e expr, positive bool) string {
+	switch e := e.(type) {
+	case andExpr:
+		return "(" + c.condition(e.left, positive) + " AND " + c.condition(e.right, positive) + ")"
+	case orExpr:
+		return "(" + c.condition(e.left, positive) + " OR " + c.condition(e.right, positive) + ")"
+	case notExpr:
+		return "NOT " + c.condition(e.expr, !positive)
+	case textExpr:
+		return c.text(e, positive)
+	case comparison:
+		if e.field.kind == argumentField {
+			if !c.withArguments {
+				return unknown(positive)
+			}
+			return "COALESCE(" + c.argument(e) + ", FALSE)"
+		}
+		return c.column(e)
+	}
+	return unknown(positive)
+}
+
+func (c *compiler) like() string {
+	if c.postgres {
+		return "ILIKE"
+	}
+	return "LIKE"
+}
+
+// likeEscaper escapes the wildcards of LIKE patterns, so that % and _ in a search term match themselves. The patterns
+// are compared with likeEscape, because SQLite has no escape character by default.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+const likeEscape = ` ESCAPE '\'`
+
+// contains returns the LIKE pattern that matches values that contain the term.
+func contains(term string) string {
+	return "%" + likeEscaper.Replace(term) + "%"
+}
+
+func (c *compiler) text(e textExpr, positive bool) string {
+	conditions := make([]string, 0, len(textColumns)+1)
+	for _, column := range textColumns {
+		conditions = append(conditions, column+" "+c.like()+" ?"+likeEscape)
+		c.args = append(c.args, contains(e.term))
+	}
+
+	switch {
+	case !c.textInArguments:
+	case !c.withArguments:
+		conditions = append(conditions, unknown(positive))
+	case c.postgres:
+		conditions = append(conditions, "COALESCE(to_tsvector('simple', request_arguments) @@ plainto_tsquery('simple', ?), FALSE)")
+		c.args = append(c.args, e.term)
+	default:
+		conditions = append(conditions, "COALESCE(CAST(request_arguments AS TEXT) LIKE ?"+likeEscape+", FALSE)")
+		c.args = append(c.args, contains(e.term))
+	}
+
+	return "(" + strings.Join(conditions, " OR ") + ")"
+}
+
+func (c *compiler) column(e comparison) string {
+	value := e.value
+	if t, ok := value.(time.Time); ok {
+		// Times are stored in the local time zone.
+		value = t.Local()
+	}
+
+	if e.op == "~" {
+		c.args = append(c.args, contains(value.(string)))
+		return e.field.column + " " + c.like() + " ?" + likeEscape
+	}
+
+	c.args = append(c.args, value)
+	return e.field.column + " " + e.op + " ?"
+}
+
+func (c *compiler) argument(e comparison) string {
+	if c.postgres {
+		return c.postgresArgument(e)
+	}
+
+	// json_each returns the elements of an array, or the value itself, so that a comparison matches any element of an
+	// array, like it does in PostgreSQL.
+	path := "$"
+	for _, segment := range e.path {
+		path += `."` + segment + `"`
+	}
+
+	var condition string
+	switch e.op {
+	case "~":
+		condition = "value LIKE ?" + likeEscape
+		c.args = append(c.args, path, contains(e.value.(string)))
+	case "!=":
+		c.args = append(c.args, path, sqliteValue(e.value))
+		return "NOT EXISTS (SELECT 1 FROM json_each(request_arguments, ?) WHERE value = ?)"
+	default:
+		condition = "value " + e.op + " ?"
+		c.args = append(c.args, path, sqliteValue(e.value))
+	}
+	return "EXISTS (SELECT 1 FROM json_each(request_arguments, ?) WHERE " + condition + ")"
+}
+
+// sqliteValue returns the SQLite value of a JSON value. json_each returns booleans as 1 and 0.
+func sqliteValue(value any) any {
+	if b, ok := value.(bool); ok {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	return value
+}
+
+func (c *compiler) postgresArgument(e comparison) string {
+	switch e.op {
+	case "=", "!=":
+		// Containment can use the GIN index on request_arguments. The second document matches arrays that contain the value.
+		c.args = append(c.args, containment(e.path, e.value), containment(e.path, []any{e.value}))
+		condition := "(request_arguments @> ?::jsonb OR request_arguments @> ?::jsonb)"
+		if e.op == "!=" {
+			return "NOT COALESCE(" + condition + ", FALSE)"
+		}
+		return condition
+	case "~":
+		c.args = append(c.args, "{"+strings.Join(e.path, ",")+"}", contains(e.value.(string)))
+		return "request_arguments #>> ?::text[] ILIKE ?" + likeEscape
+	default:
+		// The path segments are validated by the parser and the value is a number, so the JSON path is safe to build.
+		path := "$"
+		for _, segment := range e.path {
+			path += `."` + segment + `"`
+		}
+		c.args = append(c.args, path+" "+e.op+" "+strconv.FormatFloat(e.value.(float64), 'f', -1, 64))
+		return "request_arguments @@ ?::jsonpath"
+	}
+}
+
+// containment returns a JSON document with the value at the path, like {"a":{"b":value}}.
+func containment(path []string, value any) string {
+	document := value
+	for i := len(path) - 1; i >= 0; i-- {
+		document = map[string]any{path[i]: document}
+	}
+	b, _ := json.Marshal(document)
+	return string(b)
+}
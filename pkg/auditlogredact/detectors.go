@@ -0,0 +1,81 @@
+package auditlogredact
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+// matcher finds one format of sensitive value. If valid is set, matches that it rejects are kept.
+type matcher struct {
+	pattern *regexp.Regexp
+	valid   func(string) bool
+}
+
+// detectorOrder is the order that the built-in detectors run in. JWTs are found before API keys so that
+// bearer tokens that are JWTs are labeled as JWTs.
+var detectorOrder = []types.AuditLogDetector{
+	types.AuditLogDetectorJWT,
+	types.AuditLogDetectorAPIKey,
+	types.AuditLogDetectorEmail,
+	types.AuditLogDetectorCreditCard,
+	types.AuditLogDetectorNationalID,
+}
+
+var detectors = map[types.AuditLogDetector][]matcher{
+	types.AuditLogDetectorJWT: {
+		{pattern: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)},
+	},
+	types.AuditLogDetectorAPIKey: {
+		// OpenAI and Anthropic, GitHub, GitLab, Slack, AWS access key IDs, and Google.
+		{pattern: regexp.MustCompile(`\b(?:sk-[A-Za-z0-9_-]{20,}|gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,}|glpat-[A-Za-z0-9_-]{20,}|xox[abprs]-[A-Za-z0-9-]{10,}|AKIA[0-9A-Z]{16}|AIza[0-9A-Za-z_-]{35})`)},
+		{pattern: regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9._~+/-]+=*`)},
+	},
+	types.AuditLogDetectorEmail: {
+		{pattern: regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`)},
+	},
+	types.AuditLogDetectorCreditCard: {
+		// Card numbers start with 2 through 6, which also keeps millisecond timestamps from being redacted.
+		{pattern: regexp.MustCompile(`\b[2-6]\d(?:[ -]?\d){11,17}\b`), valid: luhn},
+	},
+	types.AuditLogDetectorNationalID: {
+		{pattern: regexp.MustCompile(`\b\d{3}-\d{2}-\d{4}\b`), valid: validSSN},
+		{pattern: regexp.MustCompile(`\b[A-CEGHJ-PR-TW-Z][A-CEGHJ-NPR-TW-Z] ?\d{2} ?\d{2} ?\d{2} ?[A-D]\b`)},
+	},
+}
+
+// IsDetector reports whether name is a built-in detector.
+func IsDetector(name types.AuditLogDetector) bool {
+	_, ok := detectors[name]
+	return ok
+}
+
+// luhn reports whether the digits in s pass the Luhn checksum that all credit card numbers use.
+func luhn(s string) bool {
+	var sum, n int
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if n%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		n++
+	}
+	return n > 0 && sum%10 == 0
+}
+
+// validSSN rejects numbers in the form of a US social security number that are never issued,
+// which are often used as placeholders.
+func validSSN(s string) bool {
+	area, rest, _ := strings.Cut(s, "-")
+	group, serial, _ := strings.Cut(rest, "-")
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
@@ -0,0 +1,140 @@
+package auditlogredact
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pathSegment is one step of a JSON path. It matches object keys by name, array elements by index, or any child if it is a wildcard.
+type pathSegment struct {
+	name     string
+	index    int
+	wildcard bool
+	// recursive segments match at any depth, like ..name.
+	recursive bool
+}
+
+func (s pathSegment) matchesKey(key string) bool {
+	return s.wildcard || (s.index < 0 && s.name == key)
+}
+
+func (s pathSegment) matchesIndex(i int) bool {
+	return s.wildcard || s.index == i
+}
+
+// jsonPath is a parsed JSON path. Only the subset that selects values is supported: child names, .* and [*] wildcards,
+// array indexes, quoted names like ['a.b'], and recursive descent.
+type jsonPath []pathSegment
+
+// ValidateJSONPath returns an error if path isn't a JSON path that redaction rules support.
+func ValidateJSONPath(path string) error {
+	_, err := parseJSONPath(path)
+	return err
+}
+
+// parseJSONPath parses a JSON path like $.params.arguments.ssn or $..password.
+func parseJSONPath(path string) (jsonPath, error) {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(path), "$")
+	if !ok {
+		return nil, fmt.Errorf("JSON path %q must start with $", path)
+	}
+
+	var result jsonPath
+	for rest != "" {
+		seg := pathSegment{index: -1}
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			seg.recursive = true
+			rest = rest[2:]
+		case strings.HasPrefix(rest, "."):
+			rest = rest[1:]
+		case strings.HasPrefix(rest, "["):
+		default:
+			return nil, fmt.Errorf("unexpected %q in JSON path %q", rest[:1], path)
+		}
+
+		if strings.HasPrefix(rest, "[") {
+			inner, after, ok := strings.Cut(rest[1:], "]")
+			if !ok {
+				return nil, fmt.Errorf("unclosed [ in JSON path %q", path)
+			}
+			rest = after
+
+			switch {
+			case inner == "*":
+				seg.wildcard = true
+			case len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0]:
+				seg.name = inner[1 : len(inner)-1]
+			default:
+				i, err := strconv.Atoi(inner)
+				if err != nil || i < 0 {
+					return nil, fmt.Errorf("invalid index %q in JSON path %q", inner, path)
+				}
+				seg.index = i
+			}
+		} else {
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			seg.name, rest = rest[:end], rest[end:]
+			if seg.name == "*" {
+				seg.name, seg.wildcard = "", true
+			}
+		}
+
+		if !seg.wildcard && seg.index < 0 && seg.name == "" {
+			return nil, fmt.Errorf("empty name in JSON path %q", path)
+		}
+		result = append(result, seg)
+	}
+
+	if len(result) == 0 {
+		return nil, fmt.Errorf("JSON path %q must select at least one field", path)
+	}
+	return result, nil
+}
+
+// replace sets every value that the path selects in v to replacement, and reports whether any were found.
+// Objects and arrays are changed in place. The returned value is v, unless the path selected v itself.
+func (p jsonPath) replace(v any, replacement string) (any, bool) {
+	if len(p) == 0 {
+		return replacement, true
+	}
+
+	var (
+		seg     = p[0]
+		changed bool
+	)
+	visit := func(child any, matches bool) (any, bool) {
+		if matches {
+			if newChild, ok := p[1:].replace(child, replacement); ok {
+				return newChild, true
+			}
+		}
+		if seg.recursive {
+			return p.replace(child, replacement)
+		}
+		return child, false
+	}
+
+	switch v := v.(type) {
+	case map[string]any:
+		for key, child := range v {
+			if newChild, ok := visit(child, seg.matchesKey(key)); ok {
+				v[key] = newChild
+				changed = true
+			}
+		}
+	case []any:
+		for i, child := range v {
+			if newChild, ok := visit(child, seg.matchesIndex(i)); ok {
+				v[i] = newChild
+				changed = true
+			}
+		}
+	}
+
+	return v, changed
+}
@@ -0,0 +1,231 @@
+package auditlogredact
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+// credentialHeaders are request and response headers whose values are always credentials.
+// The API key detector redacts them entirely.
+var credentialHeaders = []string{"authorization", "proxy-authorization", "cookie", "set-cookie", "x-api-key"}
+
+type stringRule struct {
+	replacement string
+	matchers    []matcher
+}
+
+type pathRule struct {
+	replacement string
+	path        jsonPath
+}
+
+// Redactor removes sensitive values from MCP audit logs before they are stored.
+type Redactor struct {
+	metadataOnly      bool
+	redactCredentials bool
+	stringRules       []stringRule
+	pathRules         []pathRule
+}
+
+// New returns a Redactor for an MCP server's audit log redaction. The built-in detectors that aren't disabled always run,
+// so a nil redaction uses all of them.
+func New(redaction *types.AuditLogRedaction) (*Redactor, error) {
+	if redaction == nil {
+		redaction = &types.AuditLogRedaction{}
+	}
+
+	r := &Redactor{
+		metadataOnly:      redaction.MetadataOnly,
+		redactCredentials: !slices.Contains(redaction.DisabledDetectors, types.AuditLogDetectorAPIKey),
+	}
+	for _, name := range detectorOrder {
+		if !slices.Contains(redaction.DisabledDetectors, name) {
+			r.stringRules = append(r.stringRules, stringRule{
+				replacement: replacement(string(name)),
+				matchers:    detectors[name],
+			})
+		}
+	}
+
+	for _, rule := range redaction.Rules {
+		if rule.Pattern != "" {
+			pattern, err := regexp.Compile(rule.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern for redaction rule %q: %w", rule.Name, err)
+			}
+			r.stringRules = append(r.stringRules, stringRule{
+				replacement: replacement(rule.Name),
+				matchers:    []matcher{{pattern: pattern}},
+			})
+		}
+		if rule.JSONPath != "" {
+			path, err := parseJSONPath(rule.JSONPath)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON path for redaction rule %q: %w", rule.Name, err)
+			}
+			r.pathRules = append(r.pathRules, pathRule{
+				replacement: replacement(rule.Name),
+				path:        path,
+			})
+		}
+	}
+
+	return r, nil
+}
+
+// MetadataOnly returns a Redactor that drops all bodies and headers.
+func MetadataOnly() *Redactor {
+	return &Redactor{metadataOnly: true}
+}
+
+func replacement(name string) string {
+	return "[REDACTED:" + name + "]"
+}
+
+// Redact removes sensitive values from the bodies, headers, and error of an audit log, or drops the bodies and headers
+// in metadata-only mode. It marks the log as redacted if anything was removed.
+func (r *Redactor) Redact(log *gatewaytypes.MCPAuditLog) {
+	if r.metadataOnly {
+		if len(log.RequestBody) > 0 || len(log.ResponseBody) > 0 || len(log.RequestHeaders) > 0 || len(log.ResponseHeaders) > 0 {
+			log.Redacted = true
+		}
+		log.RequestBody, log.ResponseBody, log.RequestHeaders, log.ResponseHeaders = nil, nil, nil, nil
+	} else {
+		var changed [5]bool
+		log.RequestBody, changed[0] = r.redactJSON(log.RequestBody, false)
+		log.ResponseBody, changed[1] = r.redactJSON(log.ResponseBody, false)
+		log.RequestHeaders, changed[2] = r.redactJSON(log.RequestHeaders, true)
+		log.ResponseHeaders, changed[3] = r.redactJSON(log.ResponseHeaders, true)
+		log.Error, changed[4] = r.redactString(log.Error)
+		if slices.Contains(changed[:], true) {
+			log.Redacted = true
+		}
+	}
+}
+
+// RedactText removes sensitive values from free text, like a line from a server's logs. In metadata-only mode, all of
+// the text is removed. It returns true if anything was removed.
+func (r *Redactor) RedactText(s string) (string, bool) {
+	if r.metadataOnly {
+		return "", s != ""
+	}
+	return r.redactString(s)
+}
+
+// redactJSON redacts a JSON body, or headers if headers is true. Values that aren't valid JSON are redacted as text
+// and stored as a JSON string.
+func (r *Redactor) redactJSON(raw json.RawMessage, headers bool) (json.RawMessage, bool) {
+	if len(raw) == 0 {
+		return raw, false
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		s, changed := r.redactString(string(raw))
+		if !changed {
+			return raw, false
+		}
+		result, _ := json.Marshal(s)
+		return result, true
+	}
+
+	var changed bool
+	if headers {
+		v, changed = r.redactHeaders(v)
+	} else {
+		for _, rule := range r.pathRules {
+			var ok bool
+			if v, ok = rule.path.replace(v, rule.replacement); ok {
+				changed = true
+			}
+		}
+	}
+	var valuesChanged bool
+	if v, valuesChanged = r.redactValue(v); !changed && !valuesChanged {
+		return raw, false
+	}
+
+	result, err := json.Marshal(v)
+	if err != nil {
+		// This can't happen for values that were decoded from JSON, but don't keep the original if it does.
+		return json.RawMessage(strconv.Quote(replacement("invalid"))), true
+	}
+	return result, true
+}
+
+// redactHeaders replaces the values of credential headers, which are a map of header names to values.
+func (r *Redactor) redactHeaders(v any) (any, bool) {
+	headers, ok := v.(map[string]any)
+	if !ok || !r.redactCredentials {
+		return v, false
+	}
+
+	var changed bool
+	for name := range headers {
+		if slices.Contains(credentialHeaders, strings.ToLower(name)) {
+			headers[name] = replacement(string(types.AuditLogDetectorAPIKey))
+			changed = true
+		}
+	}
+	return headers, changed
+}
+
+// redactValue applies the string rules to every string and number in v.
+func (r *Redactor) redactValue(v any) (any, bool) {
+	var changed bool
+	switch v := v.(type) {
+	case string:
+		return r.redactString(v)
+	case json.Number:
+		// Card numbers and the like can be sent as numbers. If one is redacted, the number becomes a string.
+		if s, ok := r.redactString(v.String()); ok {
+			return s, true
+		}
+	case map[string]any:
+		for key, child := range v {
+			if newChild, ok := r.redactValue(child); ok {
+				v[key] = newChild
+				changed = true
+			}
+		}
+	case []any:
+		for i, child := range v {
+			if newChild, ok := r.redactValue(child); ok {
+				v[i] = newChild
+				changed = true
+			}
+		}
+	}
+	return v, changed
+}
+
+func (r *Redactor) redactString(s string) (string, bool) {
+	if s == "" {
+		return s, false
+	}
+
+	var changed bool
+	for _, rule := range r.stringRules {
+		for _, m := range rule.matchers {
+			s = m.pattern.ReplaceAllStringFunc(s, func(match string) string {
+				if m.valid != nil && !m.valid(match) {
+					return match
+				}
+				changed = true
+				return rule.replacement
+			})
+		}
+	}
+	return s, changed
+}
@@ -8,11 +8,14 @@ import (
 	"github.com/obot-platform/nah"
 	"github.com/obot-platform/nah/pkg/router"
 	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/auditloganomaly"
 	"github.com/obot-platform/obot/pkg/controller/data"
 	"github.com/obot-platform/obot/pkg/controller/handlers/adminworkspace"
 	"github.com/obot-platform/obot/pkg/controller/handlers/deployment"
 	"github.com/obot-platform/obot/pkg/controller/handlers/mcpcatalog"
+	"github.com/obot-platform/obot/pkg/controller/handlers/mcpserver"
 	"github.com/obot-platform/obot/pkg/controller/handlers/toolreference"
+	"github.com/obot-platform/obot/pkg/mcp"
 	"github.com/obot-platform/obot/pkg/services"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
@@ -33,12 +36,16 @@ type Controller struct {
 	toolRefHandler        *toolreference.Handler
 	mcpCatalogHandler     *mcpcatalog.Handler
 	adminWorkspaceHandler *adminworkspace.Handler
+	mcpServerProber       *mcpserver.Prober
+	auditLogAnalyzer      *auditloganomaly.Analyzer
 }
 
 func New(services *services.Services) (*Controller, error) {
 	c := &Controller{
-		router:   services.Router,
-		services: services,
+		router:           services.Router,
+		services:         services,
+		mcpServerProber:  mcpserver.NewProber(services.GPTClient, services.MCPLoader, services.GatewayClient, services.ServerURL),
+		auditLogAnalyzer: auditloganomaly.NewAnalyzer(services.GatewayClient, services.AuditLogAnomalyInterval),
 	}
 
 	// Create local Kubernetes router if MCP is enabled and config is available
@@ -90,6 +97,14 @@ func (c *Controller) PreStart(ctx context.Context) error {
 
 func (c *Controller) PostStart(ctx context.Context, client kclient.Client) {
 	go c.toolRefHandler.PollRegistries(ctx, client)
+	go c.services.MCPLoader.CollectServerHistory(ctx, c.services.GatewayClient)
+	go c.services.MCPLoader.ScaleDownIdleServers(ctx, c.services.GatewayClient)
+	go c.services.MCPLoader.AutoscaleServers(ctx, c.services.GatewayClient)
+	go c.services.MCPLoader.RemoveDrainedReplicas(ctx)
+	go c.services.MCPLoader.RefreshEgressPolicies(ctx)
+	go c.mcpServerProber.Run(ctx, client)
+	go c.auditLogAnalyzer.Run(ctx)
+	go mcp.PruneShadowVerdicts(ctx, c.services.GatewayClient)
 	var err error
 	for range 3 {
 		err = c.toolRefHandler.EnsureOpenAIEnvCredentialAndDefaults(ctx, client)
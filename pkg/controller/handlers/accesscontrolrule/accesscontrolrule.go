@@ -2,6 +2,7 @@ package accesscontrolrule
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/obot-platform/nah/pkg/router"
 	"github.com/obot-platform/obot/apiclient/types"
@@ -88,3 +89,22 @@ func (h *Handler) PruneDeletedResources(req router.Request, _ router.Response) e
 
 	return nil
 }
+
+// ExpireGrants deletes access control rules whose expiration time has passed.
+// Rules with an expiration time are generated when an MCPAccessRequest is approved.
+func (h *Handler) ExpireGrants(req router.Request, resp router.Response) error {
+	acr := req.Object.(*v1.AccessControlRule)
+	if acr.Spec.ExpiresAt == nil || !acr.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	if acr.IsExpired() {
+		return req.Delete(acr)
+	}
+
+	if expiresIn := time.Until(acr.Spec.ExpiresAt.Time); expiresIn < 10*time.Hour {
+		resp.RetryAfter(expiresIn)
+	}
+
+	return nil
+}
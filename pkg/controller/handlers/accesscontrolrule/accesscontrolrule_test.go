@@ -0,0 +1,64 @@
+package accesscontrolrule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/storage/scheme"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type response struct {
+	retryAfter time.Duration
+}
+
+func (r *response) Attributes() map[string]any {
+	return nil
+}
+
+func (r *response) RetryAfter(delay time.Duration) {
+	r.retryAfter = delay
+}
+
+func TestExpireGrants(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		expiresIn  time.Duration
+		deleted    bool
+		retryAfter time.Duration
+	}{
+		{name: "no expiration"},
+		{name: "expired", expiresIn: -time.Minute, deleted: true},
+		{name: "expires soon", expiresIn: time.Hour, retryAfter: time.Hour},
+		{name: "expires later", expiresIn: 24 * time.Hour},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			acr := &v1.AccessControlRule{
+				ObjectMeta: metav1.ObjectMeta{Name: "acr1", Namespace: system.DefaultNamespace},
+			}
+			if tt.expiresIn != 0 {
+				acr.Spec.ExpiresAt = &metav1.Time{Time: time.Now().Add(tt.expiresIn)}
+			}
+
+			client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(acr).Build()
+			resp := &response{}
+			require.NoError(t, New(nil).ExpireGrants(router.Request{Client: client, Object: acr, Ctx: context.Background()}, resp))
+
+			err := client.Get(context.Background(), router.Key(acr.Namespace, acr.Name), &v1.AccessControlRule{})
+			if tt.deleted {
+				assert.True(t, apierrors.IsNotFound(err))
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.InDelta(t, tt.retryAfter, resp.retryAfter, float64(time.Minute))
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package auditlogexport
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -11,25 +12,30 @@ import (
 	"github.com/gptscript-ai/go-gptscript"
 	"github.com/obot-platform/nah/pkg/router"
 	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/accesscontrolrule"
 	"github.com/obot-platform/obot/pkg/auditlogexport"
+	"github.com/obot-platform/obot/pkg/auditlogquery"
 	client "github.com/obot-platform/obot/pkg/gateway/client"
 	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/server/options/encryptionconfig"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type Handler struct {
 	gptClient        *gptscript.GPTScript
 	gatewayClient    *client.Client
+	acrHelper        *accesscontrolrule.Helper
 	credProvider     *auditlogexport.GPTScriptCredentialProvider
 	encryptionConfig *encryptionconfig.EncryptionConfiguration
 }
 
-func NewHandler(gptClient *gptscript.GPTScript, gatewayClient *client.Client, encryptionConfig *encryptionconfig.EncryptionConfiguration) *Handler {
+func NewHandler(gptClient *gptscript.GPTScript, gatewayClient *client.Client, acrHelper *accesscontrolrule.Helper, encryptionConfig *encryptionconfig.EncryptionConfiguration) *Handler {
 	return &Handler{
 		gptClient:        gptClient,
 		gatewayClient:    gatewayClient,
+		acrHelper:        acrHelper,
 		credProvider:     auditlogexport.NewGPTScriptCredentialProvider(gptClient),
 		encryptionConfig: encryptionConfig,
 	}
@@ -49,7 +55,7 @@ func (h *Handler) ExportAuditLogs(req router.Request, _ router.Response) error {
 		return fmt.Errorf("failed to update export status: %w", err)
 	}
 
-	if err := h.performExport(req.Ctx, export); err != nil {
+	if err := h.performExport(req.Ctx, req.Client, export); err != nil {
 		export.Status.State = types.AuditLogExportStateFailed
 		export.Status.Error = err.Error()
 
@@ -63,7 +69,7 @@ func (h *Handler) ExportAuditLogs(req router.Request, _ router.Response) error {
 	return req.Client.Status().Update(req.Ctx, export)
 }
 
-func (h *Handler) performExport(ctx context.Context, export *v1.AuditLogExport) error {
+func (h *Handler) performExport(ctx context.Context, c kclient.Client, export *v1.AuditLogExport) error {
 	storageConfig, err := h.credProvider.GetStorageConfig(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get storage config: %w", err)
@@ -72,17 +78,9 @@ func (h *Handler) performExport(ctx context.Context, export *v1.AuditLogExport)
 		return fmt.Errorf("storage config is nil")
 	}
 
-	var provider types.StorageProviderType
-	if storageConfig.S3Config != nil {
-		provider = types.StorageProviderS3
-	} else if storageConfig.GCSConfig != nil {
-		provider = types.StorageProviderGCS
-	} else if storageConfig.AzureConfig != nil {
-		provider = types.StorageProviderAzureBlob
-	} else if storageConfig.CustomS3Config != nil {
-		provider = types.StorageProviderCustomS3
-	} else {
-		return fmt.Errorf("invalid storage config, no storage provider found")
+	provider, err := auditlogexport.ProviderTypeOf(*storageConfig)
+	if err != nil {
+		return err
 	}
 
 	// Create storage provider
@@ -96,10 +94,18 @@ func (h *Handler) performExport(ctx context.Context, export *v1.AuditLogExport)
 	// Generate export path
 	exportPath := h.generateExportPath(export)
 
-	// Use streaming export with batching
-	exportSize, err := h.streamingExport(ctx, export, storageProvider, exportPath)
-	if err != nil {
-		return fmt.Errorf("failed to perform streaming export: %w", err)
+	var exportSize int64
+	if export.Spec.Type == types.AuditLogExportTypeAccessReview {
+		exportSize, err = h.accessReviewExport(ctx, export, storageProvider, exportPath)
+		if err != nil {
+			return fmt.Errorf("failed to perform access review export: %w", err)
+		}
+	} else {
+		// Use streaming export with batching
+		exportSize, err = h.streamingExport(ctx, c, export, storageProvider, exportPath)
+		if err != nil {
+			return fmt.Errorf("failed to perform streaming export: %w", err)
+		}
 	}
 
 	// Update export status with results
@@ -111,12 +117,17 @@ func (h *Handler) performExport(ctx context.Context, export *v1.AuditLogExport)
 	return nil
 }
 
-func (h *Handler) streamingExport(ctx context.Context, export *v1.AuditLogExport, storageProvider auditlogexport.StorageProvider, exportPath string) (int64, error) {
+func (h *Handler) streamingExport(ctx context.Context, c kclient.Client, export *v1.AuditLogExport, storageProvider auditlogexport.StorageProvider, exportPath string) (int64, error) {
 	storageConfig, err := h.credProvider.GetStorageConfig(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get storage config: %w", err)
 	}
 
+	search, err := exportSearch(ctx, c, export)
+	if err != nil {
+		return 0, err
+	}
+
 	const batchSize = 10000 // Process 10,000 records per batch
 
 	var totalSize int64
@@ -151,6 +162,7 @@ func (h *Handler) streamingExport(ctx context.Context, export *v1.AuditLogExport
 			ResponseStatus:            export.Spec.Filters.ResponseStatuses,
 			ClientIP:                  export.Spec.Filters.ClientIPs,
 			Query:                     export.Spec.Filters.Query,
+			Search:                    search,
 			Limit:                     batchSize,
 			Offset:                    offset,
 			WithRequestAndResponse:    export.Spec.WithRequestAndResponse,
@@ -195,6 +207,70 @@ func (h *Handler) streamingExport(ctx context.Context, export *v1.AuditLogExport
 	return totalSize, nil
 }
 
+// accessReviewExport writes a snapshot of every effective grant in the system, one JSON line per grant.
+// exportSearch returns the search of the export, combined with the query of its saved search, or nil if it has none.
+// The saved search is read when the export runs, so that scheduled exports follow changes to it.
+func exportSearch(ctx context.Context, c kclient.Client, export *v1.AuditLogExport) (*auditlogquery.Query, error) {
+	search := export.Spec.Filters.Search
+	if id := export.Spec.Filters.SavedSearchID; id != "" {
+		var saved v1.MCPAuditLogSavedSearch
+		if err := c.Get(ctx, kclient.ObjectKey{Namespace: export.Namespace, Name: id}, &saved); err != nil {
+			return nil, fmt.Errorf("failed to get saved search %s: %w", id, err)
+		}
+		search = auditlogquery.Combine(saved.Spec.Manifest.Query, search)
+	}
+
+	if strings.TrimSpace(search) == "" {
+		return nil, nil
+	}
+
+	query, err := auditlogquery.Parse(search)
+	if err != nil {
+		return nil, fmt.Errorf("invalid search: %w", err)
+	}
+
+	// Exports without request bodies are created by users that can't see them, so they can't search them either.
+	if !export.Spec.WithRequestAndResponse {
+		if query = query.WithoutArgumentText(); query.UsesArguments() {
+			return nil, fmt.Errorf("only exports with request and response bodies can search the arguments of requests")
+		}
+	}
+
+	return query, nil
+}
+
+func (h *Handler) accessReviewExport(ctx context.Context, export *v1.AuditLogExport, storageProvider auditlogexport.StorageProvider, exportPath string) (int64, error) {
+	storageConfig, err := h.credProvider.GetStorageConfig(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get storage config: %w", err)
+	}
+
+	users, err := h.gatewayClient.Users(ctx, gatewaytypes.UserQuery{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	grants, err := h.acrHelper.AccessReview(ctx, export.Namespace, users)
+	if err != nil {
+		return 0, fmt.Errorf("failed to compute access review: %w", err)
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, grant := range grants {
+		if err := encoder.Encode(grant); err != nil {
+			return 0, fmt.Errorf("failed to marshal grant: %w", err)
+		}
+	}
+
+	size := int64(buf.Len())
+	if err := storageProvider.Upload(ctx, *storageConfig, export.Spec.Bucket, exportPath, &buf); err != nil {
+		return 0, fmt.Errorf("upload failed: %w", err)
+	}
+
+	return size, nil
+}
+
 func (h *Handler) formatLogs(logs []gatewaytypes.MCPAuditLog) ([]byte, error) {
 	lines := make([]string, 0, len(logs))
 
@@ -226,7 +302,11 @@ func (h *Handler) generateExportPath(export *v1.AuditLogExport) string {
 	keyPrefix := export.Spec.KeyPrefix
 	if keyPrefix == "" {
 		// Generate default prefix with year/month/day
-		keyPrefix = fmt.Sprintf("mcp-audit-logs/%04d/%02d/%02d", now.Year(), now.Month(), now.Day())
+		base := "mcp-audit-logs"
+		if export.Spec.Type == types.AuditLogExportTypeAccessReview {
+			base = "mcp-access-reviews"
+		}
+		keyPrefix = fmt.Sprintf("%s/%04d/%02d/%02d", base, now.Year(), now.Month(), now.Day())
 	}
 
 	// Ensure keyPrefix ends with / if it's not empty
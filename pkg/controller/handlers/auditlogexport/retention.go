@@ -0,0 +1,313 @@
+package auditlogexport
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/logger"
+	"github.com/obot-platform/obot/pkg/auditlogexport"
+	client "github.com/obot-platform/obot/pkg/gateway/client"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var log = logger.Package()
+
+const (
+	// retentionInterval is how often the retention policy is enforced, unless it changes.
+	retentionInterval = time.Hour
+	// archiveBatchSize is the number of audit logs that are read from the database at a time while archiving.
+	archiveBatchSize = 5000
+	// DefaultArchiveKeyPrefix is the key prefix of archives when the policy doesn't set one.
+	DefaultArchiveKeyPrefix = "mcp-audit-log-archives"
+	// DefaultRetentionScope is the scope of the audit logs that aren't covered by an override.
+	DefaultRetentionScope = "default"
+)
+
+// retentionScope is a set of audit logs with the same number of hot days.
+type retentionScope struct {
+	name    string
+	hotDays int
+	scope   client.MCPAuditLogScope
+}
+
+// EnforceRetentionPolicy archives and deletes the audit logs that are older than the policy allows, at most once per
+// retention interval, or when the policy changes.
+func (h *Handler) EnforceRetentionPolicy(req router.Request, resp router.Response) error {
+	policy := req.Object.(*v1.MCPAuditLogRetentionPolicy)
+	if policy.Name != system.MCPAuditLogRetentionPolicyName {
+		return nil
+	}
+
+	if lastRun := policy.Status.LastRunAt; lastRun != nil && policy.Status.ObservedGeneration == policy.Generation {
+		if wait := retentionInterval - time.Since(lastRun.Time); wait > 0 {
+			resp.RetryAfter(wait)
+			return nil
+		}
+	}
+
+	scopes, err := h.retentionScopes(req.Ctx, req.Client, policy)
+	var archived, deleted int64
+	if err == nil {
+		now := time.Now()
+		for _, scope := range scopes {
+			a, d, scopeErr := h.enforceRetention(req.Ctx, policy.Spec.Manifest, scope, now)
+			archived += a
+			deleted += d
+			if scopeErr != nil {
+				err = fmt.Errorf("failed to enforce retention for %s audit logs: %w", scope.name, scopeErr)
+				break
+			}
+		}
+	}
+	if err != nil {
+		log.Warnf("failed to enforce MCP audit log retention policy: %v", err)
+	} else if deleted > 0 {
+		log.Infof("Deleted %d MCP audit logs that are older than the retention policy allows, after archiving %d", deleted, archived)
+	}
+
+	policy.Status.ObservedGeneration = policy.Generation
+	policy.Status.LastRunAt = &metav1.Time{Time: time.Now()}
+	policy.Status.ArchivedLogCount = archived
+	policy.Status.DeletedLogCount = deleted
+	policy.Status.LastError = ""
+	if err != nil {
+		policy.Status.LastError = err.Error()
+	}
+
+	resp.RetryAfter(retentionInterval)
+	return req.Client.Status().Update(req.Ctx, policy)
+}
+
+// retentionScopes returns the scope of each override, and the default scope for all the other audit logs.
+func (h *Handler) retentionScopes(ctx context.Context, c kclient.Client, policy *v1.MCPAuditLogRetentionPolicy) ([]retentionScope, error) {
+	var (
+		scopes   []retentionScope
+		excluded client.MCPAuditLogScope
+		servers  v1.MCPServerList
+		entries  v1.MCPServerCatalogEntryList
+	)
+
+	for _, override := range policy.Spec.Manifest.Overrides {
+		if override.PowerUserWorkspaceID != "" {
+			scope := client.MCPAuditLogScope{PowerUserWorkspaceIDs: []string{override.PowerUserWorkspaceID}}
+			scopes = append(scopes, retentionScope{
+				name:    "powerUserWorkspace/" + override.PowerUserWorkspaceID,
+				hotDays: override.HotDays,
+				scope:   scope,
+			})
+			excluded.PowerUserWorkspaceIDs = append(excluded.PowerUserWorkspaceIDs, override.PowerUserWorkspaceID)
+			continue
+		}
+
+		if servers.Items == nil {
+			if err := c.List(ctx, &servers, kclient.InNamespace(policy.Namespace)); err != nil {
+				return nil, fmt.Errorf("failed to list MCP servers: %w", err)
+			}
+			if err := c.List(ctx, &entries, kclient.InNamespace(policy.Namespace)); err != nil {
+				return nil, fmt.Errorf("failed to list MCP server catalog entries: %w", err)
+			}
+		}
+
+		var scope client.MCPAuditLogScope
+		for _, server := range servers.Items {
+			if server.Spec.MCPCatalogID == override.MCPCatalogID || server.Status.MCPCatalogID == override.MCPCatalogID {
+				scope.MCPIDs = append(scope.MCPIDs, server.Name)
+			}
+		}
+		for _, entry := range entries.Items {
+			if entry.Spec.MCPCatalogName == override.MCPCatalogID {
+				scope.MCPServerCatalogEntryNames = append(scope.MCPServerCatalogEntryNames, entry.Name)
+			}
+		}
+		if len(scope.MCPIDs) == 0 && len(scope.MCPServerCatalogEntryNames) == 0 {
+			// An empty scope would match every audit log.
+			continue
+		}
+
+		scopes = append(scopes, retentionScope{
+			name:    "mcpCatalog/" + override.MCPCatalogID,
+			hotDays: override.HotDays,
+			scope:   scope,
+		})
+		excluded.MCPIDs = append(excluded.MCPIDs, scope.MCPIDs...)
+		excluded.MCPServerCatalogEntryNames = append(excluded.MCPServerCatalogEntryNames, scope.MCPServerCatalogEntryNames...)
+	}
+
+	return append(scopes, retentionScope{
+		name:    DefaultRetentionScope,
+		hotDays: policy.Spec.Manifest.HotDays,
+		scope:   client.MCPAuditLogScope{Exclude: &excluded},
+	}), nil
+}
+
+// enforceRetention archives, if enabled, and deletes the audit logs of the scope from the UTC days that ended more than
+// its hot days ago, one day at a time. It returns the number of archived and deleted logs.
+func (h *Handler) enforceRetention(ctx context.Context, manifest types.MCPAuditLogRetentionPolicyManifest, scope retentionScope, now time.Time) (int64, int64, error) {
+	if scope.hotDays <= 0 {
+		return 0, 0, nil
+	}
+
+	var (
+		storageConfig *types.StorageConfig
+		provider      auditlogexport.StorageProvider
+		providerType  types.StorageProviderType
+		err           error
+	)
+	if manifest.Archive {
+		storageConfig, err = h.credProvider.GetStorageConfig(ctx)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to get storage config: %w", err)
+		}
+		if storageConfig == nil {
+			return 0, 0, fmt.Errorf("no storage provider is configured for archiving")
+		}
+		if providerType, err = auditlogexport.ProviderTypeOf(*storageConfig); err != nil {
+			return 0, 0, err
+		}
+		if provider, err = auditlogexport.NewStorageProvider(providerType, h.credProvider); err != nil {
+			return 0, 0, fmt.Errorf("failed to create storage provider: %w", err)
+		}
+	}
+
+	// Only whole days are archived, so that each day of logs ends up in a single archive.
+	cutoff := now.AddDate(0, 0, -scope.hotDays).UTC().Truncate(24 * time.Hour)
+
+	var archived, deleted int64
+	for {
+		oldest, ok, err := h.gatewayClient.GetOldestMCPAuditLogTime(ctx, scope.scope, cutoff)
+		if err != nil || !ok {
+			return archived, deleted, err
+		}
+
+		start := oldest.UTC().Truncate(24 * time.Hour)
+		end := start.AddDate(0, 0, 1)
+
+		var maxID uint
+		if manifest.Archive {
+			archive, id, err := h.archiveMCPAuditLogs(ctx, manifest, *storageConfig, provider, providerType, scope, start, end)
+			if err != nil {
+				return archived, deleted, err
+			}
+			if id == 0 {
+				// Deleting without an ID limit would remove logs that weren't archived.
+				return archived, deleted, nil
+			}
+			archived += archive.LogCount
+			maxID = id
+		}
+
+		n, err := h.gatewayClient.DeleteMCPAuditLogsInRange(ctx, scope.scope, start, end, maxID)
+		deleted += n
+		if err != nil {
+			return archived, deleted, fmt.Errorf("failed to delete audit logs: %w", err)
+		}
+		if n == 0 {
+			// Nothing was deleted, so looking again would find the same audit logs.
+			return archived, deleted, nil
+		}
+	}
+}
+
+// archiveMCPAuditLogs uploads the audit logs of the scope that were created in [start, end) as gzipped JSON lines, and
+// records the archive. It returns the archive and the ID of the last archived log.
+func (h *Handler) archiveMCPAuditLogs(ctx context.Context, manifest types.MCPAuditLogRetentionPolicyManifest, storageConfig types.StorageConfig, provider auditlogexport.StorageProvider, providerType types.StorageProviderType, scope retentionScope, start, end time.Time) (*gatewaytypes.MCPAuditLogArchive, uint, error) {
+	// A day can be archived more than once, like when logs arrive late, so each archive gets its own key.
+	archive := &gatewaytypes.MCPAuditLogArchive{
+		Scope:           scope.name,
+		StartTime:       start,
+		EndTime:         end,
+		StorageProvider: string(providerType),
+		Bucket:          manifest.Bucket,
+		Key:             archiveKey(manifest.KeyPrefix, scope.name, start, end, time.Now()),
+	}
+
+	pr, pw := io.Pipe()
+	defer pr.Close()
+
+	uploadErrCh := make(chan error, 1)
+	go func() {
+		err := provider.Upload(ctx, storageConfig, manifest.Bucket, archive.Key, pr)
+		// Unblock the writer if the upload stopped reading early.
+		pr.CloseWithError(err)
+		uploadErrCh <- err
+	}()
+
+	counter := &countingWriter{w: pw}
+	gz := gzip.NewWriter(counter)
+	encoder := json.NewEncoder(gz)
+
+	maxID, err := func() (uint, error) {
+		var lastID uint
+		for {
+			logs, err := h.gatewayClient.GetMCPAuditLogsInRange(ctx, scope.scope, start, end, lastID, archiveBatchSize)
+			if err != nil {
+				return 0, fmt.Errorf("failed to get audit logs: %w", err)
+			}
+			for _, l := range logs {
+				if err := encoder.Encode(gatewaytypes.ConvertMCPAuditLog(l)); err != nil {
+					return 0, fmt.Errorf("failed to write audit log: %w", err)
+				}
+			}
+			archive.LogCount += int64(len(logs))
+			if len(logs) > 0 {
+				lastID = logs[len(logs)-1].ID
+			}
+			if len(logs) < archiveBatchSize {
+				return lastID, gz.Close()
+			}
+		}
+	}()
+	if err != nil {
+		pw.CloseWithError(err)
+		<-uploadErrCh
+		return nil, 0, err
+	}
+
+	pw.Close()
+	if err := <-uploadErrCh; err != nil {
+		return nil, 0, fmt.Errorf("failed to upload archive: %w", err)
+	}
+
+	archive.Size = counter.n
+	if err := h.gatewayClient.CreateMCPAuditLogArchive(ctx, archive); err != nil {
+		return nil, 0, fmt.Errorf("failed to record archive: %w", err)
+	}
+
+	return archive, maxID, nil
+}
+
+// archiveKey returns the key of an archive that was created at the given time, like
+// mcp-audit-log-archives/default/2026/03/20/20260320T000000Z-20260321T000000Z_20260328T010203.000000000Z.jsonl.gz.
+func archiveKey(prefix, scope string, start, end, created time.Time) string {
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		prefix = DefaultArchiveKeyPrefix
+	}
+
+	const layout = "20060102T150405Z"
+	start, end = start.UTC(), end.UTC()
+	return fmt.Sprintf("%s/%s/%s/%s-%s_%s.jsonl.gz", prefix, scope, start.Format("2006/01/02"), start.Format(layout), end.Format(layout),
+		created.UTC().Format("20060102T150405.000000000Z"))
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,20 @@
+package auditlogexport
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchiveKey(t *testing.T) {
+	start := time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+	created := time.Date(2026, 3, 28, 1, 2, 3, 4, time.UTC)
+
+	assert.Equal(t, "mcp-audit-log-archives/default/2026/03/20/20260320T000000Z-20260321T000000Z_20260328T010203.000000004Z.jsonl.gz", archiveKey("", DefaultRetentionScope, start, end, created))
+	assert.Equal(t, "audit/mcpCatalog/default/2026/03/20/20260320T000000Z-20260321T000000Z_20260328T010203.000000004Z.jsonl.gz", archiveKey("audit/", "mcpCatalog/default", start.In(time.FixedZone("PST", -8*3600)), end, created))
+
+	// Archiving the same day again doesn't overwrite the earlier archive.
+	assert.NotEqual(t, archiveKey("", DefaultRetentionScope, start, end, created), archiveKey("", DefaultRetentionScope, start, end, created.Add(time.Millisecond)))
+}
@@ -169,5 +169,11 @@ func (c *Credentials) RemoveMCPCredentials(req router.Request, _ router.Response
 		return fmt.Errorf("failed to shutdown server: %w", err)
 	}
 
+	if volume := mcpServer.Spec.Manifest.PersistentVolume; volume != nil && !mcp.PersistentVolumeRetained(volume) {
+		if err = c.mcpSessionManager.DeletePersistentVolume(req.Ctx, mcp.PersistentVolumeName(*mcpServer)); err != nil {
+			return fmt.Errorf("failed to delete persistent volume: %w", err)
+		}
+	}
+
 	return nil
 }
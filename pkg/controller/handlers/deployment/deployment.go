@@ -113,6 +113,11 @@ func (h *Handler) CleanupOldIDs(req router.Request, _ router.Response) error {
 
 // getDeploymentStatus determines the overall deployment status based on conditions
 func getDeploymentStatus(deployment *appsv1.Deployment) string {
+	if deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == 0 {
+		// The server was scaled to zero because it was idle. It will be woken up by the next request.
+		return "ScaledToZero"
+	}
+
 	for _, condition := range deployment.Status.Conditions {
 		switch condition.Type {
 		case appsv1.DeploymentAvailable:
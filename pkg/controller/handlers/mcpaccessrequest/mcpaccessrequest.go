@@ -0,0 +1,160 @@
+package mcpaccessrequest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/name"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// pendingTimeout is how long a request can wait for a decision before it expires.
+	pendingTimeout = 7 * 24 * time.Hour
+	// retention is how long a request is kept after it has been denied, revoked, or expired.
+	retention = 30 * 24 * time.Hour
+)
+
+// Expiration marks pending requests that have not been decided within the timeout, and approved requests whose
+// grant has ended, as expired.
+func Expiration(req router.Request, resp router.Response) error {
+	request := req.Object.(*v1.MCPAccessRequest)
+
+	var expiresIn time.Duration
+	switch request.Spec.State {
+	case types.MCPAccessRequestStatePending:
+		expiresIn = pendingTimeout - time.Since(request.CreationTimestamp.Time)
+	case types.MCPAccessRequestStateApproved:
+		if request.Spec.ExpiresAt == nil {
+			return nil
+		}
+		expiresIn = time.Until(request.Spec.ExpiresAt.Time)
+	default:
+		return nil
+	}
+
+	if expiresIn > 0 {
+		if expiresIn < 10*time.Hour {
+			resp.RetryAfter(expiresIn)
+		}
+		return nil
+	}
+
+	request.Spec.State = types.MCPAccessRequestStateExpired
+	return req.Client.Update(req.Ctx, request)
+}
+
+// EnsureGrant creates the AccessControlRule for approved requests and removes it once the request is no longer approved.
+func EnsureGrant(req router.Request, _ router.Response) error {
+	request := req.Object.(*v1.MCPAccessRequest)
+
+	switch request.Spec.State {
+	case types.MCPAccessRequestStateApproved:
+		return ensureAccessControlRule(req, request)
+	case types.MCPAccessRequestStateDenied, types.MCPAccessRequestStateRevoked, types.MCPAccessRequestStateExpired:
+		return removeAccessControlRule(req, request)
+	}
+
+	return nil
+}
+
+func ensureAccessControlRule(req router.Request, request *v1.MCPAccessRequest) error {
+	if request.Spec.ExpiresAt != nil && !request.Spec.ExpiresAt.After(time.Now()) {
+		// The Expiration handler will move this request to expired.
+		return nil
+	}
+
+	if request.Status.AccessControlRuleName != "" {
+		var acr v1.AccessControlRule
+		if err := req.Get(&acr, request.Namespace, request.Status.AccessControlRuleName); apierrors.IsNotFound(err) {
+			// The generated rule was deleted out from under the request, so treat the access as revoked.
+			request.Spec.State = types.MCPAccessRequestStateRevoked
+			if request.Spec.DecisionReason == "" {
+				request.Spec.DecisionReason = "access control rule was deleted"
+			}
+			return req.Client.Update(req.Ctx, request)
+		} else if err != nil {
+			return fmt.Errorf("failed to get access control rule %s: %w", request.Status.AccessControlRuleName, err)
+		}
+		return nil
+	}
+
+	acr := &v1.AccessControlRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name.SafeConcatName(system.AccessControlRulePrefix, request.Name),
+			Namespace:  request.Namespace,
+			Finalizers: []string{v1.AccessControlRuleFinalizer},
+		},
+		Spec: v1.AccessControlRuleSpec{
+			MCPCatalogID:         request.Spec.MCPCatalogID,
+			PowerUserWorkspaceID: request.Spec.PowerUserWorkspaceID,
+			Generated:            true,
+			MCPAccessRequestName: request.Name,
+			ExpiresAt:            request.Spec.ExpiresAt,
+			Manifest: types.AccessControlRuleManifest{
+				DisplayName: fmt.Sprintf("Access request %s", request.Name),
+				Subjects: []types.Subject{
+					{
+						Type: types.SubjectTypeUser,
+						ID:   request.Spec.UserID,
+					},
+				},
+				Resources: []types.Resource{request.Spec.Manifest.Resource},
+			},
+		},
+	}
+
+	if err := req.Client.Create(req.Ctx, acr); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create access control rule for access request %s: %w", request.Name, err)
+	}
+
+	request.Status.AccessControlRuleName = acr.Name
+	return req.Client.Status().Update(req.Ctx, request)
+}
+
+func removeAccessControlRule(req router.Request, request *v1.MCPAccessRequest) error {
+	if request.Status.AccessControlRuleName != "" {
+		if err := req.Delete(&v1.AccessControlRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      request.Status.AccessControlRuleName,
+				Namespace: request.Namespace,
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to delete access control rule %s: %w", request.Status.AccessControlRuleName, err)
+		}
+	}
+
+	if request.Status.EndedAt.IsZero() || request.Status.AccessControlRuleName != "" {
+		request.Status.AccessControlRuleName = ""
+		if request.Status.EndedAt.IsZero() {
+			request.Status.EndedAt = &metav1.Time{Time: time.Now()}
+		}
+		return req.Client.Status().Update(req.Ctx, request)
+	}
+
+	return nil
+}
+
+// Cleanup deletes requests that were denied, revoked, or expired more than 30 days ago.
+func Cleanup(req router.Request, resp router.Response) error {
+	request := req.Object.(*v1.MCPAccessRequest)
+
+	if request.Status.EndedAt.IsZero() {
+		return nil
+	}
+
+	if time.Since(request.Status.EndedAt.Time) > retention {
+		return req.Client.Delete(req.Ctx, request)
+	}
+
+	if cleanupIn := retention - time.Since(request.Status.EndedAt.Time); cleanupIn < 10*time.Hour {
+		resp.RetryAfter(cleanupIn)
+	}
+
+	return nil
+}
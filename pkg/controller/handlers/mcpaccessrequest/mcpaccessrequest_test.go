@@ -0,0 +1,180 @@
+package mcpaccessrequest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/storage/scheme"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type response struct {
+	retryAfter time.Duration
+}
+
+func (r *response) Attributes() map[string]any {
+	return nil
+}
+
+func (r *response) RetryAfter(delay time.Duration) {
+	r.retryAfter = delay
+}
+
+func newRequest(t *testing.T, request *v1.MCPAccessRequest, objs ...kclient.Object) router.Request {
+	t.Helper()
+
+	client := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(append(objs, request)...).
+		WithStatusSubresource(&v1.MCPAccessRequest{}).
+		Build()
+
+	// Handlers get the stored object, with its resource version.
+	require.NoError(t, client.Get(context.Background(), kclient.ObjectKeyFromObject(request), request))
+	return router.Request{
+		Client:    client,
+		Object:    request,
+		Ctx:       context.Background(),
+		Namespace: request.Namespace,
+		Name:      request.Name,
+	}
+}
+
+func accessRequest(state types.MCPAccessRequestState) *v1.MCPAccessRequest {
+	return &v1.MCPAccessRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "mar1",
+			Namespace:         system.DefaultNamespace,
+			CreationTimestamp: metav1.Now(),
+		},
+		Spec: v1.MCPAccessRequestSpec{
+			Manifest: types.MCPAccessRequestManifest{
+				Resource: types.Resource{Type: types.ResourceTypeMCPServer, ID: "ms1"},
+			},
+			UserID:       "u1",
+			MCPCatalogID: system.DefaultCatalog,
+			State:        state,
+		},
+	}
+}
+
+func getRequest(t *testing.T, req router.Request) *v1.MCPAccessRequest {
+	t.Helper()
+	var request v1.MCPAccessRequest
+	require.NoError(t, req.Get(&request, req.Namespace, req.Name))
+	return &request
+}
+
+func TestExpiration(t *testing.T) {
+	// A pending request that was never decided expires.
+	stale := accessRequest(types.MCPAccessRequestStatePending)
+	stale.CreationTimestamp = metav1.NewTime(time.Now().Add(-pendingTimeout - time.Minute))
+	req := newRequest(t, stale)
+	require.NoError(t, Expiration(req, &response{}))
+	assert.Equal(t, types.MCPAccessRequestStateExpired, getRequest(t, req).Spec.State)
+
+	// A new pending request is left alone, and checked again closer to its timeout.
+	resp := &response{}
+	req = newRequest(t, accessRequest(types.MCPAccessRequestStatePending))
+	require.NoError(t, Expiration(req, resp))
+	assert.Equal(t, types.MCPAccessRequestStatePending, getRequest(t, req).Spec.State)
+	assert.Zero(t, resp.retryAfter)
+
+	// An approved request expires when its grant ends.
+	ended := accessRequest(types.MCPAccessRequestStateApproved)
+	ended.Spec.ExpiresAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	req = newRequest(t, ended)
+	require.NoError(t, Expiration(req, &response{}))
+	assert.Equal(t, types.MCPAccessRequestStateExpired, getRequest(t, req).Spec.State)
+
+	// A grant that ends soon is checked again when it ends.
+	resp = &response{}
+	ending := accessRequest(types.MCPAccessRequestStateApproved)
+	ending.Spec.ExpiresAt = &metav1.Time{Time: time.Now().Add(time.Hour)}
+	req = newRequest(t, ending)
+	require.NoError(t, Expiration(req, resp))
+	assert.Equal(t, types.MCPAccessRequestStateApproved, getRequest(t, req).Spec.State)
+	assert.InDelta(t, time.Hour, resp.retryAfter, float64(time.Minute))
+
+	// Requests that were decided don't expire.
+	req = newRequest(t, accessRequest(types.MCPAccessRequestStateDenied))
+	require.NoError(t, Expiration(req, &response{}))
+	assert.Equal(t, types.MCPAccessRequestStateDenied, getRequest(t, req).Spec.State)
+}
+
+func TestEnsureGrant(t *testing.T) {
+	approved := accessRequest(types.MCPAccessRequestStateApproved)
+	approved.Spec.ExpiresAt = &metav1.Time{Time: time.Now().Add(time.Hour).Truncate(time.Second)}
+	req := newRequest(t, approved)
+
+	// Approving a request creates a rule that grants the user the resource until the request expires.
+	require.NoError(t, EnsureGrant(req, &response{}))
+	request := getRequest(t, req)
+	require.NotEmpty(t, request.Status.AccessControlRuleName)
+
+	var acr v1.AccessControlRule
+	require.NoError(t, req.Get(&acr, request.Namespace, request.Status.AccessControlRuleName))
+	assert.True(t, acr.Spec.Generated)
+	assert.Equal(t, "mar1", acr.Spec.MCPAccessRequestName)
+	assert.Equal(t, system.DefaultCatalog, acr.Spec.MCPCatalogID)
+	assert.True(t, approved.Spec.ExpiresAt.Equal(acr.Spec.ExpiresAt))
+	assert.Equal(t, []types.Subject{{Type: types.SubjectTypeUser, ID: "u1"}}, acr.Spec.Manifest.Subjects)
+	assert.Equal(t, []types.Resource{{Type: types.ResourceTypeMCPServer, ID: "ms1"}}, acr.Spec.Manifest.Resources)
+
+	// Revoking the request removes the rule and records when the access ended.
+	request.Spec.State = types.MCPAccessRequestStateRevoked
+	require.NoError(t, req.Client.Update(req.Ctx, request))
+	req.Object = request
+	require.NoError(t, EnsureGrant(req, &response{}))
+
+	request = getRequest(t, req)
+	assert.Empty(t, request.Status.AccessControlRuleName)
+	assert.False(t, request.Status.EndedAt.IsZero())
+	// The rule has a finalizer, so it is only marked for deletion here.
+	require.NoError(t, req.Get(&acr, request.Namespace, acr.Name))
+	assert.False(t, acr.DeletionTimestamp.IsZero())
+
+	// If the generated rule is deleted, the access is revoked.
+	deleted := accessRequest(types.MCPAccessRequestStateApproved)
+	deleted.Spec.ExpiresAt = &metav1.Time{Time: time.Now().Add(time.Hour)}
+	deleted.Status.AccessControlRuleName = "acr1deleted"
+	req = newRequest(t, deleted)
+	require.NoError(t, EnsureGrant(req, &response{}))
+	request = getRequest(t, req)
+	assert.Equal(t, types.MCPAccessRequestStateRevoked, request.Spec.State)
+	assert.Equal(t, "access control rule was deleted", request.Spec.DecisionReason)
+}
+
+func TestCleanup(t *testing.T) {
+	// Requests that haven't ended are kept.
+	req := newRequest(t, accessRequest(types.MCPAccessRequestStatePending))
+	require.NoError(t, Cleanup(req, &response{}))
+	getRequest(t, req)
+
+	// Requests that ended recently are kept, and checked again when their retention is almost over.
+	resp := &response{}
+	recent := accessRequest(types.MCPAccessRequestStateExpired)
+	recent.Status.EndedAt = &metav1.Time{Time: time.Now().Add(-retention + time.Hour)}
+	req = newRequest(t, recent)
+	require.NoError(t, Cleanup(req, resp))
+	getRequest(t, req)
+	assert.InDelta(t, time.Hour, resp.retryAfter, float64(time.Minute))
+
+	// Requests that ended before the retention are deleted.
+	old := accessRequest(types.MCPAccessRequestStateDenied)
+	old.Status.EndedAt = &metav1.Time{Time: time.Now().Add(-retention - time.Hour)}
+	req = newRequest(t, old)
+	require.NoError(t, Cleanup(req, &response{}))
+	var request v1.MCPAccessRequest
+	assert.True(t, apierrors.IsNotFound(req.Get(&request, req.Namespace, req.Name)))
+}
@@ -0,0 +1,130 @@
+package mcpcatalog
+
+import (
+	"cmp"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+const (
+	gitHTTPSPrefix = "git+https://"
+	gitSSHPrefix   = "git+ssh://"
+)
+
+// isGitURL returns true if the source URL should be cloned with Git. Git sources use the git+https:// or git+ssh:// scheme,
+// and can select a branch or tag with a URL fragment, for example git+ssh://git@example.com/org/catalog.git#v1.
+func isGitURL(sourceURL string) bool {
+	return strings.HasPrefix(sourceURL, gitHTTPSPrefix) || strings.HasPrefix(sourceURL, gitSSHPrefix)
+}
+
+func readGitCatalog(source catalogSource) ([]types.MCPServerCatalogEntryManifest, error) {
+	u, err := url.Parse(strings.TrimPrefix(source.URL, "git+"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Git URL: %w", err)
+	}
+
+	ref := u.Fragment
+	u.Fragment = ""
+
+	cloneOptions := &git.CloneOptions{
+		URL:   u.String(),
+		Depth: 1,
+	}
+
+	if ref != "" {
+		if err := validateBranchName(ref); err != nil {
+			return nil, fmt.Errorf("invalid Git reference: %w", err)
+		}
+		if strings.HasPrefix(ref, "refs/") {
+			cloneOptions.ReferenceName = plumbing.ReferenceName(ref)
+		} else {
+			cloneOptions.ReferenceName = plumbing.NewBranchReferenceName(ref)
+		}
+		cloneOptions.SingleBranch = true
+	}
+
+	tempDir, err := os.MkdirTemp("", "catalog-clone-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	cloneOptions.Auth, err = gitAuth(u, source.Credentials, tempDir)
+	if err != nil {
+		return nil, err
+	}
+
+	repoDir := filepath.Join(tempDir, "repo")
+	if _, err = git.PlainClone(repoDir, false, cloneOptions); err != nil {
+		if ref == "" || strings.HasPrefix(ref, "refs/") {
+			return nil, fmt.Errorf("failed to clone repository: %w", err)
+		}
+
+		// The reference might be a tag instead of a branch.
+		cloneOptions.ReferenceName = plumbing.NewTagReferenceName(ref)
+		if err := os.RemoveAll(repoDir); err != nil {
+			return nil, fmt.Errorf("failed to clean up failed clone: %w", err)
+		}
+		if _, tagErr := git.PlainClone(repoDir, false, cloneOptions); tagErr != nil {
+			return nil, fmt.Errorf("failed to clone repository as branch %s: %w, or as tag %s: %w", ref, err, ref, tagErr)
+		}
+	}
+
+	return readMCPCatalogDirectory(repoDir, source.PublicKey)
+}
+
+func gitAuth(u *url.URL, creds *types.MCPCatalogSourceCredentials, tempDir string) (transport.AuthMethod, error) {
+	if creds == nil {
+		return nil, nil
+	}
+
+	if u.Scheme == "ssh" {
+		if creds.SSHPrivateKey == "" {
+			return nil, nil
+		}
+
+		user := u.User.Username()
+		if user == "" {
+			user = "git"
+		}
+
+		auth, err := gitssh.NewPublicKeys(user, []byte(creds.SSHPrivateKey), "")
+		if err != nil {
+			return nil, fmt.Errorf("invalid SSH private key: %w", err)
+		}
+
+		if creds.SSHKnownHosts != "" {
+			knownHostsFile := filepath.Join(tempDir, "known_hosts")
+			if err := os.WriteFile(knownHostsFile, []byte(creds.SSHKnownHosts), 0600); err != nil {
+				return nil, fmt.Errorf("failed to write known hosts: %w", err)
+			}
+
+			auth.HostKeyCallback, err = gitssh.NewKnownHostsCallback(knownHostsFile)
+			if err != nil {
+				return nil, fmt.Errorf("invalid SSH known hosts: %w", err)
+			}
+		}
+
+		return auth, nil
+	}
+
+	if creds.Token == "" {
+		return nil, nil
+	}
+
+	return &githttp.BasicAuth{
+		// The username is ignored by most Git hosts when using a token, but it is required to be non-empty.
+		Username: cmp.Or(creds.Username, "obot"),
+		Password: creds.Token,
+	}, nil
+}
@@ -30,7 +30,7 @@ func isGitHubURL(catalogURL string) bool {
 }
 
 // checkRepoSize checks the repository size using GitHub API before cloning
-func checkRepoSize(org, repo string, maxSizeMB int) error {
+func checkRepoSize(org, repo, token string, maxSizeMB int) error {
 	if org == "obot-platform" {
 		return nil
 	}
@@ -49,8 +49,8 @@ func checkRepoSize(org, repo string, maxSizeMB int) error {
 	}
 
 	// Add authentication if token is available
-	if githubToken != "" {
-		req.Header.Set("Authorization", "Bearer "+githubToken)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
 	}
 
 	// Make the request
@@ -131,7 +131,7 @@ func isPathSafe(path, baseDir string) error {
 	return nil
 }
 
-func readGitHubCatalog(catalogURL string) ([]types.MCPServerCatalogEntryManifest, error) {
+func readGitHubCatalog(catalogURL string, source catalogSource) ([]types.MCPServerCatalogEntryManifest, error) {
 	// Make sure we don't use plain HTTP
 	if strings.HasPrefix(catalogURL, "http://") {
 		return nil, fmt.Errorf("only HTTPS is supported for GitHub catalogs")
@@ -167,9 +167,15 @@ func readGitHubCatalog(catalogURL string) ([]types.MCPServerCatalogEntryManifest
 		}
 	}
 
+	// Use the credentials configured for this source if there are any, otherwise fall back to the global token
+	token := githubToken
+	if source.Credentials != nil && source.Credentials.Token != "" {
+		token = source.Credentials.Token
+	}
+
 	// Check repository size before cloning (limit to 100 MB)
 	const maxRepoSizeMB = 100
-	if err := checkRepoSize(org, repo, maxRepoSizeMB); err != nil {
+	if err := checkRepoSize(org, repo, token, maxRepoSizeMB); err != nil {
 		return nil, fmt.Errorf("repository size check failed: %w", err)
 	}
 
@@ -191,10 +197,10 @@ func readGitHubCatalog(catalogURL string) ([]types.MCPServerCatalogEntryManifest
 	}
 
 	// Set up git credentials if token is available
-	if githubToken != "" {
+	if token != "" {
 		cloneOptions.Auth = &githttp.BasicAuth{
 			Username: "obot", // Use a dummy username. The username is ignored, but required to be non-empty.
-			Password: githubToken,
+			Password: token,
 		}
 	}
 
@@ -204,5 +210,5 @@ func readGitHubCatalog(catalogURL string) ([]types.MCPServerCatalogEntryManifest
 		return nil, fmt.Errorf("failed to clone repository: %w", err)
 	}
 
-	return readMCPCatalogDirectory(tempDir)
+	return readMCPCatalogDirectory(tempDir, source.PublicKey)
 }
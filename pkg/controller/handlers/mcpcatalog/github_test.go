@@ -41,7 +41,7 @@ func TestReadGitHubCatalog(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			entries, err := readGitHubCatalog(tt.catalog)
+			entries, err := readGitHubCatalog(tt.catalog, catalogSource{URL: tt.catalog})
 			if tt.wantErr {
 				assert.Error(t, err)
 				return
@@ -3,6 +3,8 @@ package mcpcatalog
 import (
 	"bufio"
 	"context"
+	"crypto"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io"
@@ -14,6 +16,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/gptscript-ai/go-gptscript"
 	"github.com/obot-platform/nah/pkg/apply"
 	"github.com/obot-platform/nah/pkg/name"
 	"github.com/obot-platform/nah/pkg/router"
@@ -21,6 +24,7 @@ import (
 	"github.com/obot-platform/obot/logger"
 	"github.com/obot-platform/obot/pkg/accesscontrolrule"
 	gclient "github.com/obot-platform/obot/pkg/gateway/client"
+	"github.com/obot-platform/obot/pkg/oci"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	"github.com/obot-platform/obot/pkg/validation"
@@ -43,18 +47,67 @@ const (
 
 type Handler struct {
 	defaultCatalogPath      string
+	gptClient               *gptscript.GPTScript
 	gatewayClient           *gclient.Client
 	accessControlRuleHelper *accesscontrolrule.Helper
 }
 
-func New(defaultCatalogPath string, gatewayClient *gclient.Client, accessControlRuleHelper *accesscontrolrule.Helper) *Handler {
+func New(defaultCatalogPath string, gptClient *gptscript.GPTScript, gatewayClient *gclient.Client, accessControlRuleHelper *accesscontrolrule.Helper) *Handler {
 	return &Handler{
 		defaultCatalogPath:      defaultCatalogPath,
+		gptClient:               gptClient,
 		gatewayClient:           gatewayClient,
 		accessControlRuleHelper: accessControlRuleHelper,
 	}
 }
 
+// catalogSource is a source URL along with its credentials and the public key used to verify its signature, if any.
+type catalogSource struct {
+	URL         string
+	Credentials *types.MCPCatalogSourceCredentials
+	PublicKey   crypto.PublicKey
+	// Registry holds the curation rules for upstream MCP registry sources.
+	Registry *types.MCPRegistrySourceConfig
+}
+
+// SourceCredentialContext returns the credential context and name used to store the credentials for a catalog source URL.
+func SourceCredentialContext(catalogName, sourceURL string) (string, string) {
+	return catalogName + "-source-credentials", fmt.Sprintf("%x", sha256.Sum256([]byte(sourceURL)))
+}
+
+func (h *Handler) catalogSource(ctx context.Context, catalog *v1.MCPCatalog, sourceURL string) (catalogSource, error) {
+	config := catalog.Spec.SourceConfigs[sourceURL]
+	source := catalogSource{
+		URL:      sourceURL,
+		Registry: config.Registry,
+	}
+
+	if config.PublicKey != "" {
+		var err error
+		if source.PublicKey, err = oci.ParsePublicKey(config.PublicKey); err != nil {
+			return source, err
+		}
+	}
+
+	if config.HasCredentials {
+		credCtx, credName := SourceCredentialContext(catalog.Name, sourceURL)
+		cred, err := h.gptClient.RevealCredential(ctx, []string{credCtx}, credName)
+		if err != nil {
+			return source, fmt.Errorf("failed to get credentials: %w", err)
+		}
+
+		source.Credentials = &types.MCPCatalogSourceCredentials{
+			SourceURL:     sourceURL,
+			Username:      cred.Env["username"],
+			Token:         cred.Env["token"],
+			SSHPrivateKey: cred.Env["ssh_private_key"],
+			SSHKnownHosts: cred.Env["ssh_known_hosts"],
+		}
+	}
+
+	return source, nil
+}
+
 func (h *Handler) Sync(req router.Request, resp router.Response) error {
 	mcpCatalog := req.Object.(*v1.MCPCatalog)
 
@@ -90,7 +143,14 @@ func (h *Handler) Sync(req router.Request, resp router.Response) error {
 	mcpCatalog.Status.SyncErrors = make(map[string]string)
 
 	for _, sourceURL := range mcpCatalog.Spec.SourceURLs {
-		objs, err := h.readMCPCatalog(mcpCatalog.Name, sourceURL)
+		source, err := h.catalogSource(req.Ctx, mcpCatalog, sourceURL)
+		if err != nil {
+			log.Errorf("failed to configure catalog source %s: %v", sourceURL, err)
+			mcpCatalog.Status.SyncErrors[sourceURL] = err.Error()
+			continue
+		}
+
+		objs, err := h.readMCPCatalog(mcpCatalog.Name, source)
 		if err != nil {
 			log.Errorf("failed to read catalog %s: %v", sourceURL, err)
 			mcpCatalog.Status.SyncErrors[sourceURL] = err.Error()
@@ -134,31 +194,56 @@ func (h *Handler) Sync(req router.Request, resp router.Response) error {
 	return app.Apply(req.Ctx, mcpCatalog, toAdd...)
 }
 
-func (h *Handler) readMCPCatalog(catalogName, sourceURL string) ([]client.Object, error) {
-	var entries []types.MCPServerCatalogEntryManifest
+func (h *Handler) readMCPCatalog(catalogName string, source catalogSource) ([]client.Object, error) {
+	var (
+		entries   []types.MCPServerCatalogEntryManifest
+		sourceURL = source.URL
+		errs      []error
+	)
 
-	if strings.HasPrefix(sourceURL, "http://") || strings.HasPrefix(sourceURL, "https://") {
+	if strings.HasPrefix(sourceURL, ociPrefix) {
+		var err error
+		entries, err = readOCICatalog(source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read OCI catalog %s: %w", sourceURL, err)
+		}
+	} else if isRegistryURL(sourceURL) {
+		var err error
+		entries, err = readRegistryCatalog(source)
+		if errors.Is(err, errRegistryTruncated) {
+			// Import the entries that were read, but report the error so that the others aren't pruned.
+			errs = append(errs, fmt.Errorf("failed to read all of registry catalog %s: %w", sourceURL, err))
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read registry catalog %s: %w", sourceURL, err)
+		}
+	} else if isGitURL(sourceURL) {
+		var err error
+		entries, err = readGitCatalog(source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Git catalog %s: %w", sourceURL, err)
+		}
+	} else if strings.HasPrefix(sourceURL, "http://") || strings.HasPrefix(sourceURL, "https://") {
 		if isGitHubURL(sourceURL) {
 			var err error
-			entries, err = readGitHubCatalog(sourceURL)
+			entries, err = readGitHubCatalog(sourceURL, source)
 			if err != nil {
 				return nil, fmt.Errorf("failed to read GitHub catalog %s: %w", sourceURL, err)
 			}
 		} else {
 			// If it wasn't a GitHub repo, treat it as a raw file.
-			resp, err := http.Get(sourceURL)
+			contents, err := httpGetCatalogFile(sourceURL, source.Credentials)
 			if err != nil {
 				return nil, fmt.Errorf("failed to read catalog %s: %w", sourceURL, err)
 			}
-			defer resp.Body.Close()
 
-			contents, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return nil, fmt.Errorf("failed to read catalog %s: %w", sourceURL, err)
-			}
-
-			if resp.StatusCode != http.StatusOK {
-				return nil, fmt.Errorf("unexpected status when reading catalog %s: %s", sourceURL, string(contents))
+			if source.PublicKey != nil {
+				signature, err := httpGetCatalogFile(sourceURL+signatureSuffix, source.Credentials)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read signature for catalog %s: %w", sourceURL, err)
+				}
+				if err := oci.VerifySignature(source.PublicKey, contents, signature); err != nil {
+					return nil, fmt.Errorf("failed to verify catalog %s: %w", sourceURL, err)
+				}
 			}
 
 			if err = yaml.Unmarshal(contents, &entries); err != nil {
@@ -172,7 +257,7 @@ func (h *Handler) readMCPCatalog(catalogName, sourceURL string) ([]client.Object
 		}
 
 		if fileInfo.IsDir() {
-			entries, err = readMCPCatalogDirectory(sourceURL)
+			entries, err = readMCPCatalogDirectory(sourceURL, source.PublicKey)
 			if err != nil {
 				return nil, fmt.Errorf("failed to read catalog %s: %w", sourceURL, err)
 			}
@@ -182,6 +267,16 @@ func (h *Handler) readMCPCatalog(catalogName, sourceURL string) ([]client.Object
 				return nil, fmt.Errorf("failed to read catalog %s: %w", sourceURL, err)
 			}
 
+			if source.PublicKey != nil {
+				signature, err := os.ReadFile(sourceURL + signatureSuffix)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read signature for catalog %s: %w", sourceURL, err)
+				}
+				if err := oci.VerifySignature(source.PublicKey, contents, signature); err != nil {
+					return nil, fmt.Errorf("failed to verify catalog %s: %w", sourceURL, err)
+				}
+			}
+
 			if err = yaml.Unmarshal(contents, &entries); err != nil {
 				return nil, fmt.Errorf("failed to decode catalog %s: %w", sourceURL, err)
 			}
@@ -189,7 +284,6 @@ func (h *Handler) readMCPCatalog(catalogName, sourceURL string) ([]client.Object
 	}
 
 	objs := make([]client.Object, 0, len(entries))
-	var errs []error
 	for _, entry := range entries {
 		if entry.Metadata["categories"] == "Official" {
 			delete(entry.Metadata, "categories") // This shouldn't happen, but do this just in case.
@@ -255,7 +349,17 @@ func (h *Handler) readMCPCatalog(catalogName, sourceURL string) ([]client.Object
 	return objs, errors.Join(errs...)
 }
 
-func readMCPCatalogDirectory(catalog string) ([]types.MCPServerCatalogEntryManifest, error) {
+// readMCPCatalogDirectory reads the catalog entries from the files in a directory. If publicKey is set, the directory
+// must contain a signed checksums file, and every catalog file must match its checksum.
+func readMCPCatalogDirectory(catalog string, publicKey crypto.PublicKey) ([]types.MCPServerCatalogEntryManifest, error) {
+	var checksums map[string]string
+	if publicKey != nil {
+		var err error
+		if checksums, err = readSignedChecksums(catalog, publicKey); err != nil {
+			return nil, err
+		}
+	}
+
 	var (
 		catalogPatterns       = []string{"*.json", "*.yaml", "*.yml"} // Default to all JSON and YAML files
 		ignorePatterns        []string
@@ -371,6 +475,13 @@ func readMCPCatalogDirectory(catalog string) ([]types.MCPServerCatalogEntryManif
 			return nil
 		}
 
+		// Verify the file hasn't been tampered with
+		if checksums != nil {
+			if err := verifyChecksum(checksums, relPath, content); err != nil {
+				return err
+			}
+		}
+
 		// Try to unmarshal as array first
 		var fileEntries []types.MCPServerCatalogEntryManifest
 		if err := yaml.Unmarshal(content, &fileEntries); err != nil {
@@ -398,6 +509,35 @@ func readMCPCatalogDirectory(catalog string) ([]types.MCPServerCatalogEntryManif
 	return entries, nil
 }
 
+// httpGetCatalogFile downloads a catalog file, using the token from the credentials as a bearer token if there is one.
+func httpGetCatalogFile(fileURL string, creds *types.MCPCatalogSourceCredentials) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, fileURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if creds != nil && creds.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+creds.Token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	contents, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(contents))
+	}
+
+	return contents, nil
+}
+
 func (h *Handler) SetUpDefaultMCPCatalog(ctx context.Context, c client.Client) error {
 	var existing v1.MCPCatalog
 	if err := c.Get(ctx, router.Key(system.DefaultNamespace, system.DefaultCatalog), &existing); err == nil {
@@ -0,0 +1,144 @@
+package mcpcatalog
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/oci"
+)
+
+const (
+	ociPrefix = "oci://"
+
+	// maxOCIArtifactSize is the limit for the total size of an OCI catalog artifact.
+	maxOCIArtifactSize = 100 * 1024 * 1024
+
+	ociTitleAnnotation   = "org.opencontainers.image.title"
+	orasUnpackAnnotation = "io.deis.oras.content.unpack"
+)
+
+// parseOCIReference parses a reference like oci://registry.example.com/org/catalog:v1 or
+// oci://registry.example.com/org/catalog@sha256:... The tag defaults to latest.
+func parseOCIReference(sourceURL string) (oci.Reference, error) {
+	ref, err := oci.ParseReference(strings.TrimPrefix(sourceURL, ociPrefix))
+	if err != nil {
+		return oci.Reference{}, fmt.Errorf("invalid OCI reference %s, expected oci://registry/repository:tag", sourceURL)
+	}
+	return ref, nil
+}
+
+// readOCICatalog pulls a catalog that was pushed to an OCI registry as an artifact, for example with `oras push`.
+// Each layer is either a single catalog file or a tar archive of a catalog directory.
+func readOCICatalog(source catalogSource) ([]types.MCPServerCatalogEntryManifest, error) {
+	ref, err := parseOCIReference(source.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	var username, password string
+	if source.Credentials != nil {
+		username, password = source.Credentials.Username, source.Credentials.Token
+	}
+	c := oci.NewClient(ref, username, password)
+
+	manifest, err := c.GetManifest(ref.Reference)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get manifest: %w", err)
+	}
+
+	var total int64
+	for _, layer := range manifest.Layers {
+		total += layer.Size
+	}
+	if total > maxOCIArtifactSize {
+		return nil, fmt.Errorf("artifact is too large: %d bytes (limit: %d bytes)", total, maxOCIArtifactSize)
+	}
+
+	tempDir, err := os.MkdirTemp("", "catalog-oci-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	for _, layer := range manifest.Layers {
+		blob, err := c.GetBlob(layer, maxOCIArtifactSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get layer %s: %w", layer.Digest, err)
+		}
+
+		title := filepath.Base(layer.Annotations[ociTitleAnnotation])
+		if layer.Annotations[orasUnpackAnnotation] == "true" || (title == "." && strings.HasSuffix(layer.MediaType, "tar+gzip")) {
+			if err := extractTarGz(blob, tempDir); err != nil {
+				return nil, fmt.Errorf("failed to extract layer %s: %w", layer.Digest, err)
+			}
+			continue
+		}
+
+		if title == "." || title == "/" {
+			title = strings.ReplaceAll(layer.Digest, ":", "-") + ".yaml"
+		}
+		if err := os.WriteFile(filepath.Join(tempDir, title), blob, 0600); err != nil {
+			return nil, fmt.Errorf("failed to write layer %s: %w", layer.Digest, err)
+		}
+	}
+
+	return readMCPCatalogDirectory(tempDir, source.PublicKey)
+}
+
+// extractTarGz extracts the regular files in a gzipped tar archive into dir, skipping anything that would escape it.
+func extractTarGz(data []byte, dir string) error {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	var extracted int64
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		target := filepath.Join(dir, filepath.Clean(header.Name))
+		if !strings.HasPrefix(target, filepath.Clean(dir)+string(filepath.Separator)) {
+			log.Warnf("Skipping unsafe file in OCI catalog artifact: %s", header.Name)
+			continue
+		}
+
+		extracted += header.Size
+		if extracted > maxOCIArtifactSize {
+			return fmt.Errorf("extracted artifact is larger than %d bytes", maxOCIArtifactSize)
+		}
+
+		if err := os.MkdirAll(filepath.Dir(target), 0700); err != nil {
+			return err
+		}
+
+		f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(f, io.LimitReader(tr, header.Size)); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+}
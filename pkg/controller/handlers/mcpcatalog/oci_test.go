@@ -0,0 +1,56 @@
+package mcpcatalog
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/pkg/oci"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOCIReference(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		want    oci.Reference
+		wantErr bool
+	}{
+		{
+			name: "tag",
+			url:  "oci://ghcr.io/org/catalog:v1",
+			want: oci.Reference{Registry: "ghcr.io", Repository: "org/catalog", Reference: "v1"},
+		},
+		{
+			name: "default tag",
+			url:  "oci://registry.example.com:5000/catalog",
+			want: oci.Reference{Registry: "registry.example.com:5000", Repository: "catalog", Reference: "latest"},
+		},
+		{
+			name: "digest",
+			url:  "oci://ghcr.io/org/catalog@sha256:abc",
+			want: oci.Reference{Registry: "ghcr.io", Repository: "org/catalog", Reference: "sha256:abc"},
+		},
+		{
+			name:    "missing repository",
+			url:     "oci://ghcr.io",
+			wantErr: true,
+		},
+		{
+			name:    "path traversal",
+			url:     "oci://ghcr.io/../catalog",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, err := parseOCIReference(tt.url)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, ref)
+		})
+	}
+}
@@ -0,0 +1,327 @@
+package mcpcatalog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/validation"
+)
+
+const (
+	registryPrefix = "registry+"
+
+	// maxRegistryPages limits how many pages are read from an upstream registry in a single sync.
+	maxRegistryPages = 100
+	registryPageSize = 100
+
+	// registryNameMetadataKey and registryVersionMetadataKey record where an imported entry came from.
+	registryNameMetadataKey    = "registryName"
+	registryVersionMetadataKey = "registryVersion"
+)
+
+// errRegistryTruncated is returned with the entries that were read when a registry has more pages than are read in a
+// single sync. The servers on the pages that weren't read are missing from the entries, so they must not be pruned.
+var errRegistryTruncated = errors.New("the registry has more than " + strconv.Itoa(maxRegistryPages*registryPageSize) + " servers, and only the first ones were read")
+
+// isRegistryURL returns true if the source URL is an upstream MCP registry, for example
+// registry+https://registry.modelcontextprotocol.io. Servers are read from its /v0.1/servers endpoint.
+func isRegistryURL(sourceURL string) bool {
+	return strings.HasPrefix(sourceURL, registryPrefix+"https://")
+}
+
+// readRegistryCatalog reads the latest version of every server in an upstream MCP registry that passes the source's
+// curation rules, and converts them to catalog entries. Servers that can't be run by Obot are skipped. If the registry
+// has more than maxRegistryPages pages, the entries that were read are returned with errRegistryTruncated.
+func readRegistryCatalog(source catalogSource) ([]types.MCPServerCatalogEntryManifest, error) {
+	if source.PublicKey != nil {
+		return nil, fmt.Errorf("signature verification is not supported for registry sources")
+	}
+
+	base, err := url.Parse(strings.TrimPrefix(source.URL, registryPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("invalid registry URL: %w", err)
+	}
+	base.Path = strings.TrimSuffix(base.Path, "/") + "/v0.1/servers"
+
+	var (
+		client    = &http.Client{Timeout: 30 * time.Second}
+		cursor    string
+		servers   = make(map[string]types.RegistryServerResponse)
+		order     []string
+		truncated = true
+	)
+	for range maxRegistryPages {
+		q := url.Values{}
+		q.Set("limit", strconv.Itoa(registryPageSize))
+		q.Set("version", "latest")
+		if cursor != "" {
+			q.Set("cursor", cursor)
+		}
+		base.RawQuery = q.Encode()
+
+		page, err := getRegistryPage(client, base.String(), source.Credentials)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, server := range page.Servers {
+			if server.Meta.Official.Status == "deleted" || !registryServerAllowed(server.Server.Name, source.Registry) {
+				continue
+			}
+
+			// Keep one version of each server, preferring the one marked as the latest.
+			existing, ok := servers[server.Server.Name]
+			if !ok {
+				order = append(order, server.Server.Name)
+			}
+			if !ok || (server.Meta.Official.IsLatest && !existing.Meta.Official.IsLatest) {
+				servers[server.Server.Name] = server
+			}
+		}
+
+		if page.Metadata == nil || page.Metadata.NextCursor == "" || len(page.Servers) == 0 {
+			truncated = false
+			break
+		}
+		cursor = page.Metadata.NextCursor
+	}
+
+	entries := make([]types.MCPServerCatalogEntryManifest, 0, len(servers))
+	seenNames := make(map[string]struct{}, len(servers))
+	for _, serverName := range order {
+		manifest, err := registryServerToManifest(servers[serverName].Server)
+		if err == nil {
+			err = validation.ValidateCatalogEntryManifest(manifest)
+		}
+		if err != nil {
+			// One server that can't be imported shouldn't fail the whole sync.
+			log.Debugf("Skipping server %s from registry %s: %v", serverName, source.URL, err)
+			continue
+		}
+
+		// Catalog entries are named after the manifest name, so make sure they are unique.
+		cleanName := strings.ToLower(strings.ReplaceAll(manifest.Name, " ", "-"))
+		if _, ok := seenNames[cleanName]; ok {
+			manifest.Name = serverName
+			cleanName = strings.ToLower(strings.ReplaceAll(manifest.Name, " ", "-"))
+		}
+		seenNames[cleanName] = struct{}{}
+
+		entries = append(entries, manifest)
+	}
+
+	if truncated {
+		return entries, errRegistryTruncated
+	}
+	return entries, nil
+}
+
+func getRegistryPage(client *http.Client, pageURL string, creds *types.MCPCatalogSourceCredentials) (types.RegistryServerList, error) {
+	var page types.RegistryServerList
+
+	req, err := http.NewRequest(http.MethodGet, pageURL, nil)
+	if err != nil {
+		return page, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if creds != nil && creds.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+creds.Token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return page, fmt.Errorf("failed to list registry servers: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return page, fmt.Errorf("registry returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 10*1024*1024)).Decode(&page); err != nil {
+		return page, fmt.Errorf("failed to decode registry servers: %w", err)
+	}
+
+	return page, nil
+}
+
+// registryServerAllowed applies the curation rules to a registry server name.
+func registryServerAllowed(serverName string, config *types.MCPRegistrySourceConfig) bool {
+	if config == nil {
+		return true
+	}
+
+	if matchesAnyServerPattern(serverName, config.DeniedServers) {
+		return false
+	}
+
+	if len(config.AllowedServers) == 0 && len(config.AutoImportPublishers) == 0 {
+		return true
+	}
+
+	publisher, _, _ := strings.Cut(serverName, "/")
+	return slices.Contains(config.AutoImportPublishers, publisher) || matchesAnyServerPattern(serverName, config.AllowedServers)
+}
+
+func matchesAnyServerPattern(serverName string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, serverName); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// registryServerToManifest converts a server from an upstream registry to a catalog entry manifest.
+// Remotes are preferred over packages, since they don't need to be run by Obot.
+func registryServerToManifest(server types.RegistryServerDetail) (types.MCPServerCatalogEntryManifest, error) {
+	manifest := types.MCPServerCatalogEntryManifest{
+		Name:             server.Title,
+		ShortDescription: server.Description,
+		Description:      server.Description,
+		Metadata: map[string]string{
+			registryNameMetadataKey:    server.Name,
+			registryVersionMetadataKey: server.Version,
+		},
+	}
+	if manifest.Name == "" {
+		manifest.Name = server.Name
+	}
+	if len(server.Icons) > 0 {
+		manifest.Icon = server.Icons[0].Src
+	}
+	if server.Repository != nil {
+		manifest.RepoURL = server.Repository.URL
+	}
+
+	for _, remote := range server.Remotes {
+		if remote.Type != "streamable-http" && remote.Type != "sse" {
+			continue
+		}
+
+		manifest.Runtime = types.RuntimeRemote
+		manifest.RemoteConfig = &types.RemoteCatalogConfig{
+			Headers: registryHeaders(remote.Headers),
+		}
+		if strings.Contains(remote.URL, "{") {
+			manifest.RemoteConfig.URLTemplate = remote.URL
+		} else {
+			manifest.RemoteConfig.FixedURL = remote.URL
+		}
+		return manifest, nil
+	}
+
+	for _, pkg := range server.Packages {
+		if pkg.Transport.Type != "stdio" {
+			continue
+		}
+
+		args := registryArgs(pkg.PackageArguments)
+		switch pkg.RegistryType {
+		case "npm":
+			manifest.Runtime = types.RuntimeNPX
+			manifest.NPXConfig = &types.NPXRuntimeConfig{
+				Package: packageWithVersion(pkg.Identifier, "@", pkg.Version),
+				Args:    args,
+			}
+		case "pypi":
+			manifest.Runtime = types.RuntimeUVX
+			manifest.UVXConfig = &types.UVXRuntimeConfig{
+				Package: packageWithVersion(pkg.Identifier, "==", pkg.Version),
+				Args:    args,
+			}
+		default:
+			continue
+		}
+
+		manifest.Env = registryEnv(pkg.EnvironmentVariables)
+		return manifest, nil
+	}
+
+	return manifest, fmt.Errorf("no supported remotes or stdio npm or pypi packages")
+}
+
+func packageWithVersion(identifier, separator, version string) string {
+	if version == "" || version == "latest" {
+		return identifier
+	}
+	return identifier + separator + version
+}
+
+// registryArgs converts package arguments to command line arguments. Arguments that need a value from the user are skipped.
+func registryArgs(arguments []types.RegistryArgument) []string {
+	var args []string
+	for _, arg := range arguments {
+		value := arg.Value
+		if value == "" {
+			value = arg.Default
+		}
+
+		switch arg.Type {
+		case "named":
+			if value == "" && arg.IsRequired {
+				continue
+			}
+			args = append(args, arg.Name)
+			if value != "" {
+				args = append(args, value)
+			}
+		default:
+			if value != "" {
+				args = append(args, value)
+			}
+		}
+	}
+	return args
+}
+
+func registryEnv(vars []types.RegistryKeyValueInput) []types.MCPEnv {
+	env := make([]types.MCPEnv, 0, len(vars))
+	for _, v := range vars {
+		env = append(env, types.MCPEnv{
+			MCPHeader: types.MCPHeader{
+				Name:        v.Name,
+				Key:         v.Name,
+				Description: v.Description,
+				Value:       v.Value,
+				Sensitive:   v.IsSecret,
+				Required:    v.IsRequired,
+			},
+		})
+	}
+	return env
+}
+
+func registryHeaders(headers []types.RegistryKeyValueInput) []types.MCPHeader {
+	result := make([]types.MCPHeader, 0, len(headers))
+	for _, h := range headers {
+		header := types.MCPHeader{
+			Name:        h.Name,
+			Key:         h.Name,
+			Description: h.Description,
+			Sensitive:   h.IsSecret,
+			Required:    h.IsRequired,
+		}
+
+		// Values with {placeholders} are filled in by the user. A common case is "Bearer {token}".
+		if i := strings.Index(h.Value, "{"); i >= 0 {
+			header.Prefix = h.Value[:i]
+		} else {
+			header.Value = h.Value
+		}
+
+		result = append(result, header)
+	}
+	return result
+}
@@ -0,0 +1,158 @@
+package mcpcatalog
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryServerAllowed(t *testing.T) {
+	config := &types.MCPRegistrySourceConfig{
+		AllowedServers:       []string{"io.github.example/weather", "com.acme/*"},
+		DeniedServers:        []string{"com.acme/internal"},
+		AutoImportPublishers: []string{"io.github.trusted"},
+	}
+
+	assert.True(t, registryServerAllowed("anything/goes", nil))
+	assert.True(t, registryServerAllowed("io.github.example/weather", config))
+	assert.True(t, registryServerAllowed("com.acme/search", config))
+	assert.True(t, registryServerAllowed("io.github.trusted/tool", config))
+	assert.False(t, registryServerAllowed("com.acme/internal", config), "denied servers take precedence")
+	assert.False(t, registryServerAllowed("io.github.example/other", config))
+	assert.True(t, registryServerAllowed("io.github.example/other", &types.MCPRegistrySourceConfig{DeniedServers: []string{"com.acme/*"}}))
+}
+
+func TestRegistryServerToManifest(t *testing.T) {
+	manifest, err := registryServerToManifest(types.RegistryServerDetail{
+		Name:        "com.acme/search",
+		Title:       "Acme Search",
+		Description: "Search Acme",
+		Version:     "1.2.0",
+		Remotes: []types.RegistryServerRemote{{
+			Type: "streamable-http",
+			URL:  "https://mcp.acme.com/mcp",
+			Headers: []types.RegistryKeyValueInput{{
+				Name:       "Authorization",
+				Value:      "Bearer {api_key}",
+				IsRequired: true,
+				IsSecret:   true,
+			}},
+		}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.RuntimeRemote, manifest.Runtime)
+	assert.Equal(t, "https://mcp.acme.com/mcp", manifest.RemoteConfig.FixedURL)
+	assert.Equal(t, "Bearer ", manifest.RemoteConfig.Headers[0].Prefix)
+	assert.Equal(t, "com.acme/search", manifest.Metadata[registryNameMetadataKey])
+
+	manifest, err = registryServerToManifest(types.RegistryServerDetail{
+		Name:    "io.github.example/weather",
+		Version: "0.3.1",
+		Packages: []types.RegistryServerPackage{{
+			RegistryType: "pypi",
+			Identifier:   "weather-mcp",
+			Version:      "0.3.1",
+			Transport:    types.RegistryPackageTransport{Type: "stdio"},
+			PackageArguments: []types.RegistryArgument{
+				{Type: "named", Name: "--units", Default: "metric"},
+				{Type: "positional", ValueHint: "city", IsRequired: true},
+			},
+			EnvironmentVariables: []types.RegistryKeyValueInput{{Name: "WEATHER_API_KEY", IsRequired: true, IsSecret: true}},
+		}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "io.github.example/weather", manifest.Name)
+	assert.Equal(t, types.RuntimeUVX, manifest.Runtime)
+	assert.Equal(t, "weather-mcp==0.3.1", manifest.UVXConfig.Package)
+	assert.Equal(t, []string{"--units", "metric"}, manifest.UVXConfig.Args)
+	assert.Equal(t, "WEATHER_API_KEY", manifest.Env[0].Key)
+	assert.True(t, manifest.Env[0].Sensitive)
+
+	_, err = registryServerToManifest(types.RegistryServerDetail{
+		Name: "io.github.example/binary",
+		Packages: []types.RegistryServerPackage{{
+			RegistryType: "nuget",
+			Identifier:   "Example.Server",
+			Transport:    types.RegistryPackageTransport{Type: "stdio"},
+		}},
+	})
+	assert.Error(t, err)
+}
+
+func TestReadRegistryCatalog(t *testing.T) {
+	pages := map[string]types.RegistryServerList{
+		"": {
+			Servers: []types.RegistryServerResponse{
+				registryTestServer("com.acme/search", "Acme Search"),
+				registryTestServer("com.acme/internal", "Acme Internal"),
+			},
+			Metadata: &types.RegistryServerListMetadata{NextCursor: "page2"},
+		},
+		"page2": {
+			Servers: []types.RegistryServerResponse{
+				registryTestServer("io.github.other/search", "Acme Search"),
+			},
+		},
+	}
+
+	// Registry sources are always HTTPS, so use a TLS test server and trust its certificate.
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v0.1/servers", r.URL.Path)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		_ = json.NewEncoder(w).Encode(pages[r.URL.Query().Get("cursor")])
+	}))
+	defer server.Close()
+
+	defaultTransport := http.DefaultTransport
+	http.DefaultTransport = server.Client().Transport
+	defer func() { http.DefaultTransport = defaultTransport }()
+
+	entries, err := readRegistryCatalog(catalogSource{
+		URL:         registryPrefix + server.URL,
+		Credentials: &types.MCPCatalogSourceCredentials{Token: "secret"},
+		Registry:    &types.MCPRegistrySourceConfig{DeniedServers: []string{"com.acme/internal"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "Acme Search", entries[0].Name)
+	// The second server has the same title, so it is named after its registry name to keep entry names unique.
+	assert.Equal(t, "io.github.other/search", entries[1].Name)
+}
+
+func TestReadRegistryCatalogTruncated(t *testing.T) {
+	// Every page points to another one, so the registry is never read to the end.
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(types.RegistryServerList{
+			Servers:  []types.RegistryServerResponse{registryTestServer("com.acme/search", "Acme Search")},
+			Metadata: &types.RegistryServerListMetadata{NextCursor: "next"},
+		})
+	}))
+	defer server.Close()
+
+	defaultTransport := http.DefaultTransport
+	http.DefaultTransport = server.Client().Transport
+	defer func() { http.DefaultTransport = defaultTransport }()
+
+	entries, err := readRegistryCatalog(catalogSource{URL: registryPrefix + server.URL})
+	assert.ErrorIs(t, err, errRegistryTruncated)
+	// The entries that were read are still returned, so that they can be imported.
+	assert.Len(t, entries, 1)
+}
+
+func registryTestServer(name, title string) types.RegistryServerResponse {
+	return types.RegistryServerResponse{
+		Server: types.RegistryServerDetail{
+			Name:        name,
+			Title:       title,
+			Description: "A test server",
+			Version:     "1.0.0",
+			Remotes:     []types.RegistryServerRemote{{Type: "streamable-http", URL: "https://example.com/" + name}},
+		},
+		Meta: types.RegistryMeta{Official: types.RegistryOfficialMeta{IsLatest: true}},
+	}
+}
@@ -0,0 +1,75 @@
+package mcpcatalog
+
+import (
+	"bufio"
+	"bytes"
+	"crypto"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/obot-platform/obot/pkg/oci"
+)
+
+const (
+	// checksumsFile lists the SHA-256 checksum of every catalog file in a signed directory, in the format written by sha256sum.
+	checksumsFile = ".obotcatalog-checksums"
+	// signatureSuffix is appended to the name of a signed file to get the name of its detached signature.
+	signatureSuffix = ".sig"
+)
+
+// readSignedChecksums reads the checksums file from a catalog directory and verifies its signature.
+// It returns a map of relative file paths to their expected SHA-256 checksums.
+func readSignedChecksums(dir string, publicKey crypto.PublicKey) (map[string]string, error) {
+	contents, err := os.ReadFile(filepath.Join(dir, checksumsFile))
+	if err != nil {
+		return nil, fmt.Errorf("signed catalogs must contain a %s file: %w", checksumsFile, err)
+	}
+
+	signature, err := os.ReadFile(filepath.Join(dir, checksumsFile+signatureSuffix))
+	if err != nil {
+		return nil, fmt.Errorf("signed catalogs must contain a %s file: %w", checksumsFile+signatureSuffix, err)
+	}
+
+	if err := oci.VerifySignature(publicKey, contents, signature); err != nil {
+		return nil, fmt.Errorf("failed to verify %s: %w", checksumsFile, err)
+	}
+
+	checksums := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		sum, file, ok := strings.Cut(line, " ")
+		if !ok {
+			return nil, fmt.Errorf("invalid line in %s: %s", checksumsFile, line)
+		}
+
+		// sha256sum marks binary mode files with a leading '*'.
+		file = strings.TrimPrefix(strings.TrimSpace(file), "*")
+		checksums[path.Clean(strings.TrimPrefix(file, "./"))] = strings.ToLower(sum)
+	}
+
+	return checksums, scanner.Err()
+}
+
+// verifyChecksum checks the contents of a catalog file against the signed checksums.
+func verifyChecksum(checksums map[string]string, relPath string, contents []byte) error {
+	expected, ok := checksums[filepath.ToSlash(relPath)]
+	if !ok {
+		return fmt.Errorf("file %s is not listed in %s", relPath, checksumsFile)
+	}
+
+	sum := sha256.Sum256(contents)
+	if hex.EncodeToString(sum[:]) != expected {
+		return fmt.Errorf("checksum mismatch for %s", relPath)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package mcpcatalog
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCatalogEntry = `name: Test 1
+description: A test MCP server
+runtime: uvx
+uvxConfig:
+  package: test-mcp-server
+`
+
+func TestReadSignedMCPCatalogDirectory(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	writeSignedCatalog(t, dir, key, map[string]string{"test.yaml": testCatalogEntry})
+
+	entries, err := readMCPCatalogDirectory(dir, &key.PublicKey)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	// A file that isn't in the checksums should be rejected.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "extra.yaml"), []byte(testCatalogEntry), 0600))
+	_, err = readMCPCatalogDirectory(dir, &key.PublicKey)
+	assert.Error(t, err)
+	require.NoError(t, os.Remove(filepath.Join(dir, "extra.yaml")))
+
+	// A modified file should be rejected.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "test.yaml"), []byte(testCatalogEntry+"# tampered\n"), 0600))
+	_, err = readMCPCatalogDirectory(dir, &key.PublicKey)
+	assert.Error(t, err)
+
+	// Unsigned directories are still read when no public key is configured.
+	entries, err = readMCPCatalogDirectory(dir, nil)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	// Directories without a signature are rejected when a public key is configured.
+	unsigned := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(unsigned, "test.yaml"), []byte(testCatalogEntry), 0600))
+	_, err = readMCPCatalogDirectory(unsigned, &key.PublicKey)
+	assert.Error(t, err)
+}
+
+func writeSignedCatalog(t *testing.T, dir string, key *ecdsa.PrivateKey, files map[string]string) {
+	t.Helper()
+
+	var checksums string
+	for name, contents := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(contents), 0600))
+		sum := sha256.Sum256([]byte(contents))
+		checksums += fmt.Sprintf("%s  %s\n", hex.EncodeToString(sum[:]), name)
+	}
+
+	digest := sha256.Sum256([]byte(checksums))
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, checksumsFile), []byte(checksums), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, checksumsFile+signatureSuffix), []byte(base64.StdEncoding.EncodeToString(sig)), 0600))
+}
@@ -47,7 +47,10 @@ func (h *Handler) DetectDrift(req router.Request, _ router.Response) error {
 		return err
 	}
 
-	var entryManifest types.MCPServerCatalogEntryManifest
+	var (
+		entryManifest    types.MCPServerCatalogEntryManifest
+		availableVersion int
+	)
 	if compositeName := server.Spec.CompositeName; compositeName != "" {
 		// The server belongs to a composite server, so we should get the entry from the runtime of the composite entry that this server was created with.
 		var compositeServer v1.MCPServer
@@ -74,12 +77,30 @@ func (h *Handler) DetectDrift(req router.Request, _ router.Response) error {
 		if !found {
 			return fmt.Errorf("component server %s not found in composite server catalog entry %s", server.Spec.MCPServerCatalogEntryName, compositeServer.Spec.MCPServerCatalogEntryName)
 		}
+	} else if server.Spec.MCPServerCatalogEntryVersionPinned {
+		// Pinned servers are never offered updates.
+		if server.Status.NeedsUpdate || server.Status.AvailableCatalogEntryVersion != 0 {
+			server.Status.NeedsUpdate = false
+			server.Status.AvailableCatalogEntryVersion = 0
+			return req.Client.Status().Update(req.Ctx, server)
+		}
+		return nil
 	} else {
-		var entry v1.MCPServerCatalogEntry
-		if err := req.Get(&entry, server.Namespace, server.Spec.MCPServerCatalogEntryName); err != nil {
-			return err
+		var versions v1.MCPServerCatalogEntryVersionList
+		if err := req.List(&versions, &kclient.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("spec.mcpServerCatalogEntryName", entry.Name),
+			Namespace:     entry.Namespace,
+		}); err != nil {
+			return fmt.Errorf("failed to list versions of catalog entry %s: %w", entry.Name, err)
 		}
+
+		// Compare against the newest version that has been rolled out to the server's user.
+		// Entries that haven't had a version recorded yet are compared against their current manifest.
 		entryManifest = entry.Spec.Manifest
+		if version := versions.VersionFor(server.Spec.UserID); version != nil {
+			entryManifest = version.Spec.Manifest
+			availableVersion = version.Spec.Version
+		}
 	}
 
 	drifted, err := configurationHasDrifted(server.Spec.NeedsURL, server.Spec.Manifest, entryManifest)
@@ -87,8 +108,13 @@ func (h *Handler) DetectDrift(req router.Request, _ router.Response) error {
 		return err
 	}
 
-	if server.Status.NeedsUpdate != drifted {
+	if !drifted {
+		availableVersion = 0
+	}
+
+	if server.Status.NeedsUpdate != drifted || server.Status.AvailableCatalogEntryVersion != availableVersion {
 		server.Status.NeedsUpdate = drifted
+		server.Status.AvailableCatalogEntryVersion = availableVersion
 		return req.Client.Status().Update(req.Ctx, server)
 	}
 	return nil
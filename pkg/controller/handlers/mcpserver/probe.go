@@ -0,0 +1,220 @@
+package mcpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/logger"
+	gatewayclient "github.com/obot-platform/obot/pkg/gateway/client"
+	gtypes "github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/obot-platform/obot/pkg/mcp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var log = logger.Package()
+
+const (
+	// probeConcurrency is the number of servers that are probed at the same time.
+	probeConcurrency = 10
+	// probeResultRetention is how long probe results are kept, which is also the longest SLO window.
+	probeResultRetention = 30 * 24 * time.Hour
+)
+
+// Prober periodically runs synthetic health probes against multi-user MCP servers and stores the results,
+// so that outages are noticed even when nobody is using the server.
+type Prober struct {
+	gptClient      *gptscript.GPTScript
+	sessionManager *mcp.SessionManager
+	gatewayClient  *gatewayclient.Client
+	baseURL        string
+
+	// lastProbed is when each server was last probed, for servers that are probed less often than the global interval.
+	lastProbed map[string]time.Time
+}
+
+func NewProber(gptClient *gptscript.GPTScript, sessionManager *mcp.SessionManager, gatewayClient *gatewayclient.Client, baseURL string) *Prober {
+	return &Prober{
+		gptClient:      gptClient,
+		sessionManager: sessionManager,
+		gatewayClient:  gatewayClient,
+		baseURL:        baseURL,
+		lastProbed:     map[string]time.Time{},
+	}
+}
+
+// Run probes the servers until the context is canceled. It should only run on the leader.
+func (p *Prober) Run(ctx context.Context, client kclient.Client) {
+	interval := p.sessionManager.ProbeInterval()
+	if interval <= 0 {
+		return
+	}
+
+	probeTicker := time.NewTicker(interval)
+	defer probeTicker.Stop()
+	pruneTicker := time.NewTicker(time.Hour)
+	defer pruneTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-probeTicker.C:
+			if err := p.probeServers(ctx, client, interval); err != nil {
+				log.Warnf("failed to probe MCP servers: %v", err)
+			}
+		case <-pruneTicker.C:
+			if err := p.gatewayClient.DeleteMCPServerProbeResultsBefore(ctx, time.Now().Add(-probeResultRetention)); err != nil {
+				log.Warnf("failed to prune MCP server probe results: %v", err)
+			}
+		}
+	}
+}
+
+func (p *Prober) probeServers(ctx context.Context, client kclient.Client, interval time.Duration) error {
+	var servers v1.MCPServerList
+	if err := client.List(ctx, &servers, kclient.InNamespace(system.DefaultNamespace)); err != nil {
+		return fmt.Errorf("failed to list MCP servers: %w", err)
+	}
+
+	var (
+		now  = time.Now()
+		due  []v1.MCPServer
+		seen = make(map[string]struct{}, len(servers.Items))
+	)
+	for _, server := range servers.Items {
+		if !shouldProbe(server) {
+			continue
+		}
+		seen[server.Name] = struct{}{}
+
+		serverInterval := interval
+		if probe := server.Spec.Manifest.HealthProbe; probe != nil {
+			serverInterval = max(interval, time.Duration(probe.IntervalSeconds)*time.Second)
+		}
+		// Leave some slack so that servers with the global interval are probed on every tick.
+		if now.Sub(p.lastProbed[server.Name]) < serverInterval-interval/2 {
+			continue
+		}
+
+		p.lastProbed[server.Name] = now
+		due = append(due, server)
+	}
+
+	for name := range p.lastProbed {
+		if _, ok := seen[name]; !ok {
+			delete(p.lastProbed, name)
+		}
+	}
+
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, probeConcurrency)
+	)
+	for _, server := range due {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			p.probeServer(ctx, server)
+		}()
+	}
+	wg.Wait()
+
+	return nil
+}
+
+func (p *Prober) probeServer(ctx context.Context, server v1.MCPServer) {
+	serverConfig, err := p.serverConfig(ctx, server)
+	if err != nil {
+		log.Debugf("not probing MCP server %s: %v", server.Name, err)
+		return
+	}
+
+	result, err := p.sessionManager.ProbeServer(ctx, serverConfig, server.Spec.Manifest.HealthProbe)
+	if errors.Is(err, mcp.ErrServerNotRunning) {
+		return
+	} else if err != nil {
+		log.Debugf("failed to probe MCP server %s: %v", server.Name, err)
+		return
+	}
+
+	if err = p.gatewayClient.InsertMCPServerProbeResult(ctx, &gtypes.MCPServerProbeResult{
+		MCPServerName:       server.Name,
+		Time:                result.Time,
+		Success:             result.Success,
+		AuthRequired:        result.AuthRequired,
+		LatencyMS:           result.Latency.Milliseconds(),
+		InitializeLatencyMS: result.InitializeLatency.Milliseconds(),
+		ListToolsLatencyMS:  result.ListToolsLatency.Milliseconds(),
+		ToolCallLatencyMS:   result.ToolCallLatency.Milliseconds(),
+		ToolCount:           result.ToolCount,
+		Error:               result.Error,
+	}); err != nil {
+		log.Warnf("failed to store probe result of MCP server %s: %v", server.Name, err)
+	}
+}
+
+// serverConfig builds the config that is needed to connect to the server. It doesn't include everything that is needed
+// to deploy the server, because probes never deploy servers.
+func (p *Prober) serverConfig(ctx context.Context, server v1.MCPServer) (mcp.ServerConfig, error) {
+	var scope string
+	switch {
+	case server.Spec.MCPCatalogID != "":
+		scope = server.Spec.MCPCatalogID
+	case server.Spec.PowerUserWorkspaceID != "":
+		scope = server.Spec.PowerUserWorkspaceID
+	default:
+		scope = server.Spec.UserID
+	}
+	cred, err := p.gptClient.RevealCredential(ctx, []string{fmt.Sprintf("%s-%s", scope, server.Name)}, server.Name)
+	if err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+		return mcp.ServerConfig{}, fmt.Errorf("failed to find credential: %w", err)
+	}
+
+	catalogName := server.Spec.MCPCatalogID
+	if catalogName == "" {
+		catalogName = server.Status.MCPCatalogID
+	}
+	if catalogName == "" {
+		catalogName = server.Spec.PowerUserWorkspaceID
+	}
+
+	serverConfig, missing, err := mcp.ServerToServerConfig(server, server.ValidConnectURLs(p.baseURL), p.baseURL, "", server.Spec.UserID, scope, catalogName, cred.Env, nil)
+	if err != nil {
+		return mcp.ServerConfig{}, err
+	} else if len(missing) > 0 {
+		return mcp.ServerConfig{}, fmt.Errorf("missing required config: %v", missing)
+	}
+
+	return serverConfig, nil
+}
+
+// shouldProbe returns true for multi-user and workspace servers that are configured and don't have probes disabled.
+// Single-user servers are only probed if their probes are enabled, because there can be one for every user.
+// Composite servers and their components aren't probed, and neither are project servers.
+func shouldProbe(server v1.MCPServer) bool {
+	if !server.DeletionTimestamp.IsZero() || server.Spec.NeedsURL || server.Spec.CompositeName != "" || server.Spec.ThreadName != "" {
+		return false
+	}
+
+	probe := server.Spec.Manifest.HealthProbe
+	if probe != nil && probe.Disabled {
+		return false
+	}
+
+	switch server.Spec.Manifest.Runtime {
+	case types.RuntimeRemote, types.RuntimeUVX, types.RuntimeNPX, types.RuntimeContainerized:
+		return server.Spec.MCPCatalogID != "" || server.Spec.PowerUserWorkspaceID != "" || probe != nil && probe.Enabled
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package mcpserver
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+func TestShouldProbe(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     v1.MCPServerSpec
+		expected bool
+	}{
+		{
+			name:     "single-user remote server",
+			spec:     v1.MCPServerSpec{UserID: "u1", Manifest: types.MCPServerManifest{Runtime: types.RuntimeRemote}},
+			expected: false,
+		},
+		{
+			name: "single-user remote server with probes enabled",
+			spec: v1.MCPServerSpec{UserID: "u1", Manifest: types.MCPServerManifest{
+				Runtime:     types.RuntimeRemote,
+				HealthProbe: &types.HealthProbe{Enabled: true},
+			}},
+			expected: true,
+		},
+		{
+			name:     "multi-user remote server",
+			spec:     v1.MCPServerSpec{MCPCatalogID: "default", Manifest: types.MCPServerManifest{Runtime: types.RuntimeRemote}},
+			expected: true,
+		},
+		{
+			name:     "single-user npx server",
+			spec:     v1.MCPServerSpec{UserID: "u1", Manifest: types.MCPServerManifest{Runtime: types.RuntimeNPX}},
+			expected: false,
+		},
+		{
+			name:     "multi-user npx server",
+			spec:     v1.MCPServerSpec{MCPCatalogID: "default", Manifest: types.MCPServerManifest{Runtime: types.RuntimeNPX}},
+			expected: true,
+		},
+		{
+			name:     "workspace containerized server",
+			spec:     v1.MCPServerSpec{PowerUserWorkspaceID: "puw1", Manifest: types.MCPServerManifest{Runtime: types.RuntimeContainerized}},
+			expected: true,
+		},
+		{
+			name: "probes disabled",
+			spec: v1.MCPServerSpec{MCPCatalogID: "default", Manifest: types.MCPServerManifest{
+				Runtime:     types.RuntimeRemote,
+				HealthProbe: &types.HealthProbe{Disabled: true},
+			}},
+			expected: false,
+		},
+		{
+			name:     "remote server that needs a URL",
+			spec:     v1.MCPServerSpec{MCPCatalogID: "default", NeedsURL: true, Manifest: types.MCPServerManifest{Runtime: types.RuntimeRemote}},
+			expected: false,
+		},
+		{
+			name:     "component of a composite server",
+			spec:     v1.MCPServerSpec{MCPCatalogID: "default", CompositeName: "ms1composite", Manifest: types.MCPServerManifest{Runtime: types.RuntimeRemote}},
+			expected: false,
+		},
+		{
+			name:     "composite server",
+			spec:     v1.MCPServerSpec{MCPCatalogID: "default", Manifest: types.MCPServerManifest{Runtime: types.RuntimeComposite}},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := shouldProbe(v1.MCPServer{Spec: tt.spec}); got != tt.expected {
+				t.Errorf("shouldProbe() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
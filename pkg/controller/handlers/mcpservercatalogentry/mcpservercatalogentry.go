@@ -3,12 +3,16 @@ package mcpservercatalogentry
 import (
 	"fmt"
 	"slices"
+	"strconv"
 
 	"github.com/gptscript-ai/gptscript/pkg/hash"
+	"github.com/obot-platform/nah/pkg/name"
 	"github.com/obot-platform/nah/pkg/router"
 	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/injectionscan"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -70,6 +74,30 @@ func UpdateManifestHashAndLastUpdated(req router.Request, _ router.Response) err
 	return nil
 }
 
+// ScanToolPreviews records what the injection scanner flags in the tool previews of the catalog entry, so that admins can
+// review the entry before anyone uses it. The entry is scanned again when the injection scan policy changes.
+func ScanToolPreviews(req router.Request, _ router.Response) error {
+	entry := req.Object.(*v1.MCPServerCatalogEntry)
+
+	var policy v1.MCPInjectionScanPolicy
+	if err := req.Get(&policy, system.DefaultNamespace, system.MCPInjectionScanPolicyName); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get MCP injection scan policy: %w", err)
+	}
+
+	scanner, err := injectionscan.New(policy.Spec.Manifest)
+	if err != nil {
+		return fmt.Errorf("failed to create injection scanner: %w", err)
+	}
+
+	findings := scanner.ScanTools(entry.Spec.Manifest.ToolPreview)
+	if equality.Semantic.DeepEqual(entry.Status.ToolPreviewScanFindings, findings) {
+		return nil
+	}
+
+	entry.Status.ToolPreviewScanFindings = findings
+	return req.Client.Status().Update(req.Ctx, entry)
+}
+
 // DetectCompositeDrift detects when a composite catalog entry's component snapshots have drifted
 // from their source catalog entries or multi-user servers
 func DetectCompositeDrift(req router.Request, _ router.Response) error {
@@ -165,3 +193,162 @@ func CleanupNestedCompositeEntries(req router.Request, _ router.Response) error
 	entry.Spec.Manifest.CompositeConfig.ComponentServers = components
 	return kclient.IgnoreNotFound(req.Client.Update(req.Ctx, entry))
 }
+
+// versionRetention is how many versions of a catalog entry are kept. Older versions are deleted,
+// unless they are released, being rolled out, or a server is pinned to them.
+const versionRetention = 25
+
+// RecordVersion records a new version of the catalog entry whenever its manifest changes,
+// prunes versions beyond the retention, and keeps the entry's latest and released versions up to date.
+func RecordVersion(req router.Request, _ router.Response) error {
+	entry := req.Object.(*v1.MCPServerCatalogEntry)
+
+	var versions v1.MCPServerCatalogEntryVersionList
+	if err := req.List(&versions, &kclient.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.mcpServerCatalogEntryName", entry.Name),
+		Namespace:     entry.Namespace,
+	}); err != nil {
+		return fmt.Errorf("failed to list versions of catalog entry %s: %w", entry.Name, err)
+	}
+
+	var (
+		currentHash = hash.Digest(entry.Spec.Manifest)
+		latest      *v1.MCPServerCatalogEntryVersion
+	)
+	for i := range versions.Items {
+		if v := &versions.Items[i]; latest == nil || v.Spec.Version > latest.Spec.Version {
+			latest = v
+		}
+	}
+
+	// Changing the manifest back to an earlier version's manifest still records a new version,
+	// so that the newest version always matches the entry.
+	if latest == nil || latest.Spec.ManifestHash != currentHash {
+		number := 1
+		if latest != nil {
+			number = latest.Spec.Version + 1
+		}
+
+		// The first version is always released, so that there is a version to offer users while later ones are staged.
+		staged, err := stagedRollout(req, entry)
+		if err != nil {
+			return err
+		}
+
+		version := v1.MCPServerCatalogEntryVersion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name.SafeConcatName(entry.Name, "v"+strconv.Itoa(number)),
+				Namespace: entry.Namespace,
+			},
+			Spec: v1.MCPServerCatalogEntryVersionSpec{
+				MCPServerCatalogEntryName: entry.Name,
+				Version:                   number,
+				Manifest:                  entry.Spec.Manifest,
+				ManifestHash:              currentHash,
+				Released:                  latest == nil || !staged,
+			},
+		}
+		if err := req.Client.Create(req.Ctx, &version); err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to record version %d of catalog entry %s: %w", number, entry.Name, err)
+		}
+
+		versions.Items = append(versions.Items, version)
+	}
+
+	var latestVersion, releasedVersion int
+	for _, v := range versions.Items {
+		latestVersion = max(latestVersion, v.Spec.Version)
+		if v.Spec.Released {
+			releasedVersion = max(releasedVersion, v.Spec.Version)
+		}
+	}
+
+	if err := pruneVersions(req, entry, versions.Items, releasedVersion); err != nil {
+		return err
+	}
+
+	if entry.Status.LatestVersion != latestVersion || entry.Status.ReleasedVersion != releasedVersion {
+		entry.Status.LatestVersion = latestVersion
+		entry.Status.ReleasedVersion = releasedVersion
+		return req.Client.Status().Update(req.Ctx, entry)
+	}
+
+	return nil
+}
+
+// pruneVersions deletes the oldest versions of the entry beyond the retention. The released version, versions that are
+// being rolled out, and versions that servers are pinned to are never deleted, and neither is anything newer than the
+// released version.
+func pruneVersions(req router.Request, entry *v1.MCPServerCatalogEntry, versions []v1.MCPServerCatalogEntryVersion, releasedVersion int) error {
+	if len(versions) <= versionRetention {
+		return nil
+	}
+
+	var servers v1.MCPServerList
+	if err := req.List(&servers, &kclient.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.mcpServerCatalogEntryName", entry.Name),
+		Namespace:     entry.Namespace,
+	}); err != nil {
+		return fmt.Errorf("failed to list MCP servers: %w", err)
+	}
+
+	pinned := make(map[int]struct{}, len(servers.Items))
+	for _, server := range servers.Items {
+		if server.Spec.MCPServerCatalogEntryVersionPinned {
+			pinned[server.Spec.MCPServerCatalogEntryVersion] = struct{}{}
+		}
+	}
+
+	slices.SortFunc(versions, func(a, b v1.MCPServerCatalogEntryVersion) int {
+		return b.Spec.Version - a.Spec.Version
+	})
+	for _, version := range versions[versionRetention:] {
+		if _, ok := pinned[version.Spec.Version]; ok || version.Spec.Version >= releasedVersion || len(version.Spec.RolloutUserIDs) > 0 {
+			continue
+		}
+		if err := req.Delete(&version); err != nil {
+			return fmt.Errorf("failed to delete version %d of catalog entry %s: %w", version.Spec.Version, entry.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// DeleteVersions deletes the versions of a catalog entry when the entry is deleted.
+func DeleteVersions(req router.Request, _ router.Response) error {
+	entry := req.Object.(*v1.MCPServerCatalogEntry)
+
+	var versions v1.MCPServerCatalogEntryVersionList
+	if err := req.List(&versions, &kclient.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.mcpServerCatalogEntryName", entry.Name),
+		Namespace:     entry.Namespace,
+	}); err != nil {
+		return fmt.Errorf("failed to list versions of catalog entry %s: %w", entry.Name, err)
+	}
+
+	for _, version := range versions.Items {
+		if err := req.Delete(&version); err != nil {
+			return fmt.Errorf("failed to delete version %d of catalog entry %s: %w", version.Spec.Version, entry.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// stagedRollout returns true if new versions of the entry are recorded without releasing them.
+func stagedRollout(req router.Request, entry *v1.MCPServerCatalogEntry) (bool, error) {
+	if entry.Spec.StagedRollout {
+		return true, nil
+	}
+	if entry.Spec.MCPCatalogName == "" {
+		return false, nil
+	}
+
+	var catalog v1.MCPCatalog
+	if err := req.Get(&catalog, entry.Namespace, entry.Spec.MCPCatalogName); apierrors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to get catalog %s: %w", entry.Spec.MCPCatalogName, err)
+	}
+	return catalog.Spec.StagedRollout, nil
+}
@@ -0,0 +1,193 @@
+package mcpservercatalogentry
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/storage/scheme"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type response struct{}
+
+func (response) Attributes() map[string]any {
+	return nil
+}
+
+func (response) RetryAfter(time.Duration) {}
+
+func newRequest(t *testing.T, entry *v1.MCPServerCatalogEntry, objs ...kclient.Object) router.Request {
+	t.Helper()
+
+	client := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(append(objs, entry)...).
+		WithStatusSubresource(&v1.MCPServerCatalogEntry{}).
+		WithIndex(&v1.MCPServerCatalogEntryVersion{}, "spec.mcpServerCatalogEntryName", func(obj kclient.Object) []string {
+			return []string{obj.(*v1.MCPServerCatalogEntryVersion).Spec.MCPServerCatalogEntryName}
+		}).
+		WithIndex(&v1.MCPServer{}, "spec.mcpServerCatalogEntryName", func(obj kclient.Object) []string {
+			return []string{obj.(*v1.MCPServer).Spec.MCPServerCatalogEntryName}
+		}).
+		Build()
+
+	require.NoError(t, client.Get(context.Background(), kclient.ObjectKeyFromObject(entry), entry))
+	return router.Request{
+		Client:    client,
+		Object:    entry,
+		Ctx:       context.Background(),
+		Namespace: entry.Namespace,
+		Name:      entry.Name,
+	}
+}
+
+func catalogEntry(description string) *v1.MCPServerCatalogEntry {
+	return &v1.MCPServerCatalogEntry{
+		ObjectMeta: metav1.ObjectMeta{Name: "entry1", Namespace: system.DefaultNamespace},
+		Spec: v1.MCPServerCatalogEntrySpec{
+			Manifest: types.MCPServerCatalogEntryManifest{
+				Name:        "entry1",
+				Description: description,
+				Runtime:     types.RuntimeRemote,
+			},
+		},
+	}
+}
+
+func entryVersion(number int, released bool) *v1.MCPServerCatalogEntryVersion {
+	return &v1.MCPServerCatalogEntryVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "entry1-v" + strconv.Itoa(number), Namespace: system.DefaultNamespace},
+		Spec: v1.MCPServerCatalogEntryVersionSpec{
+			MCPServerCatalogEntryName: "entry1",
+			Version:                   number,
+			ManifestHash:              "hash" + strconv.Itoa(number),
+			Released:                  released,
+		},
+	}
+}
+
+func listVersions(t *testing.T, req router.Request) []int {
+	t.Helper()
+
+	var versions v1.MCPServerCatalogEntryVersionList
+	require.NoError(t, req.List(&versions, &kclient.ListOptions{Namespace: req.Namespace}))
+
+	numbers := make([]int, 0, len(versions.Items))
+	for _, version := range versions.Items {
+		numbers = append(numbers, version.Spec.Version)
+	}
+	return numbers
+}
+
+func TestRecordVersion(t *testing.T) {
+	// The first version is released even if the entry is staged.
+	entry := catalogEntry("first")
+	entry.Spec.StagedRollout = true
+	req := newRequest(t, entry)
+	require.NoError(t, RecordVersion(req, response{}))
+	assert.Equal(t, []int{1}, listVersions(t, req))
+	assert.Equal(t, 1, entry.Status.LatestVersion)
+	assert.Equal(t, 1, entry.Status.ReleasedVersion)
+
+	// Later versions of a staged entry aren't released.
+	entry.Spec.Manifest.Description = "second"
+	require.NoError(t, req.Client.Update(req.Ctx, entry))
+	require.NoError(t, RecordVersion(req, response{}))
+	assert.ElementsMatch(t, []int{1, 2}, listVersions(t, req))
+	assert.Equal(t, 2, entry.Status.LatestVersion)
+	assert.Equal(t, 1, entry.Status.ReleasedVersion)
+
+	// Changing whether the entry is staged doesn't record a version.
+	entry.Spec.StagedRollout = false
+	require.NoError(t, req.Client.Update(req.Ctx, entry))
+	require.NoError(t, RecordVersion(req, response{}))
+	assert.ElementsMatch(t, []int{1, 2}, listVersions(t, req))
+}
+
+func TestRecordVersionRetention(t *testing.T) {
+	var objs []kclient.Object
+	for i := 1; i <= versionRetention+5; i++ {
+		version := entryVersion(i, true)
+		switch i {
+		case 2:
+			version.Spec.Released = false
+			version.Spec.RolloutUserIDs = []string{"u1"}
+		case 4:
+			version.Spec.Released = false
+		}
+		objs = append(objs, version)
+	}
+	objs = append(objs,
+		// A server that is pinned to an old version.
+		&v1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "ms1", Namespace: system.DefaultNamespace},
+			Spec: v1.MCPServerSpec{
+				MCPServerCatalogEntryName:          "entry1",
+				MCPServerCatalogEntryVersion:       3,
+				MCPServerCatalogEntryVersionPinned: true,
+			},
+		},
+		// Servers that aren't pinned don't keep their version.
+		&v1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "ms2", Namespace: system.DefaultNamespace},
+			Spec: v1.MCPServerSpec{
+				MCPServerCatalogEntryName:    "entry1",
+				MCPServerCatalogEntryVersion: 5,
+			},
+		},
+	)
+
+	// Recording a new version leaves one more version than the retention. The oldest ones are deleted,
+	// except the versions being rolled out or pinned.
+	req := newRequest(t, catalogEntry("new"), objs...)
+	require.NoError(t, RecordVersion(req, response{}))
+
+	versions := listVersions(t, req)
+	assert.Len(t, versions, versionRetention+2)
+	assert.Subset(t, versions, []int{2, 3, versionRetention + 6})
+	assert.NotContains(t, versions, 1)
+	assert.NotContains(t, versions, 4)
+	assert.NotContains(t, versions, 5)
+	assert.NotContains(t, versions, 6)
+}
+
+func TestRecordVersionRetentionKeepsReleasedVersion(t *testing.T) {
+	// Nothing after version 1 is released, so it is kept along with all of the staged versions after it.
+	objs := []kclient.Object{entryVersion(1, true)}
+	for i := 2; i <= versionRetention+5; i++ {
+		objs = append(objs, entryVersion(i, false))
+	}
+
+	entry := catalogEntry("new")
+	entry.Spec.StagedRollout = true
+	req := newRequest(t, entry, objs...)
+	require.NoError(t, RecordVersion(req, response{}))
+
+	assert.Len(t, listVersions(t, req), versionRetention+6)
+	assert.Equal(t, 1, entry.Status.ReleasedVersion)
+}
+
+func TestDeleteVersions(t *testing.T) {
+	other := entryVersion(1, true)
+	other.Name = "entry2-v1"
+	other.Spec.MCPServerCatalogEntryName = "entry2"
+
+	req := newRequest(t, catalogEntry("deleted"), entryVersion(1, true), entryVersion(2, false), other)
+	require.NoError(t, DeleteVersions(req, response{}))
+
+	// Only the versions of the deleted entry are deleted.
+	var versions v1.MCPServerCatalogEntryVersionList
+	require.NoError(t, req.List(&versions, &kclient.ListOptions{Namespace: req.Namespace}))
+	require.Len(t, versions.Items, 1)
+	assert.Equal(t, "entry2-v1", versions.Items[0].Name)
+}
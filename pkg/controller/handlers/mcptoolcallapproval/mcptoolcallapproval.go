@@ -0,0 +1,65 @@
+package mcptoolcallapproval
+
+import (
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// retention is how long a paused call is kept after it has been decided on or expired.
+const retention = 30 * 24 * time.Hour
+
+// Expiration marks pending calls that have not been decided on in time as expired. The gateway expires the calls it is
+// holding itself, so this catches the calls that were held when Obot restarted.
+func Expiration(req router.Request, resp router.Response) error {
+	approval := req.Object.(*v1.MCPToolCallApproval)
+	if approval.Spec.State != types.MCPToolCallApprovalStatePending {
+		return nil
+	}
+
+	if expiresIn := time.Until(approval.Spec.ExpiresAt.Time); expiresIn > 0 {
+		if expiresIn < 10*time.Hour {
+			resp.RetryAfter(expiresIn)
+		}
+		return nil
+	}
+
+	now := metav1.Now()
+	approval.Spec.State = types.MCPToolCallApprovalStateExpired
+	approval.Spec.DecisionReason = "the call was not approved in time"
+	approval.Spec.DecidedAt = &now
+	return req.Client.Update(req.Ctx, approval)
+}
+
+// SetEndedAt records when the call was decided on or expired.
+func SetEndedAt(req router.Request, _ router.Response) error {
+	approval := req.Object.(*v1.MCPToolCallApproval)
+	if approval.Spec.State == types.MCPToolCallApprovalStatePending || !approval.Status.EndedAt.IsZero() {
+		return nil
+	}
+
+	approval.Status.EndedAt = &metav1.Time{Time: time.Now()}
+	return req.Client.Status().Update(req.Ctx, approval)
+}
+
+// Cleanup deletes calls that were decided on or expired more than 30 days ago.
+func Cleanup(req router.Request, resp router.Response) error {
+	approval := req.Object.(*v1.MCPToolCallApproval)
+
+	if approval.Status.EndedAt.IsZero() {
+		return nil
+	}
+
+	if time.Since(approval.Status.EndedAt.Time) > retention {
+		return req.Client.Delete(req.Ctx, approval)
+	}
+
+	if cleanupIn := retention - time.Since(approval.Status.EndedAt.Time); cleanupIn < 10*time.Hour {
+		resp.RetryAfter(cleanupIn)
+	}
+
+	return nil
+}
@@ -258,7 +258,8 @@ func (h *Handler) createDefaultAccessControlRule(ctx context.Context, client kcl
 	}
 
 	for _, acr := range existingACRs.Items {
-		if acr.Spec.Generated {
+		// Rules for access requests are generated too, but they aren't the workspace's default rule.
+		if acr.Spec.Generated && acr.Spec.MCPAccessRequestName == "" {
 			workspace.Status.DefaultAccessControlRuleGenerated = true
 			return client.Status().Update(ctx, workspace)
 		}
@@ -61,6 +61,7 @@ func (h *Handler) createExportFromSchedule(req router.Request, scheduledExport *
 		},
 		Spec: v1.AuditLogExportSpec{
 			Name:                   fmt.Sprintf("%s-%d", scheduledExport.Spec.Name, scheduledExport.Status.TotalExportsCreated+1),
+			Type:                   scheduledExport.Spec.Type,
 			Bucket:                 scheduledExport.Spec.Bucket,
 			KeyPrefix:              scheduledExport.Spec.KeyPrefix,
 			StartTime:              metav1.NewTime(startTime),
@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/pkg/metrics"
+	"github.com/obot-platform/obot/pkg/tracing"
+)
+
+// instrument is router middleware that traces and times each handler.
+func instrument(next router.Handler) router.Handler {
+	name := handlerName(next)
+	return tracing.Reconcile(name, metrics.Reconcile(name, next))
+}
+
+// handlerName returns a short name for a handler, like runs.(*Handler).Resume, for spans and metric labels.
+func handlerName(h router.Handler) string {
+	f, ok := h.(router.HandlerFunc)
+	if !ok {
+		return fmt.Sprintf("%T", h)
+	}
+	name := runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
+	return strings.TrimSuffix(name[strings.LastIndex(name, "/")+1:], "-fm")
+}
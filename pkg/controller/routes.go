@@ -15,11 +15,13 @@ import (
 	"github.com/obot-platform/obot/pkg/controller/handlers/knowledgeset"
 	"github.com/obot-platform/obot/pkg/controller/handlers/knowledgesource"
 	"github.com/obot-platform/obot/pkg/controller/handlers/knowledgesummary"
+	"github.com/obot-platform/obot/pkg/controller/handlers/mcpaccessrequest"
 	"github.com/obot-platform/obot/pkg/controller/handlers/mcpcatalog"
 	"github.com/obot-platform/obot/pkg/controller/handlers/mcpserver"
 	"github.com/obot-platform/obot/pkg/controller/handlers/mcpservercatalogentry"
 	"github.com/obot-platform/obot/pkg/controller/handlers/mcpserverinstance"
 	"github.com/obot-platform/obot/pkg/controller/handlers/mcpsession"
+	"github.com/obot-platform/obot/pkg/controller/handlers/mcptoolcallapproval"
 	"github.com/obot-platform/obot/pkg/controller/handlers/oauthapp"
 	"github.com/obot-platform/obot/pkg/controller/handlers/oauthclients"
 	"github.com/obot-platform/obot/pkg/controller/handlers/poweruserworkspace"
@@ -44,7 +46,7 @@ import (
 )
 
 func (c *Controller) setupRoutes() {
-	root := c.router
+	root := c.router.Middleware(instrument)
 
 	workflowExecution := workflowexecution.New(c.services.Invoker)
 	workflowStep := workflowstep.New(c.services.Invoker, c.services.GPTClient, c.services.MCPLoader)
@@ -68,7 +70,7 @@ func (c *Controller) setupRoutes() {
 	projects := projects.NewHandler()
 	runstates := runstates.NewHandler(c.services.GatewayClient)
 	userCleanup := cleanup.NewUserCleanup(c.services.GatewayClient, c.services.AccessControlRuleHelper)
-	mcpCatalog := mcpcatalog.New(c.services.DefaultMCPCatalogPath, c.services.GatewayClient, c.services.AccessControlRuleHelper)
+	mcpCatalog := mcpcatalog.New(c.services.DefaultMCPCatalogPath, c.services.GPTClient, c.services.GatewayClient, c.services.AccessControlRuleHelper)
 	mcpSession := mcpsession.New(c.services.GPTClient)
 	mcpserver := mcpserver.New(c.services.GPTClient, c.services.ServerURL)
 	mcpserverinstance := mcpserverinstance.New(c.services.GatewayClient)
@@ -76,7 +78,7 @@ func (c *Controller) setupRoutes() {
 	mcpWebhookValidations := mcpwebhookvalidation.New()
 	powerUserWorkspaceHandler := poweruserworkspace.NewHandler(c.services.GatewayClient)
 	adminWorkspaceHandler := adminworkspace.New(c.services.GatewayClient)
-	auditLogExportHandler := auditlogexport.NewHandler(c.services.GPTClient, c.services.GatewayClient, c.services.EncryptionConfig)
+	auditLogExportHandler := auditlogexport.NewHandler(c.services.GPTClient, c.services.GatewayClient, c.services.AccessControlRuleHelper, c.services.EncryptionConfig)
 	scheduledAuditLogExportHandler := scheduledauditlogexport.NewHandler()
 	oauthclients := oauthclients.NewHandler(c.services.GPTClient)
 	projectMCPServerHandler := projectmcpserver.NewHandler()
@@ -224,9 +226,15 @@ func (c *Controller) setupRoutes() {
 	root.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(cleanup.Cleanup)
 	root.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpservercatalogentry.DeleteEntriesWithoutRuntime)
 	root.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpservercatalogentry.UpdateManifestHashAndLastUpdated)
+	root.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpservercatalogentry.RecordVersion)
+	root.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpservercatalogentry.ScanToolPreviews)
 	root.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpservercatalogentry.CleanupNestedCompositeEntries)
 	root.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpservercatalogentry.DetectCompositeDrift)
 	root.Type(&v1.MCPServerCatalogEntry{}).HandlerFunc(mcpservercatalogentry.EnsureUserCount)
+	root.Type(&v1.MCPServerCatalogEntry{}).FinalizeFunc(v1.MCPServerCatalogEntryFinalizer, mcpservercatalogentry.DeleteVersions)
+
+	// MCPServerCatalogEntryVersion
+	root.Type(&v1.MCPServerCatalogEntryVersion{}).HandlerFunc(cleanup.Cleanup)
 
 	// MCPServer
 	root.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.EnsureMCPCatalogID)
@@ -248,6 +256,7 @@ func (c *Controller) setupRoutes() {
 	// AccessControlRule
 	root.Type(&v1.AccessControlRule{}).HandlerFunc(cleanup.Cleanup)
 	root.Type(&v1.AccessControlRule{}).HandlerFunc(accesscontrolrule.PruneDeletedResources)
+	root.Type(&v1.AccessControlRule{}).HandlerFunc(accesscontrolrule.ExpireGrants)
 	// This is a hack. We use field selectors to trigger other resources. However, when an access control rule is deleted,
 	// we don't trigger because we don't have the object to match the field selectors against.
 	// Having a finalizer that does nothing will ensure that the other resources are triggered.
@@ -255,6 +264,17 @@ func (c *Controller) setupRoutes() {
 		return nil
 	})
 
+	// MCPAccessRequests
+	root.Type(&v1.MCPAccessRequest{}).HandlerFunc(cleanup.Cleanup)
+	root.Type(&v1.MCPAccessRequest{}).HandlerFunc(mcpaccessrequest.Expiration)
+	root.Type(&v1.MCPAccessRequest{}).HandlerFunc(mcpaccessrequest.EnsureGrant)
+	root.Type(&v1.MCPAccessRequest{}).HandlerFunc(mcpaccessrequest.Cleanup)
+
+	// MCPToolCallApprovals
+	root.Type(&v1.MCPToolCallApproval{}).HandlerFunc(mcptoolcallapproval.Expiration)
+	root.Type(&v1.MCPToolCallApproval{}).HandlerFunc(mcptoolcallapproval.SetEndedAt)
+	root.Type(&v1.MCPToolCallApproval{}).HandlerFunc(mcptoolcallapproval.Cleanup)
+
 	// ProjectInvitations
 	root.Type(&v1.ProjectInvitation{}).HandlerFunc(projectinvitation.SetRespondedTime)
 	root.Type(&v1.ProjectInvitation{}).HandlerFunc(projectinvitation.Expiration)
@@ -309,6 +329,9 @@ func (c *Controller) setupRoutes() {
 	// ScheduledAuditLogExport
 	root.Type(&v1.ScheduledAuditLogExport{}).HandlerFunc(scheduledAuditLogExportHandler.ScheduleExports)
 
+	// MCPAuditLogRetentionPolicy
+	root.Type(&v1.MCPAuditLogRetentionPolicy{}).HandlerFunc(auditLogExportHandler.EnforceRetentionPolicy)
+
 	c.toolRefHandler = toolRef
 	c.mcpCatalogHandler = mcpCatalog
 	c.adminWorkspaceHandler = adminWorkspaceHandler
@@ -5,7 +5,10 @@ import (
 	"time"
 
 	"github.com/obot-platform/obot/logger"
+	"github.com/obot-platform/obot/pkg/auditlogquery"
 	"github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/obot-platform/obot/pkg/metrics"
+	"gorm.io/datatypes"
 )
 
 var log = logger.Package()
@@ -15,6 +18,11 @@ func (c *Client) LogMCPAuditEntry(entry types.MCPAuditLog) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	if !c.encryptsMCPAuditLogs() {
+		// Keep the arguments searchable. They would leak the encrypted request body otherwise.
+		entry.RequestArguments = datatypes.JSON(auditlogquery.Arguments(entry.RequestBody))
+	}
+
 	if err := c.encryptMCPAuditLog(ctx, &entry); err != nil {
 		log.Errorf("Failed to encrypt MCP audit log: %v", err)
 	}
@@ -23,6 +31,7 @@ func (c *Client) LogMCPAuditEntry(entry types.MCPAuditLog) {
 	defer c.auditLock.Unlock()
 
 	c.auditBuffer = append(c.auditBuffer, entry)
+	metrics.AuditLogQueueDepth.Set(float64(len(c.auditBuffer)))
 	if len(c.auditBuffer) >= cap(c.auditBuffer)/2 {
 		select {
 		case c.kickAuditPersist <- struct{}{}:
@@ -66,12 +75,16 @@ func (c *Client) persistAuditLogs() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	if err := c.insertMCPAuditLogs(ctx, buf); err != nil {
-		c.auditLock.Lock()
+	start := time.Now()
+	err := c.insertMCPAuditLogs(ctx, buf)
+	metrics.AuditLogFlushDuration.WithLabelValues(metrics.Result(err)).Observe(time.Since(start).Seconds())
+
+	c.auditLock.Lock()
+	defer c.auditLock.Unlock()
+	if err != nil {
 		c.auditBuffer = append(buf, c.auditBuffer...)
-		c.auditLock.Unlock()
-		return err
 	}
+	metrics.AuditLogQueueDepth.Set(float64(len(c.auditBuffer)))
 
-	return nil
+	return err
 }
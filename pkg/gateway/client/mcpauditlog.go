@@ -11,8 +11,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/obot-platform/obot/pkg/auditlogquery"
 	"github.com/obot-platform/obot/pkg/gateway/types"
 	"gorm.io/gorm"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apiserver/pkg/storage/value"
 )
@@ -81,9 +83,16 @@ func (c *Client) insertMCPAuditLogs(ctx context.Context, logs []types.MCPAuditLo
 				if responseLog.Error != "" {
 					updates["error"] = responseLog.Error
 				}
+				if responseLog.Redacted {
+					updates["redacted"] = true
+				}
 				if len(responseLog.WebhookStatuses) > 0 {
 					updates["webhook_statuses"] = append(existingLog.WebhookStatuses, responseLog.WebhookStatuses...)
 				}
+				if len(responseLog.InjectionScanFindings) > 0 {
+					updates["injection_scan_findings"] = responseLog.InjectionScanFindings
+					updates["injection_scan_action"] = responseLog.InjectionScanAction
+				}
 				if existingLog.UserID == "" {
 					updates["user_id"] = responseLog.UserID
 				}
@@ -203,6 +212,9 @@ session_id %[1]s ? OR request_id %[1]s ? OR user_agent %[1]s ?`
 	if len(opts.ClientIP) > 0 {
 		db = db.Where("client_ip IN (?)", opts.ClientIP)
 	}
+	if len(opts.InjectionScanAction) > 0 {
+		db = db.Where("injection_scan_action IN (?)", opts.InjectionScanAction)
+	}
 	if opts.ProcessingTimeMin > 0 {
 		db = db.Where("processing_time_ms >= ?", opts.ProcessingTimeMin)
 	}
@@ -216,6 +228,15 @@ session_id %[1]s ? OR request_id %[1]s ? OR user_agent %[1]s ?`
 		db = db.Where("created_at < ?", opts.EndTime.Local())
 	}
 
+	if opts.Search != nil {
+		condition, args := opts.Search.Where(db.Name(), !c.encryptsMCPAuditLogs())
+		db = db.Where(condition, args...)
+
+		if c.encryptsMCPAuditLogs() && opts.Search.UsesArguments() {
+			return c.searchEncryptedMCPAuditLogs(ctx, sortMCPAuditLogs(db, opts), opts)
+		}
+	}
+
 	// Get the total before applying the limit
 	var total int64
 	if err := db.Count(&total).Error; err != nil {
@@ -230,7 +251,83 @@ session_id %[1]s ? OR request_id %[1]s ? OR user_agent %[1]s ?`
 		db = db.Offset(opts.Offset)
 	}
 
-	// Apply sorting
+	err := sortMCPAuditLogs(db, opts).Find(&logs).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Decrypt the logs after fetching
+	for i := range logs {
+		if !opts.WithRequestAndResponse {
+			// These are the only fields that are encrypted right now.
+			// So, just blank them out and skip decryption.
+			logs[i].RequestBody = nil
+			logs[i].ResponseBody = nil
+			logs[i].RequestHeaders = nil
+			logs[i].ResponseHeaders = nil
+		} else {
+			if err := c.decryptMCPAuditLog(ctx, &logs[i]); err != nil {
+				return nil, 0, fmt.Errorf("failed to decrypt MCP audit log: %w", err)
+			}
+		}
+	}
+
+	return logs, total, nil
+}
+
+// maxEncryptedSearchScan is the most audit logs that are decrypted to search their arguments, when request bodies are
+// encrypted and so can't be searched in the database.
+const maxEncryptedSearchScan = 100000
+
+// searchEncryptedMCPAuditLogs decrypts the audit logs that db selects, a batch at a time, and returns the page of the
+// ones that match the search's arguments, and their total.
+func (c *Client) searchEncryptedMCPAuditLogs(ctx context.Context, db *gorm.DB, opts MCPAuditLogOptions) ([]types.MCPAuditLog, int64, error) {
+	const batchSize = 1000
+
+	var (
+		result = make([]types.MCPAuditLog, 0, opts.Limit)
+		total  int64
+	)
+	for offset := 0; ; offset += batchSize {
+		if offset >= maxEncryptedSearchScan {
+			return nil, 0, apierrors.NewBadRequest(fmt.Sprintf("the search matches more than %d audit logs before looking at their encrypted arguments, narrow it down with a time range or other fields", maxEncryptedSearchScan))
+		}
+
+		var logs []types.MCPAuditLog
+		if err := db.Session(&gorm.Session{}).Offset(offset).Limit(batchSize).Find(&logs).Error; err != nil {
+			return nil, 0, err
+		}
+
+		for i := range logs {
+			if err := c.decryptMCPAuditLog(ctx, &logs[i]); err != nil {
+				return nil, 0, fmt.Errorf("failed to decrypt MCP audit log: %w", err)
+			}
+
+			arguments, _ := auditlogquery.DecodeArguments(auditlogquery.Arguments(logs[i].RequestBody))
+			if !opts.Search.Match(&logs[i], arguments) {
+				continue
+			}
+
+			total++
+			if total <= int64(opts.Offset) || (opts.Limit > 0 && len(result) >= opts.Limit) {
+				continue
+			}
+			if !opts.WithRequestAndResponse {
+				logs[i].RequestBody = nil
+				logs[i].ResponseBody = nil
+				logs[i].RequestHeaders = nil
+				logs[i].ResponseHeaders = nil
+			}
+			result = append(result, logs[i])
+		}
+
+		if len(logs) < batchSize {
+			return result, total, nil
+		}
+	}
+}
+
+func sortMCPAuditLogs(db *gorm.DB, opts MCPAuditLogOptions) *gorm.DB {
 	if opts.SortBy != "" {
 		// Validate sort field to prevent SQL injection
 		validSortFields := map[string]bool{
@@ -262,28 +359,7 @@ session_id %[1]s ? OR request_id %[1]s ? OR user_agent %[1]s ?`
 		db = db.Order("created_at DESC")
 	}
 
-	err := db.Find(&logs).Error
-	if err != nil {
-		return nil, 0, err
-	}
-
-	// Decrypt the logs after fetching
-	for i := range logs {
-		if !opts.WithRequestAndResponse {
-			// These are the only fields that are encrypted right now.
-			// So, just blank them out and skip decryption.
-			logs[i].RequestBody = nil
-			logs[i].ResponseBody = nil
-			logs[i].RequestHeaders = nil
-			logs[i].ResponseHeaders = nil
-		} else {
-			if err := c.decryptMCPAuditLog(ctx, &logs[i]); err != nil {
-				return nil, 0, fmt.Errorf("failed to decrypt MCP audit log: %w", err)
-			}
-		}
-	}
-
-	return logs, total, nil
+	return db
 }
 
 // GetMCPAuditLog retrieves a single MCP audit log by ID
@@ -346,6 +422,9 @@ func (c *Client) GetAuditLogFilterOptions(ctx context.Context, option string, op
 	if len(opts.ClientIP) > 0 {
 		db = db.Where("client_ip IN (?)", opts.ClientIP)
 	}
+	if len(opts.InjectionScanAction) > 0 {
+		db = db.Where("injection_scan_action IN (?)", opts.InjectionScanAction)
+	}
 	if len(opts.PowerUserWorkspaceID) > 0 {
 		db = db.Where("power_user_workspace_id IN (?)", opts.PowerUserWorkspaceID)
 	}
@@ -509,9 +588,11 @@ type MCPAuditLogOptions struct {
 	ClientVersion             []string
 	ResponseStatus            []string
 	ClientIP                  []string
+	InjectionScanAction       []string
 	ProcessingTimeMin         int64
 	ProcessingTimeMax         int64
 	Query                     string // Search term for text search across multiple fields
+	Search                    *auditlogquery.Query
 	StartTime                 time.Time
 	EndTime                   time.Time
 	Limit                     int
@@ -531,6 +612,11 @@ type MCPUsageStatsOptions struct {
 	EndTime                    time.Time
 }
 
+// encryptsMCPAuditLogs returns whether the bodies and headers of audit logs are encrypted.
+func (c *Client) encryptsMCPAuditLogs() bool {
+	return c.encryptionConfig != nil && c.encryptionConfig.Transformers[mcpAuditLogGroupResource] != nil
+}
+
 func (c *Client) encryptMCPAuditLog(ctx context.Context, log *types.MCPAuditLog) error {
 	if c.encryptionConfig == nil {
 		return nil
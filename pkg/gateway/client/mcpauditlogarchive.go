@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var mcpAuditLogArchiveGroupResource = schema.GroupResource{
+	Group:    "obot.obot.ai",
+	Resource: "mcpauditlogarchives",
+}
+
+// mcpAuditLogDeleteBatchSize is the number of audit logs that are deleted at a time, so that large deletes don't hold
+// locks on the table for too long.
+const mcpAuditLogDeleteBatchSize = 10000
+
+// MCPAuditLogScope selects the audit logs that a retention rule applies to. A log is in the scope if it matches any of
+// the fields, or if none are set, and it doesn't match Exclude.
+type MCPAuditLogScope struct {
+	MCPIDs                     []string
+	MCPServerCatalogEntryNames []string
+	PowerUserWorkspaceIDs      []string
+	// Exclude leaves out the logs of other scopes, like the catalogs and workspaces that have their own retention.
+	Exclude *MCPAuditLogScope
+}
+
+func (s MCPAuditLogScope) condition() (string, []any) {
+	var (
+		conditions []string
+		args       []any
+	)
+	if len(s.MCPIDs) > 0 {
+		conditions = append(conditions, "mcp_id IN (?)")
+		args = append(args, s.MCPIDs)
+	}
+	if len(s.MCPServerCatalogEntryNames) > 0 {
+		conditions = append(conditions, "mcp_server_catalog_entry_name IN (?)")
+		args = append(args, s.MCPServerCatalogEntryNames)
+	}
+	if len(s.PowerUserWorkspaceIDs) > 0 {
+		conditions = append(conditions, "power_user_workspace_id IN (?)")
+		args = append(args, s.PowerUserWorkspaceIDs)
+	}
+	return strings.Join(conditions, " OR "), args
+}
+
+func (s MCPAuditLogScope) apply(db *gorm.DB) *gorm.DB {
+	if condition, args := s.condition(); condition != "" {
+		db = db.Where("("+condition+")", args...)
+	}
+	if s.Exclude != nil {
+		if condition, args := s.Exclude.condition(); condition != "" {
+			db = db.Where("NOT ("+condition+")", args...)
+		}
+	}
+	return db
+}
+
+// GetOldestMCPAuditLogTime returns the creation time of the oldest audit log in the scope that was created before the
+// given time, and false if there is none.
+func (c *Client) GetOldestMCPAuditLogTime(ctx context.Context, scope MCPAuditLogScope, before time.Time) (time.Time, bool, error) {
+	var log types.MCPAuditLog
+	err := scope.apply(c.db.WithContext(ctx).Model(&types.MCPAuditLog{})).
+		Select("id, created_at").
+		Where("created_at < ?", before.Local()).
+		Order("created_at ASC").
+		Limit(1).
+		Find(&log).Error
+	if err != nil || log.ID == 0 {
+		return time.Time{}, false, err
+	}
+	return log.CreatedAt, true, nil
+}
+
+// GetMCPAuditLogsInRange returns the decrypted audit logs in the scope that were created in [start, end) and have an ID
+// after afterID, in ID order, with their bodies and headers.
+func (c *Client) GetMCPAuditLogsInRange(ctx context.Context, scope MCPAuditLogScope, start, end time.Time, afterID uint, limit int) ([]types.MCPAuditLog, error) {
+	var logs []types.MCPAuditLog
+	if err := scope.apply(c.db.WithContext(ctx).Model(&types.MCPAuditLog{})).
+		Where("created_at >= ? AND created_at < ? AND id > ?", start.Local(), end.Local(), afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&logs).Error; err != nil {
+		return nil, err
+	}
+
+	for i := range logs {
+		if err := c.decryptMCPAuditLog(ctx, &logs[i]); err != nil {
+			return nil, fmt.Errorf("failed to decrypt MCP audit log: %w", err)
+		}
+	}
+
+	return logs, nil
+}
+
+// DeleteMCPAuditLogsInRange deletes the audit logs in the scope that were created in [start, end). If maxID is not 0,
+// only logs with an ID up to maxID are deleted, so that logs that were written after an archive was made are kept.
+// It returns the number of deleted logs.
+func (c *Client) DeleteMCPAuditLogsInRange(ctx context.Context, scope MCPAuditLogScope, start, end time.Time, maxID uint) (int64, error) {
+	var deleted int64
+	for {
+		var ids []uint
+		db := scope.apply(c.db.WithContext(ctx).Model(&types.MCPAuditLog{})).
+			Where("created_at >= ? AND created_at < ?", start.Local(), end.Local())
+		if maxID != 0 {
+			db = db.Where("id <= ?", maxID)
+		}
+		if err := db.Limit(mcpAuditLogDeleteBatchSize).Pluck("id", &ids).Error; err != nil {
+			return deleted, err
+		}
+		if len(ids) == 0 {
+			return deleted, nil
+		}
+
+		result := c.db.WithContext(ctx).Where("id IN (?)", ids).Delete(&types.MCPAuditLog{})
+		if result.Error != nil {
+			return deleted, result.Error
+		}
+		deleted += result.RowsAffected
+
+		if len(ids) < mcpAuditLogDeleteBatchSize {
+			return deleted, nil
+		}
+	}
+}
+
+// CreateMCPAuditLogArchive records an archive that was uploaded to the storage provider.
+func (c *Client) CreateMCPAuditLogArchive(ctx context.Context, archive *types.MCPAuditLogArchive) error {
+	return c.db.WithContext(ctx).Create(archive).Error
+}
+
+// GetMCPAuditLogArchives returns the archives whose range overlaps [start, end), oldest first. A zero start or end
+// leaves that side of the range open.
+func (c *Client) GetMCPAuditLogArchives(ctx context.Context, scope string, start, end time.Time) ([]types.MCPAuditLogArchive, error) {
+	db := c.db.WithContext(ctx)
+	if scope != "" {
+		db = db.Where("scope = ?", scope)
+	}
+	if !start.IsZero() {
+		db = db.Where("end_time > ?", start.Local())
+	}
+	if !end.IsZero() {
+		db = db.Where("start_time < ?", end.Local())
+	}
+
+	var archives []types.MCPAuditLogArchive
+	return archives, db.Order("start_time ASC, id ASC").Find(&archives).Error
+}
+
+// GetMCPAuditLogArchive returns an archive by ID.
+func (c *Client) GetMCPAuditLogArchive(ctx context.Context, id uint) (*types.MCPAuditLogArchive, error) {
+	var archive types.MCPAuditLogArchive
+	if err := c.db.WithContext(ctx).Where("id = ?", id).First(&archive).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apierrors.NewNotFound(mcpAuditLogArchiveGroupResource, strconv.FormatUint(uint64(id), 10))
+	} else if err != nil {
+		return nil, err
+	}
+	return &archive, nil
+}
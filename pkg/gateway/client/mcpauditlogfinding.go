@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var mcpAuditLogFindingGroupResource = schema.GroupResource{
+	Group:    "obot.obot.ai",
+	Resource: "mcpauditlogfindings",
+}
+
+// MCPAuditLogFindingOptions are the filters for listing findings.
+type MCPAuditLogFindingOptions struct {
+	// Acknowledged filters by whether the findings have been acknowledged, if it is set.
+	Acknowledged *bool
+	Type         []string
+	Severity     []string
+	UserID       []string
+	MCPID        []string
+	StartTime    time.Time
+	EndTime      time.Time
+	Limit        int
+	Offset       int
+}
+
+// GetMCPAuditLogsForAnalysis returns the metadata of the audit logs with an ID after afterID, in ID order, up to the
+// first log that wasn't created before the given time. Bodies and headers are not included.
+// Logs aren't always inserted in the order they were created, so the batch stops at the first log that is too new
+// rather than skipping it, which would move the caller's cursor past it for good.
+func (c *Client) GetMCPAuditLogsForAnalysis(ctx context.Context, afterID uint, before time.Time, limit int) ([]types.MCPAuditLog, error) {
+	var logs []types.MCPAuditLog
+	if err := c.db.WithContext(ctx).
+		Select("id, created_at, user_id, mcp_id, mcp_server_display_name, client_ip, call_type, call_identifier, response_status, error").
+		Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&logs).Error; err != nil {
+		return nil, err
+	}
+
+	for i, l := range logs {
+		if !l.CreatedAt.Before(before) {
+			return logs[:i], nil
+		}
+	}
+	return logs, nil
+}
+
+// GetMCPAuditLogBaselines returns the baselines with the given keys. Keys without a baseline are not included.
+func (c *Client) GetMCPAuditLogBaselines(ctx context.Context, keys []string) (map[string]*types.MCPAuditLogBaseline, error) {
+	result := make(map[string]*types.MCPAuditLogBaseline, len(keys))
+	if len(keys) == 0 {
+		return result, nil
+	}
+
+	var baselines []types.MCPAuditLogBaseline
+	if err := c.db.WithContext(ctx).Where("key IN ?", keys).Find(&baselines).Error; err != nil {
+		return nil, err
+	}
+	for i := range baselines {
+		result[baselines[i].Key] = &baselines[i]
+	}
+	return result, nil
+}
+
+// SaveMCPAuditLogAnalysis stores the baselines and the new findings of an analysis pass, along with the ID of the last
+// audit log that was analyzed, so the next pass can start after it. Findings that were already raised are skipped.
+func (c *Client) SaveMCPAuditLogAnalysis(ctx context.Context, baselines []types.MCPAuditLogBaseline, findings []types.MCPAuditLogFinding, cursorKey, cursor string) error {
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(baselines) > 0 {
+			if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).CreateInBatches(baselines, 100).Error; err != nil {
+				return err
+			}
+		}
+		if len(findings) > 0 {
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(findings, 100).Error; err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "key"}},
+			DoUpdates: clause.AssignmentColumns([]string{"value", "updated_at"}),
+		}).Create(&types.Property{Key: cursorKey, Value: cursor, CreatedAt: now, UpdatedAt: now}).Error
+	})
+}
+
+// GetMCPAuditLogFindings returns the findings that match the options, newest first, and the total number of matches.
+func (c *Client) GetMCPAuditLogFindings(ctx context.Context, opts MCPAuditLogFindingOptions) ([]types.MCPAuditLogFinding, int64, error) {
+	db := c.db.WithContext(ctx).Model(&types.MCPAuditLogFinding{})
+
+	if opts.Acknowledged != nil {
+		if *opts.Acknowledged {
+			db = db.Where("acknowledged_at IS NOT NULL")
+		} else {
+			db = db.Where("acknowledged_at IS NULL")
+		}
+	}
+	if len(opts.Type) > 0 {
+		db = db.Where("type IN (?)", opts.Type)
+	}
+	if len(opts.Severity) > 0 {
+		db = db.Where("severity IN (?)", opts.Severity)
+	}
+	if len(opts.UserID) > 0 {
+		db = db.Where("user_id IN (?)", opts.UserID)
+	}
+	if len(opts.MCPID) > 0 {
+		db = db.Where("mcp_id IN (?)", opts.MCPID)
+	}
+	if !opts.StartTime.IsZero() {
+		db = db.Where("created_at >= ?", opts.StartTime.Local())
+	}
+	if !opts.EndTime.IsZero() {
+		db = db.Where("created_at < ?", opts.EndTime.Local())
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if opts.Limit > 0 {
+		db = db.Limit(opts.Limit)
+	}
+	if opts.Offset > 0 {
+		db = db.Offset(opts.Offset)
+	}
+
+	var findings []types.MCPAuditLogFinding
+	return findings, total, db.Order("created_at DESC, id DESC").Find(&findings).Error
+}
+
+// GetMCPAuditLogFinding returns a finding by ID.
+func (c *Client) GetMCPAuditLogFinding(ctx context.Context, id uint) (*types.MCPAuditLogFinding, error) {
+	var finding types.MCPAuditLogFinding
+	if err := c.db.WithContext(ctx).Where("id = ?", id).First(&finding).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apierrors.NewNotFound(mcpAuditLogFindingGroupResource, strconv.FormatUint(uint64(id), 10))
+	} else if err != nil {
+		return nil, err
+	}
+	return &finding, nil
+}
+
+// AcknowledgeMCPAuditLogFinding marks a finding as acknowledged by the given user. Acknowledging a finding again replaces the note.
+func (c *Client) AcknowledgeMCPAuditLogFinding(ctx context.Context, id uint, userID, note string) (*types.MCPAuditLogFinding, error) {
+	finding, err := c.GetMCPAuditLogFinding(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	finding.AcknowledgedAt = &now
+	finding.AcknowledgedBy = userID
+	finding.AcknowledgedNote = note
+	if err := c.db.WithContext(ctx).Model(finding).Updates(map[string]any{
+		"acknowledged_at":   finding.AcknowledgedAt,
+		"acknowledged_by":   finding.AcknowledgedBy,
+		"acknowledged_note": finding.AcknowledgedNote,
+	}).Error; err != nil {
+		return nil, err
+	}
+	return finding, nil
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm/clause"
+)
+
+// SaveMCPServerActivity stores the activity of MCP servers that an Obot replica saw, replacing what it stored before.
+func (c *Client) SaveMCPServerActivity(ctx context.Context, activity []types.MCPServerActivity) error {
+	if len(activity) == 0 {
+		return nil
+	}
+
+	return c.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "mcp_server_name"}, {Name: "replica"}},
+		DoUpdates: clause.AssignmentColumns([]string{"in_flight", "last_active_at", "idle_timeout_seconds", "peak_in_flight", "min_replicas", "max_replicas", "target_concurrency", "updated_at"}),
+	}).CreateInBatches(activity, 100).Error
+}
+
+// GetMCPServerActivity returns the activity of every MCP server that any Obot replica stored.
+func (c *Client) GetMCPServerActivity(ctx context.Context) ([]types.MCPServerActivity, error) {
+	var activity []types.MCPServerActivity
+	return activity, c.db.WithContext(ctx).Find(&activity).Error
+}
+
+// DeleteMCPServerActivity deletes the activity of an MCP server that all Obot replicas stored.
+func (c *Client) DeleteMCPServerActivity(ctx context.Context, mcpServerName string) error {
+	return c.db.WithContext(ctx).Where("mcp_server_name = ?", mcpServerName).Delete(&types.MCPServerActivity{}).Error
+}
+
+// DeleteMCPServerActivityBefore deletes the activity that wasn't updated since the given time.
+func (c *Client) DeleteMCPServerActivityBefore(ctx context.Context, before time.Time) error {
+	return c.db.WithContext(ctx).Where("updated_at < ?", before).Delete(&types.MCPServerActivity{}).Error
+}
@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"k8s.io/apiserver/pkg/storage/value"
+)
+
+// InsertMCPServerDeploymentEvents stores deployment events of MCP servers. An event that is already stored has its count,
+// message, and time updated, because the backend keeps updating repeated events.
+func (c *Client) InsertMCPServerDeploymentEvents(ctx context.Context, events []types.MCPServerDeploymentEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	return c.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "dedupe_key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"count", "message", "time"}),
+	}).CreateInBatches(events, 100).Error
+}
+
+// InsertMCPServerLogLines stores log lines of an MCP server, and then deletes all but the most recent keep lines of the server.
+// Lines that are already stored are skipped.
+func (c *Client) InsertMCPServerLogLines(ctx context.Context, mcpServerName string, lines []types.MCPServerLogLine, keep int) error {
+	if len(lines) == 0 {
+		return nil
+	}
+
+	// Server logs can contain the same data as the server's audit logs, so they are encrypted the same way.
+	for i := range lines {
+		if err := c.encryptMCPServerLogLine(ctx, &lines[i]); err != nil {
+			return fmt.Errorf("failed to encrypt log line: %w", err)
+		}
+	}
+
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(lines, 100).Error; err != nil {
+			return fmt.Errorf("failed to insert log lines: %w", err)
+		}
+
+		var oldest types.MCPServerLogLine
+		if err := tx.Where("mcp_server_name = ?", mcpServerName).Order("id DESC").Offset(keep).Limit(1).First(&oldest).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to find log lines to delete: %w", err)
+		}
+
+		return tx.Where("mcp_server_name = ? AND id <= ?", mcpServerName, oldest.ID).Delete(&types.MCPServerLogLine{}).Error
+	})
+}
+
+// GetMCPServerDeploymentEvents returns the most recent deployment events of an MCP server, oldest first.
+func (c *Client) GetMCPServerDeploymentEvents(ctx context.Context, mcpServerName string, limit int) ([]types.MCPServerDeploymentEvent, error) {
+	var events []types.MCPServerDeploymentEvent
+	if err := c.db.WithContext(ctx).Where("mcp_server_name = ?", mcpServerName).Order("time DESC").Order("id DESC").Limit(limit).Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	slices.Reverse(events)
+	return events, nil
+}
+
+// GetMCPServerLogLines returns the most recent log lines of an MCP server, oldest first.
+func (c *Client) GetMCPServerLogLines(ctx context.Context, mcpServerName string, limit int) ([]types.MCPServerLogLine, error) {
+	var lines []types.MCPServerLogLine
+	if err := c.db.WithContext(ctx).Where("mcp_server_name = ?", mcpServerName).Order("id DESC").Limit(limit).Find(&lines).Error; err != nil {
+		return nil, err
+	}
+
+	for i := range lines {
+		if err := c.decryptMCPServerLogLine(ctx, &lines[i]); err != nil {
+			return nil, fmt.Errorf("failed to decrypt log line: %w", err)
+		}
+	}
+
+	slices.Reverse(lines)
+	return lines, nil
+}
+
+// DeleteMCPServerHistoryBefore deletes the deployment events and log lines of MCP servers that are older than the given time.
+func (c *Client) DeleteMCPServerHistoryBefore(ctx context.Context, before time.Time) error {
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("time < ?", before).Delete(&types.MCPServerDeploymentEvent{}).Error; err != nil {
+			return fmt.Errorf("failed to delete deployment events: %w", err)
+		}
+		if err := tx.Where("time < ?", before).Delete(&types.MCPServerLogLine{}).Error; err != nil {
+			return fmt.Errorf("failed to delete log lines: %w", err)
+		}
+		return nil
+	})
+}
+
+func (c *Client) encryptMCPServerLogLine(ctx context.Context, line *types.MCPServerLogLine) error {
+	if c.encryptionConfig == nil {
+		return nil
+	}
+
+	transformer := c.encryptionConfig.Transformers[mcpAuditLogGroupResource]
+	if transformer == nil {
+		return nil
+	}
+
+	b, err := transformer.TransformToStorage(ctx, []byte(line.Line), mcpServerLogLineDataCtx(line))
+	if err != nil {
+		return err
+	}
+
+	line.Line = base64.StdEncoding.EncodeToString(b)
+	line.Encrypted = true
+	return nil
+}
+
+func (c *Client) decryptMCPServerLogLine(ctx context.Context, line *types.MCPServerLogLine) error {
+	if !line.Encrypted || c.encryptionConfig == nil {
+		return nil
+	}
+
+	transformer := c.encryptionConfig.Transformers[mcpAuditLogGroupResource]
+	if transformer == nil {
+		return nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(line.Line)
+	if err != nil {
+		return err
+	}
+
+	out, _, err := transformer.TransformFromStorage(ctx, decoded, mcpServerLogLineDataCtx(line))
+	if err != nil {
+		return err
+	}
+
+	line.Line = string(out)
+	return nil
+}
+
+func mcpServerLogLineDataCtx(line *types.MCPServerLogLine) value.Context {
+	return value.DefaultContext(fmt.Sprintf("%s/%s/logs", mcpAuditLogGroupResource.String(), line.MCPServerName))
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+// InsertMCPServerProbeResult stores the result of a health probe of an MCP server.
+func (c *Client) InsertMCPServerProbeResult(ctx context.Context, result *types.MCPServerProbeResult) error {
+	return c.db.WithContext(ctx).Create(result).Error
+}
+
+// GetMCPServerProbeResults returns the probe results of an MCP server since the given time, oldest first.
+func (c *Client) GetMCPServerProbeResults(ctx context.Context, mcpServerName string, since time.Time) ([]types.MCPServerProbeResult, error) {
+	var results []types.MCPServerProbeResult
+	return results, c.db.WithContext(ctx).Where("mcp_server_name = ? AND time >= ?", mcpServerName, since).Order("time ASC").Find(&results).Error
+}
+
+// GetMCPServerProbeStats returns the number of probes and successful probes of each MCP server since the given time.
+// Servers without probes in that time are not included.
+func (c *Client) GetMCPServerProbeStats(ctx context.Context, since time.Time, mcpServerNames ...string) (map[string]types.MCPServerProbeStats, error) {
+	db := c.db.WithContext(ctx).Model(&types.MCPServerProbeResult{}).Where("time >= ?", since)
+	if len(mcpServerNames) > 0 {
+		db = db.Where("mcp_server_name IN ?", mcpServerNames)
+	}
+
+	var rows []types.MCPServerProbeStats
+	if err := db.Select("mcp_server_name, COUNT(*) AS total, SUM(CASE WHEN success THEN 1 ELSE 0 END) AS succeeded").
+		Group("mcp_server_name").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]types.MCPServerProbeStats, len(rows))
+	for _, row := range rows {
+		result[row.MCPServerName] = row
+	}
+	return result, nil
+}
+
+// DeleteMCPServerProbeResultsBefore deletes the probe results that are older than the given time.
+func (c *Client) DeleteMCPServerProbeResultsBefore(ctx context.Context, before time.Time) error {
+	return c.db.WithContext(ctx).Where("time < ?", before).Delete(&types.MCPServerProbeResult{}).Error
+}
@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var mcpToolPinGroupResource = schema.GroupResource{
+	Group:    "obot.obot.ai",
+	Resource: "mcptoolpins",
+}
+
+// MCPToolPinOptions are the filters for listing tool pins.
+type MCPToolPinOptions struct {
+	// Changed filters by whether the tools changed, if it is set.
+	Changed  *bool
+	SourceID []string
+	ToolName []string
+	Limit    int
+	Offset   int
+}
+
+// GetMCPToolPinsForSource returns the pins of the tools of a source, by tool name.
+func (c *Client) GetMCPToolPinsForSource(ctx context.Context, sourceID string) (map[string]types.MCPToolPin, error) {
+	var pins []types.MCPToolPin
+	if err := c.db.WithContext(ctx).Where("source_id = ?", sourceID).Find(&pins).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]types.MCPToolPin, len(pins))
+	for _, pin := range pins {
+		result[pin.ToolName] = pin
+	}
+	return result, nil
+}
+
+// GetMCPToolPinForTool returns the pin of a tool of a source, or nil if the tool hasn't been pinned.
+func (c *Client) GetMCPToolPinForTool(ctx context.Context, sourceID, toolName string) (*types.MCPToolPin, error) {
+	var pin types.MCPToolPin
+	if err := c.db.WithContext(ctx).Where("source_id = ? AND tool_name = ?", sourceID, toolName).First(&pin).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &pin, nil
+}
+
+// CreateMCPToolPins stores the pins of tools that were listed for the first time. Tools that were pinned in the
+// meantime, by another list of the same server, keep their pin.
+func (c *Client) CreateMCPToolPins(ctx context.Context, pins []types.MCPToolPin) error {
+	if len(pins) == 0 {
+		return nil
+	}
+	return c.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(pins, 100).Error
+}
+
+// UpdateMCPToolPinCurrent stores the version of a tool that its server listed most recently, and the finding about the
+// change, if there is one. Findings that were already raised are skipped.
+func (c *Client) UpdateMCPToolPinCurrent(ctx context.Context, pin *types.MCPToolPin, finding *types.MCPAuditLogFinding) error {
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(pin).Updates(map[string]any{
+			"mcp_server_display_name": pin.MCPServerDisplayName,
+			"current_hash":            pin.CurrentHash,
+			"current_description":     pin.CurrentDescription,
+			"current_input_schema":    pin.CurrentInputSchema,
+			"changed_at":              pin.ChangedAt,
+		}).Error; err != nil {
+			return err
+		}
+
+		if finding == nil {
+			return nil
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(finding).Error
+	})
+}
+
+// GetMCPToolPins returns the pins that match the options, with the most recently changed tools first, and the total
+// number of matches.
+func (c *Client) GetMCPToolPins(ctx context.Context, opts MCPToolPinOptions) ([]types.MCPToolPin, int64, error) {
+	db := c.db.WithContext(ctx).Model(&types.MCPToolPin{})
+
+	if opts.Changed != nil {
+		if *opts.Changed {
+			db = db.Where("current_hash <> hash")
+		} else {
+			db = db.Where("current_hash = hash")
+		}
+	}
+	if len(opts.SourceID) > 0 {
+		db = db.Where("source_id IN (?)", opts.SourceID)
+	}
+	if len(opts.ToolName) > 0 {
+		db = db.Where("tool_name IN (?)", opts.ToolName)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if opts.Limit > 0 {
+		db = db.Limit(opts.Limit)
+	}
+	if opts.Offset > 0 {
+		db = db.Offset(opts.Offset)
+	}
+
+	var pins []types.MCPToolPin
+	return pins, total, db.Order("changed_at IS NULL, changed_at DESC, id DESC").Find(&pins).Error
+}
+
+// GetMCPToolPin returns a tool pin by ID.
+func (c *Client) GetMCPToolPin(ctx context.Context, id uint) (*types.MCPToolPin, error) {
+	var pin types.MCPToolPin
+	if err := c.db.WithContext(ctx).Where("id = ?", id).First(&pin).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, apierrors.NewNotFound(mcpToolPinGroupResource, strconv.FormatUint(uint64(id), 10))
+	} else if err != nil {
+		return nil, err
+	}
+	return &pin, nil
+}
+
+// ApproveMCPToolPin makes the current version of a tool its approved version. If currentHash is set, the current
+// version must have that hash, so that a version that changed after it was reviewed isn't approved.
+func (c *Client) ApproveMCPToolPin(ctx context.Context, id uint, userID, currentHash string) (*types.MCPToolPin, error) {
+	pin, err := c.GetMCPToolPin(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	conflict := apierrors.NewConflict(mcpToolPinGroupResource, strconv.FormatUint(uint64(id), 10), fmt.Errorf("the tool changed again, review the new version before approving it"))
+	if currentHash != "" && pin.CurrentHash != currentHash {
+		return nil, conflict
+	}
+
+	now := time.Now()
+	result := c.db.WithContext(ctx).Model(&types.MCPToolPin{}).
+		Where("id = ? AND current_hash = ?", id, pin.CurrentHash).
+		Updates(map[string]any{
+			"hash":         pin.CurrentHash,
+			"description":  pin.CurrentDescription,
+			"input_schema": pin.CurrentInputSchema,
+			"changed_at":   nil,
+			"approved_at":  now,
+			"approved_by":  userID,
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		// The server listed another version while the approval was being stored.
+		return nil, conflict
+	}
+
+	pin.Hash = pin.CurrentHash
+	pin.Description = pin.CurrentDescription
+	pin.InputSchema = pin.CurrentInputSchema
+	pin.ChangedAt = nil
+	pin.ApprovedAt = &now
+	pin.ApprovedBy = userID
+	return pin, nil
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm"
+)
+
+// shadowReportTopN is the number of tools, servers, and users, and of recent verdicts, in a shadow report.
+const shadowReportTopN = 10
+
+// InsertMCPWebhookShadowVerdicts stores the verdicts of webhooks in shadow mode.
+func (c *Client) InsertMCPWebhookShadowVerdicts(ctx context.Context, verdicts []types.MCPWebhookShadowVerdict) error {
+	if len(verdicts) == 0 {
+		return nil
+	}
+	return c.db.WithContext(ctx).CreateInBatches(verdicts, 100).Error
+}
+
+// GetMCPWebhookShadowReport summarizes the verdicts of a webhook validation in shadow mode in [start, end).
+func (c *Client) GetMCPWebhookShadowReport(ctx context.Context, webhookValidationID string, start, end time.Time) (types2.MCPWebhookShadowReport, error) {
+	report := types2.MCPWebhookShadowReport{
+		Start: *types2.NewTime(start),
+		End:   *types2.NewTime(end),
+	}
+
+	scoped := func() *gorm.DB {
+		return c.db.WithContext(ctx).Model(&types.MCPWebhookShadowVerdict{}).
+			Where("webhook_validation_id = ? AND created_at >= ? AND created_at < ?", webhookValidationID, start.Local(), end.Local())
+	}
+
+	var counts []struct {
+		Verdict string
+		Count   int64
+	}
+	if err := scoped().Select("verdict, COUNT(*) AS count").Group("verdict").Scan(&counts).Error; err != nil {
+		return report, err
+	}
+	for _, count := range counts {
+		report.Evaluated += count.Count
+		switch types2.MCPWebhookShadowVerdictType(count.Verdict) {
+		case types2.MCPWebhookShadowVerdictBlocked:
+			report.WouldBlock = count.Count
+		case types2.MCPWebhookShadowVerdictError:
+			report.Errors = count.Count
+		}
+	}
+
+	blocked := func() *gorm.DB {
+		return scoped().Where("verdict = ?", string(types2.MCPWebhookShadowVerdictBlocked))
+	}
+	for column, result := range map[string]*[]types2.MCPWebhookShadowReportCount{
+		"call_identifier": &report.BlockedByTool,
+		"mcp_id":          &report.BlockedByServer,
+		"user_id":         &report.BlockedByUser,
+	} {
+		*result = []types2.MCPWebhookShadowReportCount{}
+		if err := blocked().
+			Select(column + " AS key, COUNT(*) AS count").
+			Group(column).
+			Order("count DESC, key ASC").
+			Limit(shadowReportTopN).
+			Scan(result).Error; err != nil {
+			return report, err
+		}
+	}
+
+	var recent []types.MCPWebhookShadowVerdict
+	if err := blocked().Order("created_at DESC, id DESC").Limit(shadowReportTopN).Find(&recent).Error; err != nil {
+		return report, err
+	}
+	report.RecentBlocked = make([]types2.MCPWebhookShadowVerdict, 0, len(recent))
+	for _, v := range recent {
+		report.RecentBlocked = append(report.RecentBlocked, types.ConvertMCPWebhookShadowVerdict(v))
+	}
+
+	return report, nil
+}
+
+// DeleteMCPWebhookShadowVerdictsBefore deletes the shadow verdicts that are older than the given time.
+func (c *Client) DeleteMCPWebhookShadowVerdictsBefore(ctx context.Context, before time.Time) error {
+	return c.db.WithContext(ctx).Where("created_at < ?", before.Local()).Delete(&types.MCPWebhookShadowVerdict{}).Error
+}
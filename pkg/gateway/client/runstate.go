@@ -83,6 +83,34 @@ func (c *Client) DeleteRunState(ctx context.Context, namespace, name string) err
 	return nil
 }
 
+// CountRunStates returns the number of run states that are running, done, and done with an error.
+func (c *Client) CountRunStates(ctx context.Context) (map[string]int, error) {
+	var rows []struct {
+		Done   bool
+		Failed bool
+		Count  int
+	}
+	if err := c.db.WithContext(ctx).Model(new(types.RunState)).
+		Select("done, error <> '' AS failed, COUNT(*) AS count").
+		Group("done, failed").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int{"running": 0, "done": 0, "error": 0}
+	for _, row := range rows {
+		switch {
+		case row.Failed:
+			counts["error"] += row.Count
+		case row.Done:
+			counts["done"] += row.Count
+		default:
+			counts["running"] += row.Count
+		}
+	}
+	return counts, nil
+}
+
 func (c *Client) encryptRunState(ctx context.Context, runState *types.RunState) error {
 	if c.encryptionConfig == nil {
 		return nil
@@ -96,10 +96,29 @@ func (db *DB) AutoMigrate() (err error) {
 		types.MCPAuditLog{},
 		types.TempSetupUser{},
 		types.Property{},
+		types.MCPServerDeploymentEvent{},
+		types.MCPServerLogLine{},
+		types.MCPServerProbeResult{},
+		types.MCPServerActivity{},
+		types.MCPAuditLogFinding{},
+		types.MCPAuditLogBaseline{},
+		types.MCPAuditLogArchive{},
+		types.MCPWebhookShadowVerdict{},
+		types.MCPToolPin{},
 	); err != nil {
 		return fmt.Errorf("failed to auto migrate gateway types: %w", err)
 	}
 
+	if db.gormDB.Name() == "postgres" {
+		if err := addMCPAuditLogSearchIndexes(tx); err != nil {
+			return fmt.Errorf("failed to add mcp_audit_log search indexes: %w", err)
+		}
+	}
+
+	if err := migrateIfEntryNotFoundInMigrationsTable(tx, "backfill_mcp_audit_log_request_arguments", backfillMCPAuditLogRequestArguments); err != nil {
+		return fmt.Errorf("failed to backfill mcp_audit_log request arguments: %w", err)
+	}
+
 	// MIGRATION: replace mcp_server_instance with mcp_id as the new primary key.
 	// First, check to se if the mcp_server_instance column still exists.
 	if exists := tx.Migrator().HasColumn(&types.MCPOAuthToken{}, "mcp_server_instance"); exists {
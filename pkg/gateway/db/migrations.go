@@ -5,8 +5,10 @@ import (
 	"fmt"
 
 	types2 "github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/auditlogquery"
 	"github.com/obot-platform/obot/pkg/gateway/types"
 	"github.com/obot-platform/obot/pkg/hash"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
@@ -160,6 +162,43 @@ func migrateMCPAuditLogClientInfo(tx *gorm.DB) error {
 	return nil
 }
 
+// addMCPAuditLogSearchIndexes adds the PostgreSQL indexes that are used to search the arguments of audit logs: a GIN
+// index for JSON containment and one for full-text search.
+func addMCPAuditLogSearchIndexes(tx *gorm.DB) error {
+	if err := tx.Exec("CREATE INDEX IF NOT EXISTS idx_mcp_audit_logs_request_arguments ON mcp_audit_logs USING GIN (request_arguments jsonb_path_ops)").Error; err != nil {
+		return err
+	}
+	return tx.Exec("CREATE INDEX IF NOT EXISTS idx_mcp_audit_logs_request_arguments_text ON mcp_audit_logs USING GIN (to_tsvector('simple', request_arguments))").Error
+}
+
+// backfillMCPAuditLogRequestArguments sets the searchable arguments of the audit logs that were stored before the
+// arguments were. Like new logs, only the logs with unencrypted request bodies get them.
+func backfillMCPAuditLogRequestArguments(tx *gorm.DB) error {
+	var lastID uint
+	for {
+		var logs []types.MCPAuditLog
+		if err := tx.Select("id, request_body").
+			Where("id > ? AND encrypted = ? AND request_arguments IS NULL", lastID, false).
+			Order("id ASC").
+			Limit(1000).
+			Find(&logs).Error; err != nil {
+			return err
+		}
+		if len(logs) == 0 {
+			return nil
+		}
+
+		for _, l := range logs {
+			if args := auditlogquery.Arguments(l.RequestBody); args != nil {
+				if err := tx.Model(&types.MCPAuditLog{}).Where("id = ?", l.ID).Update("request_arguments", datatypes.JSON(args)).Error; err != nil {
+					return err
+				}
+			}
+		}
+		lastID = logs[len(logs)-1].ID
+	}
+}
+
 func migrateUserRoles(tx *gorm.DB) error {
 	migrator := tx.Migrator()
 	if migrator.HasTable(&types.User{}) && migrator.HasColumn(&types.User{}, "role") {
@@ -10,6 +10,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httputil"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -19,15 +20,22 @@ import (
 	"github.com/obot-platform/obot/pkg/api"
 	"github.com/obot-platform/obot/pkg/gateway/client"
 	"github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/obot-platform/obot/pkg/metrics"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
+	"github.com/obot-platform/obot/pkg/tracing"
 	"github.com/tidwall/gjson"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const tokenUsageTimePeriod = 24 * time.Hour
 
-func (s *Server) llmProxy(req api.Context) error {
+var tracer = otel.Tracer("obot/gateway")
+
+func (s *Server) llmProxy(req api.Context) (err error) {
 	token, err := s.tokenService.DecodeToken(req.Context(), strings.TrimPrefix(req.Request.Header.Get("Authorization"), "Bearer "))
 	if err != nil {
 		return types2.NewErrHTTP(http.StatusUnauthorized, fmt.Sprintf("invalid token: %v", err))
@@ -39,6 +47,11 @@ func (s *Server) llmProxy(req api.Context) error {
 		model         = token.Model
 		modelProvider = token.ModelProvider
 	)
+	defer func() {
+		if err != nil {
+			metrics.LLMProxyRequests.WithLabelValues(model, modelProvider, metrics.ErrorCode(err)).Inc()
+		}
+	}()
 
 	body, err := readBody(req.Request)
 	if err != nil {
@@ -90,6 +103,29 @@ func (s *Server) llmProxy(req api.Context) error {
 	req.Request.Body = io.NopCloser(bytes.NewReader(b))
 	req.ContentLength = int64(len(b))
 
+	// gptscript doesn't pass on the trace context, so continue the trace of the run that this call is for.
+	ctx := req.Context()
+	if token.RunID != "" {
+		var run v1.Run
+		if err := req.Storage.Get(ctx, kclient.ObjectKey{Namespace: token.Namespace, Name: token.RunID}, &run); err == nil {
+			ctx = tracing.Extract(ctx, &run)
+		}
+	}
+	ctx, span := tracer.Start(ctx, "chat "+model, trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithLinks(trace.LinkFromContext(req.Context())),
+		trace.WithAttributes(
+			attribute.String("gen_ai.operation.name", "chat"),
+			attribute.String("gen_ai.system", modelProvider),
+			attribute.String("gen_ai.request.model", model),
+			attribute.String("obot.run.id", token.RunID),
+			attribute.String("obot.thread.id", token.ThreadID),
+		))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+	req.Request = req.Request.WithContext(ctx)
+
 	u, err := s.dispatcher.URLForModelProvider(req.Context(), req.GPTClient, token.Namespace, modelProvider)
 	if err != nil {
 		return fmt.Errorf("failed to get model provider: %w", err)
@@ -108,11 +144,28 @@ func (s *Server) llmProxy(req api.Context) error {
 		return fmt.Errorf("failed to create monitor: %w", err)
 	}
 
+	modifier := &responseModifier{userID: token.UserID, runID: token.RunID, client: req.GatewayClient, personalToken: personalToken}
 	(&httputil.ReverseProxy{
 		Director:       s.dispatcher.TransformRequest(u, credEnv),
-		ModifyResponse: (&responseModifier{userID: token.UserID, runID: token.RunID, client: req.GatewayClient, personalToken: personalToken}).modifyResponse,
+		ModifyResponse: modifier.modifyResponse,
 	}).ServeHTTP(req.ResponseWriter, req.Request)
 
+	// The response has been copied and closed, so the token counts are final.
+	modifier.lock.Lock()
+	defer modifier.lock.Unlock()
+	if modifier.status == 0 {
+		// The reverse proxy couldn't reach the model provider and responded with a bad gateway error.
+		modifier.status = http.StatusBadGateway
+	}
+	tracing.RecordHTTPStatus(span, modifier.status)
+	span.SetAttributes(
+		attribute.Int("gen_ai.usage.input_tokens", modifier.promptTokens),
+		attribute.Int("gen_ai.usage.output_tokens", modifier.completionTokens),
+	)
+	metrics.LLMProxyRequests.WithLabelValues(model, modelProvider, strconv.Itoa(modifier.status)).Inc()
+	metrics.LLMProxyTokens.WithLabelValues(model, modelProvider, "prompt").Add(float64(modifier.promptTokens))
+	metrics.LLMProxyTokens.WithLabelValues(model, modelProvider, "completion").Add(float64(modifier.completionTokens))
+
 	return nil
 }
 
@@ -167,9 +220,14 @@ type responseModifier struct {
 	b                                           *bufio.Reader
 	c                                           io.Closer
 	stream                                      bool
+	status                                      int
 }
 
 func (r *responseModifier) modifyResponse(resp *http.Response) error {
+	r.lock.Lock()
+	r.status = resp.StatusCode
+	r.lock.Unlock()
+
 	if resp.StatusCode != http.StatusOK || resp.Request.URL.Path != "/v1/chat/completions" {
 		return nil
 	}
@@ -36,9 +36,24 @@ type MCPAuditLog struct {
 	UserAgent       string          `json:"userAgent,omitempty"`
 	RequestHeaders  json.RawMessage `json:"requestHeaders,omitempty"`
 	ResponseHeaders json.RawMessage `json:"responseHeaders,omitempty"`
+	// RequestArguments are the arguments of the request, for searching. They are only stored when request bodies are not encrypted.
+	RequestArguments datatypes.JSON `json:"-"`
+
+	// ApprovalID is the MCPToolCallApproval of calls that needed approval, and ApprovalState and ApprovalDecidedBy the
+	// decision on them.
+	ApprovalID        string `json:"approvalID,omitempty" gorm:"index"`
+	ApprovalState     string `json:"approvalState,omitempty"`
+	ApprovalDecidedBy string `json:"approvalDecidedBy,omitempty"`
+
+	// InjectionScanFindings is what the injection scanner flagged in the response, and InjectionScanAction what was done
+	// about it.
+	InjectionScanFindings datatypes.JSONSlice[types2.MCPInjectionScanFinding] `json:"injectionScanFindings,omitempty"`
+	InjectionScanAction   string                                              `json:"injectionScanAction,omitempty" gorm:"index"`
 
 	ResponseReceived bool `json:"responseReceived"`
 	Encrypted        bool `json:"encrypted"`
+	// Redacted is true if sensitive values were removed from the bodies, headers, or error before the log was stored.
+	Redacted bool `json:"redacted"`
 }
 
 type MCPWebhookStatus struct {
@@ -121,20 +136,26 @@ func ConvertMCPAuditLog(a MCPAuditLog) types2.MCPAuditLog {
 			Name:    a.ClientName,
 			Version: a.ClientVersion,
 		},
-		ClientIP:         a.ClientIP,
-		CallType:         a.CallType,
-		CallIdentifier:   a.CallIdentifier,
-		RequestBody:      a.RequestBody,
-		ResponseBody:     a.ResponseBody,
-		ResponseStatus:   a.ResponseStatus,
-		Error:            a.Error,
-		WebhookStatuses:  webhookStatus,
-		ProcessingTimeMs: a.ProcessingTimeMs,
-		SessionID:        a.SessionID,
-		RequestID:        a.RequestID,
-		UserAgent:        a.UserAgent,
-		RequestHeaders:   a.RequestHeaders,
-		ResponseHeaders:  a.ResponseHeaders,
+		ClientIP:              a.ClientIP,
+		CallType:              a.CallType,
+		CallIdentifier:        a.CallIdentifier,
+		RequestBody:           a.RequestBody,
+		ResponseBody:          a.ResponseBody,
+		ResponseStatus:        a.ResponseStatus,
+		Error:                 a.Error,
+		WebhookStatuses:       webhookStatus,
+		ProcessingTimeMs:      a.ProcessingTimeMs,
+		SessionID:             a.SessionID,
+		RequestID:             a.RequestID,
+		UserAgent:             a.UserAgent,
+		RequestHeaders:        a.RequestHeaders,
+		ResponseHeaders:       a.ResponseHeaders,
+		Redacted:              a.Redacted,
+		ApprovalID:            a.ApprovalID,
+		ApprovalState:         types2.MCPToolCallApprovalState(a.ApprovalState),
+		ApprovalDecidedBy:     a.ApprovalDecidedBy,
+		InjectionScanFindings: a.InjectionScanFindings,
+		InjectionScanAction:   types2.MCPInjectionScanAction(a.InjectionScanAction),
 	}
 }
 
@@ -0,0 +1,38 @@
+//nolint:revive
+package types
+
+import (
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+)
+
+// MCPAuditLogArchive is a file of audit logs that the retention policy uploaded to the storage provider before it
+// deleted them from the database.
+type MCPAuditLogArchive struct {
+	ID              uint      `json:"id" gorm:"primaryKey"`
+	CreatedAt       time.Time `json:"createdAt"`
+	Scope           string    `json:"scope" gorm:"index"`
+	StartTime       time.Time `json:"startTime" gorm:"index"`
+	EndTime         time.Time `json:"endTime" gorm:"index"`
+	LogCount        int64     `json:"logCount"`
+	Size            int64     `json:"size"`
+	StorageProvider string    `json:"storageProvider"`
+	Bucket          string    `json:"bucket"`
+	Key             string    `json:"key"`
+}
+
+func ConvertMCPAuditLogArchive(a MCPAuditLogArchive) types2.MCPAuditLogArchive {
+	return types2.MCPAuditLogArchive{
+		ID:              a.ID,
+		CreatedAt:       *types2.NewTime(a.CreatedAt),
+		Scope:           a.Scope,
+		StartTime:       *types2.NewTime(a.StartTime),
+		EndTime:         *types2.NewTime(a.EndTime),
+		LogCount:        a.LogCount,
+		Size:            a.Size,
+		StorageProvider: types2.StorageProviderType(a.StorageProvider),
+		Bucket:          a.Bucket,
+		Key:             a.Key,
+	}
+}
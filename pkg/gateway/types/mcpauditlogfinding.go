@@ -0,0 +1,84 @@
+//nolint:revive
+package types
+
+import (
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"gorm.io/datatypes"
+)
+
+// MCPAuditLogFinding is unusual MCP activity that was found in the audit logs. The dedupe key makes sure that the same
+// finding, like a call volume spike in a given hour, is only raised once.
+type MCPAuditLogFinding struct {
+	ID                   uint      `json:"id" gorm:"primaryKey"`
+	CreatedAt            time.Time `json:"createdAt" gorm:"index"`
+	DedupeKey            string    `json:"-" gorm:"uniqueIndex"`
+	Type                 string    `json:"type" gorm:"index"`
+	Severity             string    `json:"severity" gorm:"index"`
+	Description          string    `json:"description"`
+	UserID               string    `json:"userID" gorm:"index"`
+	MCPID                string    `json:"mcpID" gorm:"index"`
+	MCPServerDisplayName string    `json:"mcpServerDisplayName"`
+	ClientIP             string    `json:"clientIP"`
+	CallIdentifier       string    `json:"callIdentifier"`
+	AuditLogID           uint      `json:"auditLogID"`
+	Count                int       `json:"count"`
+	Baseline             float64   `json:"baseline"`
+
+	AcknowledgedAt   *time.Time `json:"acknowledgedAt,omitempty" gorm:"index"`
+	AcknowledgedBy   string     `json:"acknowledgedBy,omitempty"`
+	AcknowledgedNote string     `json:"acknowledgedNote,omitempty"`
+}
+
+// MCPAuditLogBaseline is the usual activity of a user or an MCP server, learned from their audit logs.
+// Call volumes are kept per hour, and only hours with activity count towards the means.
+type MCPAuditLogBaseline struct {
+	// Key is "user:" followed by the user ID, or "server:" followed by the MCP ID.
+	Key       string    `json:"key" gorm:"primaryKey"`
+	FirstSeen time.Time `json:"firstSeen"`
+	LastSeen  time.Time `json:"lastSeen"`
+
+	// Hours is the number of active hours, before the current one, that the means are over.
+	Hours       int64   `json:"hours"`
+	CallMean    float64 `json:"callMean"`
+	CallM2      float64 `json:"callM2"`
+	ReadMean    float64 `json:"readMean"`
+	ReadM2      float64 `json:"readM2"`
+	TotalCalls  int64   `json:"totalCalls"`
+	TotalErrors int64   `json:"totalErrors"`
+
+	// CurrentHour is the start of the hour that is still being counted.
+	CurrentHour   time.Time `json:"currentHour"`
+	CurrentCalls  int64     `json:"currentCalls"`
+	CurrentReads  int64     `json:"currentReads"`
+	CurrentErrors int64     `json:"currentErrors"`
+
+	// HourOfDayCalls has the number of calls in each hour of the day, in UTC.
+	HourOfDayCalls datatypes.JSONSlice[int64] `json:"hourOfDayCalls"`
+	// Tools are the tools that have been called, as the MCP ID and tool name separated by a slash.
+	Tools datatypes.JSONSlice[string] `json:"tools"`
+	// IPRanges are the IPv4 /24 and IPv6 /48 ranges that calls have come from.
+	IPRanges datatypes.JSONSlice[string] `json:"ipRanges"`
+}
+
+func ConvertMCPAuditLogFinding(f MCPAuditLogFinding) types2.MCPAuditLogFinding {
+	return types2.MCPAuditLogFinding{
+		ID:                   f.ID,
+		CreatedAt:            *types2.NewTime(f.CreatedAt),
+		Type:                 types2.MCPAuditLogFindingType(f.Type),
+		Severity:             types2.MCPAuditLogFindingSeverity(f.Severity),
+		Description:          f.Description,
+		UserID:               f.UserID,
+		MCPID:                f.MCPID,
+		MCPServerDisplayName: f.MCPServerDisplayName,
+		ClientIP:             f.ClientIP,
+		CallIdentifier:       f.CallIdentifier,
+		AuditLogID:           f.AuditLogID,
+		Count:                f.Count,
+		Baseline:             f.Baseline,
+		AcknowledgedAt:       types2.NewTimeFromPointer(f.AcknowledgedAt),
+		AcknowledgedBy:       f.AcknowledgedBy,
+		AcknowledgedNote:     f.AcknowledgedNote,
+	}
+}
@@ -0,0 +1,22 @@
+//nolint:revive
+package types
+
+import "time"
+
+// MCPServerActivity is the request activity of an MCP server that one Obot replica saw. Every replica stores its own
+// activity, so that servers can be scaled to zero and autoscaled based on the requests that all replicas proxied.
+type MCPServerActivity struct {
+	MCPServerName string    `json:"mcpServerName" gorm:"primaryKey"`
+	Replica       string    `json:"replica" gorm:"primaryKey"`
+	InFlight      int       `json:"inFlight"`
+	LastActiveAt  time.Time `json:"lastActiveAt"`
+	// IdleTimeoutSeconds is how long the server can go without requests before it is scaled to zero. Zero means never.
+	IdleTimeoutSeconds int64 `json:"idleTimeoutSeconds"`
+	// PeakInFlight is the highest number of concurrent requests since the replica last stored its activity.
+	PeakInFlight int `json:"peakInFlight"`
+	// MinReplicas, MaxReplicas, and TargetConcurrency are how the server is autoscaled.
+	MinReplicas       int32     `json:"minReplicas"`
+	MaxReplicas       int32     `json:"maxReplicas"`
+	TargetConcurrency int       `json:"targetConcurrency"`
+	UpdatedAt         time.Time `json:"updatedAt" gorm:"index"`
+}
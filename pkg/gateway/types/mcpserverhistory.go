@@ -0,0 +1,31 @@
+//nolint:revive
+package types
+
+import "time"
+
+// MCPServerDeploymentEvent is an event from the deployment of an MCP server, like an image pull, a crash, or a restart.
+// Events are kept after the pod or container that they are about is gone.
+type MCPServerDeploymentEvent struct {
+	ID            uint      `json:"id" gorm:"primaryKey"`
+	MCPServerName string    `json:"mcpServerName" gorm:"index"`
+	DedupeKey     string    `json:"-" gorm:"uniqueIndex"`
+	Time          time.Time `json:"time" gorm:"index"`
+	EventType     string    `json:"eventType"`
+	Reason        string    `json:"reason"`
+	Message       string    `json:"message"`
+	Action        string    `json:"action"`
+	Count         int32     `json:"count"`
+	ResourceName  string    `json:"resourceName"`
+	ResourceKind  string    `json:"resourceKind"`
+}
+
+// MCPServerLogLine is a line from the logs of an MCP server. Only the most recent lines of each server are kept.
+type MCPServerLogLine struct {
+	ID            uint      `json:"id" gorm:"primaryKey"`
+	MCPServerName string    `json:"mcpServerName" gorm:"index"`
+	DedupeKey     string    `json:"-" gorm:"uniqueIndex"`
+	Time          time.Time `json:"time" gorm:"index"`
+	Replica       string    `json:"replica"`
+	Line          string    `json:"line"`
+	Encrypted     bool      `json:"encrypted"`
+}
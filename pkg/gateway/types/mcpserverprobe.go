@@ -0,0 +1,26 @@
+//nolint:revive
+package types
+
+import "time"
+
+// MCPServerProbeResult is the result of one health probe of an MCP server. Latencies are in milliseconds.
+type MCPServerProbeResult struct {
+	ID                  uint      `json:"id" gorm:"primaryKey"`
+	MCPServerName       string    `json:"mcpServerName" gorm:"index:idx_mcp_server_probe_results_server_time"`
+	Time                time.Time `json:"time" gorm:"index:idx_mcp_server_probe_results_server_time"`
+	Success             bool      `json:"success"`
+	AuthRequired        bool      `json:"authRequired"`
+	LatencyMS           int64     `json:"latencyMS"`
+	InitializeLatencyMS int64     `json:"initializeLatencyMS"`
+	ListToolsLatencyMS  int64     `json:"listToolsLatencyMS"`
+	ToolCallLatencyMS   int64     `json:"toolCallLatencyMS"`
+	ToolCount           int       `json:"toolCount"`
+	Error               string    `json:"error"`
+}
+
+// MCPServerProbeStats summarizes the probe results of an MCP server over a window of time.
+type MCPServerProbeStats struct {
+	MCPServerName string
+	Total         int64
+	Succeeded     int64
+}
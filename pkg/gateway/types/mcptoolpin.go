@@ -0,0 +1,57 @@
+//nolint:revive
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"gorm.io/datatypes"
+)
+
+// MCPToolPin is the approved version of a tool, pinned the first time its server listed it, and the version that the
+// server listed most recently. The tool changed if their hashes are different.
+type MCPToolPin struct {
+	ID                   uint      `json:"id" gorm:"primaryKey"`
+	CreatedAt            time.Time `json:"createdAt"`
+	SourceID             string    `json:"sourceID" gorm:"uniqueIndex:idx_mcp_tool_pin_source_tool"`
+	ToolName             string    `json:"toolName" gorm:"uniqueIndex:idx_mcp_tool_pin_source_tool"`
+	MCPServerDisplayName string    `json:"mcpServerDisplayName"`
+
+	Hash        string         `json:"hash"`
+	Description string         `json:"description"`
+	InputSchema datatypes.JSON `json:"inputSchema"`
+
+	CurrentHash        string         `json:"currentHash"`
+	CurrentDescription string         `json:"currentDescription"`
+	CurrentInputSchema datatypes.JSON `json:"currentInputSchema"`
+	ChangedAt          *time.Time     `json:"changedAt,omitempty" gorm:"index"`
+
+	ApprovedAt *time.Time `json:"approvedAt,omitempty"`
+	ApprovedBy string     `json:"approvedBy,omitempty"`
+}
+
+// Changed reports whether the server lists a version of the tool that hasn't been approved.
+func (p MCPToolPin) Changed() bool {
+	return p.CurrentHash != p.Hash
+}
+
+func ConvertMCPToolPin(p MCPToolPin) types2.MCPToolPin {
+	return types2.MCPToolPin{
+		ID:                   p.ID,
+		CreatedAt:            *types2.NewTime(p.CreatedAt),
+		SourceID:             p.SourceID,
+		MCPServerDisplayName: p.MCPServerDisplayName,
+		ToolName:             p.ToolName,
+		Hash:                 p.Hash,
+		Description:          p.Description,
+		InputSchema:          json.RawMessage(p.InputSchema),
+		Changed:              p.Changed(),
+		CurrentHash:          p.CurrentHash,
+		CurrentDescription:   p.CurrentDescription,
+		CurrentInputSchema:   json.RawMessage(p.CurrentInputSchema),
+		ChangedAt:            types2.NewTimeFromPointer(p.ChangedAt),
+		ApprovedAt:           types2.NewTimeFromPointer(p.ApprovedAt),
+		ApprovedBy:           p.ApprovedBy,
+	}
+}
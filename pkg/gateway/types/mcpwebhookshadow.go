@@ -0,0 +1,41 @@
+//nolint:revive
+package types
+
+import (
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+)
+
+// MCPWebhookShadowVerdict is the verdict of a webhook validation in shadow mode on a request. Verdicts are kept apart from
+// the audit logs so that what a webhook would have blocked can be counted without reading the logs.
+type MCPWebhookShadowVerdict struct {
+	ID                   uint      `json:"id" gorm:"primaryKey"`
+	CreatedAt            time.Time `json:"createdAt" gorm:"index"`
+	WebhookValidationID  string    `json:"webhookValidationID" gorm:"index"`
+	UserID               string    `json:"userID"`
+	MCPID                string    `json:"mcpID"`
+	MCPServerDisplayName string    `json:"mcpServerDisplayName"`
+	CallType             string    `json:"callType"`
+	CallIdentifier       string    `json:"callIdentifier"`
+	SessionID            string    `json:"sessionID"`
+	RequestID            string    `json:"requestID"`
+	Verdict              string    `json:"verdict" gorm:"index"`
+	Message              string    `json:"message"`
+}
+
+func ConvertMCPWebhookShadowVerdict(v MCPWebhookShadowVerdict) types2.MCPWebhookShadowVerdict {
+	return types2.MCPWebhookShadowVerdict{
+		ID:                   v.ID,
+		CreatedAt:            *types2.NewTime(v.CreatedAt),
+		UserID:               v.UserID,
+		MCPID:                v.MCPID,
+		MCPServerDisplayName: v.MCPServerDisplayName,
+		CallType:             v.CallType,
+		CallIdentifier:       v.CallIdentifier,
+		SessionID:            v.SessionID,
+		RequestID:            v.RequestID,
+		Verdict:              types2.MCPWebhookShadowVerdictType(v.Verdict),
+		Message:              v.Message,
+	}
+}
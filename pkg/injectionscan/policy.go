@@ -0,0 +1,55 @@
+package injectionscan
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Provider returns the Scanner for the current injection scan policy. The scanner is only rebuilt when the policy
+// changes, so that patterns aren't compiled for every response.
+type Provider struct {
+	client kclient.Client
+
+	lock            sync.Mutex
+	resourceVersion string
+	scanner         *Scanner
+	built           bool
+}
+
+func NewProvider(client kclient.Client) *Provider {
+	return &Provider{client: client}
+}
+
+// Scanner returns the scanner for the policy, or nil if scanning is turned off. Without a policy, all built-in rules run
+// and findings are only recorded.
+func (p *Provider) Scanner(ctx context.Context) (*Scanner, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	var policy v1.MCPInjectionScanPolicy
+	if err := p.client.Get(ctx, kclient.ObjectKey{Namespace: system.DefaultNamespace, Name: system.MCPInjectionScanPolicyName}, &policy); err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get MCP injection scan policy: %w", err)
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.built && p.resourceVersion == policy.ResourceVersion {
+		return p.scanner, nil
+	}
+
+	scanner, err := New(policy.Spec.Manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	p.scanner, p.resourceVersion, p.built = scanner, policy.ResourceVersion, true
+	return scanner, nil
+}
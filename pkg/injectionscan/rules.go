@@ -0,0 +1,112 @@
+package injectionscan
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+// ruleOrder is the order that the built-in rules run in.
+var ruleOrder = []types.MCPInjectionScanRule{
+	types.MCPInjectionScanRuleInstructionOverride,
+	types.MCPInjectionScanRuleHiddenInstructions,
+	types.MCPInjectionScanRuleExfiltration,
+	types.MCPInjectionScanRuleUnicodeSmuggling,
+}
+
+// patterns are the regular expressions of the built-in rules. Unicode smuggling is found by looking at the characters
+// instead. The patterns are matched against text without invisible characters, so that they can't be split up by them.
+var patterns = map[types.MCPInjectionScanRule][]*regexp.Regexp{
+	types.MCPInjectionScanRuleInstructionOverride: {
+		regexp.MustCompile(`(?i)\b(?:ignore|disregard|forget|override|bypass)\s+(?:all\s+|any\s+)?(?:of\s+)?(?:the\s+|your\s+|these\s+)?(?:previous|prior|above|earlier|preceding|original|system|developer)\s+(?:instructions|prompts?|rules|directions|guidelines|messages)`),
+		regexp.MustCompile(`(?i)\bnew\s+(?:system\s+)?instructions\s*:`),
+		regexp.MustCompile(`(?i)\b(?:enter|enable|activate|you\s+are\s+now\s+in)\s+(?:developer|debug|god|jailbreak|unrestricted|dan)\s+mode\b`),
+		regexp.MustCompile(`(?i)<\|im_start\|>|<\|system\|>|<\|start_header_id\|>|\[/?INST\]|<</?SYS>>`),
+	},
+	types.MCPInjectionScanRuleHiddenInstructions: {
+		regexp.MustCompile(`(?i)<\s*/?\s*(?:important|system|instructions?|secret|hidden|admin)\s*>`),
+		regexp.MustCompile(`(?i)<!--[^>]{0,500}?\b(?:ignore|instructions?|assistant|llm|ai\s+model|you\s+must|do\s+not\s+tell)\b[\s\S]{0,500}?-->`),
+		regexp.MustCompile(`(?i)\b(?:do\s+not|don't|never)\s+(?:tell|inform|mention|reveal|show|notify|alert)\s+(?:this\s+to\s+)?(?:the\s+)?user\b`),
+		regexp.MustCompile(`(?i)\bwithout\s+(?:telling|informing|notifying|asking|alerting)\s+(?:the\s+)?user\b`),
+	},
+	types.MCPInjectionScanRuleExfiltration: {
+		regexp.MustCompile(`(?i)~/\.ssh\b|\bid_(?:rsa|ed25519|ecdsa)\b|\.aws/credentials\b|/etc/(?:passwd|shadow)\b|\.kube/config\b|\.config/gcloud\b|\bmcp\.json\b`),
+		regexp.MustCompile(`(?i)\b(?:send|forward|upload|post|exfiltrate|leak)\s+(?:all\s+|the\s+|your\s+|any\s+)*(?:conversation|chat\s+history|previous\s+messages|credentials|api\s+keys?|secrets?|passwords?|private\s+keys?)\b`),
+		// Markdown images whose URLs the LLM is asked to fill in leak data when the client renders them.
+		regexp.MustCompile(`!\[[^\]]*\]\(https?://[^)\s]*[?&][^)\s=]*=(?:\{|\$|%7[Bb]|<)`),
+	},
+}
+
+// IsRule reports whether name is a built-in rule.
+func IsRule(name types.MCPInjectionScanRule) bool {
+	_, ok := patterns[name]
+	return ok || name == types.MCPInjectionScanRuleUnicodeSmuggling
+}
+
+// isInvisible reports whether r is a character that isn't shown, but is read by LLMs or changes how text is shown.
+func isInvisible(r rune) bool {
+	switch {
+	case r >= 0xE0000 && r <= 0xE007F:
+		// Tag characters, which map to ASCII and are used to smuggle text.
+		return true
+	case r >= 0xE0100 && r <= 0xE01EF:
+		// Variation selectors that aren't used by emoji.
+		return true
+	case r >= 0x202A && r <= 0x202E, r >= 0x2066 && r <= 0x2069:
+		// Bidirectional overrides and isolates.
+		return true
+	case r == 0x200B, r >= 0x2060 && r <= 0x2064, r == 0x180E, r == 0xFEFF:
+		// Zero-width spaces, word joiner, and invisible operators.
+		return true
+	}
+	return false
+}
+
+// stripInvisible returns the text without invisible characters, and whether it had any. A byte order mark at the start
+// is not counted.
+func stripInvisible(text string) (string, bool) {
+	text = strings.TrimPrefix(text, "\uFEFF")
+	if !strings.ContainsFunc(text, isInvisible) {
+		return text, false
+	}
+	return strings.Map(func(r rune) rune {
+		if isInvisible(r) {
+			return -1
+		}
+		return r
+	}, text), true
+}
+
+// smuggledExcerpt describes the invisible characters in the text. Text hidden in tag characters is decoded, because it is
+// what the LLM reads.
+func smuggledExcerpt(text string) string {
+	var hidden, codes strings.Builder
+	for _, r := range text {
+		switch {
+		case r >= 0xE0020 && r <= 0xE007E:
+			hidden.WriteRune(r - 0xE0000)
+		case isInvisible(r):
+			if codes.Len() < maxExcerptLength {
+				fmt.Fprintf(&codes, "<U+%04X>", r)
+			}
+		}
+	}
+
+	if hidden.Len() > 0 {
+		return truncate("hidden text: " + hidden.String())
+	}
+	return truncate("invisible characters: " + codes.String())
+}
+
+const maxExcerptLength = 200
+
+// truncate shortens the text to maxExcerptLength characters.
+func truncate(text string) string {
+	if utf8.RuneCountInString(text) <= maxExcerptLength {
+		return text
+	}
+	return string([]rune(text)[:maxExcerptLength]) + "…"
+}
@@ -29,7 +29,11 @@ import (
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	threadmodel "github.com/obot-platform/obot/pkg/thread"
+	"github.com/obot-platform/obot/pkg/tracing"
 	"github.com/obot-platform/obot/pkg/wait"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/util/retry"
@@ -38,6 +42,7 @@ import (
 
 var (
 	log              = logger.Package()
+	tracer           = otel.Tracer("obot/invoke")
 	ephemeralCounter atomic.Int32
 )
 
@@ -446,7 +451,18 @@ func isEphemeral(run *v1.Run) bool {
 	return strings.HasPrefix(run.Name, ephemeralRunPrefix)
 }
 
-func (i *Invoker) createRun(ctx context.Context, gptClient *gptscript.GPTScript, c kclient.WithWatch, thread *v1.Thread, tool any, input string, opts runOptions) (*Response, error) {
+func (i *Invoker) createRun(ctx context.Context, gptClient *gptscript.GPTScript, c kclient.WithWatch, thread *v1.Thread, tool any, input string, opts runOptions) (_ *Response, err error) {
+	ctx, span := tracer.Start(ctx, "createRun", trace.WithAttributes(
+		attribute.String("obot.thread.id", thread.Name),
+		attribute.String("obot.agent.id", opts.AgentName),
+		attribute.String("obot.workflow.id", opts.WorkflowName),
+		attribute.Bool("obot.run.synchronous", opts.Synchronous),
+	))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
 	if thread.Spec.Project && !opts.Ephemeral {
 		return nil, fmt.Errorf("project threads cannot be invoked")
 	}
@@ -501,6 +517,9 @@ func (i *Invoker) createRun(ctx context.Context, gptClient *gptscript.GPTScript,
 		run.Spec.Username = u.DisplayName
 	}
 
+	// Save the trace context so that the run is part of this trace when the controller runs it.
+	tracing.Inject(ctx, &run)
+
 	if opts.Ephemeral {
 		run.Name = fmt.Sprintf("%s-%d", ephemeralRunPrefix, ephemeralCounter.Add(1))
 	} else {
@@ -508,6 +527,7 @@ func (i *Invoker) createRun(ctx context.Context, gptClient *gptscript.GPTScript,
 			return nil, err
 		}
 	}
+	span.SetAttributes(attribute.String("obot.run.id", run.Name))
 
 	if !thread.Spec.SystemTask && !opts.Ephemeral {
 		err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
@@ -565,6 +585,17 @@ func (i *Invoker) createRun(ctx context.Context, gptClient *gptscript.GPTScript,
 }
 
 func (i *Invoker) Resume(ctx context.Context, gptClient *gptscript.GPTScript, c kclient.WithWatch, thread *v1.Thread, run *v1.Run) (err error) {
+	ctx, span := tracer.Start(tracing.Extract(ctx, run), "run", trace.WithAttributes(
+		attribute.String("obot.run.id", run.Name),
+		attribute.String("obot.thread.id", thread.Name),
+		attribute.String("obot.agent.id", run.Spec.AgentName),
+		attribute.String("obot.workflow.id", run.Spec.WorkflowName),
+	))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
 	defer func() {
 		if err != nil {
 			errStr, _, _ := strings.Cut(err.Error(), ": exit status")
@@ -28,8 +28,30 @@ type backend interface {
 	transformConfig(ctx context.Context, serverConfig ServerConfig) (*ServerConfig, error)
 	streamServerLogs(ctx context.Context, id string) (io.ReadCloser, error)
 	getServerDetails(ctx context.Context, id string) (types.MCPServerDetails, error)
-	restartServer(ctx context.Context, id string) error
+	restartServer(ctx context.Context, server ServerConfig) error
 	shutdownServer(ctx context.Context, id string) error
+	// scaleServer sets the number of replicas for the server. Scaling to zero stops the server without removing it,
+	// and the next call to ensureServerDeployment will wake it back up.
+	scaleServer(ctx context.Context, id string, replicas int32) error
+	// replicaURLs returns the base URLs of the ready replicas of the server, including the replicas of its old version
+	// that are draining after a blue/green rollout. Backends that only run a single replica of a server return no replicas.
+	replicaURLs(ctx context.Context, id string) (Replicas, error)
+	// removeDrainedReplicas removes the replicas of old server versions whose drain timeout has passed.
+	removeDrainedReplicas(ctx context.Context) error
+	// refreshEgressPolicies resolves the allowed egress hosts of the servers again, for backends that block egress by address.
+	refreshEgressPolicies(ctx context.Context) error
+	// registryCredentials returns the credentials that the backend pulls images from the registry with, if it has any.
+	registryCredentials(ctx context.Context, registry string) (username, password string, err error)
+	// downloadPersistentVolume returns a gzipped tar archive of the contents of the server's persistent volume.
+	// The server must be running.
+	downloadPersistentVolume(ctx context.Context, server ServerConfig) (io.ReadCloser, error)
+	// listServers returns the names of the servers that are deployed, including servers that are scaled to zero.
+	listServers(ctx context.Context) ([]string, error)
+	// serverLogs returns the log lines that the server's replicas wrote since the given time, at most tail lines per replica.
+	// The logs of containers that crashed since then are included. A zero time returns the last tail lines.
+	serverLogs(ctx context.Context, id string, since time.Time, tail int) ([]serverLogLine, error)
+	// deletePersistentVolume deletes a persistent volume. The server that uses it must be shut down first.
+	deletePersistentVolume(ctx context.Context, volumeName string) error
 }
 
 type ErrNotSupportedByBackend struct {
@@ -159,6 +181,7 @@ func ensureServerReady(ctx context.Context, url string, server ServerConfig) err
 func webhookToServerConfig(webhook Webhook, baseImage, mcpServerName, userID, scope string, port int) (ServerConfig, error) {
 	return ServerConfig{
 		Runtime:              types.RuntimeContainerized,
+		TrustLevel:           types.TrustLevelTrusted,
 		Scope:                scope,
 		MCPServerName:        fmt.Sprintf("%s-%s", mcpServerName, webhook.Name),
 		MCPServerDisplayName: webhook.DisplayName,
@@ -113,18 +113,9 @@ func (sm *SessionManager) loadSession(ctx context.Context, server ServerConfig,
 			token string
 			err   error
 		)
-
-		now := time.Now().Add(-time.Second)
-		// TODO(thedadams): This needs to be fixed before user information headers can be passed to the MCP server.
-		jwtToken, token, err = sm.tokenService.NewTokenWithClaims(ctx, jwt.MapClaims{
-			"aud":   gtypes.FirstSet(server.Audiences...),
-			"exp":   float64(now.Add(time.Hour + 15*time.Minute).Unix()),
-			"iat":   float64(now.Unix()),
-			"sub":   server.UserID,
-			"MCPID": server.MCPServerName,
-		})
+		jwtToken, token, err = sm.newClientToken(ctx, server)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create JWT token for client: %w", err)
+			return nil, err
 		}
 
 		headers["Authorization"] = "Bearer " + token
@@ -167,6 +158,23 @@ func (sm *SessionManager) loadSession(ctx context.Context, server ServerConfig,
 	return result, nil
 }
 
+// newClientToken creates the token that Obot's own clients use to authenticate to the server.
+func (sm *SessionManager) newClientToken(ctx context.Context, server ServerConfig) (*jwt.Token, string, error) {
+	now := time.Now().Add(-time.Second)
+	// TODO(thedadams): This needs to be fixed before user information headers can be passed to the MCP server.
+	jwtToken, token, err := sm.tokenService.NewTokenWithClaims(ctx, jwt.MapClaims{
+		"aud":   gtypes.FirstSet(server.Audiences...),
+		"exp":   float64(now.Add(time.Hour + 15*time.Minute).Unix()),
+		"iat":   float64(now.Unix()),
+		"sub":   server.UserID,
+		"MCPID": server.MCPServerName,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create JWT token for client: %w", err)
+	}
+	return jwtToken, token, nil
+}
+
 func (sm *SessionManager) getClient(id, clientScope string) *Client {
 	sessions, _ := sm.sessions.LoadOrStore(id, &sync.Map{})
 
@@ -17,11 +17,24 @@ func (sm *SessionManager) GetServerDetails(ctx context.Context, serverConfig Ser
 		return types.MCPServerDetails{}, fmt.Errorf("getting server details is not supported for remote servers")
 	}
 
-	if err := sm.deployServer(ctx, serverConfig); err != nil {
+	deployErr := sm.deployServer(ctx, serverConfig)
+
+	condition, failedImagePolicy := sm.imagePolicy.condition(serverConfig.MCPServerName)
+	if deployErr != nil && !failedImagePolicy {
+		return types.MCPServerDetails{}, deployErr
+	}
+
+	details, err := sm.backend.getServerDetails(ctx, serverConfig.MCPServerName)
+	if err != nil && !failedImagePolicy {
 		return types.MCPServerDetails{}, err
 	}
 
-	return sm.backend.getServerDetails(ctx, serverConfig.MCPServerName)
+	if failedImagePolicy {
+		// Report why the server can't be deployed, even if there is no deployment to get details for.
+		details.Conditions = append(details.Conditions, condition)
+	}
+
+	return details, nil
 }
 
 // StreamServerLogs will stream the logs of a specific MCP server based on its configuration, if the backend supports it.
@@ -39,6 +52,39 @@ func (sm *SessionManager) StreamServerLogs(ctx context.Context, serverConfig Ser
 	return sm.backend.streamServerLogs(ctx, serverConfig.MCPServerName)
 }
 
+// DownloadPersistentVolume returns a gzipped tar archive of the contents of the server's persistent volume.
+// The server is started first if it isn't running, because the contents are read from inside its container.
+func (sm *SessionManager) DownloadPersistentVolume(ctx context.Context, serverConfig ServerConfig) (io.ReadCloser, error) {
+	if serverConfig.PersistentVolume == nil {
+		return nil, types.NewErrBadRequest("MCP server %s does not have a persistent volume", serverConfig.MCPServerDisplayName)
+	}
+
+	if _, err := sm.ensureDeployment(ctx, serverConfig, false); err != nil {
+		return nil, err
+	}
+
+	return sm.backend.downloadPersistentVolume(ctx, serverConfig)
+}
+
+// ResetPersistentVolume shuts down the server and deletes its persistent volume.
+// The next time the server is used, it is started with an empty volume.
+func (sm *SessionManager) ResetPersistentVolume(ctx context.Context, serverConfig ServerConfig) error {
+	if serverConfig.PersistentVolume == nil {
+		return types.NewErrBadRequest("MCP server %s does not have a persistent volume", serverConfig.MCPServerDisplayName)
+	}
+
+	if err := sm.ShutdownServer(ctx, serverConfig.MCPServerName); err != nil {
+		return err
+	}
+
+	return sm.backend.deletePersistentVolume(ctx, serverConfig.PersistentVolumeName)
+}
+
+// DeletePersistentVolume deletes a persistent volume that is no longer used by any server.
+func (sm *SessionManager) DeletePersistentVolume(ctx context.Context, volumeName string) error {
+	return sm.backend.deletePersistentVolume(ctx, volumeName)
+}
+
 func (sm *SessionManager) deployServer(ctx context.Context, server ServerConfig) error {
 	var webhooks []Webhook
 	if !server.ComponentMCPServer {
@@ -60,5 +106,10 @@ func (sm *SessionManager) deployServer(ctx context.Context, server ServerConfig)
 		})
 	}
 
+	server, err := sm.imagePolicy.enforce(ctx, server)
+	if err != nil {
+		return err
+	}
+
 	return sm.backend.deployServer(ctx, server, webhooks)
 }
@@ -1,12 +1,17 @@
 package mcp
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"io"
 	"maps"
 	"net"
+	"net/http"
+	"net/url"
 	"os"
 	"path"
 	"regexp"
@@ -18,6 +23,7 @@ import (
 	cerrdefs "github.com/containerd/errdefs"
 	"github.com/docker/go-connections/nat"
 	"github.com/gptscript-ai/gptscript/pkg/hash"
+	"github.com/moby/moby/api/pkg/stdcopy"
 	"github.com/moby/moby/api/types/container"
 	"github.com/moby/moby/api/types/events"
 	"github.com/moby/moby/api/types/filters"
@@ -31,6 +37,13 @@ import (
 
 var localhostURLRegexp = regexp.MustCompile(`^http://localhost(:\d+)?`)
 
+const (
+	// egressNetworkName is the internal network that MCP containers run on when egress policies are enforced.
+	egressNetworkName = "obot-mcp-egress"
+	// egressAllowlistLabel is the container label with the egress allowlist that the egress proxy enforces for the container.
+	egressAllowlistLabel = "mcp.egress.allow"
+)
+
 type dockerBackend struct {
 	client                        *client.Client
 	containerEnv                  bool
@@ -42,6 +55,12 @@ type dockerBackend struct {
 	remoteShimBaseImage           string
 	auditLogsBatchSize            int
 	auditLogsFlushIntervalSeconds int
+	sandbox                       sandboxConfig
+
+	// egressProxyURL and egressNoProxy are set when egress policies are enforced.
+	// MCP containers are configured to send all outbound traffic that leaves the egress network through the proxy.
+	egressProxyURL string
+	egressNoProxy  string
 }
 
 func newDockerBackend(ctx context.Context, exposedPort int, opts Options) (backend, error) {
@@ -65,6 +84,19 @@ func newDockerBackend(ctx context.Context, exposedPort int, opts Options) (backe
 		}
 	}
 
+	var egressSubnet string
+	if opts.MCPEgressPolicyEnforced {
+		if !containerEnv {
+			return nil, fmt.Errorf("enforcing egress policies with the docker backend requires Obot to run in a container")
+		}
+
+		host, egressSubnet, err = setupEgressNetwork(ctx, cli)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set up egress network: %w", err)
+		}
+		network = egressNetworkName
+	}
+
 	d := &dockerBackend{
 		client:                        cli,
 		containerEnv:                  containerEnv,
@@ -75,15 +107,125 @@ func newDockerBackend(ctx context.Context, exposedPort int, opts Options) (backe
 		webhookBaseImage:              opts.MCPHTTPWebhookBaseImage,
 		remoteShimBaseImage:           opts.MCPRemoteShimBaseImage,
 		auditLogsBatchSize:            opts.MCPAuditLogsPersistBatchSize,
+		sandbox:                       newSandboxConfig(opts),
 		auditLogsFlushIntervalSeconds: opts.MCPAuditLogPersistIntervalSeconds,
 	}
 	if err = d.cleanupContainersWithOldID(ctx); err != nil {
 		return nil, fmt.Errorf("failed to cleanup containers with old ID: %w", err)
 	}
 
+	if opts.MCPEgressPolicyEnforced {
+		if err = d.startEgressProxy(ctx, opts.MCPEgressProxyPort); err != nil {
+			return nil, fmt.Errorf("failed to start egress proxy: %w", err)
+		}
+		d.egressProxyURL = fmt.Sprintf("http://%s", net.JoinHostPort(host, strconv.Itoa(opts.MCPEgressProxyPort)))
+		d.egressNoProxy = strings.Join([]string{"localhost", "127.0.0.1", egressSubnet}, ",")
+	}
+
 	return d, nil
 }
 
+// setupEgressNetwork creates the internal network for MCP containers, if it doesn't exist, and connects the Obot container to it.
+// Containers on an internal network can't reach anything outside of it, so the Obot container runs the egress proxy for them.
+// It returns Obot's IP address and the subnet of the network.
+func setupEgressNetwork(ctx context.Context, cli *client.Client) (string, string, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get hostname: %w", err)
+	}
+
+	if _, err = cli.NetworkInspect(ctx, egressNetworkName, network.InspectOptions{}); cerrdefs.IsNotFound(err) {
+		if _, err = cli.NetworkCreate(ctx, egressNetworkName, network.CreateOptions{
+			Driver:   "bridge",
+			Internal: true,
+		}); err != nil && !cerrdefs.IsConflict(err) {
+			return "", "", fmt.Errorf("failed to create network %s: %w", egressNetworkName, err)
+		}
+	} else if err != nil {
+		return "", "", fmt.Errorf("failed to inspect network %s: %w", egressNetworkName, err)
+	}
+
+	inspect, err := cli.ContainerInspect(ctx, hostname)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to inspect container: %w", err)
+	}
+	if inspect.NetworkSettings == nil || inspect.NetworkSettings.Networks[egressNetworkName] == nil {
+		if err = cli.NetworkConnect(ctx, egressNetworkName, hostname, nil); err != nil {
+			return "", "", fmt.Errorf("failed to connect to network %s: %w", egressNetworkName, err)
+		}
+		if inspect, err = cli.ContainerInspect(ctx, hostname); err != nil {
+			return "", "", fmt.Errorf("failed to inspect container: %w", err)
+		}
+	}
+
+	settings := inspect.NetworkSettings.Networks[egressNetworkName]
+	if settings == nil || settings.IPAddress == "" {
+		return "", "", fmt.Errorf("container is not connected to network %s", egressNetworkName)
+	}
+
+	egressNetwork, err := cli.NetworkInspect(ctx, egressNetworkName, network.InspectOptions{})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to inspect network %s: %w", egressNetworkName, err)
+	}
+
+	var subnet string
+	if len(egressNetwork.IPAM.Config) > 0 {
+		subnet = egressNetwork.IPAM.Config[0].Subnet
+	}
+
+	return settings.IPAddress, subnet, nil
+}
+
+// startEgressProxy starts the proxy that enforces the egress allowlists of the MCP containers.
+func (d *dockerBackend) startEgressProxy(ctx context.Context, port int) error {
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Handler:           newEgressProxy(d.egressAllowlistFor),
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+
+	go func() {
+		if err := server.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf("egress proxy stopped: %v", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+
+	return nil
+}
+
+// egressAllowlistFor returns the egress allowlist of the MCP container with the given IP address on the egress network.
+// Anything that isn't an MCP container gets an empty allowlist.
+func (d *dockerBackend) egressAllowlistFor(ctx context.Context, ip string) (egressAllowlist, error) {
+	containers, err := d.client.ContainerList(ctx, container.ListOptions{
+		Filters: filters.NewArgs(filters.KeyValuePair{
+			Key:   "network",
+			Value: egressNetworkName,
+		}),
+	})
+	if err != nil {
+		return egressAllowlist{}, err
+	}
+
+	for _, c := range containers {
+		if c.NetworkSettings == nil {
+			continue
+		}
+		if settings := c.NetworkSettings.Networks[egressNetworkName]; settings != nil && settings.IPAddress == ip {
+			return parseEgressAllowlist(c.Labels[egressAllowlistLabel]), nil
+		}
+	}
+
+	return egressAllowlist{}, nil
+}
+
 // detectContainerCurrentNetworkIP detects the Docker network and IP of the current container if running inside one.
 // Returns empty string if not running in a container or if detection fails.
 func detectContainerCurrentNetworkIP(ctx context.Context, cli *client.Client) (string, string, error) {
@@ -185,7 +327,14 @@ func (d *dockerBackend) ensureServerDeployment(ctx context.Context, server Serve
 		c, err := webhookToServerConfig(webhook, d.webhookBaseImage, server.MCPServerName, server.UserID, server.Scope, defaultContainerPort)
 		if err != nil {
 			return ServerConfig{}, fmt.Errorf("failed to ensure webhook deployment: %w", err)
-		} else if c, err = d.ensureDeployment(ctx, c, server.MCPServerName, d.containerEnv, nil); err != nil {
+		}
+
+		// The webhook container only needs to reach the webhook itself.
+		if u, err := url.Parse(webhook.URL); err == nil && u.Hostname() != "" {
+			c.EgressPolicy = &otypes.EgressPolicy{AllowedHosts: []string{u.Hostname()}}
+		}
+
+		if c, err = d.ensureDeployment(ctx, c, server.MCPServerName, d.containerEnv, nil); err != nil {
 			return ServerConfig{}, fmt.Errorf("failed to ensure server deployment: %w", err)
 		} else if existing, err := d.getContainer(ctx, c.MCPServerName); err != nil {
 			return ServerConfig{}, fmt.Errorf("failed to build server config: %w", err)
@@ -253,6 +402,12 @@ func (d *dockerBackend) ensureDeployment(ctx context.Context, server ServerConfi
 			existing.State = ""
 		}
 
+		if existing.State == container.StateExited {
+			// The container still has the current configuration, so start it back up instead of recreating it. This is how
+			// servers that were scaled to zero wake up, and it doesn't depend on which Obot replica scaled them down.
+			existing.State = container.StateCreated
+		}
+
 		// Container exists, check state
 		switch existing.State {
 		case container.StateCreated:
@@ -343,6 +498,60 @@ func (d *dockerBackend) streamServerLogs(ctx context.Context, id string) (io.Rea
 	return logs, nil
 }
 
+func (d *dockerBackend) listServers(ctx context.Context) ([]string, error) {
+	containers, err := d.client.ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("label", "mcp.server.id")),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list MCP containers: %w", err)
+	}
+
+	var names []string
+	for _, c := range containers {
+		// Skip shim and webhook containers. Their logs are not the server's logs.
+		id := c.Labels["mcp.server.id"]
+		if c.Labels["mcp.deployment.id"] != "" || strings.HasSuffix(id, "-shim") {
+			continue
+		}
+		names = append(names, id)
+	}
+	return names, nil
+}
+
+func (d *dockerBackend) serverLogs(ctx context.Context, id string, since time.Time, tail int) ([]serverLogLine, error) {
+	c, err := d.getContainer(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get container: %w", err)
+	} else if c == nil {
+		return nil, nil
+	}
+
+	opts := container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Timestamps: true,
+		Tail:       strconv.Itoa(tail),
+	}
+	if !since.IsZero() {
+		opts.Since = strconv.FormatInt(since.Unix(), 10)
+	}
+
+	logs, err := d.client.ContainerLogs(ctx, c.ID, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get container logs: %w", err)
+	}
+	defer logs.Close()
+
+	// Restarted containers keep their logs, so the logs of a crash are included.
+	var buf bytes.Buffer
+	if _, err = stdcopy.StdCopy(&buf, &buf, logs); err != nil {
+		return nil, fmt.Errorf("failed to read container logs: %w", err)
+	}
+
+	return parseTimestampedLogs(&buf, id)
+}
+
 func (d *dockerBackend) getServerDetails(ctx context.Context, id string) (otypes.MCPServerDetails, error) {
 	container, err := d.getContainer(ctx, id)
 	if err != nil {
@@ -371,10 +580,18 @@ eventLoop:
 	for {
 		select {
 		case event := <-eventMessages:
+			message := fmt.Sprintf("Container %s: %s", event.Actor.Attributes["name"], string(event.Action))
+			if exitCode, ok := event.Actor.Attributes["exitCode"]; ok {
+				message += fmt.Sprintf(" (exit code %s)", exitCode)
+			}
+			eventTime := time.Unix(event.Time, 0)
+			if event.TimeNano != 0 {
+				eventTime = time.Unix(0, event.TimeNano)
+			}
 			mcpEvents = append(mcpEvents, otypes.MCPServerEvent{
-				Time:         otypes.Time{Time: time.Unix(event.Time, 0)},
+				Time:         otypes.Time{Time: eventTime},
 				Reason:       string(event.Action),
-				Message:      fmt.Sprintf("Container %s: %s", event.Actor.Attributes["name"], string(event.Action)),
+				Message:      message,
 				EventType:    string(event.Type),
 				Action:       string(event.Action),
 				Count:        1,
@@ -423,9 +640,87 @@ eventLoop:
 	}, nil
 }
 
-func (d *dockerBackend) restartServer(ctx context.Context, id string) error {
-	if err := d.client.ContainerRestart(ctx, id, container.StopOptions{}); err != nil {
-		return fmt.Errorf("failed to restart container %s: %w", id, err)
+func (d *dockerBackend) restartServer(ctx context.Context, server ServerConfig) error {
+	if err := d.client.ContainerRestart(ctx, server.MCPServerName, container.StopOptions{}); err != nil {
+		return fmt.Errorf("failed to restart container %s: %w", server.MCPServerName, err)
+	}
+
+	return nil
+}
+
+// scaleServer only supports scaling to zero, since the Docker backend runs a single container for each server.
+func (d *dockerBackend) scaleServer(ctx context.Context, id string, replicas int32) error {
+	if replicas > 0 {
+		// Stopped containers are started again by ensureServerDeployment.
+		return nil
+	}
+
+	for _, name := range []string{id, id + "-shim"} {
+		c, err := d.getContainer(ctx, name)
+		if err != nil {
+			return fmt.Errorf("failed to get container %s: %w", name, err)
+		} else if c == nil || c.State != container.StateRunning {
+			continue
+		}
+
+		if err := d.client.ContainerStop(ctx, c.ID, container.StopOptions{}); err != nil {
+			return fmt.Errorf("failed to stop container %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func (d *dockerBackend) replicaURLs(context.Context, string) (Replicas, error) {
+	return Replicas{}, nil
+}
+
+// refreshEgressPolicies does nothing, because the egress proxy resolves allowed hosts on every connection.
+func (d *dockerBackend) refreshEgressPolicies(context.Context) error {
+	return nil
+}
+
+// registryCredentials returns no credentials, because the Docker backend pulls images anonymously.
+func (d *dockerBackend) registryCredentials(context.Context, string) (string, string, error) {
+	return "", "", nil
+}
+
+// removeDrainedReplicas does nothing, because the Docker backend replaces servers in place instead of doing blue/green rollouts.
+func (d *dockerBackend) removeDrainedReplicas(context.Context) error {
+	return nil
+}
+
+func (d *dockerBackend) downloadPersistentVolume(ctx context.Context, server ServerConfig) (io.ReadCloser, error) {
+	c, err := d.getContainer(ctx, server.MCPServerName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get container %s: %w", server.MCPServerName, err)
+	} else if c == nil || c.State != container.StateRunning {
+		return nil, fmt.Errorf("mcp server %s is not running", server.MCPServerName)
+	}
+
+	// The trailing "/." copies the contents of the directory instead of the directory itself.
+	archive, _, err := d.client.CopyFromContainer(ctx, c.ID, path.Clean(server.PersistentVolume.MountPath)+"/.")
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy persistent volume from container %s: %w", server.MCPServerName, err)
+	}
+
+	r, w := io.Pipe()
+	go func() {
+		defer archive.Close()
+		gz := gzip.NewWriter(w)
+		_, err := io.Copy(gz, archive)
+		if closeErr := gz.Close(); err == nil {
+			err = closeErr
+		}
+		w.CloseWithError(err)
+	}()
+
+	return r, nil
+}
+
+func (d *dockerBackend) deletePersistentVolume(ctx context.Context, volumeName string) error {
+	if err := d.client.VolumeRemove(ctx, volumeName, false); err != nil && !cerrdefs.IsNotFound(err) {
+		return fmt.Errorf("failed to delete persistent volume %s: %w", volumeName, err)
 	}
 
 	return nil
@@ -754,6 +1049,34 @@ func (d *dockerBackend) createAndStartContainer(ctx context.Context, server Serv
 		return "", 0, fmt.Errorf("unsupported runtime: %s", server.Runtime)
 	}
 
+	if server.PersistentVolume != nil {
+		// The volume isn't labeled with the server ID, so it isn't removed when the container is.
+		volumeMounts = append(volumeMounts, mount.Mount{
+			Type:   mount.TypeVolume,
+			Source: server.PersistentVolumeName,
+			Target: server.PersistentVolume.MountPath,
+			VolumeOptions: &mount.VolumeOptions{
+				Labels: map[string]string{
+					persistentVolumeLabel: "true",
+					"mcp.user.id":         server.UserID,
+				},
+			},
+		})
+	}
+
+	if d.egressProxyURL != "" {
+		// Most HTTP clients use these variables. Clients that don't won't be able to reach anything outside the egress network.
+		env = append(env,
+			"HTTP_PROXY="+d.egressProxyURL,
+			"HTTPS_PROXY="+d.egressProxyURL,
+			"NO_PROXY="+d.egressNoProxy,
+			"http_proxy="+d.egressProxyURL,
+			"https_proxy="+d.egressProxyURL,
+			"no_proxy="+d.egressNoProxy,
+			"NODE_USE_ENV_PROXY=1",
+		)
+	}
+
 	// Prepare port binding
 	containerPortStr := fmt.Sprintf("%d/tcp", containerPort)
 
@@ -772,6 +1095,9 @@ func (d *dockerBackend) createAndStartContainer(ctx context.Context, server Serv
 			"mcp.config.hash":        configHash,
 		},
 	}
+	if d.egressProxyURL != "" {
+		config.Labels[egressAllowlistLabel] = newEgressAllowlist(server).String()
+	}
 
 	// Host config with port bindings and volume mounts
 	hostConfig := &container.HostConfig{
@@ -781,6 +1107,7 @@ func (d *dockerBackend) createAndStartContainer(ctx context.Context, server Serv
 			Name: "unless-stopped",
 		},
 	}
+	d.sandbox.applyToContainer(config, hostConfig, server)
 
 	if os.Getenv("OBOT_DOCKER_INTERNAL_ADD_HOST") == "true" && strings.HasPrefix(server.TokenExchangeEndpoint, "http://host.docker.internal") {
 		// On some systems (like Docker on Linux), we need to add the host-gateway entry to the container's /etc/hosts file.
@@ -0,0 +1,337 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/apply"
+	"github.com/obot-platform/nah/pkg/name"
+	"github.com/obot-platform/obot/apiclient/types"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// egressAllowlist is the set of network destinations that an MCP server can reach when egress policies are enforced.
+type egressAllowlist struct {
+	hosts []string
+	cidrs []*net.IPNet
+}
+
+// newEgressAllowlist builds the allowlist for a server from its egress policy.
+// The hosts of the extra URLs, like the targets of the server's webhooks, are allowed too.
+func newEgressAllowlist(server ServerConfig, extraURLs ...string) egressAllowlist {
+	var allowlist egressAllowlist
+	if server.EgressPolicy != nil {
+		for _, host := range server.EgressPolicy.AllowedHosts {
+			allowlist.addHost(host)
+		}
+		for _, cidr := range server.EgressPolicy.AllowedCIDRs {
+			if _, ipNet, err := net.ParseCIDR(cidr); err == nil {
+				allowlist.cidrs = append(allowlist.cidrs, ipNet)
+			}
+		}
+	}
+
+	// The server always needs to reach Obot, and a remote server's shim needs to reach the remote server.
+	urls := append([]string{server.TokenExchangeEndpoint, server.AuditLogEndpoint}, extraURLs...)
+	if server.Runtime == types.RuntimeRemote {
+		urls = append(urls, server.URL)
+	}
+	for _, u := range urls {
+		if parsed, err := url.Parse(u); err == nil && parsed.Hostname() != "" {
+			allowlist.addHost(parsed.Hostname())
+		}
+	}
+
+	return allowlist
+}
+
+// parseEgressAllowlist parses an allowlist from the format returned by egressAllowlist.String.
+func parseEgressAllowlist(s string) egressAllowlist {
+	var allowlist egressAllowlist
+	for entry := range strings.SplitSeq(s, ",") {
+		if _, ipNet, err := net.ParseCIDR(entry); err == nil {
+			allowlist.cidrs = append(allowlist.cidrs, ipNet)
+		} else if entry != "" {
+			allowlist.addHost(entry)
+		}
+	}
+	return allowlist
+}
+
+func (a *egressAllowlist) addHost(host string) {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if ip := net.ParseIP(host); ip != nil {
+		if ip4 := ip.To4(); ip4 != nil {
+			ip = ip4
+		}
+		a.cidrs = append(a.cidrs, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)})
+	} else if !slices.Contains(a.hosts, host) {
+		a.hosts = append(a.hosts, host)
+	}
+}
+
+// allowsHost returns true if the host is one of the allowed hosts, or if it is an IP address in one of the allowed CIDRs.
+func (a egressAllowlist) allowsHost(host string) bool {
+	if ip := net.ParseIP(host); ip != nil {
+		return a.allowsIP(ip)
+	}
+	return slices.Contains(a.hosts, strings.ToLower(strings.TrimSuffix(host, ".")))
+}
+
+// allowsIP returns true if the IP address is in one of the allowed CIDRs.
+func (a egressAllowlist) allowsIP(ip net.IP) bool {
+	for _, cidr := range a.cidrs {
+		if cidr.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the allowlist as a comma-separated list of hosts and CIDRs.
+func (a egressAllowlist) String() string {
+	entries := slices.Clone(a.hosts)
+	for _, cidr := range a.cidrs {
+		entries = append(entries, cidr.String())
+	}
+	return strings.Join(entries, ",")
+}
+
+const (
+	// egressBaselinePolicyName is the name of the NetworkPolicy that blocks egress from every pod in the MCP namespace,
+	// except for DNS and Obot. Each server's own NetworkPolicy allows the rest of its allowlist.
+	egressBaselinePolicyName = "mcp-egress-baseline"
+
+	// egressAllowlistAnnotation stores the allowlist of a server on its NetworkPolicy, so that the allowed hosts can be
+	// resolved again when their addresses change.
+	egressAllowlistAnnotation = "obot.ai/egress-allowlist"
+	// egressRefreshInterval is how often the allowed hosts of every server are resolved again.
+	egressRefreshInterval = 5 * time.Minute
+)
+
+// RefreshEgressPolicies periodically resolves the allowed hosts of every server's egress policy again, so that the policies
+// follow the hosts' addresses, until the context is canceled. It should only run on the leader.
+func (sm *SessionManager) RefreshEgressPolicies(ctx context.Context) {
+	ticker := time.NewTicker(egressRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := sm.backend.refreshEgressPolicies(ctx); err != nil {
+				log.Warnf("failed to refresh MCP server egress policies: %v", err)
+			}
+		}
+	}
+}
+
+func egressBaselinePolicy(namespace, obotNamespace string) *networkingv1.NetworkPolicy {
+	var (
+		udp = corev1.ProtocolUDP
+		tcp = corev1.ProtocolTCP
+		dns = intstr.FromInt(53)
+	)
+
+	rules := []networkingv1.NetworkPolicyEgressRule{
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: &udp, Port: &dns},
+				{Protocol: &tcp, Port: &dns},
+			},
+		},
+	}
+	if obotNamespace != "" {
+		rules = append(rules, networkingv1.NetworkPolicyEgressRule{
+			To: []networkingv1.NetworkPolicyPeer{{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"kubernetes.io/metadata.name": obotNamespace},
+				},
+			}},
+		})
+	}
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      egressBaselinePolicyName,
+			Namespace: namespace,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+			Egress:      rules,
+		},
+	}
+}
+
+// ensureEgressBaseline creates the baseline egress policy for the MCP namespace if egress policies are enforced,
+// and removes it if they aren't. This only needs to succeed once per process.
+func (k *kubernetesBackend) ensureEgressBaseline(ctx context.Context) error {
+	k.egressBaselineLock.Lock()
+	defer k.egressBaselineLock.Unlock()
+	if k.egressBaselineApplied {
+		return nil
+	}
+
+	var objs []kclient.Object
+	if k.egressPolicyEnforced {
+		objs = append(objs, egressBaselinePolicy(k.mcpNamespace, k.obotNamespace))
+	}
+
+	if err := apply.New(k.client).WithNamespace(k.mcpNamespace).WithOwnerSubContext(egressBaselinePolicyName).WithPruneTypes(new(networkingv1.NetworkPolicy)).Apply(ctx, nil, objs...); err != nil {
+		if k.egressPolicyEnforced {
+			return fmt.Errorf("failed to apply egress baseline policy: %w", err)
+		}
+		// Failing to clean up the baseline shouldn't stop servers from being deployed when egress policies aren't enforced.
+		olog.Warnf("failed to remove egress baseline policy: %v", err)
+	}
+
+	k.egressBaselineApplied = true
+	return nil
+}
+
+// egressNetworkPolicy returns the NetworkPolicy that allows egress from the server's pods to its allowlist.
+func (k *kubernetesBackend) egressNetworkPolicy(ctx context.Context, server ServerConfig, extraURLs ...string) *networkingv1.NetworkPolicy {
+	allowlist := newEgressAllowlist(server, extraURLs...)
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name.SafeConcatName(server.MCPServerName, "egress"),
+			Namespace:   k.mcpNamespace,
+			Annotations: map[string]string{egressAllowlistAnnotation: allowlist.String()},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": server.MCPServerName},
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+			Egress:      k.egressRules(ctx, server.MCPServerName, allowlist),
+		},
+	}
+}
+
+// egressRules returns the NetworkPolicy rules that allow egress to the allowlist. NetworkPolicies can only match IP
+// addresses, so allowed hosts are resolved here, and again every egressRefreshInterval by refreshEgressPolicies.
+// Services in the MCP namespace are matched by their pods, and other services in the cluster by their namespace.
+// Obot's namespace is skipped, because the baseline policy already allows it.
+func (k *kubernetesBackend) egressRules(ctx context.Context, serverName string, allowlist egressAllowlist) []networkingv1.NetworkPolicyEgressRule {
+	var (
+		cidrs      = make([]string, 0, len(allowlist.cidrs)+len(allowlist.hosts))
+		services   []string
+		namespaces []string
+	)
+	for _, cidr := range allowlist.cidrs {
+		cidrs = append(cidrs, cidr.String())
+	}
+	for _, host := range allowlist.hosts {
+		if host == "localhost" {
+			continue
+		}
+		if service, namespace, ok := k.clusterService(host); ok {
+			switch namespace {
+			case k.mcpNamespace:
+				services = append(services, service)
+			case k.obotNamespace:
+			default:
+				namespaces = append(namespaces, namespace)
+			}
+			continue
+		}
+
+		addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			olog.Warnf("failed to resolve allowed egress host %s for MCP server %s: %v", host, serverName, err)
+			continue
+		}
+		for _, addr := range addrs {
+			if ip4 := addr.IP.To4(); ip4 != nil {
+				cidrs = append(cidrs, ip4.String()+"/32")
+			} else {
+				cidrs = append(cidrs, addr.IP.String()+"/128")
+			}
+		}
+	}
+
+	// Keep the policy stable so that it is only updated when the addresses actually change.
+	for _, s := range []*[]string{&cidrs, &services, &namespaces} {
+		slices.Sort(*s)
+		*s = slices.Compact(*s)
+	}
+
+	peers := make([]networkingv1.NetworkPolicyPeer, 0, len(cidrs)+len(services)+len(namespaces))
+	for _, cidr := range cidrs {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: cidr}})
+	}
+	for _, service := range services {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{PodSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"app": service},
+		}})
+	}
+	for _, namespace := range namespaces {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{NamespaceSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"kubernetes.io/metadata.name": namespace},
+		}})
+	}
+
+	if len(peers) == 0 {
+		return nil
+	}
+	return []networkingv1.NetworkPolicyEgressRule{{To: peers}}
+}
+
+// clusterService returns the name and namespace of the service if the host is the name of a service in the cluster,
+// like mcp-server.obot-mcp.svc.cluster.local.
+func (k *kubernetesBackend) clusterService(host string) (string, string, bool) {
+	rest, ok := strings.CutSuffix(host, ".svc."+k.mcpClusterDomain)
+	if !ok {
+		return "", "", false
+	}
+	service, namespace, ok := strings.Cut(rest, ".")
+	if !ok || service == "" || namespace == "" || strings.Contains(namespace, ".") {
+		return "", "", false
+	}
+	return service, namespace, true
+}
+
+// refreshEgressPolicies resolves the allowed hosts of every server again, and updates the egress policies whose
+// addresses changed.
+func (k *kubernetesBackend) refreshEgressPolicies(ctx context.Context) error {
+	if !k.egressPolicyEnforced {
+		return nil
+	}
+
+	var policies networkingv1.NetworkPolicyList
+	if err := k.client.List(ctx, &policies, kclient.InNamespace(k.mcpNamespace)); err != nil {
+		return fmt.Errorf("failed to list egress policies: %w", err)
+	}
+
+	for _, policy := range policies.Items {
+		allowlist, ok := policy.Annotations[egressAllowlistAnnotation]
+		if !ok {
+			continue
+		}
+
+		rules := k.egressRules(ctx, policy.Name, parseEgressAllowlist(allowlist))
+		if equality.Semantic.DeepEqual(rules, policy.Spec.Egress) {
+			continue
+		}
+
+		policy.Spec.Egress = rules
+		if err := k.client.Update(ctx, &policy); kclient.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to update egress policy %s: %w", policy.Name, err)
+		}
+	}
+
+	return nil
+}
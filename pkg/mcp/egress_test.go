@@ -0,0 +1,88 @@
+package mcp
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEgressAllowlist(t *testing.T) {
+	allowlist := newEgressAllowlist(ServerConfig{
+		Runtime:               types.RuntimeRemote,
+		URL:                   "https://mcp.example.com/mcp",
+		TokenExchangeEndpoint: "http://10.0.0.5:8080/oauth/token",
+		EgressPolicy: &types.EgressPolicy{
+			AllowedHosts: []string{"API.GitHub.com"},
+			AllowedCIDRs: []string{"192.168.0.0/16"},
+		},
+	}, "https://hooks.example.com/webhook")
+
+	assert.True(t, allowlist.allowsHost("api.github.com"))
+	assert.True(t, allowlist.allowsHost("mcp.example.com"))
+	assert.True(t, allowlist.allowsHost("hooks.example.com"))
+	assert.True(t, allowlist.allowsHost("10.0.0.5"))
+	assert.True(t, allowlist.allowsHost("192.168.1.1"))
+	assert.False(t, allowlist.allowsHost("github.com"))
+	assert.False(t, allowlist.allowsHost("10.0.0.6"))
+
+	// The allowlist survives being stored in a container label.
+	assert.Equal(t, allowlist, parseEgressAllowlist(allowlist.String()))
+	assert.Empty(t, parseEgressAllowlist("").String())
+}
+
+func TestEgressProxyDialAddress(t *testing.T) {
+	var (
+		proxy     = newEgressProxy(nil)
+		allowlist = parseEgressAllowlist("203.0.113.0/24,198.51.100.7")
+		ctx       = context.Background()
+	)
+
+	addr, err := proxy.dialAddress(ctx, allowlist, "203.0.113.10", "443")
+	require.NoError(t, err)
+	assert.Equal(t, net.JoinHostPort("203.0.113.10", "443"), addr)
+
+	_, err = proxy.dialAddress(ctx, allowlist, "198.51.100.8", "443")
+	assert.ErrorIs(t, err, errEgressDenied)
+
+	// Hostnames are denied without a lookup when nothing could allow them.
+	_, err = proxy.dialAddress(ctx, parseEgressAllowlist("api.github.com"), "example.com", "443")
+	assert.ErrorIs(t, err, errEgressDenied)
+
+	_, err = proxy.dialAddress(ctx, allowlist, "", "443")
+	assert.ErrorIs(t, err, errEgressDenied)
+}
+
+func TestEgressRules(t *testing.T) {
+	k := &kubernetesBackend{mcpNamespace: "obot-mcp", obotNamespace: "obot", mcpClusterDomain: "cluster.local"}
+
+	rules := k.egressRules(context.Background(), "server", parseEgressAllowlist(
+		"other.obot-mcp.svc.cluster.local,obot.obot.svc.cluster.local,db.data.svc.cluster.local,localhost,10.0.0.0/8,198.51.100.7",
+	))
+	require.Len(t, rules, 1)
+
+	peers := rules[0].To
+	require.Len(t, peers, 4)
+	assert.Equal(t, "10.0.0.0/8", peers[0].IPBlock.CIDR)
+	assert.Equal(t, "198.51.100.7/32", peers[1].IPBlock.CIDR)
+	// Services in the MCP namespace are matched by their pods, so that the server can't reach every other server.
+	assert.Equal(t, map[string]string{"app": "other"}, peers[2].PodSelector.MatchLabels)
+	assert.Nil(t, peers[2].NamespaceSelector)
+	assert.Equal(t, map[string]string{"kubernetes.io/metadata.name": "data"}, peers[3].NamespaceSelector.MatchLabels)
+
+	assert.Empty(t, k.egressRules(context.Background(), "server", parseEgressAllowlist("localhost")))
+}
+
+func TestEgressBaselinePolicy(t *testing.T) {
+	policy := egressBaselinePolicy("obot-mcp", "obot")
+
+	// Only DNS and Obot are allowed, and not the other pods in the MCP namespace.
+	require.Len(t, policy.Spec.Egress, 2)
+	assert.Empty(t, policy.Spec.Egress[0].To)
+	require.Len(t, policy.Spec.Egress[1].To, 1)
+	assert.Nil(t, policy.Spec.Egress[1].To[0].PodSelector)
+	assert.Equal(t, map[string]string{"kubernetes.io/metadata.name": "obot"}, policy.Spec.Egress[1].To[0].NamespaceSelector.MatchLabels)
+}
@@ -0,0 +1,164 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+)
+
+var errEgressDenied = errors.New("destination is not allowed by the egress policy")
+
+// egressProxy is an HTTP proxy that only lets MCP containers connect to the destinations in their egress allowlists.
+// The Docker backend runs MCP containers on an internal network when egress policies are enforced,
+// so this proxy is the only way for them to reach anything outside that network.
+type egressProxy struct {
+	// allowlistFor returns the allowlist for the container with the given IP address.
+	allowlistFor func(ctx context.Context, ip string) (egressAllowlist, error)
+	resolver     *net.Resolver
+	dialer       net.Dialer
+	transport    *http.Transport
+}
+
+func newEgressProxy(allowlistFor func(ctx context.Context, ip string) (egressAllowlist, error)) *egressProxy {
+	return &egressProxy{
+		allowlistFor: allowlistFor,
+		resolver:     net.DefaultResolver,
+		dialer:       net.Dialer{Timeout: 30 * time.Second},
+		transport:    &http.Transport{Proxy: nil, ResponseHeaderTimeout: time.Minute},
+	}
+}
+
+func (p *egressProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	clientIP, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		http.Error(w, "invalid remote address", http.StatusBadRequest)
+		return
+	}
+
+	allowlist, err := p.allowlistFor(r.Context(), clientIP)
+	if err != nil {
+		log.Warnf("failed to get egress allowlist for %s: %v", clientIP, err)
+		http.Error(w, "failed to get egress policy", http.StatusBadGateway)
+		return
+	}
+
+	port := r.URL.Port()
+	if port == "" {
+		port = "80"
+	}
+
+	addr, err := p.dialAddress(r.Context(), allowlist, r.URL.Hostname(), port)
+	if errors.Is(err, errEgressDenied) {
+		log.Infof("Blocked egress from %s to %s", clientIP, r.URL.Host)
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	if r.Method == http.MethodConnect {
+		p.tunnel(w, r, addr)
+		return
+	}
+
+	if r.URL.Scheme != "http" {
+		http.Error(w, "proxy requests must use an absolute http URL", http.StatusBadRequest)
+		return
+	}
+
+	// Send the request to the address that was checked, so that the host can't resolve to something else in between.
+	out := r.Clone(r.Context())
+	out.RequestURI = ""
+	out.Host = r.URL.Host
+	out.URL.Host = addr
+	out.Header.Del("Proxy-Connection")
+	out.Header.Del("Proxy-Authorization")
+
+	resp, err := p.transport.RoundTrip(out)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+
+	for k, v := range resp.Header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, _ = io.Copy(w, resp.Body)
+}
+
+// dialAddress returns the address to connect to for the host and port, or errEgressDenied if the allowlist doesn't allow it.
+// Hosts that aren't allowed by name are still allowed if they resolve to an IP address in one of the allowed CIDRs.
+func (p *egressProxy) dialAddress(ctx context.Context, allowlist egressAllowlist, host, port string) (string, error) {
+	if host == "" {
+		return "", errEgressDenied
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		if !allowlist.allowsIP(ip) {
+			return "", errEgressDenied
+		}
+		return net.JoinHostPort(host, port), nil
+	}
+
+	allowedByName := allowlist.allowsHost(host)
+	if !allowedByName && len(allowlist.cidrs) == 0 {
+		return "", errEgressDenied
+	}
+
+	addrs, err := p.resolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", host, err)
+	}
+
+	for _, addr := range addrs {
+		if allowedByName || allowlist.allowsIP(addr.IP) {
+			return net.JoinHostPort(addr.IP.String(), port), nil
+		}
+	}
+
+	return "", errEgressDenied
+}
+
+func (p *egressProxy) tunnel(w http.ResponseWriter, r *http.Request, addr string) {
+	upstream, err := p.dialer.DialContext(r.Context(), "tcp", addr)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer upstream.Close()
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "tunneling is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	client, buf, err := hijacker.Hijack()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer client.Close()
+
+	if _, err = client.Write([]byte("HTTP/1.1 200 Connection Established\r\n\r\n")); err != nil {
+		return
+	}
+
+	done := make(chan struct{}, 2)
+	go func() {
+		// Anything the client sent after the CONNECT request is still in the buffered reader.
+		_, _ = io.Copy(upstream, buf)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(client, upstream)
+		done <- struct{}{}
+	}()
+	<-done
+}
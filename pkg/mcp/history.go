@@ -0,0 +1,205 @@
+package mcp
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/auditlogredact"
+	gtypes "github.com/obot-platform/obot/pkg/gateway/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	historyCollectInterval = time.Minute
+	historyPruneInterval   = time.Hour
+
+	// maxLogLineLength is the longest log line that is kept. Longer lines are truncated.
+	maxLogLineLength = 16 * 1024
+)
+
+// HistoryStore persists the deployment events and recent logs of MCP servers.
+type HistoryStore interface {
+	InsertMCPServerDeploymentEvents(ctx context.Context, events []gtypes.MCPServerDeploymentEvent) error
+	InsertMCPServerLogLines(ctx context.Context, mcpServerName string, lines []gtypes.MCPServerLogLine, keep int) error
+	DeleteMCPServerHistoryBefore(ctx context.Context, before time.Time) error
+}
+
+// serverLogLine is a line from the logs of one replica of a server.
+type serverLogLine struct {
+	replica string
+	time    time.Time
+	line    string
+}
+
+// CollectServerHistory periodically stores the deployment events and recent logs of every deployed MCP server,
+// so that they are still available after the pod or container is gone. It runs until the context is canceled.
+func (sm *SessionManager) CollectServerHistory(ctx context.Context, store HistoryStore) {
+	if sm.historyRetention <= 0 {
+		return
+	}
+
+	collectTicker := time.NewTicker(historyCollectInterval)
+	defer collectTicker.Stop()
+	pruneTicker := time.NewTicker(historyPruneInterval)
+	defer pruneTicker.Stop()
+
+	// lastCollected is when the logs of each server were last collected, so that only new lines are read.
+	lastCollected := map[string]time.Time{}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-collectTicker.C:
+			sm.collectServerHistory(ctx, store, lastCollected)
+		case <-pruneTicker.C:
+			if err := store.DeleteMCPServerHistoryBefore(ctx, time.Now().Add(-sm.historyRetention)); err != nil {
+				log.Warnf("failed to prune MCP server history: %v", err)
+			}
+		}
+	}
+}
+
+func (sm *SessionManager) collectServerHistory(ctx context.Context, store HistoryStore, lastCollected map[string]time.Time) {
+	names, err := sm.backend.listServers(ctx)
+	if err != nil {
+		log.Warnf("failed to list MCP servers to collect history: %v", err)
+		return
+	}
+
+	for name := range lastCollected {
+		if !slices.Contains(names, name) {
+			delete(lastCollected, name)
+		}
+	}
+
+	for _, name := range names {
+		now := time.Now()
+
+		if details, err := sm.backend.getServerDetails(ctx, name); err != nil {
+			log.Debugf("failed to get details of MCP server %s to collect events: %v", name, err)
+		} else if err = store.InsertMCPServerDeploymentEvents(ctx, deploymentEvents(name, details.Events)); err != nil {
+			log.Warnf("failed to store events of MCP server %s: %v", name, err)
+		}
+
+		lines, err := sm.backend.serverLogs(ctx, name, lastCollected[name], sm.historyLogLines)
+		if err != nil {
+			log.Debugf("failed to get logs of MCP server %s: %v", name, err)
+			continue
+		}
+		if err = store.InsertMCPServerLogLines(ctx, name, logLines(name, lines, sm.historyRedactor(ctx, name)), sm.historyLogLines); err != nil {
+			log.Warnf("failed to store logs of MCP server %s: %v", name, err)
+			continue
+		}
+
+		lastCollected[name] = now
+	}
+}
+
+// historyRedactor returns the redactor for the stored logs of a server, which is the server's audit log redaction.
+// Servers that aren't MCP servers, like system servers, get the built-in detectors.
+func (sm *SessionManager) historyRedactor(ctx context.Context, name string) *auditlogredact.Redactor {
+	var redaction *types.AuditLogRedaction
+
+	var server v1.MCPServer
+	if err := sm.obotClient.Get(ctx, kclient.ObjectKey{Namespace: system.DefaultNamespace, Name: name}, &server); err == nil {
+		redaction = server.Spec.Manifest.AuditLogRedaction
+	} else if !apierrors.IsNotFound(err) {
+		log.Warnf("failed to get MCP server %s to redact its logs, not keeping them: %v", name, err)
+		return auditlogredact.MetadataOnly()
+	}
+
+	redactor, err := auditlogredact.New(redaction)
+	if err != nil {
+		// Validation should prevent this. Don't store anything that the redaction rules were meant to remove.
+		log.Errorf("invalid audit log redaction for MCP server %s, not keeping its logs: %v", name, err)
+		return auditlogredact.MetadataOnly()
+	}
+	return redactor
+}
+
+func deploymentEvents(serverName string, events []types.MCPServerEvent) []gtypes.MCPServerDeploymentEvent {
+	result := make([]gtypes.MCPServerDeploymentEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, gtypes.MCPServerDeploymentEvent{
+			MCPServerName: serverName,
+			DedupeKey:     dedupeKey(serverName, event.ResourceKind, event.ResourceName, event.Reason, event.Action, event.Time.Time.Format(time.RFC3339Nano)),
+			Time:          event.Time.Time,
+			EventType:     event.EventType,
+			Reason:        event.Reason,
+			Message:       event.Message,
+			Action:        event.Action,
+			Count:         event.Count,
+			ResourceName:  event.ResourceName,
+			ResourceKind:  event.ResourceKind,
+		})
+	}
+	return result
+}
+
+// logLines converts log lines to be stored. They are sorted by time, because only the most recently stored lines are kept.
+// The lines are redacted like the server's audit logs, and none are kept if the server's audit logs are metadata only.
+func logLines(serverName string, lines []serverLogLine, redactor *auditlogredact.Redactor) []gtypes.MCPServerLogLine {
+	slices.SortStableFunc(lines, func(a, b serverLogLine) int {
+		return a.time.Compare(b.time)
+	})
+
+	result := make([]gtypes.MCPServerLogLine, 0, len(lines))
+	for _, line := range lines {
+		if line.line, _ = redactor.RedactText(line.line); line.line == "" {
+			continue
+		}
+		result = append(result, gtypes.MCPServerLogLine{
+			MCPServerName: serverName,
+			DedupeKey:     dedupeKey(serverName, line.replica, line.time.Format(time.RFC3339Nano), line.line),
+			Time:          line.time,
+			Replica:       line.replica,
+			Line:          line.line,
+		})
+	}
+	return result
+}
+
+func dedupeKey(parts ...string) string {
+	h := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(h[:])
+}
+
+// parseTimestampedLogs parses logs where each line starts with an RFC 3339 timestamp, which is how both Kubernetes and
+// Docker return logs when timestamps are requested. Lines without a timestamp are given the time of the previous line.
+func parseTimestampedLogs(r io.Reader, replica string) ([]serverLogLine, error) {
+	var (
+		lines    []serverLogLine
+		lastTime time.Time
+		reader   = bufio.NewReader(r)
+	)
+	for {
+		line, err := reader.ReadString('\n')
+		if line = strings.TrimRight(line, "\r\n"); line != "" {
+			if ts, rest, ok := strings.Cut(line, " "); ok {
+				if t, parseErr := time.Parse(time.RFC3339Nano, ts); parseErr == nil {
+					lastTime, line = t, rest
+				}
+			}
+			if len(line) > maxLogLineLength {
+				line = line[:maxLogLineLength]
+			}
+			lines = append(lines, serverLogLine{replica: replica, time: lastTime, line: line})
+		}
+
+		if err == io.EOF {
+			return lines, nil
+		} else if err != nil {
+			return lines, err
+		}
+	}
+}
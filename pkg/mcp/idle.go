@@ -0,0 +1,100 @@
+package mcp
+
+import (
+	"context"
+	"time"
+
+	gtypes "github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+const idleCheckInterval = time.Minute
+
+// beginScaleDown marks the server as being scaled down, unless it has requests in flight on this replica.
+// Requests for the server will wait until finishScaleDown is called.
+func (t *requestTracker) beginScaleDown(serverName string) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	s := t.servers[serverName]
+	if s == nil {
+		s = new(trackedServer)
+		t.servers[serverName] = s
+	} else if s.scalingDown != nil || s.inFlight > 0 {
+		return false
+	}
+
+	s.scalingDown = make(chan struct{})
+	return true
+}
+
+// finishScaleDown stops tracking the server and releases any requests that were waiting on it.
+// The next request will start tracking the server again.
+func (t *requestTracker) finishScaleDown(serverName string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if s := t.servers[serverName]; s != nil {
+		if s.scalingDown != nil {
+			close(s.scalingDown)
+		}
+		delete(t.servers, serverName)
+	}
+}
+
+// idleServers returns the names of the servers that have no requests in flight on any replica and have been idle for
+// longer than their timeout.
+func idleServers(activity []gtypes.MCPServerActivity, now time.Time) []string {
+	var idle []string
+	for name, s := range combineActivity(activity, now) {
+		timeout := time.Duration(s.latest.IdleTimeoutSeconds) * time.Second
+		if s.inFlight == 0 && timeout > 0 && now.Sub(s.lastActive) >= timeout {
+			idle = append(idle, name)
+		}
+	}
+	return idle
+}
+
+// ScaleDownIdleServers periodically scales servers that haven't had requests on any replica for their idle timeout to zero,
+// until the context is canceled. It should only run on the leader.
+func (sm *SessionManager) ScaleDownIdleServers(ctx context.Context, store ActivityStore) {
+	ticker := time.NewTicker(idleCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sm.scaleDownIdleServers(ctx, store)
+		}
+	}
+}
+
+func (sm *SessionManager) scaleDownIdleServers(ctx context.Context, store ActivityStore) {
+	activity, err := sm.sharedActivity(ctx, store)
+	if err != nil {
+		log.Warnf("failed to get MCP server activity: %v", err)
+		return
+	}
+
+	for _, name := range idleServers(activity, sm.requests.now()) {
+		if !sm.requests.beginScaleDown(name) {
+			continue
+		}
+
+		log.Infof("Scaling idle MCP server %s to zero", name)
+
+		sm.closeClients(name)
+		if err := sm.backend.scaleServer(ctx, name, 0); err != nil {
+			log.Warnf("failed to scale idle MCP server %s to zero: %v", name, err)
+		} else if err := store.DeleteMCPServerActivity(ctx, name); err != nil {
+			log.Warnf("failed to delete activity of idle MCP server %s: %v", name, err)
+		}
+
+		sm.requests.finishScaleDown(name)
+	}
+
+	if err := store.DeleteMCPServerActivityBefore(ctx, sm.requests.now().Add(-activityRetention)); err != nil {
+		log.Warnf("failed to prune MCP server activity: %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+package mcp
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	gtypes "github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdleServers(t *testing.T) {
+	now := time.Now()
+	activity := []gtypes.MCPServerActivity{
+		// Idle for longer than its timeout on every replica.
+		{MCPServerName: "idle", Replica: "a", LastActiveAt: now.Add(-time.Hour), IdleTimeoutSeconds: 600, UpdatedAt: now.Add(-time.Hour)},
+		{MCPServerName: "idle", Replica: "b", LastActiveAt: now.Add(-20 * time.Minute), IdleTimeoutSeconds: 600, UpdatedAt: now},
+		// Used recently on one of the replicas.
+		{MCPServerName: "used", Replica: "a", LastActiveAt: now.Add(-time.Hour), IdleTimeoutSeconds: 600, UpdatedAt: now},
+		{MCPServerName: "used", Replica: "b", LastActiveAt: now.Add(-time.Minute), IdleTimeoutSeconds: 600, UpdatedAt: now},
+		// Has a request in flight on one of the replicas.
+		{MCPServerName: "busy", Replica: "a", InFlight: 1, LastActiveAt: now.Add(-time.Hour), IdleTimeoutSeconds: 600, UpdatedAt: now},
+		// The request in flight is from a replica that stopped updating its activity, so it is not counted.
+		{MCPServerName: "stale", Replica: "gone", InFlight: 1, LastActiveAt: now.Add(-time.Hour), IdleTimeoutSeconds: 600, UpdatedAt: now.Add(-time.Hour)},
+		// Never scaled to zero.
+		{MCPServerName: "disabled", Replica: "a", LastActiveAt: now.Add(-time.Hour), UpdatedAt: now},
+		// The timeout that was stored most recently is used.
+		{MCPServerName: "changed", Replica: "a", LastActiveAt: now.Add(-time.Hour), IdleTimeoutSeconds: 600, UpdatedAt: now.Add(-time.Hour)},
+		{MCPServerName: "changed", Replica: "b", LastActiveAt: now.Add(-time.Hour), UpdatedAt: now},
+	}
+
+	idle := idleServers(activity, now)
+	slices.Sort(idle)
+	assert.Equal(t, []string{"idle", "stale"}, idle)
+}
+
+func TestRequestTrackerWaitsForScaleDown(t *testing.T) {
+	tracker := newRequestTracker(time.Minute)
+
+	done, err := tracker.start(context.Background(), "server", nil, serverScaling{})
+	require.NoError(t, err)
+
+	// Servers with requests in flight on this replica are not scaled down.
+	assert.False(t, tracker.beginScaleDown("server"))
+	done()
+
+	require.True(t, tracker.beginScaleDown("server"))
+	// A server that is being scaled down isn't scaled down again.
+	assert.False(t, tracker.beginScaleDown("server"))
+
+	// Requests wait for the scale down to finish.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = tracker.start(ctx, "server", nil, serverScaling{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	started := make(chan struct{})
+	go func() {
+		done, err := tracker.start(context.Background(), "server", nil, serverScaling{})
+		assert.NoError(t, err)
+		done()
+		close(started)
+	}()
+
+	tracker.finishScaleDown("server")
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("request did not start after the scale down finished")
+	}
+
+	// Servers that weren't used on this replica can be scaled down too.
+	assert.True(t, tracker.beginScaleDown("other"))
+}
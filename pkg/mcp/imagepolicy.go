@@ -0,0 +1,233 @@
+package mcp
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/oci"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ImagePolicyConditionType is the type of the deployment condition that reports whether a server's image passed the image policy.
+	ImagePolicyConditionType = "ImagePolicy"
+
+	// imagePolicyCacheTTL is how long a verified image is trusted before its digest and signature are checked again.
+	imagePolicyCacheTTL = 10 * time.Minute
+)
+
+// imagePolicyError is returned when a server's container image doesn't satisfy the image policy.
+type imagePolicyError struct {
+	reason, message string
+}
+
+func (e *imagePolicyError) Error() string {
+	return e.message
+}
+
+type verifiedImage struct {
+	image     string
+	checkedAt time.Time
+}
+
+// imagePolicyChecker checks the container images of MCP servers against the image policy before they are deployed.
+type imagePolicyChecker struct {
+	client kclient.Client
+	// credentials returns the credentials for the registry of an image, so that private images can be verified.
+	credentials func(ctx context.Context, registry string) (string, string, error)
+
+	lock sync.Mutex
+	// verified maps an image and the version of the policy it was checked against to the image that should be deployed.
+	verified map[string]verifiedImage
+	// failures holds the last failed check for each server, so that it can be reported in the server details.
+	failures map[string]types.DeploymentCondition
+}
+
+func newImagePolicyChecker(client kclient.Client, credentials func(ctx context.Context, registry string) (string, string, error)) *imagePolicyChecker {
+	return &imagePolicyChecker{
+		client:      client,
+		credentials: credentials,
+		verified:    map[string]verifiedImage{},
+		failures:    map[string]types.DeploymentCondition{},
+	}
+}
+
+// enforce checks the server's container image against the image policy. It returns the server with its image pinned to
+// the digest that was verified, so that a moved tag can't swap in a different image between the check and the pull.
+// Only the containerized runtime is checked, because the other runtimes use Obot's own base images.
+func (c *imagePolicyChecker) enforce(ctx context.Context, server ServerConfig) (ServerConfig, error) {
+	if c == nil || c.client == nil || server.Runtime != types.RuntimeContainerized || server.ContainerImage == "" {
+		return server, nil
+	}
+
+	var policy v1.MCPImagePolicy
+	if err := c.client.Get(ctx, kclient.ObjectKey{Namespace: system.DefaultNamespace, Name: system.MCPImagePolicyName}, &policy); apierrors.IsNotFound(err) {
+		c.recordSuccess(server.MCPServerName)
+		return server, nil
+	} else if err != nil {
+		return server, fmt.Errorf("failed to get MCP image policy: %w", err)
+	}
+
+	cacheKey := policy.ResourceVersion + "/" + server.ContainerImage
+
+	c.lock.Lock()
+	cached, ok := c.verified[cacheKey]
+	c.lock.Unlock()
+	if ok && time.Since(cached.checkedAt) < imagePolicyCacheTTL {
+		server.ContainerImage = cached.image
+		c.recordSuccess(server.MCPServerName)
+		return server, nil
+	}
+
+	image, err := c.verifyImage(ctx, policy.Spec.Manifest, server.ContainerImage)
+	if err != nil {
+		c.recordFailure(server.MCPServerName, err)
+		log.Warnf("Container image %s for MCP server %s failed the image policy: %v", server.ContainerImage, server.MCPServerName, err)
+		return server, err
+	}
+
+	c.lock.Lock()
+	c.verified[cacheKey] = verifiedImage{image: image, checkedAt: time.Now()}
+	c.lock.Unlock()
+
+	server.ContainerImage = image
+	c.recordSuccess(server.MCPServerName)
+	return server, nil
+}
+
+// condition returns the failed image policy condition for the server, if its last check failed.
+func (c *imagePolicyChecker) condition(serverName string) (types.DeploymentCondition, bool) {
+	if c == nil {
+		return types.DeploymentCondition{}, false
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	condition, ok := c.failures[serverName]
+	return condition, ok
+}
+
+func (c *imagePolicyChecker) recordSuccess(serverName string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.failures, serverName)
+}
+
+func (c *imagePolicyChecker) recordFailure(serverName string, err error) {
+	reason := "VerificationFailed"
+	if e, ok := err.(*imagePolicyError); ok {
+		reason = e.reason
+	}
+
+	now := *types.NewTime(time.Now())
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	transitioned := now
+	if existing, ok := c.failures[serverName]; ok {
+		transitioned = existing.LastTransitionTime
+	}
+	c.failures[serverName] = types.DeploymentCondition{
+		Type:               ImagePolicyConditionType,
+		Status:             "False",
+		Reason:             reason,
+		Message:            err.Error(),
+		LastTransitionTime: transitioned,
+		LastUpdateTime:     now,
+	}
+}
+
+// verifyImage checks an image against the policy and returns the image that should be deployed.
+// When signatures are required, that is the image pinned to the digest whose signature was verified.
+func (c *imagePolicyChecker) verifyImage(ctx context.Context, policy types.MCPImagePolicyManifest, image string) (string, error) {
+	ref, err := checkImagePolicy(policy, image)
+	if err != nil {
+		return "", err
+	}
+
+	if len(policy.CosignPublicKeys) == 0 {
+		return image, nil
+	}
+
+	publicKeys := make([]crypto.PublicKey, 0, len(policy.CosignPublicKeys))
+	for _, key := range policy.CosignPublicKeys {
+		publicKey, err := oci.ParsePublicKey(key)
+		if err != nil {
+			return "", fmt.Errorf("invalid cosign public key in the image policy: %w", err)
+		}
+		publicKeys = append(publicKeys, publicKey)
+	}
+
+	var username, password string
+	if c.credentials != nil {
+		if username, password, err = c.credentials(ctx, ref.Registry); err != nil {
+			return "", fmt.Errorf("failed to get credentials for registry %s: %w", ref.Registry, err)
+		}
+	}
+
+	client := oci.NewClient(ref, username, password)
+	digest, err := client.ResolveDigest()
+	if err != nil {
+		return "", &imagePolicyError{
+			reason:  "SignatureNotVerified",
+			message: fmt.Sprintf("failed to resolve the digest of image %s: %v", image, err),
+		}
+	}
+
+	if err := client.VerifyCosignSignature(digest, publicKeys); err != nil {
+		return "", &imagePolicyError{
+			reason:  "SignatureNotVerified",
+			message: fmt.Sprintf("image %s is not signed by a trusted key: %v", image, err),
+		}
+	}
+
+	return ref.WithDigest(digest).String(), nil
+}
+
+// checkImagePolicy checks the parts of the policy that don't need the registry: where the image comes from,
+// and whether it is pinned to a digest.
+func checkImagePolicy(policy types.MCPImagePolicyManifest, image string) (oci.Reference, error) {
+	ref, err := oci.ParseImageReference(image)
+	if err != nil {
+		return oci.Reference{}, &imagePolicyError{
+			reason:  "InvalidImage",
+			message: fmt.Sprintf("invalid container image %s: %v", image, err),
+		}
+	}
+
+	if len(policy.AllowedRegistries) > 0 && !registryAllowed(ref, policy.AllowedRegistries) {
+		return oci.Reference{}, &imagePolicyError{
+			reason:  "RegistryNotAllowed",
+			message: fmt.Sprintf("image %s is not from an allowed registry (%s)", image, strings.Join(policy.AllowedRegistries, ", ")),
+		}
+	}
+
+	if policy.RequireDigest && !ref.IsDigest() {
+		return oci.Reference{}, &imagePolicyError{
+			reason:  "DigestRequired",
+			message: fmt.Sprintf("image %s must be pinned to a digest, like image@sha256:...", image),
+		}
+	}
+
+	return ref, nil
+}
+
+// registryAllowed returns true if the image's registry, or its registry and a leading part of its repository, is allowed.
+func registryAllowed(ref oci.Reference, allowed []string) bool {
+	name := strings.ToLower(ref.Registry + "/" + ref.Repository)
+	for _, prefix := range allowed {
+		prefix = strings.ToLower(strings.TrimSuffix(prefix, "/"))
+		if name == prefix || strings.HasPrefix(name, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package mcp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckImagePolicy(t *testing.T) {
+	const digest = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+
+	tests := []struct {
+		name       string
+		policy     types.MCPImagePolicyManifest
+		image      string
+		wantReason string
+	}{
+		{
+			name:  "empty policy",
+			image: "nginx",
+		},
+		{
+			name:   "allowed registry",
+			policy: types.MCPImagePolicyManifest{AllowedRegistries: []string{"ghcr.io"}},
+			image:  "ghcr.io/org/server:v1",
+		},
+		{
+			name:   "allowed repository prefix",
+			policy: types.MCPImagePolicyManifest{AllowedRegistries: []string{"docker.io/org/"}},
+			image:  "org/server:v1",
+		},
+		{
+			name:   "official docker hub image",
+			policy: types.MCPImagePolicyManifest{AllowedRegistries: []string{"docker.io/library"}},
+			image:  "nginx",
+		},
+		{
+			name:       "registry not allowed",
+			policy:     types.MCPImagePolicyManifest{AllowedRegistries: []string{"ghcr.io/org"}},
+			image:      "ghcr.io/other/server:v1",
+			wantReason: "RegistryNotAllowed",
+		},
+		{
+			name:       "prefix must match a whole path segment",
+			policy:     types.MCPImagePolicyManifest{AllowedRegistries: []string{"ghcr.io/org"}},
+			image:      "ghcr.io/organization/server:v1",
+			wantReason: "RegistryNotAllowed",
+		},
+		{
+			name:       "digest required",
+			policy:     types.MCPImagePolicyManifest{RequireDigest: true},
+			image:      "ghcr.io/org/server:v1",
+			wantReason: "DigestRequired",
+		},
+		{
+			name:   "digest pinned",
+			policy: types.MCPImagePolicyManifest{RequireDigest: true},
+			image:  "ghcr.io/org/server:v1@" + digest,
+		},
+		{
+			name:       "invalid image",
+			image:      "ghcr.io/../server",
+			wantReason: "InvalidImage",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := checkImagePolicy(tt.policy, tt.image)
+			if tt.wantReason == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var policyErr *imagePolicyError
+			require.ErrorAs(t, err, &policyErr)
+			assert.Equal(t, tt.wantReason, policyErr.reason)
+		})
+	}
+}
+
+func TestImagePolicyConditions(t *testing.T) {
+	c := newImagePolicyChecker(nil, nil)
+
+	_, ok := c.condition("server")
+	assert.False(t, ok)
+
+	c.recordFailure("server", &imagePolicyError{reason: "DigestRequired", message: "image must be pinned"})
+	condition, ok := c.condition("server")
+	require.True(t, ok)
+	assert.Equal(t, ImagePolicyConditionType, condition.Type)
+	assert.Equal(t, "False", condition.Status)
+	assert.Equal(t, "DigestRequired", condition.Reason)
+	assert.Equal(t, "image must be pinned", condition.Message)
+
+	// Repeated failures keep the time the check started failing.
+	c.recordFailure("server", errors.New("registry is down"))
+	updated, ok := c.condition("server")
+	require.True(t, ok)
+	assert.Equal(t, "VerificationFailed", updated.Reason)
+	assert.Equal(t, condition.LastTransitionTime, updated.LastTransitionTime)
+
+	c.recordSuccess("server")
+	_, ok = c.condition("server")
+	assert.False(t, ok)
+}
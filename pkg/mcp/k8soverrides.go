@@ -0,0 +1,116 @@
+package mcp
+
+import (
+	"maps"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// mcpContainerResources returns the resources for the MCP server container, falling back to a default memory request
+// if there are no resources in the K8s settings.
+func mcpContainerResources(settings v1.K8sSettingsSpec) corev1.ResourceRequirements {
+	if settings.Resources != nil {
+		return *settings.Resources.DeepCopy()
+	}
+	return corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("400Mi"),
+		},
+	}
+}
+
+// applyK8sOverrides merges a server's resource overrides over the global K8s settings.
+func applyK8sOverrides(settings v1.K8sSettingsSpec, overrides *types.K8sOverrides) v1.K8sSettingsSpec {
+	if overrides == nil || overrides.Resources == nil {
+		return settings
+	}
+
+	resources := mcpContainerResources(settings)
+	if resources.Requests == nil {
+		resources.Requests = corev1.ResourceList{}
+	}
+	if resources.Limits == nil {
+		resources.Limits = corev1.ResourceList{}
+	}
+
+	setQuantity(resources.Requests, corev1.ResourceCPU, overrides.Resources.CPURequest)
+	setQuantity(resources.Limits, corev1.ResourceCPU, overrides.Resources.CPULimit)
+	setQuantity(resources.Requests, corev1.ResourceMemory, overrides.Resources.MemoryRequest)
+	setQuantity(resources.Limits, corev1.ResourceMemory, overrides.Resources.MemoryLimit)
+
+	// A lower limit from the overrides can't be combined with a higher request from the global settings.
+	for name, limit := range resources.Limits {
+		if request, ok := resources.Requests[name]; ok && request.Cmp(limit) > 0 {
+			resources.Requests[name] = limit
+		}
+	}
+
+	settings.Resources = &resources
+	return settings
+}
+
+func setQuantity(list corev1.ResourceList, name corev1.ResourceName, value string) {
+	if value == "" {
+		return
+	}
+	// The overrides are validated when they are saved, so ignore anything that can't be parsed.
+	if q, err := resource.ParseQuantity(value); err == nil {
+		list[name] = q
+	}
+}
+
+// applyK8sPodOverrides applies the pod-level overrides for a server to its pod spec. Extra volumes are mounted in the container
+// with the given name.
+func applyK8sPodOverrides(spec *corev1.PodSpec, containerName string, overrides *types.K8sOverrides) {
+	if overrides == nil {
+		return
+	}
+
+	if len(overrides.NodeSelector) > 0 {
+		if spec.NodeSelector == nil {
+			spec.NodeSelector = make(map[string]string, len(overrides.NodeSelector))
+		}
+		maps.Copy(spec.NodeSelector, overrides.NodeSelector)
+	}
+
+	if overrides.PriorityClassName != "" {
+		spec.PriorityClassName = overrides.PriorityClassName
+	}
+
+	if overrides.ServiceAccountName != "" {
+		spec.ServiceAccountName = overrides.ServiceAccountName
+	}
+
+	for _, volume := range overrides.Volumes {
+		v := corev1.Volume{Name: volume.Name}
+		switch {
+		case volume.ConfigMap != "":
+			v.ConfigMap = &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: volume.ConfigMap},
+			}
+		case volume.Secret != "":
+			v.Secret = &corev1.SecretVolumeSource{SecretName: volume.Secret}
+		case volume.EmptyDir != nil:
+			v.EmptyDir = &corev1.EmptyDirVolumeSource{}
+			if q, err := resource.ParseQuantity(volume.EmptyDir.SizeLimit); volume.EmptyDir.SizeLimit != "" && err == nil {
+				v.EmptyDir.SizeLimit = &q
+			}
+		default:
+			continue
+		}
+		spec.Volumes = append(spec.Volumes, v)
+
+		for i := range spec.Containers {
+			if spec.Containers[i].Name == containerName {
+				spec.Containers[i].VolumeMounts = append(spec.Containers[i].VolumeMounts, corev1.VolumeMount{
+					Name:      volume.Name,
+					MountPath: volume.MountPath,
+					ReadOnly:  volume.ReadOnly,
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestApplyK8sOverrides(t *testing.T) {
+	global := v1.K8sSettingsSpec{
+		Resources: &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("100m"),
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+			},
+		},
+	}
+
+	// No overrides leaves the global settings alone.
+	assert.Equal(t, global, applyK8sOverrides(global, nil))
+
+	settings := applyK8sOverrides(global, &types.K8sOverrides{
+		Resources: &types.K8sResourceOverrides{
+			CPULimit:    "2",
+			MemoryLimit: "512Mi",
+		},
+	})
+
+	// Overridden values replace the global ones and the rest are kept.
+	assert.True(t, settings.Resources.Requests.Cpu().Equal(resource.MustParse("100m")))
+	assert.True(t, settings.Resources.Limits.Cpu().Equal(resource.MustParse("2")))
+	// The global memory request is lowered to the overridden limit.
+	assert.True(t, settings.Resources.Requests.Memory().Equal(resource.MustParse("512Mi")))
+	assert.True(t, settings.Resources.Limits.Memory().Equal(resource.MustParse("512Mi")))
+
+	// The global settings aren't modified.
+	assert.Nil(t, global.Resources.Limits)
+	assert.True(t, global.Resources.Requests.Memory().Equal(resource.MustParse("1Gi")))
+}
+
+func TestApplyK8sPodOverrides(t *testing.T) {
+	spec := corev1.PodSpec{
+		NodeSelector: map[string]string{"pool": "default"},
+		Containers:   []corev1.Container{{Name: "shim"}, {Name: "mcp"}},
+	}
+
+	applyK8sPodOverrides(&spec, "mcp", &types.K8sOverrides{
+		NodeSelector:       map[string]string{"pool": "browsers", "zone": "a"},
+		PriorityClassName:  "high",
+		ServiceAccountName: "browser",
+		Volumes: []types.K8sVolume{
+			{Name: "cache", MountPath: "/cache", EmptyDir: &types.K8sEmptyDirVolume{SizeLimit: "1Gi"}},
+			{Name: "config", MountPath: "/etc/server", ReadOnly: true, ConfigMap: "server-config"},
+		},
+	})
+
+	assert.Equal(t, map[string]string{"pool": "browsers", "zone": "a"}, spec.NodeSelector)
+	assert.Equal(t, "high", spec.PriorityClassName)
+	assert.Equal(t, "browser", spec.ServiceAccountName)
+	assert.Len(t, spec.Volumes, 2)
+	assert.True(t, spec.Volumes[0].EmptyDir.SizeLimit.Equal(resource.MustParse("1Gi")))
+	assert.Equal(t, "server-config", spec.Volumes[1].ConfigMap.Name)
+	assert.Empty(t, spec.Containers[0].VolumeMounts)
+	assert.Equal(t, []corev1.VolumeMount{
+		{Name: "cache", MountPath: "/cache"},
+		{Name: "config", MountPath: "/etc/server", ReadOnly: true},
+	}, spec.Containers[1].VolumeMounts)
+}
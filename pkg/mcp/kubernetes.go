@@ -6,9 +6,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
+	"net"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gptscript-ai/gptscript/pkg/hash"
@@ -16,25 +19,28 @@ import (
 	"github.com/obot-platform/nah/pkg/name"
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/logger"
+	"github.com/obot-platform/obot/pkg/oci"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	"github.com/obot-platform/obot/pkg/wait"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	ktypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var olog = logger.Package()
 
 type kubernetesBackend struct {
+	restConfig                    *rest.Config
 	clientset                     *kubernetes.Clientset
 	client                        kclient.WithWatch
 	baseImage                     string
@@ -47,15 +53,25 @@ type kubernetesBackend struct {
 	auditLogsBatchSize            int
 	auditLogsFlushIntervalSeconds int
 	obotClient                    kclient.Client
+	sandbox                       sandboxConfig
+
+	egressPolicyEnforced  bool
+	obotNamespace         string
+	egressBaselineLock    sync.Mutex
+	egressBaselineApplied bool
+
+	// rolloutLocks keeps concurrent requests from starting the same blue/green rollout twice.
+	rolloutLocks sync.Map
 }
 
-func newKubernetesBackend(clientset *kubernetes.Clientset, client kclient.WithWatch, obotClient kclient.Client, opts Options) backend {
+func newKubernetesBackend(restConfig *rest.Config, clientset *kubernetes.Clientset, client kclient.WithWatch, obotClient kclient.Client, opts Options) backend {
 	var serviceFQDN string
 	if opts.ServiceName != "" && opts.ServiceNamespace != "" {
 		serviceFQDN = fmt.Sprintf("%s.%s.svc.%s", opts.ServiceName, opts.ServiceNamespace, opts.MCPClusterDomain)
 	}
 
 	return &kubernetesBackend{
+		restConfig:                    restConfig,
 		clientset:                     clientset,
 		client:                        client,
 		baseImage:                     opts.MCPBaseImage,
@@ -68,6 +84,9 @@ func newKubernetesBackend(clientset *kubernetes.Clientset, client kclient.WithWa
 		auditLogsBatchSize:            opts.MCPAuditLogsPersistBatchSize,
 		auditLogsFlushIntervalSeconds: opts.MCPAuditLogPersistIntervalSeconds,
 		obotClient:                    obotClient,
+		sandbox:                       newSandboxConfig(opts),
+		egressPolicyEnforced:          opts.MCPEgressPolicyEnforced,
+		obotNamespace:                 opts.ServiceNamespace,
 	}
 }
 
@@ -78,11 +97,26 @@ func (k *kubernetesBackend) deployServer(ctx context.Context, server ServerConfi
 		return fmt.Errorf("failed to generate kubernetes objects for server %s: %w", server.MCPServerName, err)
 	}
 
+	return k.applyServerObjects(ctx, server, objs)
+}
+
+func (k *kubernetesBackend) applyServerObjects(ctx context.Context, server ServerConfig, objs []kclient.Object) error {
 	if err := apply.New(k.client).WithNamespace(k.mcpNamespace).WithOwnerSubContext(server.Scope).WithPruneTypes(new(corev1.Secret), new(appsv1.Deployment), new(corev1.Service)).Apply(ctx, nil, nil); err != nil && !apierrors.IsNotFound(err) {
 		return fmt.Errorf("failed to cleanup old MCP deployment %s: %w", server.MCPServerName, err)
 	}
 
-	if err := apply.New(k.client).WithNamespace(k.mcpNamespace).WithOwnerSubContext(server.MCPServerName).Apply(ctx, nil, objs...); err != nil {
+	if err := k.ensureEgressBaseline(ctx); err != nil {
+		return err
+	}
+
+	if server.PersistentVolume != nil {
+		if err := k.ensurePersistentVolumeClaim(ctx, server); err != nil {
+			return err
+		}
+	}
+
+	// NetworkPolicy is always a prune type so that the server's policy is removed if egress policies stop being enforced.
+	if err := apply.New(k.client).WithNamespace(k.mcpNamespace).WithOwnerSubContext(server.MCPServerName).WithPruneTypes(new(networkingv1.NetworkPolicy)).Apply(ctx, nil, objs...); err != nil {
 		return fmt.Errorf("failed to create MCP deployment %s: %w", server.MCPServerName, err)
 	}
 
@@ -100,16 +134,32 @@ func (k *kubernetesBackend) ensureServerDeployment(ctx context.Context, server S
 		server.Components[i] = component
 	}
 
-	if err := k.deployServer(ctx, server, webhooks); err != nil {
+	deploy := k.deployServer
+	if blueGreen(server) {
+		deploy = k.deployBlueGreen
+	}
+	if err := deploy(ctx, server, webhooks); err != nil {
+		return ServerConfig{}, err
+	}
+
+	// Wake the server up if it was scaled to zero because it was idle, and keep the replicas within the configured bounds.
+	if err := k.ensureReplicas(ctx, server.MCPServerName, max(server.MinReplicas, 1), max(server.MaxReplicas, 1)); err != nil {
 		return ServerConfig{}, err
 	}
 
 	u := fmt.Sprintf("http://%s.%s.svc.%s", server.MCPServerName, k.mcpNamespace, k.mcpClusterDomain)
-	podName, err := k.updatedMCPPodName(ctx, u, server.MCPServerName, server)
+	pod, err := k.updatedMCPPod(ctx, u, server.MCPServerName, server)
 	if err != nil {
 		return ServerConfig{}, err
 	}
 
+	if PinsSessionsToReplicas(server) {
+		// MCP sessions are only valid on the replica that created them, so Obot's own clients talk to a specific pod instead of the service.
+		if podURL := podBaseURL(pod); podURL != "" {
+			u = podURL
+		}
+	}
+
 	fullURL := fmt.Sprintf("%s/%s", u, strings.TrimPrefix(server.ContainerPath, "/"))
 
 	// Use the pod name as the scope, so we get a new session if the pod restarts. MCP sessions aren't persistent on the server side.
@@ -119,7 +169,7 @@ func (k *kubernetesBackend) ensureServerDeployment(ctx context.Context, server S
 		Audiences:            server.Audiences,
 		MCPServerNamespace:   server.MCPServerNamespace,
 		MCPServerDisplayName: server.MCPServerDisplayName,
-		Scope:                podName,
+		Scope:                pod.Name,
 		UserID:               server.UserID,
 		Runtime:              types.RuntimeRemote,
 		Issuer:               server.Issuer,
@@ -197,6 +247,18 @@ func (k *kubernetesBackend) getServerDetails(ctx context.Context, id string) (ty
 		})
 	}
 
+	// Kubernetes doesn't create events when a container crashes or is OOM killed, so report the last termination of each container.
+	for _, pod := range pods.Items {
+		for _, status := range pod.Status.ContainerStatuses {
+			if terminated := status.LastTerminationState.Terminated; terminated != nil {
+				mcpEvents = append(mcpEvents, containerTerminatedEvent(pod.Name, status.Name, terminated))
+			}
+		}
+	}
+	sort.SliceStable(mcpEvents, func(i, j int) bool {
+		return mcpEvents[i].Time.Time.Before(mcpEvents[j].Time.Time)
+	})
+
 	return types.MCPServerDetails{
 		DeploymentName: deployment.Name,
 		Namespace:      deployment.Namespace,
@@ -208,6 +270,29 @@ func (k *kubernetesBackend) getServerDetails(ctx context.Context, id string) (ty
 	}, nil
 }
 
+func containerTerminatedEvent(podName, containerName string, terminated *corev1.ContainerStateTerminated) types.MCPServerEvent {
+	reason := terminated.Reason
+	if reason == "" {
+		reason = "Terminated"
+	}
+
+	message := fmt.Sprintf("Container %s terminated with exit code %d", containerName, terminated.ExitCode)
+	if terminated.Message != "" {
+		message += ": " + terminated.Message
+	}
+
+	return types.MCPServerEvent{
+		Time:         types.Time{Time: terminated.FinishedAt.Time},
+		Reason:       reason,
+		Message:      message,
+		EventType:    corev1.EventTypeWarning,
+		Action:       "Terminated",
+		Count:        1,
+		ResourceName: podName,
+		ResourceKind: "Pod",
+	}
+}
+
 func (k *kubernetesBackend) streamServerLogs(ctx context.Context, id string) (io.ReadCloser, error) {
 	var deployment appsv1.Deployment
 	if err := k.client.Get(ctx, kclient.ObjectKey{Name: id, Namespace: k.mcpNamespace}, &deployment); err != nil {
@@ -241,6 +326,74 @@ func (k *kubernetesBackend) streamServerLogs(ctx context.Context, id string) (io
 	return logs, nil
 }
 
+func (k *kubernetesBackend) listServers(ctx context.Context) ([]string, error) {
+	var deployments appsv1.DeploymentList
+	if err := k.client.List(ctx, &deployments, kclient.InNamespace(k.mcpNamespace)); err != nil {
+		return nil, fmt.Errorf("failed to list MCP deployments: %w", err)
+	}
+
+	names := make([]string, 0, len(deployments.Items))
+	for _, deployment := range deployments.Items {
+		names = append(names, deployment.Name)
+	}
+	return names, nil
+}
+
+func (k *kubernetesBackend) serverLogs(ctx context.Context, id string, since time.Time, tail int) ([]serverLogLine, error) {
+	var pods corev1.PodList
+	if err := k.client.List(ctx, &pods, kclient.InNamespace(k.mcpNamespace), kclient.MatchingLabels{"app": id}); err != nil {
+		return nil, fmt.Errorf("failed to list MCP pods: %w", err)
+	}
+
+	var lines []serverLogLine
+	for _, pod := range pods.Items {
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name != "mcp" {
+				continue
+			}
+
+			// The logs of a crashed container are only kept until it crashes again, so get them while they're there.
+			if terminated := status.LastTerminationState.Terminated; terminated != nil && terminated.FinishedAt.After(since) {
+				previous, err := k.podLogs(ctx, pod.Name, since, tail, true)
+				if err != nil {
+					return nil, err
+				}
+				lines = append(lines, previous...)
+			}
+
+			if status.State.Waiting == nil {
+				current, err := k.podLogs(ctx, pod.Name, since, tail, false)
+				if err != nil {
+					return nil, err
+				}
+				lines = append(lines, current...)
+			}
+		}
+	}
+
+	return lines, nil
+}
+
+func (k *kubernetesBackend) podLogs(ctx context.Context, podName string, since time.Time, tail int, previous bool) ([]serverLogLine, error) {
+	opts := &corev1.PodLogOptions{
+		Container:  "mcp",
+		Timestamps: true,
+		Previous:   previous,
+		TailLines:  &[]int64{int64(tail)}[0],
+	}
+	if !since.IsZero() {
+		opts.SinceTime = &metav1.Time{Time: since}
+	}
+
+	logs, err := k.clientset.CoreV1().Pods(k.mcpNamespace).GetLogs(podName, opts).Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logs of pod %s: %w", podName, err)
+	}
+	defer logs.Close()
+
+	return parseTimestampedLogs(logs, podName)
+}
+
 func (k *kubernetesBackend) transformConfig(ctx context.Context, serverConfig ServerConfig) (*ServerConfig, error) {
 	var pods corev1.PodList
 	if err := k.client.List(ctx, &pods, &kclient.ListOptions{
@@ -285,13 +438,117 @@ func (k *kubernetesBackend) replaceHostWithServiceFQDN(urlStr string) string {
 }
 
 func (k *kubernetesBackend) shutdownServer(ctx context.Context, id string) error {
-	if err := apply.New(k.client).WithNamespace(k.mcpNamespace).WithOwnerSubContext(id).WithPruneTypes(new(corev1.Secret), new(appsv1.Deployment), new(corev1.Service)).Apply(ctx, nil, nil); err != nil {
+	if err := apply.New(k.client).WithNamespace(k.mcpNamespace).WithOwnerSubContext(id).WithPruneTypes(new(corev1.Secret), new(appsv1.Deployment), new(corev1.Service), new(networkingv1.NetworkPolicy)).Apply(ctx, nil, nil); err != nil {
 		return fmt.Errorf("failed to delete MCP deployment %s: %w", id, err)
 	}
 
+	return k.deleteDrainingReplicas(ctx, id)
+}
+
+func (k *kubernetesBackend) scaleServer(ctx context.Context, id string, replicas int32) error {
+	if replicas == 0 {
+		// The server is idle, so the sessions on its old version are too.
+		if err := k.deleteDrainingReplicas(ctx, id); err != nil {
+			return err
+		}
+	}
+	return k.patchReplicas(ctx, id, func(int32) int32 { return replicas })
+}
+
+// ensureReplicas keeps the replicas of the server's deployment between minReplicas and maxReplicas, leaving it alone if it already is.
+func (k *kubernetesBackend) ensureReplicas(ctx context.Context, id string, minReplicas, maxReplicas int32) error {
+	return k.patchReplicas(ctx, id, func(current int32) int32 { return min(max(current, minReplicas), maxReplicas) })
+}
+
+func (k *kubernetesBackend) patchReplicas(ctx context.Context, id string, desired func(current int32) int32) error {
+	var deployment appsv1.Deployment
+	if err := k.client.Get(ctx, kclient.ObjectKey{Name: id, Namespace: k.mcpNamespace}, &deployment); apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get deployment %s: %w", id, err)
+	}
+
+	current := int32(1)
+	if deployment.Spec.Replicas != nil {
+		current = *deployment.Spec.Replicas
+	}
+
+	replicas := desired(current)
+	if replicas == current {
+		return nil
+	}
+
+	patch := fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas)
+	if err := k.client.Patch(ctx, &deployment, kclient.RawPatch(ktypes.MergePatchType, []byte(patch))); err != nil {
+		return fmt.Errorf("failed to scale deployment %s to %d replicas: %w", id, replicas, err)
+	}
+
 	return nil
 }
 
+func (k *kubernetesBackend) replicaURLs(ctx context.Context, id string) (Replicas, error) {
+	serving, err := k.readyPodURLs(ctx, map[string]string{"app": id})
+	if err != nil {
+		return Replicas{}, err
+	}
+
+	draining, err := k.readyPodURLs(ctx, map[string]string{drainingLabel: id})
+	if err != nil {
+		return Replicas{}, err
+	}
+
+	return Replicas{Serving: serving, Draining: draining}, nil
+}
+
+// readyPodURLs returns the base URLs of the ready pods that match the labels, keyed by pod name.
+func (k *kubernetesBackend) readyPodURLs(ctx context.Context, podLabels map[string]string) (map[string]string, error) {
+	var pods corev1.PodList
+	if err := k.client.List(ctx, &pods, &kclient.ListOptions{
+		Namespace:     k.mcpNamespace,
+		LabelSelector: labels.SelectorFromSet(podLabels),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list MCP pods: %w", err)
+	}
+
+	urls := make(map[string]string, len(pods.Items))
+	for _, pod := range pods.Items {
+		if !pod.DeletionTimestamp.IsZero() || !isPodReady(&pod) {
+			continue
+		}
+		if u := podBaseURL(&pod); u != "" {
+			urls[pod.Name] = u
+		}
+	}
+
+	return urls, nil
+}
+
+// podBaseURL returns the URL of the port named "http" on the pod, which is the port that the server's service targets.
+func podBaseURL(pod *corev1.Pod) string {
+	if pod.Status.PodIP == "" {
+		return ""
+	}
+
+	for _, c := range pod.Spec.Containers {
+		for _, port := range c.Ports {
+			if port.Name == "http" {
+				return "http://" + net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(port.ContainerPort)))
+			}
+		}
+	}
+
+	return ""
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
 func (k *kubernetesBackend) k8sObjects(ctx context.Context, server ServerConfig, webhooks []Webhook) ([]kclient.Object, error) {
 	var (
 		command  []string
@@ -406,6 +663,19 @@ func (k *kubernetesBackend) k8sObjects(ctx context.Context, server ServerConfig,
 	// Add K8s settings hash to annotations
 	annotations["obot.ai/k8s-settings-hash"] = ComputeK8sSettingsHash(k8sSettings)
 
+	// The server's own overrides are merged over the global settings, but aren't part of the hash,
+	// because they are compared against the global settings to find servers that need to be redeployed.
+	k8sSettings = applyK8sOverrides(k8sSettings, server.K8sOverrides)
+
+	if k.egressPolicyEnforced {
+		// The webhook containers run in the same pod, so the pod needs to be able to reach the webhooks too.
+		webhookURLs := make([]string, 0, len(webhooks))
+		for _, webhook := range webhooks {
+			webhookURLs = append(webhookURLs, webhook.URL)
+		}
+		objs = append(objs, k.egressNetworkPolicy(ctx, server, webhookURLs...))
+	}
+
 	webhookSecretStringData := make(map[string]string, len(webhooks))
 	containers := make([]corev1.Container, 0, len(webhooks)+2)
 	// Add a container for each webhook, ensuring that there are no port collisions.
@@ -602,16 +872,7 @@ func (k *kubernetesBackend) k8sObjects(ctx context.Context, server ServerConfig,
 			ContainerPort: int32(port),
 		}},
 		// Apply resources from K8s settings with fallback to default
-		Resources: func() corev1.ResourceRequirements {
-			if k8sSettings.Resources != nil {
-				return *k8sSettings.Resources
-			}
-			return corev1.ResourceRequirements{
-				Requests: corev1.ResourceList{
-					corev1.ResourceMemory: resource.MustParse("400Mi"),
-				},
-			}
-		}(),
+		Resources: mcpContainerResources(k8sSettings),
 		SecurityContext: &corev1.SecurityContext{
 			AllowPrivilegeEscalation: &[]bool{false}[0],
 			RunAsNonRoot:             &[]bool{true}[0],
@@ -734,12 +995,20 @@ func (k *kubernetesBackend) k8sObjects(ctx context.Context, server ServerConfig,
 		}
 	}
 
+	applyK8sPodOverrides(&dep.Spec.Template.Spec, "mcp", server.K8sOverrides)
+	k.sandbox.applyToPod(&dep.Spec.Template.Spec, "mcp", server)
+	applyPersistentVolume(&dep.Spec, "mcp", server)
+
 	if len(k.imagePullSecrets) > 0 {
 		for _, secret := range k.imagePullSecrets {
 			dep.Spec.Template.Spec.ImagePullSecrets = append(dep.Spec.Template.Spec.ImagePullSecrets, corev1.LocalObjectReference{Name: secret})
 		}
 	}
 
+	// The revision covers the whole pod template, so that blue/green rollouts can tell when the server changed.
+	dep.Annotations = maps.Clone(annotations)
+	dep.Annotations[revisionAnnotation] = hash.Digest(dep.Spec.Template)
+
 	objs = append(objs, dep)
 
 	objs = append(objs, &corev1.Service{
@@ -852,7 +1121,9 @@ func analyzePodStatus(pod *corev1.Pod) (bool, error) {
 	return true, fmt.Errorf("pod in phase %s, waiting for containers to be ready", pod.Status.Phase)
 }
 
-func (k *kubernetesBackend) updatedMCPPodName(ctx context.Context, url, id string, server ServerConfig) (string, error) {
+// updatedMCPPod waits for the server's deployment to be rolled out and ready, and returns a running pod for it.
+// If the deployment has more than one replica, then the ready pod with the lowest name is returned so that the choice is stable.
+func (k *kubernetesBackend) updatedMCPPod(ctx context.Context, url, id string, server ServerConfig) (*corev1.Pod, error) {
 	const maxRetries = 5
 	var lastErr error
 
@@ -862,20 +1133,20 @@ func (k *kubernetesBackend) updatedMCPPodName(ctx context.Context, url, id strin
 	// Retry loop with smart pod status checking
 	for attempt := range maxRetries {
 		// Wait for the deployment to be updated.
-		_, err := wait.For(ctx, k.client, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: id, Namespace: k.mcpNamespace}}, func(dep *appsv1.Deployment) (bool, error) {
-			return dep.Generation == dep.Status.ObservedGeneration && dep.Status.Replicas == 1 && dep.Status.UpdatedReplicas == 1 && dep.Status.ReadyReplicas == 1 && dep.Status.AvailableReplicas == 1, nil
+		// All pods must be from the latest revision, but only one of them needs to be ready, so that scaling up doesn't block requests.
+		dep, err := wait.For(ctx, k.client, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: id, Namespace: k.mcpNamespace}}, func(dep *appsv1.Deployment) (bool, error) {
+			return dep.Generation == dep.Status.ObservedGeneration && dep.Status.Replicas >= 1 && dep.Status.UpdatedReplicas == dep.Status.Replicas && dep.Status.ReadyReplicas >= 1 && dep.Status.AvailableReplicas >= 1, nil
 		}, wait.Option{Timeout: time.Minute})
 		if err == nil {
 			// Deployment is ready, now ensure the server is ready
 			if err = ensureServerReady(ctx, url, server); err != nil {
-				return "", fmt.Errorf("failed to ensure MCP server is ready: %w", err)
+				return nil, fmt.Errorf("failed to ensure MCP server is ready: %w", err)
 			}
 
-			// Now get the pod name that is currently running
+			// Now get the pods that are currently running
 			var (
-				pods            corev1.PodList
-				runningPodCount int
-				podName         string
+				pods        corev1.PodList
+				runningPods []*corev1.Pod
 			)
 			if err = k.client.List(ctx, &pods, &kclient.ListOptions{
 				Namespace: k.mcpNamespace,
@@ -883,21 +1154,34 @@ func (k *kubernetesBackend) updatedMCPPodName(ctx context.Context, url, id strin
 					"app": id,
 				}),
 			}); err != nil {
-				return "", fmt.Errorf("failed to list MCP pods: %w", err)
+				return nil, fmt.Errorf("failed to list MCP pods: %w", err)
 			}
 
-			for _, p := range pods.Items {
+			for i, p := range pods.Items {
 				if p.DeletionTimestamp.IsZero() && p.Status.Phase == corev1.PodRunning {
-					podName = p.Name
-					runningPodCount++
+					runningPods = append(runningPods, &pods.Items[i])
 				}
 			}
 
-			// runningPodCount should always equal 1, if the deployment is ready, as it is by this point in the code.
+			if dep.Spec.Replicas != nil && *dep.Spec.Replicas > 1 {
+				var ready *corev1.Pod
+				for _, p := range runningPods {
+					if isPodReady(p) && (ready == nil || p.Name < ready.Name) {
+						ready = p
+					}
+				}
+				if ready != nil {
+					return ready, nil
+				}
+				lastErr = fmt.Errorf("no ready pods found")
+				continue
+			}
+
+			// There should always be exactly one running pod, if the deployment is ready, as it is by this point in the code.
 			// However, we will check just to make sure, and retry if it isn't.
-			if runningPodCount == 1 {
-				return podName, nil
-			} else if runningPodCount > 1 {
+			if len(runningPods) == 1 {
+				return runningPods[0], nil
+			} else if len(runningPods) > 1 {
 				lastErr = fmt.Errorf("more than one running pod found")
 			} else {
 				lastErr = fmt.Errorf("no pods found")
@@ -914,7 +1198,7 @@ func (k *kubernetesBackend) updatedMCPPodName(ctx context.Context, url, id strin
 			}),
 		}); listErr != nil {
 			olog.Debugf("failed to list MCP pods for status check: id=%s error=%v", id, listErr)
-			return "", fmt.Errorf("failed to list MCP pods: %w", listErr)
+			return nil, fmt.Errorf("failed to list MCP pods: %w", listErr)
 		}
 
 		if len(pods.Items) == 0 {
@@ -923,7 +1207,7 @@ func (k *kubernetesBackend) updatedMCPPodName(ctx context.Context, url, id strin
 			if attempt < maxRetries {
 				continue
 			}
-			return "", fmt.Errorf("%w: %v", ErrHealthCheckTimeout, lastErr)
+			return nil, fmt.Errorf("%w: %v", ErrHealthCheckTimeout, lastErr)
 		}
 
 		// Get the newest pod and analyze its status
@@ -934,7 +1218,7 @@ func (k *kubernetesBackend) updatedMCPPodName(ctx context.Context, url, id strin
 			if attempt < maxRetries {
 				continue
 			}
-			return "", fmt.Errorf("%w: %v", ErrHealthCheckTimeout, lastErr)
+			return nil, fmt.Errorf("%w: %v", ErrHealthCheckTimeout, lastErr)
 		}
 
 		shouldRetry, podErr := analyzePodStatus(newestPod)
@@ -943,15 +1227,17 @@ func (k *kubernetesBackend) updatedMCPPodName(ctx context.Context, url, id strin
 		if !shouldRetry {
 			// Permanent failure - return the error with the appropriate type already wrapped
 			olog.Debugf("pod in non-retryable state: id=%s error=%v attempt=%d", id, podErr, attempt+1)
-			return "", podErr
+			return nil, podErr
 		}
 	}
 
 	olog.Debugf("exceeded max retries waiting for pod: id=%s lastError=%v attempts=%d", id, lastErr, maxRetries)
-	return "", fmt.Errorf("%w after %d retries: %v", ErrHealthCheckTimeout, maxRetries, lastErr)
+	return nil, fmt.Errorf("%w after %d retries: %v", ErrHealthCheckTimeout, maxRetries, lastErr)
 }
 
-func (k *kubernetesBackend) restartServer(ctx context.Context, id string) error {
+func (k *kubernetesBackend) restartServer(ctx context.Context, server ServerConfig) error {
+	id := server.MCPServerName
+
 	var deployment appsv1.Deployment
 	if err := k.client.Get(ctx, kclient.ObjectKey{Name: id, Namespace: k.mcpNamespace}, &deployment); err != nil {
 		return fmt.Errorf("failed to get deployment %s: %w", id, err)
@@ -967,6 +1253,7 @@ func (k *kubernetesBackend) restartServer(ctx context.Context, id string) error
 
 	// Compute K8s settings hash
 	k8sSettingsHash := ComputeK8sSettingsHash(k8sSettings)
+	k8sSettings = applyK8sOverrides(k8sSettings, server.K8sOverrides)
 
 	// Build the patch with restart annotation and k8s settings hash
 	podAnnotations := map[string]string{
@@ -974,9 +1261,11 @@ func (k *kubernetesBackend) restartServer(ctx context.Context, id string) error
 		"obot.ai/k8s-settings-hash":         k8sSettingsHash,
 	}
 
-	// Update the deployment metadata annotation as well
-	deploymentAnnotations := map[string]string{
+	// Update the deployment metadata annotation as well.
+	// Restarting also clears a failed blue/green rollout, so that the next deployment tries the new version again.
+	deploymentAnnotations := map[string]any{
 		"obot.ai/k8s-settings-hash": k8sSettingsHash,
+		failedRevisionAnnotation:    nil,
 	}
 
 	// Build the patch structure
@@ -1073,12 +1362,22 @@ func (k *kubernetesBackend) restartServer(ctx context.Context, id string) error
 		return fmt.Errorf("failed to marshal patch: %w", err)
 	}
 
-	// Use StrategicMergePatchType to merge containers by name without requiring all fields
-	if err := k.client.Patch(ctx, &deployment, kclient.RawPatch(ktypes.StrategicMergePatchType, patchBytes)); err != nil {
-		return fmt.Errorf("failed to patch deployment %s: %w", id, err)
+	restart := func() error {
+		// Use StrategicMergePatchType to merge containers by name without requiring all fields
+		if err := k.client.Patch(ctx, &deployment, kclient.RawPatch(ktypes.StrategicMergePatchType, patchBytes)); err != nil {
+			return fmt.Errorf("failed to patch deployment %s: %w", id, err)
+		}
+		return nil
 	}
 
-	return nil
+	if !blueGreen(server) || deployment.Status.ReadyReplicas == 0 {
+		return restart()
+	}
+
+	unlock := k.lockRollout(id)
+	defer unlock()
+
+	return k.rollout(ctx, server, &deployment, "", restart)
 }
 
 // ComputeK8sSettingsHash computes a hash of K8s settings for change detection
@@ -1119,3 +1418,34 @@ func (k *kubernetesBackend) getK8sSettings(ctx context.Context) (v1.K8sSettingsS
 
 	return settings.Spec, err
 }
+
+// registryCredentials returns the credentials for the registry from the image pull secrets that the servers are deployed with.
+func (k *kubernetesBackend) registryCredentials(ctx context.Context, registry string) (string, string, error) {
+	for _, name := range k.imagePullSecrets {
+		var secret corev1.Secret
+		if err := k.client.Get(ctx, kclient.ObjectKey{Namespace: k.mcpNamespace, Name: name}, &secret); apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return "", "", fmt.Errorf("failed to get image pull secret %s: %w", name, err)
+		}
+
+		config := secret.Data[corev1.DockerConfigJsonKey]
+		if legacy := secret.Data[corev1.DockerConfigKey]; len(config) == 0 && len(legacy) > 0 {
+			// The legacy format is just the auths map.
+			config = append(append([]byte(`{"auths":`), legacy...), '}')
+		}
+		if len(config) == 0 {
+			continue
+		}
+
+		username, password, ok, err := oci.DockerConfigCredentials(config, registry)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid image pull secret %s: %w", name, err)
+		}
+		if ok {
+			return username, password, nil
+		}
+	}
+
+	return "", "", nil
+}
@@ -6,16 +6,20 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"os"
 	"slices"
 	"sync"
+	"time"
 
 	"github.com/gptscript-ai/go-gptscript"
 	"github.com/gptscript-ai/gptscript/pkg/hash"
 	"github.com/gptscript-ai/gptscript/pkg/types"
 	otypes "github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/logger"
+	"github.com/obot-platform/obot/pkg/injectionscan"
 	"github.com/obot-platform/obot/pkg/storage"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/toolapproval"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -26,14 +30,24 @@ import (
 var log = logger.Package()
 
 type Options struct {
-	MCPBaseImage            string   `usage:"The base image to use for MCP containers" default:"ghcr.io/obot-platform/mcp-images/phat:main"`
-	MCPHTTPWebhookBaseImage string   `usage:"The base image to use for HTTP-based MCP webhook containers" default:"ghcr.io/obot-platform/mcp-images/http-webhook-converter:main"`
-	MCPRemoteShimBaseImage  string   `usage:"The base image to use for MCP remote shim containers" default:"ghcr.io/nanobot-ai/nanobot:v0.0.45"`
-	MCPNamespace            string   `usage:"The namespace to use for MCP containers" default:"obot-mcp"`
-	MCPClusterDomain        string   `usage:"The cluster domain to use for MCP containers" default:"cluster.local"`
-	DisallowLocalhostMCP    bool     `usage:"Allow MCP containers to run on localhost"`
-	MCPRuntimeBackend       string   `usage:"The runtime backend to use for running MCP servers: docker, kubernetes, or local. Defaults to docker." default:"docker"`
-	MCPImagePullSecrets     []string `usage:"The name of the image pull secret to use for pulling MCP images"`
+	MCPBaseImage                string   `usage:"The base image to use for MCP containers" default:"ghcr.io/obot-platform/mcp-images/phat:main"`
+	MCPHTTPWebhookBaseImage     string   `usage:"The base image to use for HTTP-based MCP webhook containers" default:"ghcr.io/obot-platform/mcp-images/http-webhook-converter:main"`
+	MCPRemoteShimBaseImage      string   `usage:"The base image to use for MCP remote shim containers" default:"ghcr.io/nanobot-ai/nanobot:v0.0.45"`
+	MCPNamespace                string   `usage:"The namespace to use for MCP containers" default:"obot-mcp"`
+	MCPClusterDomain            string   `usage:"The cluster domain to use for MCP containers" default:"cluster.local"`
+	DisallowLocalhostMCP        bool     `usage:"Allow MCP containers to run on localhost"`
+	MCPRuntimeBackend           string   `usage:"The runtime backend to use for running MCP servers: docker, kubernetes, or local. Defaults to docker." default:"docker"`
+	MCPImagePullSecrets         []string `usage:"The name of the image pull secret to use for pulling MCP images"`
+	MCPServerIdleTimeoutMinutes int      `usage:"The number of minutes without requests after which an MCP server is scaled to zero. Set to 0 to disable." default:"0"`
+	MCPEgressPolicyEnforced     bool     `usage:"Block network egress from MCP servers, except to the hosts and CIDRs in their egress policies"`
+	MCPEgressProxyPort          int      `usage:"The port for the egress proxy that MCP containers use when egress policies are enforced with the docker backend" default:"8095"`
+
+	// Sandbox settings for untrusted MCP servers
+	MCPSandboxRuntimeClass    string `usage:"The Kubernetes RuntimeClass, like gvisor or kata, that untrusted MCP servers run with"`
+	MCPSandboxDockerRuntime   string `usage:"The Docker runtime, like runsc, that untrusted MCP servers run with"`
+	MCPSandboxSeccompProfile  string `usage:"The localhost seccomp profile for untrusted MCP server pods, relative to the kubelet's seccomp directory"`
+	MCPSandboxAppArmorProfile string `usage:"The AppArmor profile for untrusted MCP servers, which must be loaded on every node or Docker host"`
+	MCPReadOnlyRootFilesystem bool   `usage:"Run MCP server containers with a read-only root filesystem and a writable /tmp" default:"true"`
 
 	// Kubernetes settings from Helm
 	MCPK8sSettingsAffinity    string `usage:"Affinity rules for MCP server pods (JSON)" env:"OBOT_SERVER_MCPK8S_SETTINGS_AFFINITY"`
@@ -47,10 +61,20 @@ type Options struct {
 	// Audit log configuration
 	MCPAuditLogPersistIntervalSeconds int `usage:"The interval in seconds to persist MCP audit logs to the database" default:"5"`
 	MCPAuditLogsPersistBatchSize      int `usage:"The number of MCP audit logs to persist in a single batch" default:"1000"`
+	MCPAuditLogAnomalyIntervalSeconds int `usage:"The interval in seconds between checks of new MCP audit logs for unusual activity. Set to 0 to disable anomaly detection." default:"300"`
+
+	// Deployment history configuration
+	MCPServerHistoryRetentionHours int `usage:"The number of hours to keep the deployment events and logs of MCP servers. Set to 0 to disable collecting them." default:"168"`
+	MCPServerHistoryLogLines       int `usage:"The number of recent log lines to keep for each MCP server" default:"1000"`
+
+	// Health probe configuration
+	MCPServerProbeIntervalSeconds int `usage:"The number of seconds between health probes of multi-user MCP servers. Set to 0 to disable probes." default:"60"`
 }
 
 type SessionManager struct {
 	backend           backend
+	backendName       string
+	obotClient        kclient.Client
 	contextLock       sync.Mutex
 	sessionCtx        context.Context
 	cancel            func()
@@ -58,9 +82,17 @@ type SessionManager struct {
 	tokenService      TokenService
 	baseURL           string
 	allowLocalhostMCP bool
-
-	webhookHelper *WebhookHelper
-	gptClient     *gptscript.GPTScript
+	requests          *requestTracker
+	replica           string
+	imagePolicy       *imagePolicyChecker
+	historyRetention  time.Duration
+	historyLogLines   int
+	probeInterval     time.Duration
+
+	webhookHelper    *WebhookHelper
+	gptClient        *gptscript.GPTScript
+	toolApprovals    *toolapproval.Gate
+	injectionScanner *injectionscan.Provider
 }
 
 const streamableHTTPHealthcheckBody string = `{
@@ -78,7 +110,10 @@ const streamableHTTPHealthcheckBody string = `{
 }`
 
 func NewSessionManager(ctx context.Context, tokenService TokenService, baseURL string, httpListenPort int, opts Options, localK8sConfig *rest.Config, obotStorageClient storage.Client) (*SessionManager, error) {
-	var backend backend
+	var (
+		backend     backend
+		backendName = opts.MCPRuntimeBackend
+	)
 
 	switch opts.MCPRuntimeBackend {
 	case "docker":
@@ -111,23 +146,50 @@ func NewSessionManager(ctx context.Context, tokenService TokenService, baseURL s
 			return nil, err
 		}
 
-		backend = newKubernetesBackend(clientset, client, obotStorageClient, opts)
+		backend = newKubernetesBackend(localK8sConfig, clientset, client, obotStorageClient, opts)
+		backendName = "kubernetes"
 	default:
 		return nil, fmt.Errorf("unknown runtime backend: %s", opts.MCPRuntimeBackend)
 	}
 
-	return &SessionManager{
+	sm := &SessionManager{
 		tokenService:      tokenService,
 		backend:           backend,
+		backendName:       backendName,
+		obotClient:        obotStorageClient,
 		baseURL:           baseURL,
 		allowLocalhostMCP: !opts.DisallowLocalhostMCP,
-	}, nil
+		requests:          newRequestTracker(time.Duration(opts.MCPServerIdleTimeoutMinutes) * time.Minute),
+		imagePolicy:       newImagePolicyChecker(obotStorageClient, backend.registryCredentials),
+		historyRetention:  time.Duration(opts.MCPServerHistoryRetentionHours) * time.Hour,
+		historyLogLines:   opts.MCPServerHistoryLogLines,
+		probeInterval:     time.Duration(opts.MCPServerProbeIntervalSeconds) * time.Second,
+	}
+
+	// The replica identifies the activity that this Obot replica shares with the others.
+	if sm.replica, _ = os.Hostname(); sm.replica == "" {
+		sm.replica = "obot"
+	}
+
+	return sm, nil
+}
+
+// CountDeployments returns the number of MCP servers that are deployed, including servers that are scaled to zero,
+// keyed by the name of the runtime backend.
+func (sm *SessionManager) CountDeployments(ctx context.Context) (map[string]int, error) {
+	names, err := sm.backend.listServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]int{sm.backendName: len(names)}, nil
 }
 
 // Init must be called before the session manager is used.
-func (sm *SessionManager) Init(gptClient *gptscript.GPTScript, webhookHelper *WebhookHelper) {
+func (sm *SessionManager) Init(gptClient *gptscript.GPTScript, webhookHelper *WebhookHelper, toolApprovals *toolapproval.Gate, injectionScanner *injectionscan.Provider) {
 	sm.gptClient = gptClient
 	sm.webhookHelper = webhookHelper
+	sm.toolApprovals = toolApprovals
+	sm.injectionScanner = injectionScanner
 }
 
 // Load is used by GPTScript to load tools from dynamic MCP server tool definitions.
@@ -258,13 +320,19 @@ func (sm *SessionManager) closeClients(serverName string) {
 	})
 }
 
+// ServerReplicas returns the base URLs of the ready replicas of the server, keyed by replica name.
+// It returns no replicas if the backend only runs a single replica of each server.
+func (sm *SessionManager) ServerReplicas(ctx context.Context, serverName string) (Replicas, error) {
+	return sm.backend.replicaURLs(ctx, serverName)
+}
+
 // RestartServerDeployment restarts the server in the currently used backend, if the backend supports it.
 // If the backend does not support restarts, then an [ErrNotSupportedByBackend] error is returned.
 func (sm *SessionManager) RestartServerDeployment(ctx context.Context, server ServerConfig) error {
 	if server.Runtime == otypes.RuntimeRemote {
 		return otypes.NewErrBadRequest("cannot restart deployment for remote MCP server")
 	}
-	return sm.backend.restartServer(ctx, server.MCPServerName)
+	return sm.backend.restartServer(ctx, server)
 }
 
 func (sm *SessionManager) ensureDeployment(ctx context.Context, server ServerConfig, transformRemote bool) (ServerConfig, error) {
@@ -293,23 +361,9 @@ func (sm *SessionManager) ensureDeployment(ctx context.Context, server ServerCon
 			return ServerConfig{}, fmt.Errorf("MCP server %s needs to update its URL", server.MCPServerDisplayName)
 		}
 
-		if !sm.allowLocalhostMCP && !server.ProjectMCPServer && server.URL != "" {
-			// Ensure the URL is not a localhost URL.
-			u, err := url.Parse(server.URL)
-			if err != nil {
-				return ServerConfig{}, fmt.Errorf("failed to parse MCP server URL: %w", err)
-			}
-
-			// LookupHost will properly detect IP addresses.
-			addrs, err := net.DefaultResolver.LookupHost(ctx, u.Hostname())
-			if err != nil {
-				return ServerConfig{}, fmt.Errorf("failed to resolve MCP server URL hostname: %w", err)
-			}
-
-			for _, addr := range addrs {
-				if ip := net.ParseIP(addr); ip != nil && ip.IsLoopback() {
-					return ServerConfig{}, fmt.Errorf("MCP server URL must not be a localhost URL: %s", server.URL)
-				}
+		if !server.ProjectMCPServer {
+			if err := sm.checkRemoteURL(ctx, server.URL); err != nil {
+				return ServerConfig{}, err
 			}
 		}
 
@@ -319,12 +373,48 @@ func (sm *SessionManager) ensureDeployment(ctx context.Context, server ServerCon
 		}
 	}
 
+	// Using the server counts as activity, even if it doesn't come through the gateway.
+	sm.requests.touch(server.MCPServerName)
+
+	server, err := sm.imagePolicy.enforce(ctx, server)
+	if err != nil {
+		return ServerConfig{}, err
+	}
+
 	return sm.backend.ensureServerDeployment(ctx, server, webhooks)
 }
 
+// checkRemoteURL returns an error if the URL of a remote server is a localhost URL and those aren't allowed.
+func (sm *SessionManager) checkRemoteURL(ctx context.Context, serverURL string) error {
+	if sm.allowLocalhostMCP || serverURL == "" {
+		return nil
+	}
+
+	// Ensure the URL is not a localhost URL.
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return fmt.Errorf("failed to parse MCP server URL: %w", err)
+	}
+
+	// LookupHost will properly detect IP addresses.
+	addrs, err := net.DefaultResolver.LookupHost(ctx, u.Hostname())
+	if err != nil {
+		return fmt.Errorf("failed to resolve MCP server URL hostname: %w", err)
+	}
+
+	for _, addr := range addrs {
+		if ip := net.ParseIP(addr); ip != nil && ip.IsLoopback() {
+			return fmt.Errorf("MCP server URL must not be a localhost URL: %s", serverURL)
+		}
+	}
+
+	return nil
+}
+
 func clientID(server ServerConfig) string {
-	// The user ID and scope is not part of the client ID.
+	// The user ID, scope, and scaling configuration are not part of the client ID.
 	server.UserID = ""
+	server.MinReplicas, server.MaxReplicas, server.TargetConcurrency = 0, 0, 0
 	return "mcp" + hash.Digest(server)
 }
 
@@ -146,6 +146,8 @@ func findSpecialError(err error, mcpServerDisplayName string) (bool, error) {
 			switch e := unwrappedErr.(type) {
 			case nmcp.AuthRequiredErr:
 				return true, fmt.Errorf("MCP server %s requires OAuth", mcpServerDisplayName)
+			case *imagePolicyError:
+				return true, fmt.Errorf("MCP server %s cannot be deployed: %s", mcpServerDisplayName, e.message)
 			case interface{ Unwrap() []error }:
 				for _, err := range e.Unwrap() {
 					if found, err := findSpecialError(err, mcpServerDisplayName); found {
@@ -0,0 +1,217 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/obot-platform/nah/pkg/name"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// persistentVolumeMountName is the name of the pod volume that the persistent volume is mounted from.
+	persistentVolumeMountName = "workspace"
+	// persistentVolumeLabel marks the PVCs and Docker volumes that hold MCP server workspaces.
+	persistentVolumeLabel = "mcp.obot.ai/workspace"
+
+	defaultPersistentVolumeSize = "1Gi"
+	// persistentVolumeFSGroup is the group that owns the files in the volume, so that servers that don't run as root can write to it.
+	persistentVolumeFSGroup = 1000
+)
+
+// PersistentVolumeName returns the name of the PVC or Docker volume that backs the server's persistent volume.
+// A single-user server with a user-scoped volume uses a name derived from the catalog entry and the user,
+// so that the workspace is reused when the user deletes the server and creates it again.
+func PersistentVolumeName(mcpServer v1.MCPServer) string {
+	volume := mcpServer.Spec.Manifest.PersistentVolume
+	if volume != nil && volume.Scope == types.PersistentVolumeScopeUser &&
+		mcpServer.Spec.MCPCatalogID == "" && mcpServer.Spec.PowerUserWorkspaceID == "" &&
+		mcpServer.Spec.MCPServerCatalogEntryName != "" && mcpServer.Spec.UserID != "" {
+		return name.SafeConcatName("workspace", mcpServer.Spec.MCPServerCatalogEntryName, mcpServer.Spec.UserID)
+	}
+	return name.SafeConcatName(mcpServer.Name, "workspace")
+}
+
+// PersistentVolumeRetained returns true if the volume should be kept when its server is deleted.
+func PersistentVolumeRetained(volume *types.PersistentVolume) bool {
+	if volume == nil {
+		return false
+	}
+
+	switch volume.RetentionPolicy {
+	case types.PersistentVolumeRetentionRetain:
+		return true
+	case types.PersistentVolumeRetentionDelete:
+		return false
+	}
+	return volume.Scope == types.PersistentVolumeScopeUser
+}
+
+// applyPersistentVolume mounts the server's persistent volume in the main container of the deployment.
+func applyPersistentVolume(spec *appsv1.DeploymentSpec, mainContainer string, server ServerConfig) {
+	if server.PersistentVolume == nil {
+		return
+	}
+
+	// The volume is ReadWriteOnce, so the old pod has to be gone before the new one can mount it.
+	spec.Strategy = appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType}
+
+	podSpec := &spec.Template.Spec
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: persistentVolumeMountName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: server.PersistentVolumeName,
+			},
+		},
+	})
+
+	if podSpec.SecurityContext == nil {
+		podSpec.SecurityContext = &corev1.PodSecurityContext{}
+	}
+	if podSpec.SecurityContext.FSGroup == nil {
+		podSpec.SecurityContext.FSGroup = &[]int64{persistentVolumeFSGroup}[0]
+	}
+
+	for i := range podSpec.Containers {
+		if podSpec.Containers[i].Name == mainContainer {
+			podSpec.Containers[i].VolumeMounts = append(podSpec.Containers[i].VolumeMounts, corev1.VolumeMount{
+				Name:      persistentVolumeMountName,
+				MountPath: server.PersistentVolume.MountPath,
+			})
+		}
+	}
+}
+
+// ensurePersistentVolumeClaim creates the server's PVC if it doesn't exist. The PVC isn't owned by the server's other objects,
+// so that it survives restarts and shutdowns. It is only deleted when the server is deleted and the volume isn't retained,
+// or when the volume is reset.
+func (k *kubernetesBackend) ensurePersistentVolumeClaim(ctx context.Context, server ServerConfig) error {
+	var existing corev1.PersistentVolumeClaim
+	if err := k.client.Get(ctx, kclient.ObjectKey{Namespace: k.mcpNamespace, Name: server.PersistentVolumeName}, &existing); err == nil {
+		if !existing.DeletionTimestamp.IsZero() {
+			return fmt.Errorf("persistent volume %s for MCP server %s is being deleted, try again later", server.PersistentVolumeName, server.MCPServerName)
+		}
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get persistent volume %s: %w", server.PersistentVolumeName, err)
+	}
+
+	size := server.PersistentVolume.Size
+	if size == "" {
+		size = defaultPersistentVolumeSize
+	}
+	quantity, err := resource.ParseQuantity(size)
+	if err != nil {
+		return fmt.Errorf("invalid persistent volume size %q: %w", size, err)
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      server.PersistentVolumeName,
+			Namespace: k.mcpNamespace,
+			Labels: map[string]string{
+				persistentVolumeLabel: "true",
+				"mcp-user-id":         server.UserID,
+			},
+			Annotations: map[string]string{
+				"mcp-server-display-name": server.MCPServerDisplayName,
+				"mcp-server-scope":        server.MCPServerName,
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: quantity,
+				},
+			},
+		},
+	}
+
+	if err := k.client.Create(ctx, pvc); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create persistent volume %s: %w", server.PersistentVolumeName, err)
+	}
+
+	return nil
+}
+
+func (k *kubernetesBackend) deletePersistentVolume(ctx context.Context, volumeName string) error {
+	if err := k.client.Delete(ctx, &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      volumeName,
+			Namespace: k.mcpNamespace,
+		},
+	}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete persistent volume %s: %w", volumeName, err)
+	}
+
+	return nil
+}
+
+// downloadPersistentVolume streams a gzipped tar archive of the volume from a running pod of the server.
+func (k *kubernetesBackend) downloadPersistentVolume(ctx context.Context, server ServerConfig) (io.ReadCloser, error) {
+	if k.restConfig == nil {
+		return nil, &ErrNotSupportedByBackend{Feature: "persistent volume download", Backend: "kubernetes"}
+	}
+
+	var pods corev1.PodList
+	if err := k.client.List(ctx, &pods, kclient.InNamespace(k.mcpNamespace), kclient.MatchingLabels{"app": server.MCPServerName}); err != nil {
+		return nil, fmt.Errorf("failed to list MCP pods: %w", err)
+	}
+
+	var pod *corev1.Pod
+	for i := range pods.Items {
+		if pods.Items[i].DeletionTimestamp.IsZero() && pods.Items[i].Status.Phase == corev1.PodRunning {
+			pod = &pods.Items[i]
+			break
+		}
+	}
+	if pod == nil {
+		return nil, fmt.Errorf("mcp server %s is not running", server.MCPServerName)
+	}
+
+	req := k.clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(k.mcpNamespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: "mcp",
+			Command:   []string{"tar", "-czf", "-", "-C", server.PersistentVolume.MountPath, "."},
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(k.restConfig, "POST", req.URL())
+	if err != nil {
+		return nil, fmt.Errorf("failed to exec into pod %s: %w", pod.Name, err)
+	}
+
+	r, w := io.Pipe()
+	go func() {
+		var stderr bytes.Buffer
+		err := exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+			Stdout: w,
+			Stderr: &stderr,
+		})
+		if err != nil && stderr.Len() > 0 {
+			err = fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+		}
+		w.CloseWithError(err)
+	}()
+
+	return r, nil
+}
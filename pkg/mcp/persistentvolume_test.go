@@ -0,0 +1,73 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPersistentVolumeName(t *testing.T) {
+	server := v1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "ms1abc"},
+		Spec: v1.MCPServerSpec{
+			UserID:                    "user1",
+			MCPServerCatalogEntryName: "entry1",
+			Manifest: types.MCPServerManifest{
+				PersistentVolume: &types.PersistentVolume{MountPath: "/data"},
+			},
+		},
+	}
+	assert.Equal(t, "ms1abc-workspace", PersistentVolumeName(server))
+
+	// User-scoped volumes outlive the server, so they are named after the catalog entry and the user.
+	server.Spec.Manifest.PersistentVolume.Scope = types.PersistentVolumeScopeUser
+	userVolume := PersistentVolumeName(server)
+	assert.Equal(t, "workspace-entry1-user1", userVolume)
+
+	server.Name = "ms1def"
+	assert.Equal(t, userVolume, PersistentVolumeName(server))
+
+	// Multi-user servers are shared, so their volumes are always scoped to the server.
+	server.Spec.MCPCatalogID = "default"
+	assert.Equal(t, "ms1def-workspace", PersistentVolumeName(server))
+}
+
+func TestPersistentVolumeRetained(t *testing.T) {
+	assert.False(t, PersistentVolumeRetained(nil))
+	assert.False(t, PersistentVolumeRetained(&types.PersistentVolume{}))
+	assert.True(t, PersistentVolumeRetained(&types.PersistentVolume{Scope: types.PersistentVolumeScopeUser}))
+	assert.True(t, PersistentVolumeRetained(&types.PersistentVolume{RetentionPolicy: types.PersistentVolumeRetentionRetain}))
+	assert.False(t, PersistentVolumeRetained(&types.PersistentVolume{Scope: types.PersistentVolumeScopeUser, RetentionPolicy: types.PersistentVolumeRetentionDelete}))
+}
+
+func TestApplyPersistentVolume(t *testing.T) {
+	spec := appsv1.DeploymentSpec{
+		Template: corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "shim"}, {Name: "mcp"}},
+			},
+		},
+	}
+
+	applyPersistentVolume(&spec, "mcp", ServerConfig{})
+	assert.Empty(t, spec.Template.Spec.Volumes)
+
+	applyPersistentVolume(&spec, "mcp", ServerConfig{
+		PersistentVolume:     &types.PersistentVolume{MountPath: "/data"},
+		PersistentVolumeName: "ms1abc-workspace",
+	})
+	assert.Equal(t, appsv1.RecreateDeploymentStrategyType, spec.Strategy.Type)
+
+	require.Len(t, spec.Template.Spec.Volumes, 1)
+	assert.Equal(t, "ms1abc-workspace", spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, int64(persistentVolumeFSGroup), *spec.Template.Spec.SecurityContext.FSGroup)
+
+	assert.Empty(t, spec.Template.Spec.Containers[0].VolumeMounts)
+	assert.Equal(t, []corev1.VolumeMount{{Name: persistentVolumeMountName, MountPath: "/data"}}, spec.Template.Spec.Containers[1].VolumeMounts)
+}
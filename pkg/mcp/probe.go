@@ -0,0 +1,164 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	nmcp "github.com/nanobot-ai/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/apiclient/types"
+	"golang.org/x/oauth2"
+)
+
+const probeTimeout = 30 * time.Second
+
+// ErrServerNotRunning is returned when probing a server that isn't running. Probes don't deploy servers or wake up idle ones.
+var ErrServerNotRunning = errors.New("MCP server is not running")
+
+// ProbeResult is the outcome of one health probe of an MCP server.
+type ProbeResult struct {
+	Time    time.Time
+	Success bool
+	// AuthRequired is true if a remote server responded, but required OAuth. The probe can't use a user's tokens,
+	// so this counts as a success, and the tools aren't listed.
+	AuthRequired bool
+	// Latency is the time the whole probe took, and the other latencies are the times of each step.
+	Latency           time.Duration
+	InitializeLatency time.Duration
+	ListToolsLatency  time.Duration
+	ToolCallLatency   time.Duration
+	ToolCount         int
+	Error             string
+}
+
+// ProbeInterval is how often multi-user servers are probed. It is zero if probes are disabled.
+func (sm *SessionManager) ProbeInterval() time.Duration {
+	return sm.probeInterval
+}
+
+// ProbeServer initializes a new session with the server, lists its tools, and calls the probe's tool, if it has one.
+// A failed probe is reported in the result. An error is returned if the server couldn't be probed, such as when it isn't running.
+func (sm *SessionManager) ProbeServer(ctx context.Context, server ServerConfig, probe *types.HealthProbe) (ProbeResult, error) {
+	var (
+		headers = splitIntoMap(server.Headers)
+		opt     = nmcp.ClientOption{ClientName: "Obot Health Probe"}
+	)
+	if server.Runtime == types.RuntimeRemote {
+		if server.URL == "" {
+			return ProbeResult{}, fmt.Errorf("MCP server %s needs to update its URL", server.MCPServerDisplayName)
+		}
+		if err := sm.checkRemoteURL(ctx, server.URL); err != nil {
+			return ProbeResult{}, err
+		}
+
+		// Remote servers are probed directly, rather than through their shim, and must not get an Obot token.
+		opt.TokenStorage = probeTokenStorage{}
+	} else {
+		// Use the config of the running server so that probing it doesn't redeploy it or count as activity.
+		running, err := sm.backend.transformConfig(ctx, server)
+		if err != nil {
+			return ProbeResult{}, err
+		} else if running == nil {
+			return ProbeResult{}, ErrServerNotRunning
+		}
+
+		_, token, err := sm.newClientToken(ctx, server)
+		if err != nil {
+			return ProbeResult{}, err
+		}
+
+		server.URL = running.URL
+		headers = map[string]string{"Authorization": "Bearer " + token}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	result := ProbeResult{Time: time.Now()}
+	failed := func(step string, err error) (ProbeResult, error) {
+		result.Latency = time.Since(result.Time)
+		result.Error = fmt.Sprintf("%s: %v", step, err)
+		return result, nil
+	}
+
+	start := time.Now()
+	client, err := nmcp.NewClient(ctx, server.MCPServerDisplayName, nmcp.Server{
+		BaseURL: server.URL,
+		Headers: headers,
+	}, opt)
+	result.InitializeLatency = time.Since(start)
+	if client != nil {
+		defer client.Close(true)
+	}
+	if err != nil {
+		if server.Runtime == types.RuntimeRemote && isOAuthRequired(err) {
+			result.Success, result.AuthRequired = true, true
+			result.Latency = time.Since(result.Time)
+			return result, nil
+		}
+		return failed("initialize", err)
+	}
+
+	start = time.Now()
+	tools, err := client.ListTools(ctx)
+	result.ListToolsLatency = time.Since(start)
+	if err != nil {
+		return failed("list tools", err)
+	}
+	result.ToolCount = len(tools.Tools)
+
+	if probe != nil && probe.ToolName != "" {
+		args := map[string]any{}
+		if len(probe.ToolArguments) > 0 {
+			if err := json.Unmarshal(probe.ToolArguments, &args); err != nil {
+				return failed("call tool", fmt.Errorf("invalid tool arguments: %w", err))
+			}
+		}
+
+		start = time.Now()
+		callResult, err := client.Call(ctx, probe.ToolName, args)
+		result.ToolCallLatency = time.Since(start)
+		if err != nil {
+			return failed("call tool", err)
+		} else if callResult.IsError {
+			return failed("call tool", errors.New(toolErrorMessage(callResult)))
+		}
+	}
+
+	result.Success = true
+	result.Latency = time.Since(result.Time)
+	return result, nil
+}
+
+// isOAuthRequired returns true if creating a client failed because the server started an OAuth flow,
+// which the probe's client can't complete because it has no callback handler.
+func isOAuthRequired(err error) bool {
+	return strings.Contains(err.Error(), "oauth callback server is not configured")
+}
+
+func toolErrorMessage(result *nmcp.CallToolResult) string {
+	var texts []string
+	for _, content := range result.Content {
+		if content.Text != "" {
+			texts = append(texts, content.Text)
+		}
+	}
+	if len(texts) == 0 {
+		return "tool returned an error"
+	}
+	return strings.Join(texts, "\n")
+}
+
+// probeTokenStorage never has a token, so the probe's client doesn't use the tokens stored for other clients.
+type probeTokenStorage struct{}
+
+func (probeTokenStorage) GetTokenConfig(context.Context, string) (*oauth2.Config, *oauth2.Token, error) {
+	return nil, nil, nil
+}
+
+func (probeTokenStorage) SetTokenConfig(context.Context, string, *oauth2.Config, *oauth2.Token) error {
+	return nil
+}
@@ -0,0 +1,446 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/name"
+	"github.com/obot-platform/obot/apiclient/types"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// drainingLabel replaces the app label on the pods of the old version of a server during a blue/green rollout.
+	// Without the app label, the pods are released by their ReplicaSet and taken out of the server's service,
+	// so they only get the requests in the sessions that they already have.
+	drainingLabel = "mcp.obot.ai/draining"
+	// drainDeadlineAnnotation is when a draining pod is deleted, whether or not its sessions have ended.
+	drainDeadlineAnnotation = "mcp.obot.ai/drain-deadline"
+	// revisionAnnotation is the hash of a deployment's pod template, which changes whenever the server does.
+	revisionAnnotation = "mcp.obot.ai/revision"
+	// failedRevisionAnnotation is the revision of a server that failed its readiness check during a blue/green rollout,
+	// so that the rollout isn't tried again on every request. Restarting the server clears it.
+	failedRevisionAnnotation = "mcp.obot.ai/failed-revision"
+
+	defaultDrainTimeout = 15 * time.Minute
+	drainCheckInterval  = time.Minute
+)
+
+// RemoveDrainedReplicas periodically removes the old replicas of servers after a blue/green rollout, once their sessions
+// have ended or their drain timeout has passed, until the context is canceled. It should only run on the leader.
+func (sm *SessionManager) RemoveDrainedReplicas(ctx context.Context) {
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := sm.backend.removeDrainedReplicas(ctx); err != nil {
+				log.Warnf("failed to remove drained MCP server replicas: %v", err)
+			}
+		}
+	}
+}
+
+// ErrRolloutFailed is returned when the new version of a server didn't become ready during a blue/green rollout
+// and the old version was restored.
+var ErrRolloutFailed = errors.New("new version of MCP server failed its readiness check and was rolled back")
+
+// Replicas are the base URLs of the ready replicas of a server, keyed by replica name.
+type Replicas struct {
+	// Serving replicas run the current version of the server and get new sessions.
+	Serving map[string]string
+	// Draining replicas run the old version of the server after a blue/green rollout.
+	// They only get the requests in the sessions that they already have.
+	Draining map[string]string
+}
+
+func blueGreen(server ServerConfig) bool {
+	return server.Rollout != nil && server.Rollout.Strategy == types.RolloutStrategyBlueGreen
+}
+
+func drainTimeout(server ServerConfig) time.Duration {
+	if server.Rollout != nil && server.Rollout.DrainTimeoutMinutes > 0 {
+		return time.Duration(server.Rollout.DrainTimeoutMinutes) * time.Minute
+	}
+	return defaultDrainTimeout
+}
+
+// PinsSessionsToReplicas returns true if sessions with the server have to stay on the replica that created them,
+// either because the server has more than one replica, or because the old version of the server keeps serving its
+// sessions after a blue/green rollout.
+func PinsSessionsToReplicas(server ServerConfig) bool {
+	return server.MaxReplicas > 1 || blueGreen(server)
+}
+
+// serverSnapshot is what is needed to restore the running version of a server if a blue/green rollout fails.
+type serverSnapshot struct {
+	deployment   *appsv1.Deployment
+	secrets      []corev1.Secret
+	egressPolicy *networkingv1.NetworkPolicy
+}
+
+func (k *kubernetesBackend) lockRollout(id string) func() {
+	l, _ := k.rolloutLocks.LoadOrStore(id, new(sync.Mutex))
+	lock := l.(*sync.Mutex)
+	lock.Lock()
+	return lock.Unlock
+}
+
+// deployBlueGreen deploys the server, bringing the new version up alongside the running one if the server changed.
+// If the new version fails its readiness check, the old version is restored and keeps running, and the new version
+// isn't tried again until the server changes again or is restarted.
+func (k *kubernetesBackend) deployBlueGreen(ctx context.Context, server ServerConfig, webhooks []Webhook) error {
+	objs, err := k.k8sObjects(ctx, server, webhooks)
+	if err != nil {
+		return fmt.Errorf("failed to generate kubernetes objects for server %s: %w", server.MCPServerName, err)
+	}
+
+	unlock := k.lockRollout(server.MCPServerName)
+	defer unlock()
+
+	var current appsv1.Deployment
+	if err := k.client.Get(ctx, kclient.ObjectKey{Name: server.MCPServerName, Namespace: k.mcpNamespace}, &current); apierrors.IsNotFound(err) {
+		return k.applyServerObjects(ctx, server, objs)
+	} else if err != nil {
+		return fmt.Errorf("failed to get deployment %s: %w", server.MCPServerName, err)
+	}
+
+	revision := deploymentRevision(objs)
+	switch {
+	case current.Annotations[revisionAnnotation] == revision, current.Annotations[revisionAnnotation] == "":
+		// Either nothing changed, or the deployment is from before revisions were tracked, and there's no way to tell.
+		return k.applyServerObjects(ctx, server, objs)
+	case current.Annotations[failedRevisionAnnotation] == revision:
+		// This version already failed, so keep running the old one.
+		return nil
+	case current.Status.ReadyReplicas == 0:
+		// There is no running version to keep.
+		return k.applyServerObjects(ctx, server, objs)
+	}
+
+	err = k.rollout(ctx, server, &current, revision, func() error {
+		return k.applyServerObjects(ctx, server, objs)
+	})
+	if errors.Is(err, ErrRolloutFailed) {
+		olog.Warnf("rolled back MCP server %s to its previous version: %v", server.MCPServerName, err)
+		return nil
+	}
+	return err
+}
+
+// rollout replaces the running version of the server by calling update, while the old pods keep serving their sessions
+// until their drain timeout passes. If the new version doesn't become ready, then the old version is restored, the old
+// pods are adopted by the deployment again, and revision, if it isn't empty, is marked as failed.
+func (k *kubernetesBackend) rollout(ctx context.Context, server ServerConfig, current *appsv1.Deployment, revision string, update func() error) error {
+	id := server.MCPServerName
+
+	snapshot, err := k.snapshotServer(ctx, current)
+	if err != nil {
+		return err
+	}
+
+	drained, err := k.drainPods(ctx, id, time.Now().Add(drainTimeout(server)), snapshot.egressPolicy)
+	if err == nil {
+		if err = update(); err == nil {
+			u := fmt.Sprintf("http://%s.%s.svc.%s", id, k.mcpNamespace, k.mcpClusterDomain)
+			if _, err = k.updatedMCPPod(ctx, u, id, server); err == nil {
+				olog.Infof("Rolled out new version of MCP server %s, draining %d old replicas", id, len(drained))
+				return nil
+			}
+		}
+	}
+
+	// Roll back even if the request that started the rollout is canceled, so that the server isn't left half rolled out.
+	if rollbackErr := k.rollback(context.WithoutCancel(ctx), id, snapshot, drained, revision); rollbackErr != nil {
+		return fmt.Errorf("failed to roll back MCP server %s after %v: %w", id, err, rollbackErr)
+	}
+
+	return fmt.Errorf("%w: %v", ErrRolloutFailed, err)
+}
+
+// snapshotServer saves the deployment and the secrets and egress policy that its pods use.
+func (k *kubernetesBackend) snapshotServer(ctx context.Context, deployment *appsv1.Deployment) (serverSnapshot, error) {
+	snapshot := serverSnapshot{deployment: deployment.DeepCopy()}
+
+	for _, secretName := range podSecretNames(&deployment.Spec.Template.Spec) {
+		var secret corev1.Secret
+		if err := k.client.Get(ctx, kclient.ObjectKey{Name: secretName, Namespace: k.mcpNamespace}, &secret); apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return serverSnapshot{}, fmt.Errorf("failed to get secret %s: %w", secretName, err)
+		}
+		snapshot.secrets = append(snapshot.secrets, secret)
+	}
+
+	var policy networkingv1.NetworkPolicy
+	if err := k.client.Get(ctx, kclient.ObjectKey{Name: name.SafeConcatName(deployment.Name, "egress"), Namespace: k.mcpNamespace}, &policy); err == nil {
+		snapshot.egressPolicy = &policy
+	} else if !apierrors.IsNotFound(err) {
+		return serverSnapshot{}, fmt.Errorf("failed to get egress policy of %s: %w", deployment.Name, err)
+	}
+
+	return snapshot, nil
+}
+
+// drainPods releases the ready pods of the server from its deployment, so that they keep running until the deadline.
+// It returns the names of the pods that were released, even if it fails partway through.
+func (k *kubernetesBackend) drainPods(ctx context.Context, id string, deadline time.Time, egressPolicy *networkingv1.NetworkPolicy) ([]string, error) {
+	var pods corev1.PodList
+	if err := k.client.List(ctx, &pods, kclient.InNamespace(k.mcpNamespace), kclient.MatchingLabels{"app": id}); err != nil {
+		return nil, fmt.Errorf("failed to list MCP pods: %w", err)
+	}
+
+	if egressPolicy != nil {
+		// The server's egress policy selects pods by the app label, so draining pods need their own copy of it.
+		if err := k.ensureDrainingEgressPolicy(ctx, id, egressPolicy); err != nil {
+			return nil, err
+		}
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"labels": map[string]any{
+				"app":         nil,
+				drainingLabel: id,
+			},
+			"annotations": map[string]any{
+				drainDeadlineAnnotation: deadline.Format(time.RFC3339),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal patch: %w", err)
+	}
+
+	var drained []string
+	for _, pod := range pods.Items {
+		if !pod.DeletionTimestamp.IsZero() || !isPodReady(&pod) {
+			continue
+		}
+
+		if err := k.client.Patch(ctx, &pod, kclient.RawPatch(ktypes.MergePatchType, patch)); err != nil {
+			return drained, fmt.Errorf("failed to drain pod %s: %w", pod.Name, err)
+		}
+		drained = append(drained, pod.Name)
+	}
+
+	return drained, nil
+}
+
+func (k *kubernetesBackend) ensureDrainingEgressPolicy(ctx context.Context, id string, egressPolicy *networkingv1.NetworkPolicy) error {
+	policy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name.SafeConcatName(id, "egress", "draining"),
+			Namespace:   k.mcpNamespace,
+			Labels:      map[string]string{drainingLabel: id},
+			Annotations: egressPolicy.Annotations,
+		},
+		Spec: *egressPolicy.Spec.DeepCopy(),
+	}
+	policy.Spec.PodSelector = metav1.LabelSelector{MatchLabels: map[string]string{drainingLabel: id}}
+
+	var existing networkingv1.NetworkPolicy
+	if err := k.client.Get(ctx, kclient.ObjectKeyFromObject(policy), &existing); apierrors.IsNotFound(err) {
+		if err = k.client.Create(ctx, policy); err != nil {
+			return fmt.Errorf("failed to create egress policy for draining pods of %s: %w", id, err)
+		}
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get egress policy for draining pods of %s: %w", id, err)
+	}
+
+	existing.Annotations = policy.Annotations
+	existing.Spec = policy.Spec
+	if err := k.client.Update(ctx, &existing); err != nil {
+		return fmt.Errorf("failed to update egress policy for draining pods of %s: %w", id, err)
+	}
+	return nil
+}
+
+// rollback restores the server from the snapshot and returns the drained pods to its deployment.
+func (k *kubernetesBackend) rollback(ctx context.Context, id string, snapshot serverSnapshot, drained []string, failedRevision string) error {
+	for _, secret := range snapshot.secrets {
+		var existing corev1.Secret
+		if err := k.client.Get(ctx, kclient.ObjectKeyFromObject(&secret), &existing); apierrors.IsNotFound(err) {
+			restored := corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        secret.Name,
+					Namespace:   secret.Namespace,
+					Labels:      secret.Labels,
+					Annotations: secret.Annotations,
+				},
+				Type: secret.Type,
+				Data: secret.Data,
+			}
+			if err = k.client.Create(ctx, &restored); err != nil {
+				return fmt.Errorf("failed to restore secret %s: %w", secret.Name, err)
+			}
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to get secret %s: %w", secret.Name, err)
+		}
+
+		existing.Annotations = secret.Annotations
+		existing.Data = secret.Data
+		existing.StringData = nil
+		if err := k.client.Update(ctx, &existing); err != nil {
+			return fmt.Errorf("failed to restore secret %s: %w", secret.Name, err)
+		}
+	}
+
+	if snapshot.egressPolicy != nil {
+		var existing networkingv1.NetworkPolicy
+		if err := k.client.Get(ctx, kclient.ObjectKeyFromObject(snapshot.egressPolicy), &existing); err == nil {
+			existing.Spec = snapshot.egressPolicy.Spec
+			if err = k.client.Update(ctx, &existing); err != nil {
+				return fmt.Errorf("failed to restore egress policy of %s: %w", id, err)
+			}
+		} else if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get egress policy of %s: %w", id, err)
+		}
+	}
+
+	var deployment appsv1.Deployment
+	if err := k.client.Get(ctx, kclient.ObjectKey{Name: id, Namespace: k.mcpNamespace}, &deployment); err != nil {
+		return fmt.Errorf("failed to get deployment %s: %w", id, err)
+	}
+
+	deployment.Annotations = maps.Clone(snapshot.deployment.Annotations)
+	if failedRevision != "" {
+		if deployment.Annotations == nil {
+			deployment.Annotations = map[string]string{}
+		}
+		deployment.Annotations[failedRevisionAnnotation] = failedRevision
+	}
+	deployment.Spec.Template = snapshot.deployment.Spec.Template
+	if err := k.client.Update(ctx, &deployment); err != nil {
+		return fmt.Errorf("failed to restore deployment %s: %w", id, err)
+	}
+
+	// The pods still have the label with their ReplicaSet's pod template hash, so the ReplicaSet adopts them again.
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"labels": map[string]any{
+				"app":         id,
+				drainingLabel: nil,
+			},
+			"annotations": map[string]any{
+				drainDeadlineAnnotation: nil,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal patch: %w", err)
+	}
+
+	for _, podName := range drained {
+		pod := &corev1.Pod{}
+		pod.Name, pod.Namespace = podName, k.mcpNamespace
+		if err := k.client.Patch(ctx, pod, kclient.RawPatch(ktypes.MergePatchType, patch)); kclient.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to return pod %s to deployment %s: %w", podName, id, err)
+		}
+	}
+
+	return nil
+}
+
+// removeDrainedReplicas deletes the draining pods whose deadline has passed,
+// and the egress policies of servers that don't have draining pods anymore.
+func (k *kubernetesBackend) removeDrainedReplicas(ctx context.Context) error {
+	var pods corev1.PodList
+	if err := k.client.List(ctx, &pods, kclient.InNamespace(k.mcpNamespace), kclient.HasLabels{drainingLabel}); err != nil {
+		return fmt.Errorf("failed to list draining MCP pods: %w", err)
+	}
+
+	var (
+		now      = time.Now()
+		draining = map[string]struct{}{}
+	)
+	for _, pod := range pods.Items {
+		if deadline, err := time.Parse(time.RFC3339, pod.Annotations[drainDeadlineAnnotation]); err == nil && now.Before(deadline) {
+			draining[pod.Labels[drainingLabel]] = struct{}{}
+			continue
+		}
+
+		if err := k.client.Delete(ctx, &pod); kclient.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete drained pod %s: %w", pod.Name, err)
+		}
+	}
+
+	var policies networkingv1.NetworkPolicyList
+	if err := k.client.List(ctx, &policies, kclient.InNamespace(k.mcpNamespace), kclient.HasLabels{drainingLabel}); err != nil {
+		return fmt.Errorf("failed to list egress policies of draining MCP pods: %w", err)
+	}
+
+	for _, policy := range policies.Items {
+		if _, ok := draining[policy.Labels[drainingLabel]]; ok {
+			continue
+		}
+		if err := k.client.Delete(ctx, &policy); kclient.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete egress policy %s: %w", policy.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteDrainingReplicas deletes the old pods of the server and their egress policy, without waiting for them to drain.
+func (k *kubernetesBackend) deleteDrainingReplicas(ctx context.Context, id string) error {
+	if err := k.client.DeleteAllOf(ctx, &corev1.Pod{}, kclient.InNamespace(k.mcpNamespace), kclient.MatchingLabels{drainingLabel: id}); err != nil {
+		return fmt.Errorf("failed to delete draining pods of %s: %w", id, err)
+	}
+	if err := k.client.DeleteAllOf(ctx, &networkingv1.NetworkPolicy{}, kclient.InNamespace(k.mcpNamespace), kclient.MatchingLabels{drainingLabel: id}); err != nil {
+		return fmt.Errorf("failed to delete egress policy of draining pods of %s: %w", id, err)
+	}
+	return nil
+}
+
+// deploymentRevision returns the revision of the deployment in the server's objects.
+func deploymentRevision(objs []kclient.Object) string {
+	for _, obj := range objs {
+		if dep, ok := obj.(*appsv1.Deployment); ok {
+			return dep.Annotations[revisionAnnotation]
+		}
+	}
+	return ""
+}
+
+// podSecretNames returns the names of the secrets that a pod mounts or gets environment variables from.
+func podSecretNames(spec *corev1.PodSpec) []string {
+	var names []string
+	for _, volume := range spec.Volumes {
+		if volume.Secret != nil {
+			names = append(names, volume.Secret.SecretName)
+		}
+	}
+	for _, c := range spec.Containers {
+		for _, envFrom := range c.EnvFrom {
+			if envFrom.SecretRef != nil {
+				names = append(names, envFrom.SecretRef.Name)
+			}
+		}
+		for _, env := range c.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+				names = append(names, env.ValueFrom.SecretKeyRef.Name)
+			}
+		}
+	}
+
+	slices.Sort(names)
+	return slices.Compact(names)
+}
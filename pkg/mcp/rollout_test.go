@@ -0,0 +1,65 @@
+package mcp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestPinsSessionsToReplicas(t *testing.T) {
+	assert.False(t, PinsSessionsToReplicas(ServerConfig{}))
+	assert.False(t, PinsSessionsToReplicas(ServerConfig{MaxReplicas: 1, Rollout: &types.Rollout{Strategy: types.RolloutStrategyRecreate}}))
+	assert.True(t, PinsSessionsToReplicas(ServerConfig{MaxReplicas: 3}))
+
+	// The old version of a blue/green server keeps its sessions, even if the server only has one replica.
+	assert.True(t, PinsSessionsToReplicas(ServerConfig{Rollout: &types.Rollout{Strategy: types.RolloutStrategyBlueGreen}}))
+}
+
+func TestDrainTimeout(t *testing.T) {
+	assert.Equal(t, defaultDrainTimeout, drainTimeout(ServerConfig{}))
+	assert.Equal(t, defaultDrainTimeout, drainTimeout(ServerConfig{Rollout: &types.Rollout{Strategy: types.RolloutStrategyBlueGreen}}))
+	assert.Equal(t, time.Hour, drainTimeout(ServerConfig{Rollout: &types.Rollout{Strategy: types.RolloutStrategyBlueGreen, DrainTimeoutMinutes: 60}}))
+}
+
+func TestPodSecretNames(t *testing.T) {
+	spec := &corev1.PodSpec{
+		Volumes: []corev1.Volume{
+			{Name: "files", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "ms1-files"}}},
+			{Name: "tmp", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		},
+		Containers: []corev1.Container{
+			{
+				Name:    "mcp",
+				EnvFrom: []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "ms1-config"}}}},
+			},
+			{
+				Name: "ms1-webhook",
+				Env: []corev1.EnvVar{
+					{Name: "PORT", Value: "8100"},
+					{Name: "WEBHOOK_SECRET", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "ms1-webhook-secrets"},
+						Key:                  "MS1_WEBHOOK_SECRET",
+					}}},
+				},
+				EnvFrom: []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "ms1-config"}}}},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{"ms1-config", "ms1-files", "ms1-webhook-secrets"}, podSecretNames(spec))
+}
+
+func TestDeploymentRevision(t *testing.T) {
+	objs := []kclient.Object{
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ms1-config", Annotations: map[string]string{revisionAnnotation: "secret"}}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "ms1", Annotations: map[string]string{revisionAnnotation: "abc"}}},
+	}
+	assert.Equal(t, "abc", deploymentRevision(objs))
+	assert.Empty(t, deploymentRevision(objs[:1]))
+}
@@ -1,6 +1,7 @@
 package mcp
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -9,6 +10,8 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/gptscript-ai/gptscript/pkg/engine"
 	gtypes "github.com/gptscript-ai/gptscript/pkg/types"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/obot-platform/obot/pkg/toolapproval"
 )
 
 // Run is responsible for calling MCP tools when the LLM requests their execution. This method is called by GPTScript.
@@ -72,6 +75,14 @@ func (sm *SessionManager) Run(ctx engine.Context, _ chan<- gtypes.CompletionStat
 		}
 	}
 
+	if err := sm.awaitApproval(ctx.Ctx, session, toolName, arguments); err != nil {
+		if _, rejected := toolapproval.IsRejected(err); rejected && ctx.ToolCategory == engine.NoCategory && ctx.Parent != nil {
+			// Tell the LLM that the call was rejected, like any other tool error.
+			return fmt.Sprintf("ERROR: got (%v) while running tool, OUTPUT: ", err), nil
+		}
+		return "", fmt.Errorf("failed to call tool %s: %w", toolName, err)
+	}
+
 	result, err := session.Call(ctx.Ctx, toolName, arguments)
 	if err != nil {
 		if ctx.ToolCategory == engine.NoCategory && ctx.Parent != nil {
@@ -90,5 +101,66 @@ func (sm *SessionManager) Run(ctx engine.Context, _ chan<- gtypes.CompletionStat
 		return "", fmt.Errorf("failed to marshal result: %w", err)
 	}
 
-	return string(str), nil
+	return string(sm.scanToolResult(ctx.Ctx, session, toolName, str)), nil
+}
+
+// scanToolResult applies the injection scan policy to the result of a tool call. Results from servers that are reached
+// through the MCP gateway, like the ones from Obot chat, are scanned by the gateway instead.
+func (sm *SessionManager) scanToolResult(ctx context.Context, session *Client, toolName string, result []byte) []byte {
+	if sm.injectionScanner == nil || strings.HasPrefix(session.Config.URL, fmt.Sprintf("%s/mcp-connect/", sm.baseURL)) {
+		return result
+	}
+
+	scanner, err := sm.injectionScanner.Scanner(ctx)
+	if err != nil {
+		log.Errorf("failed to get injection scanner for tool %s: %v", toolName, err)
+		return result
+	}
+
+	changed, scanResult := scanner.Apply("tools/call", result)
+	if len(scanResult.Findings) > 0 {
+		log.Infof("injection scan flagged result of tool %s of MCP server %s: action=%s, findings=%d", toolName, session.Config.MCPServerName, scanResult.Action, len(scanResult.Findings))
+	}
+	return changed
+}
+
+// awaitApproval holds the tool call until it is approved, if a policy requires approval for it. Calls to servers that are
+// reached through the MCP gateway, like the ones from Obot chat, are held by the gateway instead.
+func (sm *SessionManager) awaitApproval(ctx context.Context, session *Client, toolName string, arguments map[string]any) error {
+	config := session.Config
+	if sm.toolApprovals == nil || strings.HasPrefix(config.URL, fmt.Sprintf("%s/mcp-connect/", sm.baseURL)) {
+		return nil
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"method":  "tools/call",
+		"params": map[string]any{
+			"name":      toolName,
+			"arguments": arguments,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal tool call: %w", err)
+	}
+
+	var powerUserWorkspaceID string
+	if system.IsPowerUserWorkspaceID(config.MCPCatalogName) {
+		powerUserWorkspaceID = config.MCPCatalogName
+	}
+
+	_, err = sm.toolApprovals.Await(ctx, toolapproval.Call{
+		Namespace:                 config.MCPServerNamespace,
+		MCPServerName:             config.MCPServerName,
+		MCPServerDisplayName:      config.MCPServerDisplayName,
+		MCPServerCatalogEntryName: config.MCPCatalogEntryName,
+		MCPCatalogName:            config.MCPCatalogName,
+		PowerUserWorkspaceID:      powerUserWorkspaceID,
+		UserID:                    config.UserID,
+		ClientName:                "Obot MCP Gateway",
+		Method:                    "tools/call",
+		Identifier:                toolName,
+		RequestBody:               body,
+	})
+	return err
 }
@@ -0,0 +1,127 @@
+package mcp
+
+import (
+	"github.com/moby/moby/api/types/container"
+	"github.com/obot-platform/obot/apiclient/types"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// sandboxTmpVolumeName is the name of the writable volume that is mounted at /tmp when root filesystems are read-only.
+const sandboxTmpVolumeName = "tmp"
+
+// sandboxConfig is how MCP server containers are isolated. Every container gets the baseline hardening,
+// and untrusted servers also get the sandbox runtime and profiles, if they are configured.
+type sandboxConfig struct {
+	runtimeClassName       string
+	dockerRuntime          string
+	seccompProfile         string
+	appArmorProfile        string
+	readOnlyRootFilesystem bool
+}
+
+func newSandboxConfig(opts Options) sandboxConfig {
+	return sandboxConfig{
+		runtimeClassName:       opts.MCPSandboxRuntimeClass,
+		dockerRuntime:          opts.MCPSandboxDockerRuntime,
+		seccompProfile:         opts.MCPSandboxSeccompProfile,
+		appArmorProfile:        opts.MCPSandboxAppArmorProfile,
+		readOnlyRootFilesystem: opts.MCPReadOnlyRootFilesystem,
+	}
+}
+
+// trustLevel returns the trust level that the server runs with. Servers without one are untrusted,
+// unless they only run Obot's own images.
+func trustLevel(server ServerConfig) types.TrustLevel {
+	if server.TrustLevel != "" {
+		return server.TrustLevel
+	}
+
+	switch server.Runtime {
+	case types.RuntimeRemote, types.RuntimeComposite:
+		return types.TrustLevelTrusted
+	}
+	return types.TrustLevelUntrusted
+}
+
+// applyToPod hardens every container in the pod and puts untrusted servers in the sandbox.
+// Only the main container gets a read-only root filesystem, because the others run Obot's own images.
+func (s sandboxConfig) applyToPod(spec *corev1.PodSpec, mainContainer string, server ServerConfig) {
+	untrusted := trustLevel(server) == types.TrustLevelUntrusted
+
+	if untrusted && s.runtimeClassName != "" {
+		spec.RuntimeClassName = &s.runtimeClassName
+	}
+
+	if spec.SecurityContext == nil {
+		spec.SecurityContext = &corev1.PodSecurityContext{}
+	}
+	spec.SecurityContext.SeccompProfile = &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault}
+	if untrusted && s.seccompProfile != "" {
+		spec.SecurityContext.SeccompProfile = &corev1.SeccompProfile{
+			Type:             corev1.SeccompProfileTypeLocalhost,
+			LocalhostProfile: &s.seccompProfile,
+		}
+	}
+	if untrusted && s.appArmorProfile != "" {
+		spec.SecurityContext.AppArmorProfile = &corev1.AppArmorProfile{
+			Type:             corev1.AppArmorProfileTypeLocalhost,
+			LocalhostProfile: &s.appArmorProfile,
+		}
+	}
+
+	for i := range spec.Containers {
+		c := &spec.Containers[i]
+		if c.SecurityContext == nil {
+			c.SecurityContext = &corev1.SecurityContext{}
+		}
+		c.SecurityContext.Capabilities = &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}}
+
+		if c.Name != mainContainer || !s.readOnlyRootFilesystem {
+			continue
+		}
+
+		c.SecurityContext.ReadOnlyRootFilesystem = &[]bool{true}[0]
+		c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{
+			Name:      sandboxTmpVolumeName,
+			MountPath: "/tmp",
+		})
+		if server.Runtime != types.RuntimeContainerized {
+			// npx and uvx cache packages in the home directory, so move it somewhere writable.
+			c.Env = append(c.Env, corev1.EnvVar{Name: "HOME", Value: "/tmp"})
+		}
+	}
+
+	if s.readOnlyRootFilesystem {
+		spec.Volumes = append(spec.Volumes, corev1.Volume{
+			Name: sandboxTmpVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		})
+	}
+}
+
+// applyToContainer gives a Docker container the same isolation that applyToPod gives a pod.
+// Docker already applies its default seccomp profile, so only the AppArmor profile can be changed.
+func (s sandboxConfig) applyToContainer(config *container.Config, hostConfig *container.HostConfig, server ServerConfig) {
+	hostConfig.CapDrop = []string{"ALL"}
+	hostConfig.SecurityOpt = append(hostConfig.SecurityOpt, "no-new-privileges:true")
+
+	if trustLevel(server) == types.TrustLevelUntrusted {
+		if s.dockerRuntime != "" {
+			hostConfig.Runtime = s.dockerRuntime
+		}
+		if s.appArmorProfile != "" {
+			hostConfig.SecurityOpt = append(hostConfig.SecurityOpt, "apparmor="+s.appArmorProfile)
+		}
+	}
+
+	if s.readOnlyRootFilesystem {
+		hostConfig.ReadonlyRootfs = true
+		// Packages are installed and run from here, so it can't be noexec.
+		hostConfig.Tmpfs = map[string]string{"/tmp": "rw,exec,nosuid"}
+		if server.Runtime != types.RuntimeContainerized {
+			config.Env = append(config.Env, "HOME=/tmp")
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestTrustLevel(t *testing.T) {
+	assert.Equal(t, types.TrustLevelUntrusted, trustLevel(ServerConfig{Runtime: types.RuntimeNPX}))
+	assert.Equal(t, types.TrustLevelUntrusted, trustLevel(ServerConfig{Runtime: types.RuntimeContainerized}))
+	assert.Equal(t, types.TrustLevelTrusted, trustLevel(ServerConfig{Runtime: types.RuntimeRemote}))
+	assert.Equal(t, types.TrustLevelTrusted, trustLevel(ServerConfig{Runtime: types.RuntimeUVX, TrustLevel: types.TrustLevelTrusted}))
+	assert.Equal(t, types.TrustLevelUntrusted, trustLevel(ServerConfig{Runtime: types.RuntimeRemote, TrustLevel: types.TrustLevelUntrusted}))
+}
+
+func TestSandboxApplyToPod(t *testing.T) {
+	sandbox := sandboxConfig{
+		runtimeClassName:       "gvisor",
+		seccompProfile:         "profiles/mcp.json",
+		readOnlyRootFilesystem: true,
+	}
+
+	newSpec := func() corev1.PodSpec {
+		return corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "shim", SecurityContext: &corev1.SecurityContext{RunAsNonRoot: &[]bool{true}[0]}},
+				{Name: "mcp"},
+			},
+		}
+	}
+
+	// Untrusted servers get the sandbox.
+	spec := newSpec()
+	sandbox.applyToPod(&spec, "mcp", ServerConfig{Runtime: types.RuntimeNPX})
+	require.NotNil(t, spec.RuntimeClassName)
+	assert.Equal(t, "gvisor", *spec.RuntimeClassName)
+	assert.Equal(t, corev1.SeccompProfileTypeLocalhost, spec.SecurityContext.SeccompProfile.Type)
+	assert.Equal(t, "profiles/mcp.json", *spec.SecurityContext.SeccompProfile.LocalhostProfile)
+	assert.Nil(t, spec.SecurityContext.AppArmorProfile)
+
+	for _, c := range spec.Containers {
+		assert.Equal(t, []corev1.Capability{"ALL"}, c.SecurityContext.Capabilities.Drop, c.Name)
+	}
+	// Existing settings are kept.
+	assert.True(t, *spec.Containers[0].SecurityContext.RunAsNonRoot)
+	assert.Nil(t, spec.Containers[0].SecurityContext.ReadOnlyRootFilesystem)
+
+	mcp := spec.Containers[1]
+	assert.True(t, *mcp.SecurityContext.ReadOnlyRootFilesystem)
+	assert.Equal(t, []corev1.VolumeMount{{Name: sandboxTmpVolumeName, MountPath: "/tmp"}}, mcp.VolumeMounts)
+	assert.Equal(t, []corev1.EnvVar{{Name: "HOME", Value: "/tmp"}}, mcp.Env)
+	require.Len(t, spec.Volumes, 1)
+	assert.NotNil(t, spec.Volumes[0].EmptyDir)
+
+	// Trusted servers keep the hardening, but run with the default runtime and seccomp profile.
+	spec = newSpec()
+	sandbox.applyToPod(&spec, "mcp", ServerConfig{Runtime: types.RuntimeContainerized, TrustLevel: types.TrustLevelTrusted})
+	assert.Nil(t, spec.RuntimeClassName)
+	assert.Equal(t, corev1.SeccompProfileTypeRuntimeDefault, spec.SecurityContext.SeccompProfile.Type)
+	assert.True(t, *spec.Containers[1].SecurityContext.ReadOnlyRootFilesystem)
+	// Containerized servers keep their image's home directory.
+	assert.Empty(t, spec.Containers[1].Env)
+}
+
+func TestSandboxApplyToContainer(t *testing.T) {
+	sandbox := sandboxConfig{
+		dockerRuntime:   "runsc",
+		appArmorProfile: "obot-mcp",
+	}
+
+	var (
+		config     container.Config
+		hostConfig container.HostConfig
+	)
+	sandbox.applyToContainer(&config, &hostConfig, ServerConfig{Runtime: types.RuntimeUVX})
+	assert.Equal(t, "runsc", hostConfig.Runtime)
+	assert.Equal(t, []string{"ALL"}, []string(hostConfig.CapDrop))
+	assert.Equal(t, []string{"no-new-privileges:true", "apparmor=obot-mcp"}, hostConfig.SecurityOpt)
+	assert.False(t, hostConfig.ReadonlyRootfs)
+	assert.Empty(t, config.Env)
+
+	config, hostConfig = container.Config{}, container.HostConfig{}
+	sandbox.readOnlyRootFilesystem = true
+	sandbox.applyToContainer(&config, &hostConfig, ServerConfig{Runtime: types.RuntimeNPX, TrustLevel: types.TrustLevelTrusted})
+	assert.Empty(t, hostConfig.Runtime)
+	assert.Equal(t, []string{"no-new-privileges:true"}, hostConfig.SecurityOpt)
+	assert.True(t, hostConfig.ReadonlyRootfs)
+	assert.Contains(t, hostConfig.Tmpfs, "/tmp")
+	assert.Equal(t, []string{"HOME=/tmp"}, config.Env)
+}
@@ -0,0 +1,286 @@
+package mcp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	gtypes "github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+const (
+	autoscaleCheckInterval = 30 * time.Second
+	activityShareInterval  = 15 * time.Second
+
+	// activityStaleAfter is how long the requests in flight that a replica stored are counted. A replica that is still
+	// running stores its activity more often than this, so older counts are from replicas that are gone.
+	activityStaleAfter = 4 * activityShareInterval
+	// activityRetention is how long activity that isn't updated anymore is kept.
+	activityRetention = 7 * 24 * time.Hour
+
+	defaultTargetConcurrency = 10
+)
+
+// ActivityStore shares the request activity of MCP servers between Obot replicas.
+type ActivityStore interface {
+	SaveMCPServerActivity(ctx context.Context, activity []gtypes.MCPServerActivity) error
+	GetMCPServerActivity(ctx context.Context) ([]gtypes.MCPServerActivity, error)
+	DeleteMCPServerActivity(ctx context.Context, mcpServerName string) error
+	DeleteMCPServerActivityBefore(ctx context.Context, before time.Time) error
+}
+
+// requestTracker keeps track of the proxied requests for each MCP server. It is used to scale servers that haven't been used
+// for their idle timeout to zero, and to scale multi-replica servers based on request concurrency.
+// Each Obot replica tracks its own requests and shares them through an ActivityStore, so that the leader can decide
+// which servers are idle and how many replicas the others need.
+type requestTracker struct {
+	lock           sync.Mutex
+	servers        map[string]*trackedServer
+	defaultTimeout time.Duration
+	now            func() time.Time
+	// started is signaled when a request starts for a server that isn't tracked yet, so that it is shared right away.
+	started chan struct{}
+}
+
+type trackedServer struct {
+	inFlight   int
+	lastActive time.Time
+	timeout    time.Duration
+	// sharedAt is when the activity of the server was last shared with the other replicas.
+	sharedAt time.Time
+	// scalingDown is non-nil while the server is being scaled down, and is closed when that is done.
+	scalingDown chan struct{}
+
+	// peakInFlight is the highest number of concurrent requests since the activity of the server was last shared.
+	peakInFlight int
+	scaling      serverScaling
+}
+
+type serverScaling struct {
+	minReplicas, maxReplicas int32
+	targetConcurrency        int
+}
+
+func newRequestTracker(defaultTimeout time.Duration) *requestTracker {
+	return &requestTracker{
+		servers:        make(map[string]*trackedServer),
+		defaultTimeout: defaultTimeout,
+		now:            time.Now,
+		started:        make(chan struct{}, 1),
+	}
+}
+
+// start records the start of a request to the server. If the server is being scaled down, then it waits for that to finish
+// so that the request will wake the server back up.
+func (t *requestTracker) start(ctx context.Context, serverName string, timeout *time.Duration, scaling serverScaling) (func(), error) {
+	for {
+		t.lock.Lock()
+		s := t.servers[serverName]
+		if s == nil {
+			s = new(trackedServer)
+			t.servers[serverName] = s
+
+			select {
+			case t.started <- struct{}{}:
+			default:
+			}
+		}
+
+		if s.scalingDown != nil {
+			scalingDown := s.scalingDown
+			t.lock.Unlock()
+
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-scalingDown:
+			}
+			continue
+		}
+
+		s.inFlight++
+		s.peakInFlight = max(s.peakInFlight, s.inFlight)
+		s.lastActive = t.now()
+		s.scaling = scaling
+		s.timeout = t.defaultTimeout
+		if timeout != nil {
+			s.timeout = *timeout
+		}
+		t.lock.Unlock()
+
+		return sync.OnceFunc(func() {
+			t.lock.Lock()
+			defer t.lock.Unlock()
+			s.inFlight--
+			s.lastActive = t.now()
+		}), nil
+	}
+}
+
+// touch records activity for a server that is already being tracked.
+func (t *requestTracker) touch(serverName string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if s := t.servers[serverName]; s != nil && s.scalingDown == nil {
+		s.lastActive = t.now()
+	}
+}
+
+// activity returns the activity of the servers that had requests in flight or were used since their activity was last
+// shared, and marks it as shared. What was shared about the other servers is still current.
+func (t *requestTracker) activity(replica string) []gtypes.MCPServerActivity {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	var (
+		now      = t.now()
+		activity []gtypes.MCPServerActivity
+	)
+	for name, s := range t.servers {
+		if s.scalingDown != nil || (s.inFlight == 0 && !s.lastActive.After(s.sharedAt)) {
+			continue
+		}
+
+		activity = append(activity, gtypes.MCPServerActivity{
+			MCPServerName:      name,
+			Replica:            replica,
+			InFlight:           s.inFlight,
+			LastActiveAt:       s.lastActive,
+			IdleTimeoutSeconds: int64(s.timeout / time.Second),
+			PeakInFlight:       s.peakInFlight,
+			MinReplicas:        s.scaling.minReplicas,
+			MaxReplicas:        s.scaling.maxReplicas,
+			TargetConcurrency:  s.scaling.targetConcurrency,
+			UpdatedAt:          now,
+		})
+		s.sharedAt = now
+		s.peakInFlight = s.inFlight
+	}
+
+	return activity
+}
+
+// combinedActivity is the activity of a server on all replicas.
+type combinedActivity struct {
+	// inFlight and peakInFlight only count the replicas that stored their activity recently.
+	inFlight, peakInFlight int
+	lastActive             time.Time
+	// latest is the activity that was stored most recently, which has the server's current settings.
+	latest gtypes.MCPServerActivity
+}
+
+// combineActivity combines the activity that each replica stored, by server.
+func combineActivity(activity []gtypes.MCPServerActivity, now time.Time) map[string]*combinedActivity {
+	servers := make(map[string]*combinedActivity)
+	for _, a := range activity {
+		s := servers[a.MCPServerName]
+		if s == nil {
+			s = new(combinedActivity)
+			servers[a.MCPServerName] = s
+		}
+
+		if now.Sub(a.UpdatedAt) < activityStaleAfter {
+			s.inFlight += a.InFlight
+			s.peakInFlight += a.PeakInFlight
+		}
+		if a.LastActiveAt.After(s.lastActive) {
+			s.lastActive = a.LastActiveAt
+		}
+		if a.UpdatedAt.After(s.latest.UpdatedAt) {
+			s.latest = a
+		}
+	}
+	return servers
+}
+
+// desiredReplicas returns the number of replicas that each multi-replica server should have, based on the peak number of
+// concurrent requests on all replicas.
+func desiredReplicas(activity []gtypes.MCPServerActivity, now time.Time) map[string]int32 {
+	desired := make(map[string]int32)
+	for name, s := range combineActivity(activity, now) {
+		if s.latest.MaxReplicas <= 1 {
+			continue
+		}
+
+		target := s.latest.TargetConcurrency
+		if target <= 0 {
+			target = defaultTargetConcurrency
+		}
+
+		replicas := int32((s.peakInFlight + target - 1) / target)
+		desired[name] = min(max(replicas, s.latest.MinReplicas, 1), s.latest.MaxReplicas)
+	}
+
+	return desired
+}
+
+// TrackRequest records a proxied request to an MCP server so that it isn't scaled to zero while it is in use, and so that
+// servers with more than one replica can be scaled based on request concurrency.
+// A nil timeout uses the default idle timeout, and a timeout of zero disables scale-to-zero for the server.
+// If the server is being scaled down, this waits for that to finish so that launching the server will wake it back up.
+// The returned function must be called when the request is done.
+func (sm *SessionManager) TrackRequest(ctx context.Context, server ServerConfig, timeout *time.Duration) (func(), error) {
+	return sm.requests.start(ctx, server.MCPServerName, timeout, serverScaling{
+		minReplicas:       server.MinReplicas,
+		maxReplicas:       server.MaxReplicas,
+		targetConcurrency: server.TargetConcurrency,
+	})
+}
+
+// ShareActivity periodically stores the request activity that this replica saw, so that the leader can scale idle servers
+// to zero and autoscale servers based on the requests to every replica. It runs until the context is canceled.
+func (sm *SessionManager) ShareActivity(ctx context.Context, store ActivityStore) {
+	ticker := time.NewTicker(activityShareInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-sm.requests.started:
+		}
+
+		if err := store.SaveMCPServerActivity(ctx, sm.requests.activity(sm.replica)); err != nil {
+			log.Warnf("failed to store MCP server activity: %v", err)
+		}
+	}
+}
+
+// sharedActivity stores the activity of this replica, so that it is current, and returns the activity of all replicas.
+func (sm *SessionManager) sharedActivity(ctx context.Context, store ActivityStore) ([]gtypes.MCPServerActivity, error) {
+	if err := store.SaveMCPServerActivity(ctx, sm.requests.activity(sm.replica)); err != nil {
+		return nil, err
+	}
+	return store.GetMCPServerActivity(ctx)
+}
+
+func (sm *SessionManager) autoscaleServers(ctx context.Context, store ActivityStore) {
+	activity, err := sm.sharedActivity(ctx, store)
+	if err != nil {
+		log.Warnf("failed to get MCP server activity: %v", err)
+		return
+	}
+
+	for name, replicas := range desiredReplicas(activity, sm.requests.now()) {
+		if err := sm.backend.scaleServer(ctx, name, replicas); err != nil {
+			log.Warnf("failed to scale MCP server %s to %d replicas: %v", name, replicas, err)
+		}
+	}
+}
+
+// AutoscaleServers periodically scales multi-replica servers based on the requests in flight on every replica,
+// until the context is canceled. It should only run on the leader.
+func (sm *SessionManager) AutoscaleServers(ctx context.Context, store ActivityStore) {
+	ticker := time.NewTicker(autoscaleCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sm.autoscaleServers(ctx, store)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package mcp
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	gtypes "github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestTrackerActivity(t *testing.T) {
+	now := time.Now()
+	tracker := newRequestTracker(10 * time.Minute)
+	tracker.now = func() time.Time { return now }
+
+	disabled := time.Duration(0)
+
+	doneDefault, err := tracker.start(context.Background(), "default", nil, serverScaling{})
+	require.NoError(t, err)
+	doneDisabled, err := tracker.start(context.Background(), "disabled", &disabled, serverScaling{})
+	require.NoError(t, err)
+
+	// The first request to a server signals that there is new activity to share.
+	select {
+	case <-tracker.started:
+	default:
+		t.Fatal("the first request did not signal new activity")
+	}
+
+	activity := tracker.activity("replica")
+	require.Len(t, activity, 2)
+	slices.SortFunc(activity, func(a, b gtypes.MCPServerActivity) int { return strings.Compare(a.MCPServerName, b.MCPServerName) })
+	assert.Equal(t, gtypes.MCPServerActivity{
+		MCPServerName:      "default",
+		Replica:            "replica",
+		InFlight:           1,
+		LastActiveAt:       now,
+		IdleTimeoutSeconds: 600,
+		PeakInFlight:       1,
+		UpdatedAt:          now,
+	}, activity[0])
+	assert.Equal(t, int64(0), activity[1].IdleTimeoutSeconds)
+
+	// Servers with requests in flight are shared every time, and idle servers are only shared when they were used.
+	doneDefault()
+	now = now.Add(time.Minute)
+	activity = tracker.activity("replica")
+	require.Len(t, activity, 1)
+	assert.Equal(t, "disabled", activity[0].MCPServerName)
+
+	now = now.Add(time.Minute)
+	doneDisabled()
+	assert.Len(t, tracker.activity("replica"), 1)
+	assert.Empty(t, tracker.activity("replica"))
+
+	now = now.Add(time.Minute)
+	tracker.touch("default")
+	activity = tracker.activity("replica")
+	require.Len(t, activity, 1)
+	assert.Equal(t, now, activity[0].LastActiveAt)
+}
+
+func TestDesiredReplicas(t *testing.T) {
+	now := time.Now()
+	scaling := serverScaling{minReplicas: 2, maxReplicas: 5, targetConcurrency: 4}
+
+	// Two Obot replicas proxy requests to the same server.
+	a, b := newRequestTracker(0), newRequestTracker(0)
+	a.now = func() time.Time { return now }
+	b.now = func() time.Time { return now }
+
+	var dones []func()
+	for i := range 13 {
+		tracker := a
+		if i%2 == 1 {
+			tracker = b
+		}
+		done, err := tracker.start(context.Background(), "scaled", nil, scaling)
+		require.NoError(t, err)
+		dones = append(dones, done)
+	}
+
+	// Servers without more than one replica are not autoscaled.
+	done, err := a.start(context.Background(), "single", nil, serverScaling{})
+	require.NoError(t, err)
+	done()
+
+	// 13 concurrent requests on both replicas at 4 per replica needs 4 replicas.
+	activity := slices.Concat(a.activity("a"), b.activity("b"))
+	assert.Equal(t, map[string]int32{"scaled": 4}, desiredReplicas(activity, now))
+
+	// Replicas are capped at the maximum.
+	for range 20 {
+		done, err := a.start(context.Background(), "scaled", nil, scaling)
+		require.NoError(t, err)
+		dones = append(dones, done)
+	}
+	activity = slices.Concat(a.activity("a"), b.activity("b"))
+	assert.Equal(t, map[string]int32{"scaled": 5}, desiredReplicas(activity, now))
+
+	// Once the requests finish, the server scales back down to the minimum.
+	now = now.Add(time.Second)
+	for _, done := range dones {
+		done()
+	}
+	activity = slices.Concat(a.activity("a"), b.activity("b"))
+	assert.Equal(t, map[string]int32{"scaled": 5}, desiredReplicas(activity, now), "the peak since the activity was last shared is used")
+	activity = slices.Concat(a.activity("a"), b.activity("b"))
+	assert.Empty(t, activity, "idle servers aren't shared again")
+
+	// The peaks of replicas that stopped sharing their activity are not counted.
+	activity = []gtypes.MCPServerActivity{
+		{MCPServerName: "scaled", Replica: "a", PeakInFlight: 20, MinReplicas: 2, MaxReplicas: 5, UpdatedAt: now.Add(-time.Hour)},
+		{MCPServerName: "scaled", Replica: "b", PeakInFlight: 0, MinReplicas: 2, MaxReplicas: 5, UpdatedAt: now},
+	}
+	assert.Equal(t, map[string]int32{"scaled": 2}, desiredReplicas(activity, now))
+}
@@ -69,6 +69,27 @@ type ServerConfig struct {
 	AuditLogToken    string `json:"auditLogToken"`
 	AuditLogEndpoint string `json:"auditLogEndpoint"`
 	AuditLogMetadata string `json:"auditLogMetadata"`
+
+	// K8sOverrides are merged over the global K8s settings by the Kubernetes backend.
+	K8sOverrides *types.K8sOverrides `json:"k8sOverrides,omitempty"`
+
+	// EgressPolicy lists the destinations that the server can reach when egress policies are enforced.
+	EgressPolicy *types.EgressPolicy `json:"egressPolicy,omitempty"`
+
+	// TrustLevel controls whether the server runs in the sandbox. Use trustLevel to get the effective level.
+	TrustLevel types.TrustLevel `json:"trustLevel,omitempty"`
+
+	// PersistentVolume is mounted in the server's container. PersistentVolumeName is the name of the PVC or Docker volume that backs it.
+	PersistentVolume     *types.PersistentVolume `json:"persistentVolume,omitempty"`
+	PersistentVolumeName string                  `json:"persistentVolumeName,omitempty"`
+
+	// Rollout controls how the server is replaced when its configuration changes.
+	Rollout *types.Rollout `json:"rollout,omitempty"`
+
+	// Scaling configuration for multi-user servers.
+	MinReplicas       int32 `json:"minReplicas,omitempty"`
+	MaxReplicas       int32 `json:"maxReplicas,omitempty"`
+	TargetConcurrency int   `json:"targetConcurrency,omitempty"`
 }
 
 type File struct {
@@ -309,6 +330,24 @@ func ServerToServerConfig(mcpServer v1.MCPServer, audiences []string, issuer, jw
 		TokenExchangeClientSecret: secretsCred["TOKEN_EXCHANGE_CLIENT_SECRET"],
 		TokenExchangeEndpoint:     fmt.Sprintf("%s/oauth/token", issuer),
 		ComponentMCPServer:        mcpServer.Spec.CompositeName != "",
+		K8sOverrides:              mcpServer.Spec.Manifest.K8sOverrides,
+		EgressPolicy:              mcpServer.Spec.Manifest.EgressPolicy,
+		TrustLevel:                mcpServer.Spec.Manifest.TrustLevel,
+		Rollout:                   mcpServer.Spec.Manifest.Rollout,
+	}
+
+	if scaling := mcpServer.Spec.Scaling; scaling != nil && (mcpServer.Spec.MCPCatalogID != "" || mcpServer.Spec.PowerUserWorkspaceID != "") {
+		serverConfig.MinReplicas = scaling.MinReplicas
+		serverConfig.MaxReplicas = scaling.MaxReplicas
+		serverConfig.TargetConcurrency = scaling.TargetConcurrency
+	}
+
+	if volume := mcpServer.Spec.Manifest.PersistentVolume; volume != nil {
+		serverConfig.PersistentVolume = volume
+		serverConfig.PersistentVolumeName = PersistentVolumeName(mcpServer)
+		// The volume can only be mounted by one replica at a time.
+		serverConfig.MinReplicas = min(serverConfig.MinReplicas, 1)
+		serverConfig.MaxReplicas = min(serverConfig.MaxReplicas, 1)
 	}
 
 	if mcpServer.Spec.CompositeName == "" {
@@ -7,6 +7,7 @@ import (
 	"slices"
 
 	"github.com/gptscript-ai/go-gptscript"
+	otypes "github.com/obot-platform/obot/apiclient/types"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	"k8s.io/client-go/tools/cache"
@@ -28,9 +29,24 @@ type Webhook struct {
 	Name, DisplayName  string
 	URL, Secret, Image string
 	Definitions        []string
+	// Selectors are only used to call webhooks in shadow mode. They are left out of the deployment hash, which the
+	// definitions already cover.
+	Selectors otypes.MCPSelectors `json:"-"`
 }
 
+// GetWebhooksForMCPServer returns the webhooks that validate the requests to the server. Webhooks in shadow mode are not
+// included, because they must not block requests.
 func (wh *WebhookHelper) GetWebhooksForMCPServer(ctx context.Context, gptClient *gptscript.GPTScript, serverConfig ServerConfig) ([]Webhook, error) {
+	return wh.webhooksForMCPServer(ctx, gptClient, serverConfig, false)
+}
+
+// GetShadowWebhooksForMCPServer returns the webhooks in shadow mode for the server. The gateway calls them itself and only
+// records their verdicts.
+func (wh *WebhookHelper) GetShadowWebhooksForMCPServer(ctx context.Context, gptClient *gptscript.GPTScript, serverConfig ServerConfig) ([]Webhook, error) {
+	return wh.webhooksForMCPServer(ctx, gptClient, serverConfig, true)
+}
+
+func (wh *WebhookHelper) webhooksForMCPServer(ctx context.Context, gptClient *gptscript.GPTScript, serverConfig ServerConfig, shadow bool) ([]Webhook, error) {
 	var result []Webhook
 	webhookSeen := make(map[string]struct{})
 
@@ -39,39 +55,39 @@ func (wh *WebhookHelper) GetWebhooksForMCPServer(ctx context.Context, gptClient
 		return nil, fmt.Errorf("failed to get webhooks from MCP server index: %w", err)
 	}
 
-	result = wh.appendWebhooks(ctx, gptClient, serverConfig.MCPServerNamespace, objs, webhookSeen, result)
+	result = wh.appendWebhooks(ctx, gptClient, serverConfig.MCPServerNamespace, objs, shadow, webhookSeen, result)
 
 	objs, err = wh.indexer.ByIndex("catalog-entry-names", serverConfig.MCPCatalogEntryName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get webhooks from catalog entry index: %w", err)
 	}
 
-	result = wh.appendWebhooks(ctx, gptClient, serverConfig.MCPServerNamespace, objs, webhookSeen, result)
+	result = wh.appendWebhooks(ctx, gptClient, serverConfig.MCPServerNamespace, objs, shadow, webhookSeen, result)
 
 	objs, err = wh.indexer.ByIndex("selectors", "*")
 	if err != nil {
 		return nil, fmt.Errorf("failed to get webhooks from selector index: %w", err)
 	}
 
-	result = wh.appendWebhooks(ctx, gptClient, serverConfig.MCPServerNamespace, objs, webhookSeen, result)
+	result = wh.appendWebhooks(ctx, gptClient, serverConfig.MCPServerNamespace, objs, shadow, webhookSeen, result)
 
 	objs, err = wh.indexer.ByIndex("catalog-names", serverConfig.MCPCatalogName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get webhooks from catalog index: %w", err)
 	}
 
-	result = wh.appendWebhooks(ctx, gptClient, serverConfig.MCPServerNamespace, objs, webhookSeen, result)
+	result = wh.appendWebhooks(ctx, gptClient, serverConfig.MCPServerNamespace, objs, shadow, webhookSeen, result)
 
 	return result, nil
 }
 
-func (wh *WebhookHelper) appendWebhooks(ctx context.Context, gptClient *gptscript.GPTScript, namespace string, objs []any, seen map[string]struct{}, result []Webhook) []Webhook {
+func (wh *WebhookHelper) appendWebhooks(ctx context.Context, gptClient *gptscript.GPTScript, namespace string, objs []any, shadow bool, seen map[string]struct{}, result []Webhook) []Webhook {
 	var credEnv map[string]string
 	result = slices.Grow(result, len(objs))
 
 	for _, mwv := range objs {
 		res, ok := mwv.(*v1.MCPWebhookValidation)
-		if ok && res.Namespace == namespace && !res.Spec.Manifest.Disabled {
+		if ok && res.Namespace == namespace && !res.Spec.Manifest.Disabled && res.Spec.Manifest.Shadow == shadow {
 			url := res.Spec.Manifest.URL
 			if _, seen := seen[url]; seen {
 				continue
@@ -104,6 +120,7 @@ func (wh *WebhookHelper) appendWebhooks(ctx context.Context, gptClient *gptscrip
 				Secret:      credEnv["secret"],
 				Image:       wh.defaultBaseImage,
 				Definitions: res.Spec.Manifest.Selectors.Strings(),
+				Selectors:   res.Spec.Manifest.Selectors,
 			})
 		}
 	}
@@ -0,0 +1,172 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	otypes "github.com/obot-platform/obot/apiclient/types"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+const (
+	// shadowWebhookTimeout is how long a webhook in shadow mode has to respond before its verdict is an error.
+	shadowWebhookTimeout = 5 * time.Second
+	// shadowWebhookConcurrency is the number of webhooks in shadow mode that are called at once.
+	shadowWebhookConcurrency = 10
+	// shadowWebhookMessageLimit is the number of bytes of a webhook's response that are kept as the reason for its verdict.
+	shadowWebhookMessageLimit = 1024
+	// shadowVerdictRetention is how long the verdicts of webhooks in shadow mode are kept.
+	shadowVerdictRetention = 30 * 24 * time.Hour
+	// shadowVerdictPruneInterval is how often old verdicts of webhooks in shadow mode are deleted.
+	shadowVerdictPruneInterval = time.Hour
+)
+
+var shadowWebhookClient = &http.Client{Timeout: shadowWebhookTimeout}
+
+// ShadowVerdictStore persists the verdicts of webhooks in shadow mode.
+type ShadowVerdictStore interface {
+	DeleteMCPWebhookShadowVerdictsBefore(ctx context.Context, before time.Time) error
+}
+
+// PruneShadowVerdicts deletes old verdicts of webhooks in shadow mode every hour until the context is canceled. It should
+// only run on the leader.
+func PruneShadowVerdicts(ctx context.Context, store ShadowVerdictStore) {
+	ticker := time.NewTicker(shadowVerdictPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := store.DeleteMCPWebhookShadowVerdictsBefore(ctx, time.Now().Add(-shadowVerdictRetention)); err != nil {
+				log.Warnf("failed to prune webhook shadow verdicts: %v", err)
+			}
+		}
+	}
+}
+
+// CallShadowWebhooks calls the webhooks in shadow mode for the requests in the audit logs that they select, the same way the
+// shim calls webhooks. Their verdicts are returned, but never block anything, because the requests have already been handled.
+func CallShadowWebhooks(ctx context.Context, webhooks []Webhook, logs []gatewaytypes.MCPAuditLog) []gatewaytypes.MCPWebhookShadowVerdict {
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	type call struct {
+		log     int
+		webhook Webhook
+		payload []byte
+	}
+
+	var calls []call
+	for i, auditLog := range logs {
+		payload, ok := shadowWebhookPayload(auditLog)
+		if !ok {
+			continue
+		}
+		for _, webhook := range webhooks {
+			// Webhooks without selectors get every request, like in the shim.
+			if len(webhook.Selectors) == 0 || webhook.Selectors.Matches(auditLog.CallType, auditLog.CallIdentifier) {
+				calls = append(calls, call{log: i, webhook: webhook, payload: payload})
+			}
+		}
+	}
+
+	var (
+		verdicts = make([]gatewaytypes.MCPWebhookShadowVerdict, len(calls))
+		limit    = make(chan struct{}, shadowWebhookConcurrency)
+		wg       sync.WaitGroup
+	)
+	for i, c := range calls {
+		wg.Add(1)
+		limit <- struct{}{}
+		go func() {
+			defer func() {
+				<-limit
+				wg.Done()
+			}()
+
+			auditLog := logs[c.log]
+			verdict, message := callShadowWebhook(ctx, c.webhook, c.payload)
+			verdicts[i] = gatewaytypes.MCPWebhookShadowVerdict{
+				CreatedAt:            auditLog.CreatedAt,
+				WebhookValidationID:  c.webhook.Name,
+				UserID:               auditLog.UserID,
+				MCPID:                auditLog.MCPID,
+				MCPServerDisplayName: auditLog.MCPServerDisplayName,
+				CallType:             auditLog.CallType,
+				CallIdentifier:       auditLog.CallIdentifier,
+				SessionID:            auditLog.SessionID,
+				RequestID:            auditLog.RequestID,
+				Verdict:              string(verdict),
+				Message:              message,
+			}
+		}()
+	}
+	wg.Wait()
+
+	return verdicts
+}
+
+// shadowWebhookPayload returns the JSON-RPC request of the audit log, which is what webhooks receive. Logs without a request,
+// like the ones for responses, aren't sent to webhooks.
+func shadowWebhookPayload(auditLog gatewaytypes.MCPAuditLog) ([]byte, bool) {
+	if len(auditLog.RequestBody) == 0 || auditLog.CallType == "" {
+		return nil, false
+	}
+
+	var message map[string]any
+	if err := json.Unmarshal(auditLog.RequestBody, &message); err != nil || message == nil {
+		return nil, false
+	}
+	if _, ok := message["jsonrpc"]; !ok {
+		message["jsonrpc"] = "2.0"
+	}
+	if _, ok := message["method"]; !ok {
+		message["method"] = auditLog.CallType
+	}
+
+	payload, err := json.Marshal(message)
+	return payload, err == nil
+}
+
+func callShadowWebhook(ctx context.Context, webhook Webhook, payload []byte) (otypes.MCPWebhookShadowVerdictType, string) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(payload))
+	if err != nil {
+		return otypes.MCPWebhookShadowVerdictError, fmt.Sprintf("failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if webhook.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(webhook.Secret))
+		mac.Write(payload)
+		req.Header.Set("X-Obot-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := shadowWebhookClient.Do(req)
+	if err != nil {
+		return otypes.MCPWebhookShadowVerdictError, fmt.Sprintf("failed to call webhook: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, shadowWebhookMessageLimit))
+	if resp.StatusCode == http.StatusOK {
+		return otypes.MCPWebhookShadowVerdictAllowed, ""
+	}
+
+	message := strings.TrimSpace(string(body))
+	if message == "" {
+		message = resp.Status
+	}
+	return otypes.MCPWebhookShadowVerdictBlocked, message
+}
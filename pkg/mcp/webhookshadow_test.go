@@ -0,0 +1,80 @@
+package mcp
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	otypes "github.com/obot-platform/obot/apiclient/types"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+func TestCallShadowWebhooks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write(body)
+		if r.Header.Get("X-Obot-Signature-256") != "sha256="+hex.EncodeToString(mac.Sum(nil)) {
+			http.Error(w, "bad signature", http.StatusUnauthorized)
+			return
+		}
+
+		if gjson.GetBytes(body, "params.name").String() == "delete_repo" {
+			http.Error(w, "deleting repositories is not allowed", http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	webhooks := []Webhook{{
+		Name:        "mwv1",
+		DisplayName: "policy",
+		URL:         server.URL,
+		Secret:      "secret",
+		Selectors:   otypes.MCPSelectors{{Method: "tools/call"}},
+	}}
+	logs := []gatewaytypes.MCPAuditLog{
+		{UserID: "u1", MCPID: "ms1", CallType: "tools/call", CallIdentifier: "list_repos", RequestID: "1", RequestBody: []byte(`{"id":1,"params":{"name":"list_repos"}}`)},
+		{UserID: "u1", MCPID: "ms1", CallType: "tools/call", CallIdentifier: "delete_repo", RequestID: "2", RequestBody: []byte(`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"delete_repo"}}`)},
+		// Not selected by the webhook.
+		{UserID: "u1", MCPID: "ms1", CallType: "tools/list", RequestID: "3", RequestBody: []byte(`{"id":3}`)},
+		// Responses are not sent to webhooks.
+		{UserID: "u1", MCPID: "ms1", CallType: "tools/call", CallIdentifier: "list_repos", RequestID: "1", ResponseBody: []byte(`{"result":{}}`)},
+	}
+
+	verdicts := CallShadowWebhooks(context.Background(), webhooks, logs)
+	require.Len(t, verdicts, 2)
+
+	assert.Equal(t, "mwv1", verdicts[0].WebhookValidationID)
+	assert.Equal(t, "list_repos", verdicts[0].CallIdentifier)
+	assert.Equal(t, string(otypes.MCPWebhookShadowVerdictAllowed), verdicts[0].Verdict)
+	assert.Empty(t, verdicts[0].Message)
+
+	assert.Equal(t, "delete_repo", verdicts[1].CallIdentifier)
+	assert.Equal(t, string(otypes.MCPWebhookShadowVerdictBlocked), verdicts[1].Verdict)
+	assert.Equal(t, "deleting repositories is not allowed", verdicts[1].Message)
+}
+
+func TestCallShadowWebhooksUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	logs := []gatewaytypes.MCPAuditLog{
+		{CallType: "tools/list", RequestBody: []byte(`{"id":1}`)},
+	}
+
+	verdicts := CallShadowWebhooks(context.Background(), []Webhook{{Name: "mwv1", URL: server.URL}}, logs)
+	require.Len(t, verdicts, 1)
+	assert.Equal(t, string(otypes.MCPWebhookShadowVerdictError), verdicts[0].Verdict)
+	assert.Contains(t, verdicts[0].Message, "failed to call webhook")
+}
@@ -0,0 +1,135 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Registry has the metrics of Obot's own subsystems that are served on /metrics.
+var Registry = prometheus.NewRegistry()
+
+var (
+	MCPGatewayRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "obot_mcp_gateway_requests_total",
+		Help: "Requests proxied by the MCP gateway, by multi-user MCP server or the catalog entry of single-user servers, JSON-RPC method, and HTTP status code.",
+	}, []string{"server", "method", "code"})
+	MCPGatewayRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "obot_mcp_gateway_request_duration_seconds",
+		Help:    "Time taken to proxy requests to MCP servers, including starting the server, by multi-user MCP server or the catalog entry of single-user servers, and JSON-RPC method.",
+		Buckets: []float64{.01, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60, 120},
+	}, []string{"server", "method"})
+
+	AuditLogQueueDepth = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "obot_mcp_audit_log_queue_depth",
+		Help: "MCP audit logs that are waiting to be written to the database.",
+	})
+	AuditLogFlushDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "obot_mcp_audit_log_flush_duration_seconds",
+		Help:    "Time taken to write a batch of MCP audit logs to the database, by result.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"result"})
+
+	LLMProxyRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "obot_llm_proxy_requests_total",
+		Help: "Requests proxied to model providers, by model, model provider, and HTTP status code.",
+	}, []string{"model", "provider", "code"})
+	LLMProxyTokens = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "obot_llm_proxy_tokens_total",
+		Help: "Tokens used by requests proxied to model providers, by model, model provider, and type (prompt or completion).",
+	}, []string{"model", "provider", "type"})
+
+	ReconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "obot_controller_reconcile_duration_seconds",
+		Help:    "Time taken by controller handlers to reconcile an object, by kind, handler, and result.",
+		Buckets: []float64{.001, .005, .01, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"kind", "handler", "result"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		MCPGatewayRequests,
+		MCPGatewayRequestDuration,
+		AuditLogQueueDepth,
+		AuditLogFlushDuration,
+		LLMProxyRequests,
+		LLMProxyTokens,
+		ReconcileDuration,
+	)
+}
+
+// Result is the result label for an operation that returned err.
+func Result(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "success"
+}
+
+// Reconcile wraps a controller handler so that the time it takes to reconcile each object is recorded under the given name.
+func Reconcile(name string, next router.Handler) router.Handler {
+	return router.HandlerFunc(func(req router.Request, resp router.Response) error {
+		start := time.Now()
+		err := next.Handle(req, resp)
+		ReconcileDuration.WithLabelValues(req.GVK.Kind, name, Result(err)).Observe(time.Since(start).Seconds())
+		return err
+	})
+}
+
+// ErrorCode is the HTTP status code label for a request that failed with err.
+func ErrorCode(err error) string {
+	if errHTTP := (*types.ErrHTTP)(nil); errors.As(err, &errHTTP) {
+		return strconv.Itoa(errHTTP.Code)
+	} else if errStatus := (*apierrors.StatusError)(nil); errors.As(err, &errStatus) {
+		return strconv.Itoa(int(errStatus.Status().Code))
+	}
+	return strconv.Itoa(http.StatusInternalServerError)
+}
+
+// countTimeout is how long a CountFunc can take before the scrape reports an error for its metric.
+const countTimeout = 10 * time.Second
+
+// CountFunc returns the number of things, like runs or deployments, for each value of a label.
+type CountFunc func(ctx context.Context) (map[string]int, error)
+
+// RegisterCounts registers a gauge that is read from count on every scrape. It is for numbers, like the runs in each state,
+// that are already kept somewhere else.
+func RegisterCounts(name, help, label string, count CountFunc) {
+	Registry.MustRegister(&countCollector{
+		desc:  prometheus.NewDesc(name, help, []string{label}, nil),
+		count: count,
+	})
+}
+
+type countCollector struct {
+	desc  *prometheus.Desc
+	count CountFunc
+}
+
+func (c *countCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *countCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), countTimeout)
+	defer cancel()
+
+	counts, err := c.count(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.desc, err)
+		return
+	}
+	for value, n := range counts {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(n), value)
+	}
+}
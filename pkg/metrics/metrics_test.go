@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestErrorCode(t *testing.T) {
+	assert.Equal(t, "429", ErrorCode(fmt.Errorf("failed: %w", types.NewErrHTTP(429, "slow down"))))
+	assert.Equal(t, "404", ErrorCode(apierrors.NewNotFound(schema.GroupResource{Resource: "runs"}, "r1")))
+	assert.Equal(t, "500", ErrorCode(errors.New("boom")))
+}
+
+func TestCountCollector(t *testing.T) {
+	c := &countCollector{
+		desc: prometheus.NewDesc("obot_test_things", "Things, by state.", []string{"state"}, nil),
+		count: func(context.Context) (map[string]int, error) {
+			return map[string]int{"done": 2, "running": 1}, nil
+		},
+	}
+	assert.NoError(t, testutil.CollectAndCompare(c, strings.NewReader(`
+# HELP obot_test_things Things, by state.
+# TYPE obot_test_things gauge
+obot_test_things{state="done"} 2
+obot_test_things{state="running"} 1
+`)))
+
+	c.count = func(context.Context) (map[string]int, error) {
+		return nil, errors.New("database is down")
+	}
+	assert.Error(t, testutil.CollectAndCompare(c, strings.NewReader("")))
+}
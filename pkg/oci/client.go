@@ -0,0 +1,234 @@
+package oci
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ManifestMediaTypes are the media types of single-platform manifests.
+var ManifestMediaTypes = []string{
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// indexMediaTypes are the media types of multi-platform manifests, which images are often pushed as.
+var indexMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+}
+
+// maxManifestSize is the limit for the size of a manifest.
+const maxManifestSize = 4 * 1024 * 1024
+
+type Descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type Manifest struct {
+	MediaType string       `json:"mediaType"`
+	Layers    []Descriptor `json:"layers"`
+}
+
+// Client is a minimal client for pulling manifests and blobs from a single repository in an OCI registry.
+// It supports anonymous access, basic auth, and the bearer token flow that most public registries use.
+type Client struct {
+	client   *http.Client
+	ref      Reference
+	username string
+	password string
+	token    string
+}
+
+// NewClient returns a client for the repository of the reference. The username and password are optional.
+func NewClient(ref Reference, username, password string) *Client {
+	return &Client{
+		client:   &http.Client{Timeout: time.Minute},
+		ref:      ref,
+		username: username,
+		password: password,
+	}
+}
+
+// GetManifest gets the manifest for a tag or digest.
+func (c *Client) GetManifest(reference string) (Manifest, error) {
+	var manifest Manifest
+	return manifest, c.GetJSON("manifests/"+reference, strings.Join(ManifestMediaTypes, ", "), &manifest)
+}
+
+// ResolveDigest returns the digest of the manifest that the reference points at.
+// A reference that is already a digest is returned as is.
+func (c *Client) ResolveDigest() (string, error) {
+	if c.ref.IsDigest() {
+		return c.ref.Reference, nil
+	}
+
+	body, err := c.Get("manifests/"+c.ref.Reference, strings.Join(append(ManifestMediaTypes, indexMediaTypes...), ", "), maxManifestSize)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(body)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+func (c *Client) GetJSON(path, accept string, obj any) error {
+	body, err := c.Get(path, accept, 1024*1024)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, obj)
+}
+
+// GetBlob gets a blob and checks it against its digest.
+func (c *Client) GetBlob(layer Descriptor, limit int64) ([]byte, error) {
+	algorithm, expected, ok := strings.Cut(layer.Digest, ":")
+	if !ok || algorithm != "sha256" {
+		return nil, fmt.Errorf("unsupported digest %s", layer.Digest)
+	}
+
+	blob, err := c.Get("blobs/"+layer.Digest, "", limit)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(blob)
+	if hex.EncodeToString(sum[:]) != expected {
+		return nil, fmt.Errorf("digest mismatch for %s", layer.Digest)
+	}
+
+	return blob, nil
+}
+
+func (c *Client) Get(path, accept string, limit int64) ([]byte, error) {
+	u := fmt.Sprintf("https://%s/v2/%s/%s", c.ref.apiRegistry(), c.ref.Repository, path)
+
+	resp, err := c.do(u, accept)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && c.token == "" {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+
+		if err := c.authenticate(challenge); err != nil {
+			return nil, err
+		}
+		if resp, err = c.do(u, accept); err != nil {
+			return nil, err
+		}
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("registry returned status %d: %s", resp.StatusCode, string(body))
+	}
+	if int64(len(body)) > limit {
+		return nil, fmt.Errorf("response is larger than %d bytes", limit)
+	}
+
+	return body, nil
+}
+
+func (c *Client) do(u, accept string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	} else if c.password != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	return c.client.Do(req)
+}
+
+// authenticate gets a bearer token from the registry's token service, as described by the WWW-Authenticate challenge.
+func (c *Client) authenticate(challenge string) error {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return fmt.Errorf("registry requires authentication")
+	}
+
+	values := parseChallengeParams(params)
+	realm, err := url.Parse(values["realm"])
+	if err != nil || realm.Scheme != "https" {
+		return fmt.Errorf("invalid token realm %q", values["realm"])
+	}
+
+	q := realm.Query()
+	if values["service"] != "" {
+		q.Set("service", values["service"])
+	}
+	q.Set("scope", fmt.Sprintf("repository:%s:pull", c.ref.Repository))
+	realm.RawQuery = q.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return err
+	}
+	if c.password != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to get registry token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("failed to get registry token, status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return fmt.Errorf("failed to decode registry token: %w", err)
+	}
+
+	c.token = token.Token
+	if c.token == "" {
+		c.token = token.AccessToken
+	}
+	if c.token == "" {
+		return fmt.Errorf("registry did not return a token")
+	}
+
+	return nil
+}
+
+func parseChallengeParams(params string) map[string]string {
+	result := make(map[string]string)
+	for _, param := range strings.Split(params, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if ok {
+			result[strings.ToLower(key)] = strings.Trim(value, `"`)
+		}
+	}
+	return result
+}
@@ -0,0 +1,59 @@
+package oci
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// dockerHubAliases are the names that Docker config files use for Docker Hub.
+var dockerHubAliases = []string{dockerHubRegistry, "index.docker.io", dockerHubAPIRegistry}
+
+type dockerConfig struct {
+	Auths map[string]dockerConfigAuth `json:"auths"`
+}
+
+type dockerConfigAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Auth     string `json:"auth"`
+}
+
+// DockerConfigCredentials returns the username and password for the registry from a Docker config file, like the
+// .dockerconfigjson of a Kubernetes image pull secret. It returns false if the config has no credentials for the registry.
+func DockerConfigCredentials(config []byte, registry string) (string, string, bool, error) {
+	var c dockerConfig
+	if err := json.Unmarshal(config, &c); err != nil {
+		return "", "", false, fmt.Errorf("failed to parse docker config: %w", err)
+	}
+
+	for server, auth := range c.Auths {
+		if !sameRegistry(server, registry) {
+			continue
+		}
+
+		if auth.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+			if err != nil {
+				return "", "", false, fmt.Errorf("failed to decode auth for %s: %w", server, err)
+			}
+			username, password, _ := strings.Cut(string(decoded), ":")
+			return username, password, true, nil
+		}
+		return auth.Username, auth.Password, true, nil
+	}
+
+	return "", "", false, nil
+}
+
+// sameRegistry returns true if a server key of a Docker config file, like https://index.docker.io/v1/ or ghcr.io,
+// is the registry.
+func sameRegistry(server, registry string) bool {
+	server = strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")
+	server, _, _ = strings.Cut(server, "/")
+	server, registry = strings.ToLower(server), strings.ToLower(registry)
+
+	return server == registry || slices.Contains(dockerHubAliases, server) && slices.Contains(dockerHubAliases, registry)
+}
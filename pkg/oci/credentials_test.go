@@ -0,0 +1,56 @@
+package oci
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDockerConfigCredentials(t *testing.T) {
+	config := []byte(`{"auths": {
+		"https://index.docker.io/v1/": {"auth": "` + base64.StdEncoding.EncodeToString([]byte("hub-user:hub-pass")) + `"},
+		"ghcr.io": {"username": "gh-user", "password": "gh-pass"}
+	}}`)
+
+	tests := []struct {
+		name         string
+		registry     string
+		wantUsername string
+		wantPassword string
+		wantOK       bool
+	}{
+		{
+			name:         "docker hub alias",
+			registry:     "docker.io",
+			wantUsername: "hub-user",
+			wantPassword: "hub-pass",
+			wantOK:       true,
+		},
+		{
+			name:         "username and password",
+			registry:     "GHCR.io",
+			wantUsername: "gh-user",
+			wantPassword: "gh-pass",
+			wantOK:       true,
+		},
+		{
+			name:     "other registry",
+			registry: "registry.example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			username, password, ok, err := DockerConfigCredentials(config, tt.registry)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantUsername, username)
+			assert.Equal(t, tt.wantPassword, password)
+		})
+	}
+
+	_, _, _, err := DockerConfigCredentials([]byte("not json"), "ghcr.io")
+	assert.Error(t, err)
+}
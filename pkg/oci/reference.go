@@ -0,0 +1,95 @@
+package oci
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	dockerHubRegistry    = "docker.io"
+	dockerHubAPIRegistry = "registry-1.docker.io"
+)
+
+// Reference identifies a manifest in an OCI registry by tag or digest.
+type Reference struct {
+	Registry   string
+	Repository string
+	Reference  string
+}
+
+// IsDigest returns true if the reference is a digest instead of a tag.
+func (r Reference) IsDigest() bool {
+	return strings.HasPrefix(r.Reference, "sha256:")
+}
+
+// String returns the reference in the form registry/repository:tag or registry/repository@digest.
+func (r Reference) String() string {
+	if r.IsDigest() {
+		return fmt.Sprintf("%s/%s@%s", r.Registry, r.Repository, r.Reference)
+	}
+	return fmt.Sprintf("%s/%s:%s", r.Registry, r.Repository, r.Reference)
+}
+
+// WithDigest returns a copy of the reference that points at the digest instead of its tag.
+func (r Reference) WithDigest(digest string) Reference {
+	r.Reference = digest
+	return r
+}
+
+// apiRegistry is the host that serves the registry API, which isn't the same as the registry name for Docker Hub.
+func (r Reference) apiRegistry() string {
+	if r.Registry == dockerHubRegistry {
+		return dockerHubAPIRegistry
+	}
+	return r.Registry
+}
+
+// ParseReference parses a reference like registry.example.com/org/catalog:v1 or
+// registry.example.com/org/catalog@sha256:..., where the registry is always explicit. The tag defaults to latest.
+func ParseReference(ref string) (Reference, error) {
+	registry, repository, ok := strings.Cut(ref, "/")
+	if !ok || registry == "" || repository == "" {
+		return Reference{}, fmt.Errorf("invalid OCI reference %s, expected registry/repository:tag", ref)
+	}
+
+	return parseRepository(ref, registry, repository)
+}
+
+// ParseImageReference parses a container image reference the way Docker does: the registry defaults to Docker Hub,
+// official Docker Hub images are in the library namespace, and the tag defaults to latest.
+// If the image has both a tag and a digest, the digest is used.
+func ParseImageReference(image string) (Reference, error) {
+	registry, repository := dockerHubRegistry, image
+	if first, rest, ok := strings.Cut(image, "/"); ok && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		registry, repository = first, rest
+	}
+	if registry == dockerHubRegistry && !strings.Contains(repository, "/") {
+		repository = "library/" + repository
+	}
+
+	return parseRepository(image, registry, repository)
+}
+
+func parseRepository(ref, registry, repository string) (Reference, error) {
+	result := Reference{
+		Registry:   registry,
+		Repository: repository,
+		Reference:  "latest",
+	}
+
+	if repo, digest, ok := strings.Cut(repository, "@"); ok {
+		result.Repository, result.Reference = repo, digest
+		// Drop the tag, the digest is what gets pulled.
+		if i := strings.LastIndex(repo, ":"); i > strings.LastIndex(repo, "/") {
+			result.Repository = repo[:i]
+		}
+	} else if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		result.Repository, result.Reference = repository[:i], repository[i+1:]
+	}
+
+	if result.Repository == "" || result.Reference == "" || strings.Contains(result.Repository, "..") {
+		return Reference{}, fmt.Errorf("invalid OCI reference %s", ref)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,71 @@
+package oci
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImageReference(t *testing.T) {
+	tests := []struct {
+		name    string
+		image   string
+		want    Reference
+		wantErr bool
+	}{
+		{
+			name:  "official image",
+			image: "nginx",
+			want:  Reference{Registry: "docker.io", Repository: "library/nginx", Reference: "latest"},
+		},
+		{
+			name:  "docker hub with tag",
+			image: "org/server:v1",
+			want:  Reference{Registry: "docker.io", Repository: "org/server", Reference: "v1"},
+		},
+		{
+			name:  "registry with port",
+			image: "registry.example.com:5000/server",
+			want:  Reference{Registry: "registry.example.com:5000", Repository: "server", Reference: "latest"},
+		},
+		{
+			name:  "localhost",
+			image: "localhost/server:dev",
+			want:  Reference{Registry: "localhost", Repository: "server", Reference: "dev"},
+		},
+		{
+			name:  "digest",
+			image: "ghcr.io/org/server@sha256:abc",
+			want:  Reference{Registry: "ghcr.io", Repository: "org/server", Reference: "sha256:abc"},
+		},
+		{
+			name:  "tag and digest",
+			image: "ghcr.io/org/server:v1@sha256:abc",
+			want:  Reference{Registry: "ghcr.io", Repository: "org/server", Reference: "sha256:abc"},
+		},
+		{
+			name:    "path traversal",
+			image:   "ghcr.io/../server",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, err := ParseImageReference(tt.image)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, ref)
+		})
+	}
+}
+
+func TestReferenceString(t *testing.T) {
+	ref := Reference{Registry: "ghcr.io", Repository: "org/server", Reference: "v1"}
+	assert.Equal(t, "ghcr.io/org/server:v1", ref.String())
+	assert.Equal(t, "ghcr.io/org/server@sha256:abc", ref.WithDigest("sha256:abc").String())
+}
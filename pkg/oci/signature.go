@@ -0,0 +1,114 @@
+package oci
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+)
+
+const (
+	// cosignSignatureAnnotation holds the base64 encoded signature of a cosign signature layer.
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// maxCosignPayloadSize is the limit for the size of a cosign signature payload.
+	maxCosignPayloadSize = 1024 * 1024
+)
+
+// ParsePublicKey parses a PEM encoded ECDSA, Ed25519, or RSA public key.
+func ParsePublicKey(publicKey string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(publicKey))
+	if block == nil {
+		return nil, fmt.Errorf("public key is not PEM encoded")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+
+	switch key.(type) {
+	case *ecdsa.PublicKey, ed25519.PublicKey, *rsa.PublicKey:
+		return key, nil
+	}
+	return nil, fmt.Errorf("unsupported public key type %T", key)
+}
+
+// VerifySignature checks a detached signature of data. The signature can be raw or base64 encoded, so signatures
+// created with `cosign sign-blob` or `openssl dgst -sign` both work.
+func VerifySignature(publicKey crypto.PublicKey, data, signature []byte) error {
+	if decoded, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(signature))); err == nil {
+		signature = decoded
+	}
+
+	digest := sha256.Sum256(data)
+
+	var valid bool
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(key, digest[:], signature)
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(key, data, signature)
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil ||
+			rsa.VerifyPSS(key, crypto.SHA256, digest[:], signature, nil) == nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+
+	if !valid {
+		return fmt.Errorf("invalid signature")
+	}
+	return nil
+}
+
+// cosignPayload is the part of a cosign "simple signing" payload that ties the signature to an image.
+type cosignPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// VerifyCosignSignature checks that the image digest has a cosign signature from one of the public keys.
+// Cosign stores the signatures of an image under the tag sha256-<digest>.sig in the image's repository.
+func (c *Client) VerifyCosignSignature(digest string, publicKeys []crypto.PublicKey) error {
+	manifest, err := c.GetManifest(strings.Replace(digest, ":", "-", 1) + ".sig")
+	if err != nil {
+		return fmt.Errorf("failed to get cosign signature for %s: %w", digest, err)
+	}
+
+	for _, layer := range manifest.Layers {
+		signature := layer.Annotations[cosignSignatureAnnotation]
+		if signature == "" {
+			continue
+		}
+
+		payload, err := c.GetBlob(layer, maxCosignPayloadSize)
+		if err != nil {
+			return fmt.Errorf("failed to get cosign signature payload %s: %w", layer.Digest, err)
+		}
+
+		var p cosignPayload
+		if err := json.Unmarshal(payload, &p); err != nil || p.Critical.Image.DockerManifestDigest != digest {
+			// A signature for a different image can't vouch for this one, even if it is valid.
+			continue
+		}
+
+		for _, key := range publicKeys {
+			if VerifySignature(key, payload, []byte(signature)) == nil {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("no valid cosign signature for %s", digest)
+}
@@ -0,0 +1,200 @@
+package oci
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePublicKey(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	edKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		key     string
+		wantErr bool
+	}{
+		{
+			name: "ecdsa",
+			key:  encodePublicKey(t, &ecKey.PublicKey),
+		},
+		{
+			name: "ed25519",
+			key:  encodePublicKey(t, edKey),
+		},
+		{
+			name:    "not PEM",
+			key:     "not a key",
+			wantErr: true,
+		},
+		{
+			name:    "invalid key",
+			key:     string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: []byte("invalid")})),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParsePublicKey(tt.key)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	data := []byte("some signed data\n")
+	digest := sha256.Sum256(data)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecSig, err := ecdsa.SignASN1(rand.Reader, ecKey, digest[:])
+	require.NoError(t, err)
+
+	edPub, edPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edSig := ed25519.Sign(edPriv, data)
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	// Signatures are accepted raw or base64 encoded.
+	assert.NoError(t, VerifySignature(&ecKey.PublicKey, data, ecSig))
+	assert.NoError(t, VerifySignature(&ecKey.PublicKey, data, []byte(base64.StdEncoding.EncodeToString(ecSig)+"\n")))
+	assert.NoError(t, VerifySignature(edPub, data, edSig))
+
+	assert.Error(t, VerifySignature(&otherKey.PublicKey, data, ecSig), "signature from a different key should fail")
+	assert.Error(t, VerifySignature(&ecKey.PublicKey, []byte("tampered"), ecSig), "tampered data should fail")
+}
+
+func TestVerifyCosignSignature(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	const (
+		signedDigest   = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+		unsignedDigest = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+		// This digest has a signature, but its payload is for the signed digest.
+		swappedDigest = "sha256:3333333333333333333333333333333333333333333333333333333333333333"
+	)
+
+	registry := newTestRegistry(t)
+	registry.sign(t, key, signedDigest, signedDigest)
+	registry.sign(t, key, swappedDigest, signedDigest)
+
+	c := registry.client(t, "org/server")
+
+	assert.NoError(t, c.VerifyCosignSignature(signedDigest, []crypto.PublicKey{&otherKey.PublicKey, &key.PublicKey}))
+	assert.Error(t, c.VerifyCosignSignature(signedDigest, []crypto.PublicKey{&otherKey.PublicKey}), "signature from a different key should fail")
+	assert.Error(t, c.VerifyCosignSignature(unsignedDigest, []crypto.PublicKey{&key.PublicKey}), "unsigned image should fail")
+	assert.Error(t, c.VerifyCosignSignature(swappedDigest, []crypto.PublicKey{&key.PublicKey}), "signature for a different image should fail")
+}
+
+func TestResolveDigest(t *testing.T) {
+	registry := newTestRegistry(t)
+	manifest := `{"schemaVersion":2,"mediaType":"application/vnd.oci.image.index.v1+json","manifests":[]}`
+	registry.files["/v2/org/server/manifests/v1"] = []byte(manifest)
+	sum := sha256.Sum256([]byte(manifest))
+
+	digest, err := registry.client(t, "org/server").ResolveDigest()
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:"+hex.EncodeToString(sum[:]), digest)
+
+	// Digests are returned without asking the registry.
+	ref := Reference{Registry: "registry.invalid", Repository: "org/server", Reference: digest}
+	digest, err = NewClient(ref, "", "").ResolveDigest()
+	require.NoError(t, err)
+	assert.Equal(t, ref.Reference, digest)
+}
+
+type testRegistry struct {
+	server *httptest.Server
+	files  map[string][]byte
+}
+
+func newTestRegistry(t *testing.T) *testRegistry {
+	t.Helper()
+
+	r := &testRegistry{files: map[string][]byte{}}
+	r.server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, ok := r.files[req.URL.Path]
+		if !ok {
+			http.NotFound(w, req)
+			return
+		}
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(r.server.Close)
+
+	return r
+}
+
+func (r *testRegistry) client(t *testing.T, repository string) *Client {
+	t.Helper()
+
+	c := NewClient(Reference{
+		Registry:   strings.TrimPrefix(r.server.URL, "https://"),
+		Repository: repository,
+		Reference:  "v1",
+	}, "", "")
+	c.client = r.server.Client()
+	return c
+}
+
+// sign stores a cosign signature for the digest in the org/server repository, with a payload that claims payloadDigest.
+func (r *testRegistry) sign(t *testing.T, key *ecdsa.PrivateKey, digest, payloadDigest string) {
+	t.Helper()
+
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"example.com/org/server"},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, payloadDigest))
+	payloadSum := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, payloadSum[:])
+	require.NoError(t, err)
+
+	layerDigest := "sha256:" + hex.EncodeToString(payloadSum[:])
+	manifest, err := json.Marshal(Manifest{
+		MediaType: ManifestMediaTypes[0],
+		Layers: []Descriptor{{
+			MediaType:   "application/vnd.dev.cosign.simplesigning.v1+json",
+			Digest:      layerDigest,
+			Size:        int64(len(payload)),
+			Annotations: map[string]string{cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(sig)},
+		}},
+	})
+	require.NoError(t, err)
+
+	r.files["/v2/org/server/blobs/"+layerDigest] = payload
+	r.files["/v2/org/server/manifests/"+strings.Replace(digest, ":", "-", 1)+".sig"] = manifest
+}
+
+func encodePublicKey(t *testing.T, key any) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
@@ -44,16 +44,20 @@ import (
 	"github.com/obot-platform/obot/pkg/gateway/types"
 	"github.com/obot-platform/obot/pkg/gemini"
 	"github.com/obot-platform/obot/pkg/hash"
+	"github.com/obot-platform/obot/pkg/injectionscan"
 	"github.com/obot-platform/obot/pkg/invoke"
 	"github.com/obot-platform/obot/pkg/jwt/persistent"
 	"github.com/obot-platform/obot/pkg/logutil"
 	"github.com/obot-platform/obot/pkg/mcp"
+	"github.com/obot-platform/obot/pkg/metrics"
 	"github.com/obot-platform/obot/pkg/proxy"
 	"github.com/obot-platform/obot/pkg/storage"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/storage/scheme"
 	"github.com/obot-platform/obot/pkg/storage/services"
 	"github.com/obot-platform/obot/pkg/system"
+	"github.com/obot-platform/obot/pkg/toolapproval"
+	"github.com/obot-platform/obot/pkg/tooldrift"
 	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -150,6 +154,7 @@ type Services struct {
 	AuditLogger                audit.Logger
 	PostgresDSN                string
 	RetentionPolicy            time.Duration
+	AuditLogAnomalyInterval    time.Duration
 	// Use basic auth for sendgrid webhook, if being set
 	SendgridWebhookUsername string
 	SendgridWebhookPassword string
@@ -159,6 +164,15 @@ type Services struct {
 
 	WebhookHelper *mcp.WebhookHelper
 
+	// Holds the MCP calls that need approval.
+	ToolApprovals *toolapproval.Gate
+
+	// Scans what MCP servers send to LLMs for prompt injection.
+	InjectionScanner *injectionscan.Provider
+
+	// Detects MCP tools that change after they were first listed.
+	ToolDrift *tooldrift.Detector
+
 	// Used for loading and running MCP servers with GPTScript.
 	MCPLoader *mcp.SessionManager
 
@@ -481,6 +495,9 @@ func New(ctx context.Context, config Config) (*Services, error) {
 		return nil, err
 	}
 
+	metrics.RegisterCounts("obot_runs", "Runs, by state (running, done, or error).", "state", gatewayClient.CountRunStates)
+	metrics.RegisterCounts("obot_mcp_deployments", "MCP servers that are deployed, including servers that are scaled to zero, by runtime backend.", "backend", mcpSessionManager.CountDeployments)
+
 	gptscriptClient, err := newGPTScript(ctx, config.EnvKeys, credStore, credStoreEnv, mcpSessionManager)
 	if err != nil {
 		return nil, err
@@ -634,6 +651,21 @@ func New(ctx context.Context, config Config) (*Services, error) {
 		return nil, err
 	}
 
+	// Set up MCPToolApprovalPolicy indexer, which is checked on every proxied call
+	mcpToolApprovalPolicyGVK, err := r.Backend().GroupVersionKindFor(&v1.MCPToolApprovalPolicy{})
+	if err != nil {
+		return nil, err
+	}
+
+	mcpToolApprovalPolicyInformer, err := r.Backend().GetInformerForKind(ctx, mcpToolApprovalPolicyGVK)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = mcpToolApprovalPolicyInformer.AddIndexers(toolapproval.PolicyIndexers); err != nil {
+		return nil, err
+	}
+
 	apply.AddValidOwnerChange("otto-controller", "obot-controller")
 	apply.AddValidOwnerChange("mcpcatalogentries", "catalog-default")
 
@@ -741,7 +773,14 @@ func New(ctx context.Context, config Config) (*Services, error) {
 
 	webhookHelper := mcp.NewWebhookHelper(mcpWebhookValidationInformer.GetIndexer(), config.MCPHTTPWebhookBaseImage)
 
-	mcpSessionManager.Init(gptscriptClient, webhookHelper)
+	toolApprovals := toolapproval.NewGate(storageClient, mcpToolApprovalPolicyInformer.GetIndexer(), gatewayClient)
+
+	injectionScanner := injectionscan.NewProvider(storageClient)
+
+	toolDrift := tooldrift.NewDetector(storageClient, gatewayClient)
+
+	mcpSessionManager.Init(gptscriptClient, webhookHelper, toolApprovals, injectionScanner)
+	go mcpSessionManager.ShareActivity(ctx, gatewayClient)
 
 	// Derive registryNoAuth flag from config
 	// When EnableRegistryAuth is false (default), registry is in no-auth mode
@@ -791,6 +830,7 @@ func New(ctx context.Context, config Config) (*Services, error) {
 		AuditLogger:                auditLogger,
 		PostgresDSN:                postgresDSN,
 		RetentionPolicy:            retentionPolicy,
+		AuditLogAnomalyInterval:    time.Duration(config.MCPAuditLogAnomalyIntervalSeconds) * time.Second,
 		DefaultMCPCatalogPath:      config.DefaultMCPCatalogPath,
 		MCPLoader:                  mcpSessionManager,
 		MCPOAuthTokenStorage:       mcpOAuthTokenStorage,
@@ -807,6 +847,9 @@ func New(ctx context.Context, config Config) (*Services, error) {
 		},
 		AccessControlRuleHelper: acrHelper,
 		WebhookHelper:           webhookHelper,
+		ToolApprovals:           toolApprovals,
+		InjectionScanner:        injectionScanner,
+		ToolDrift:               toolDrift,
 		LocalK8sConfig:          localK8sConfig,
 		MCPServerNamespace:      config.MCPNamespace,
 		K8sSettingsFromHelm:     helmK8sSettings,
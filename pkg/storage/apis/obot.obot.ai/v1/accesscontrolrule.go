@@ -2,6 +2,7 @@ package v1
 
 import (
 	"slices"
+	"time"
 
 	"github.com/obot-platform/nah/pkg/fields"
 	"github.com/obot-platform/obot/apiclient/types"
@@ -29,6 +30,15 @@ type AccessControlRuleSpec struct {
 	PowerUserWorkspaceID string `json:"powerUserWorkspaceID,omitempty"`
 	// Generated indicates that this access control rule was automatically generated by the system and should not be modified by users.
 	Generated bool `json:"generated,omitempty"`
+	// MCPAccessRequestName is the name of the MCPAccessRequest that this rule was generated for, if there is one.
+	MCPAccessRequestName string `json:"mcpAccessRequestName,omitempty"`
+	// ExpiresAt is the time at which this rule stops granting access and is removed. Rules without it never expire.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// IsExpired returns true if the rule has an expiration time that has passed.
+func (in *AccessControlRule) IsExpired() bool {
+	return in.Spec.ExpiresAt != nil && !in.Spec.ExpiresAt.After(time.Now())
 }
 
 func (in *AccessControlRule) GetColumns() [][]string {
@@ -65,6 +75,7 @@ func (in *AccessControlRule) FieldNames() []string {
 func (in *AccessControlRule) DeleteRefs() []Ref {
 	return []Ref{
 		{ObjType: &PowerUserWorkspace{}, Name: in.Spec.PowerUserWorkspaceID},
+		{ObjType: &MCPAccessRequest{}, Name: in.Spec.MCPAccessRequestName},
 	}
 }
 
@@ -50,6 +50,7 @@ func (*AuditLogExport) GetColumns() [][]string {
 
 type AuditLogExportSpec struct {
 	Name                   string                      `json:"name"`
+	Type                   types.AuditLogExportType    `json:"type,omitempty"`
 	Bucket                 string                      `json:"bucket"`
 	KeyPrefix              string                      `json:"keyPrefix,omitempty"`
 	StartTime              metav1.Time                 `json:"startTime"`
@@ -0,0 +1,101 @@
+package v1
+
+import (
+	"slices"
+
+	"github.com/obot-platform/nah/pkg/fields"
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	_ fields.Fields = (*MCPAccessRequest)(nil)
+	_ DeleteRefs    = (*MCPAccessRequest)(nil)
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MCPAccessRequest is a user's request for access to an MCP catalog entry or multi-user MCP server.
+// Once approved, the request is granted through a generated AccessControlRule that expires with the request.
+type MCPAccessRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MCPAccessRequestSpec   `json:"spec,omitempty"`
+	Status MCPAccessRequestStatus `json:"status,omitempty"`
+}
+
+type MCPAccessRequestSpec struct {
+	Manifest types.MCPAccessRequestManifest `json:"manifest"`
+	// UserID is the ID of the user requesting access.
+	UserID string `json:"userID,omitempty"`
+	// MCPCatalogID is the catalog that the requested resource belongs to, if it belongs to a catalog.
+	MCPCatalogID string `json:"mcpCatalogID,omitempty"`
+	// PowerUserWorkspaceID is the workspace that the requested resource belongs to, if it belongs to a workspace.
+	PowerUserWorkspaceID string                      `json:"powerUserWorkspaceID,omitempty"`
+	State                types.MCPAccessRequestState `json:"state,omitempty"`
+	DecidedBy            string                      `json:"decidedBy,omitempty"`
+	DecisionReason       string                      `json:"decisionReason,omitempty"`
+	DecidedAt            *metav1.Time                `json:"decidedAt,omitempty"`
+	// ExpiresAt is when the granted access ends. It is set when the request is approved.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+type MCPAccessRequestStatus struct {
+	// AccessControlRuleName is the name of the generated AccessControlRule granting the requested access.
+	AccessControlRuleName string `json:"accessControlRuleName,omitempty"`
+	// EndedAt is the time the request was denied, revoked, or expired.
+	EndedAt *metav1.Time `json:"endedAt,omitempty"`
+}
+
+func (in *MCPAccessRequest) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"User", "Spec.UserID"},
+		{"Resource", "Spec.Manifest.Resource.ID"},
+		{"State", "Spec.State"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+}
+
+func (in *MCPAccessRequest) Has(field string) (exists bool) {
+	return slices.Contains(in.FieldNames(), field)
+}
+
+func (in *MCPAccessRequest) Get(field string) (value string) {
+	switch field {
+	case "spec.userID":
+		return in.Spec.UserID
+	case "spec.state":
+		return string(in.Spec.State)
+	case "spec.mcpCatalogID":
+		return in.Spec.MCPCatalogID
+	case "spec.powerUserWorkspaceID":
+		return in.Spec.PowerUserWorkspaceID
+	}
+	return ""
+}
+
+func (in *MCPAccessRequest) FieldNames() []string {
+	return []string{
+		"spec.userID",
+		"spec.state",
+		"spec.mcpCatalogID",
+		"spec.powerUserWorkspaceID",
+	}
+}
+
+func (in *MCPAccessRequest) DeleteRefs() []Ref {
+	return []Ref{
+		{ObjType: &PowerUserWorkspace{}, Name: in.Spec.PowerUserWorkspaceID},
+	}
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type MCPAccessRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []MCPAccessRequest `json:"items"`
+}
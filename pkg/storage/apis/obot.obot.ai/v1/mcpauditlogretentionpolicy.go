@@ -0,0 +1,38 @@
+package v1
+
+import (
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type MCPAuditLogRetentionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MCPAuditLogRetentionPolicySpec   `json:"spec,omitempty"`
+	Status MCPAuditLogRetentionPolicyStatus `json:"status,omitempty"`
+}
+
+type MCPAuditLogRetentionPolicySpec struct {
+	Manifest types.MCPAuditLogRetentionPolicyManifest `json:"manifest,omitempty"`
+}
+
+type MCPAuditLogRetentionPolicyStatus struct {
+	// ObservedGeneration is the generation of the spec that was enforced by the last run.
+	ObservedGeneration int64        `json:"observedGeneration,omitempty"`
+	LastRunAt          *metav1.Time `json:"lastRunAt,omitempty"`
+	LastError          string       `json:"lastError,omitempty"`
+	ArchivedLogCount   int64        `json:"archivedLogCount,omitempty"`
+	DeletedLogCount    int64        `json:"deletedLogCount,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type MCPAuditLogRetentionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []MCPAuditLogRetentionPolicy `json:"items"`
+}
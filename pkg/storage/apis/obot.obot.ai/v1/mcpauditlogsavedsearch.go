@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/obot-platform/nah/pkg/fields"
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ fields.Fields = (*MCPAuditLogSavedSearch)(nil)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MCPAuditLogSavedSearch is a named audit log query of a user.
+type MCPAuditLogSavedSearch struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MCPAuditLogSavedSearchSpec `json:"spec,omitempty"`
+	Status EmptyStatus                `json:"status,omitempty"`
+}
+
+type MCPAuditLogSavedSearchSpec struct {
+	Manifest types.MCPAuditLogSavedSearchManifest `json:"manifest"`
+	// UserID is the ID of the user that created the search.
+	UserID string `json:"userID,omitempty"`
+}
+
+func (in *MCPAuditLogSavedSearch) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"Display Name", "Spec.Manifest.Name"},
+		{"User", "Spec.UserID"},
+		{"Shared", "Spec.Manifest.Shared"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+}
+
+func (in *MCPAuditLogSavedSearch) Has(field string) (exists bool) {
+	return slices.Contains(in.FieldNames(), field)
+}
+
+func (in *MCPAuditLogSavedSearch) Get(field string) (value string) {
+	switch field {
+	case "spec.userID":
+		return in.Spec.UserID
+	case "spec.manifest.shared":
+		return strconv.FormatBool(in.Spec.Manifest.Shared)
+	}
+	return ""
+}
+
+func (in *MCPAuditLogSavedSearch) FieldNames() []string {
+	return []string{
+		"spec.userID",
+		"spec.manifest.shared",
+	}
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type MCPAuditLogSavedSearchList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []MCPAuditLogSavedSearch `json:"items"`
+}
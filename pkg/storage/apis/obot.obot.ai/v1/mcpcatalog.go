@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"github.com/obot-platform/obot/apiclient/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -17,6 +18,10 @@ type MCPCatalog struct {
 type MCPCatalogSpec struct {
 	DisplayName string   `json:"displayName,omitempty"`
 	SourceURLs  []string `json:"sourceURLs,omitempty"`
+	// SourceConfigs holds the signature verification and credential settings for the source URLs, keyed by source URL.
+	SourceConfigs map[string]types.MCPCatalogSourceConfig `json:"sourceConfigs,omitempty"`
+	// StagedRollout records new versions of the catalog's entries without releasing them.
+	StagedRollout bool `json:"stagedRollout,omitempty"`
 }
 
 type MCPCatalogStatus struct {
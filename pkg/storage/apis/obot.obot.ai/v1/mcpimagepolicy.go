@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type MCPImagePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MCPImagePolicySpec   `json:"spec,omitempty"`
+	Status MCPImagePolicyStatus `json:"status,omitempty"`
+}
+
+type MCPImagePolicySpec struct {
+	Manifest types.MCPImagePolicyManifest `json:"manifest,omitempty"`
+}
+
+type MCPImagePolicyStatus struct{}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type MCPImagePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []MCPImagePolicy `json:"items"`
+}
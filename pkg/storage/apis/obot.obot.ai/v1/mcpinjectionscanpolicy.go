@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type MCPInjectionScanPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MCPInjectionScanPolicySpec   `json:"spec,omitempty"`
+	Status MCPInjectionScanPolicyStatus `json:"status,omitempty"`
+}
+
+type MCPInjectionScanPolicySpec struct {
+	Manifest types.MCPInjectionScanPolicyManifest `json:"manifest,omitempty"`
+}
+
+type MCPInjectionScanPolicyStatus struct{}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type MCPInjectionScanPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []MCPInjectionScanPolicy `json:"items"`
+}
@@ -109,6 +109,11 @@ type MCPServerSpec struct {
 	MCPCatalogID string `json:"mcpCatalogID,omitempty"`
 	// MCPServerCatalogEntryName contains the name of the MCPServerCatalogEntry from which this MCP server was created, if there is one.
 	MCPServerCatalogEntryName string `json:"mcpServerCatalogEntryName,omitempty"`
+	// MCPServerCatalogEntryVersion is the version of the catalog entry that this server's manifest came from, if there is one.
+	MCPServerCatalogEntryVersion int `json:"mcpServerCatalogEntryVersion,omitempty"`
+	// MCPServerCatalogEntryVersionPinned indicates that the server should stay on its catalog entry version
+	// and not be offered updates.
+	MCPServerCatalogEntryVersionPinned bool `json:"mcpServerCatalogEntryVersionPinned,omitempty"`
 	// NeedsURL indicates whether the server's URL needs to be updated to match the catalog entry.
 	NeedsURL bool `json:"needsURL,omitempty"`
 	// PreviousURL contains the URL of the server before it was updated to match the catalog entry.
@@ -120,6 +125,11 @@ type MCPServerSpec struct {
 	Template bool `json:"template,omitempty"`
 	// CompositeName is the name of the composite server that this MCP server is a component of, if there is one.
 	CompositeName string `json:"compositeName,omitempty"`
+	// IdleTimeoutMinutes is the number of minutes without requests after which the server is scaled to zero.
+	// If nil, the default idle timeout is used. If zero, the server is never scaled to zero.
+	IdleTimeoutMinutes *int `json:"idleTimeoutMinutes,omitempty"`
+	// Scaling configures horizontal scaling for multi-user servers. If nil, the server runs a single replica.
+	Scaling *types.MCPServerScaling `json:"scaling,omitempty"`
 }
 
 type MCPServerStatus struct {
@@ -127,6 +137,8 @@ type MCPServerStatus struct {
 	MCPCatalogID string `json:"mcpCatalogID,omitempty"`
 	// NeedsUpdate indicates whether the configuration in this server's catalog entry has drift from this server's configuration.
 	NeedsUpdate bool `json:"needsUpdate,omitempty"`
+	// AvailableCatalogEntryVersion is the catalog entry version that this server would be updated to, if there is one.
+	AvailableCatalogEntryVersion int `json:"availableCatalogEntryVersion,omitempty"`
 	// MCPServerInstanceUserCount contains the number of unique users with server instances pointing to this MCP server.
 	MCPServerInstanceUserCount *int `json:"mcpInstanceUserCount,omitempty"`
 	// DeploymentStatus indicates the overall status of the MCP server deployment (Ready, Progressing, Failed).
@@ -66,6 +66,9 @@ type MCPServerCatalogEntrySpec struct {
 	SourceURL        string                              `json:"sourceURL,omitempty"`
 	// PowerUserWorkspaceID contains the name of the PowerUserWorkspace that owns this catalog entry, if there is one.
 	PowerUserWorkspaceID string `json:"powerUserWorkspaceID,omitempty"`
+	// StagedRollout records new versions of this catalog entry without releasing them.
+	// It is kept out of the manifest so that changing it doesn't record a new version.
+	StagedRollout bool `json:"stagedRollout,omitempty"`
 }
 
 type MCPServerCatalogEntryStatus struct {
@@ -79,6 +82,12 @@ type MCPServerCatalogEntryStatus struct {
 	ManifestHash string `json:"manifestHash,omitempty"`
 	// NeedsUpdate indicates whether this composite catalog entry's component snapshots have drifted from their sources.
 	NeedsUpdate bool `json:"needsUpdate,omitempty"`
+	// LatestVersion is the newest recorded version of this catalog entry.
+	LatestVersion int `json:"latestVersion,omitempty"`
+	// ReleasedVersion is the newest version of this catalog entry that is released to all users.
+	ReleasedVersion int `json:"releasedVersion,omitempty"`
+	// ToolPreviewScanFindings is what the injection scanner flagged in the tool previews of this catalog entry.
+	ToolPreviewScanFindings []types.MCPInjectionScanFinding `json:"toolPreviewScanFindings,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
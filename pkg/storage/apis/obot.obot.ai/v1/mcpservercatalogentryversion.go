@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ DeleteRefs = (*MCPServerCatalogEntryVersion)(nil)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MCPServerCatalogEntryVersion is an immutable snapshot of a catalog entry's manifest.
+// A new version is recorded every time the entry's manifest changes.
+type MCPServerCatalogEntryVersion struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec MCPServerCatalogEntryVersionSpec `json:"spec,omitempty"`
+}
+
+func (in *MCPServerCatalogEntryVersion) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"Entry", "Spec.MCPServerCatalogEntryName"},
+		{"Version", "Spec.Version"},
+		{"Released", "Spec.Released"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+}
+
+func (in *MCPServerCatalogEntryVersion) Has(field string) bool {
+	return slices.Contains(in.FieldNames(), field)
+}
+
+func (in *MCPServerCatalogEntryVersion) Get(field string) string {
+	switch field {
+	case "spec.mcpServerCatalogEntryName":
+		return in.Spec.MCPServerCatalogEntryName
+	case "spec.version":
+		return strconv.Itoa(in.Spec.Version)
+	}
+	return ""
+}
+
+func (in *MCPServerCatalogEntryVersion) FieldNames() []string {
+	return []string{
+		"spec.mcpServerCatalogEntryName",
+		"spec.version",
+	}
+}
+
+func (in *MCPServerCatalogEntryVersion) DeleteRefs() []Ref {
+	return []Ref{
+		{ObjType: &MCPServerCatalogEntry{}, Name: in.Spec.MCPServerCatalogEntryName},
+	}
+}
+
+// AvailableTo returns true if the version is released, or is being rolled out to the given user.
+func (in *MCPServerCatalogEntryVersion) AvailableTo(userID string) bool {
+	return in.Spec.Released || slices.Contains(in.Spec.RolloutUserIDs, userID)
+}
+
+type MCPServerCatalogEntryVersionSpec struct {
+	// MCPServerCatalogEntryName is the name of the catalog entry this is a version of.
+	MCPServerCatalogEntryName string `json:"mcpServerCatalogEntryName,omitempty"`
+	// Version is the version number. Versions of an entry are numbered starting at 1.
+	Version int `json:"version,omitempty"`
+	// Manifest is the entry's manifest at this version. It is never changed after the version is created.
+	Manifest types.MCPServerCatalogEntryManifest `json:"manifest,omitempty"`
+	// ManifestHash is a SHA256 hash of the manifest.
+	ManifestHash string `json:"manifestHash,omitempty"`
+	// Released indicates whether this version is offered to all users. New versions are released when they are created,
+	// unless the entry or its catalog uses staged rollouts. The first version of an entry is always released.
+	Released bool `json:"released,omitempty"`
+	// RolloutUserIDs are the users this version is offered to before it is released.
+	RolloutUserIDs []string `json:"rolloutUserIDs,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type MCPServerCatalogEntryVersionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []MCPServerCatalogEntryVersion `json:"items"`
+}
+
+// VersionFor returns the newest version that is available to the given user, or nil if there isn't one.
+func (in *MCPServerCatalogEntryVersionList) VersionFor(userID string) *MCPServerCatalogEntryVersion {
+	var result *MCPServerCatalogEntryVersion
+	for i := range in.Items {
+		if v := &in.Items[i]; v.AvailableTo(userID) && (result == nil || v.Spec.Version > result.Spec.Version) {
+			result = v
+		}
+	}
+	return result
+}
+
+// Version returns the version with the given number, or nil if there isn't one.
+func (in *MCPServerCatalogEntryVersionList) Version(version int) *MCPServerCatalogEntryVersion {
+	for i := range in.Items {
+		if in.Items[i].Spec.Version == version {
+			return &in.Items[i]
+		}
+	}
+	return nil
+}
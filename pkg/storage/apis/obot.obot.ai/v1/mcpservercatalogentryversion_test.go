@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMCPServerCatalogEntryVersionFor(t *testing.T) {
+	version := func(number int, released bool, rolloutUserIDs ...string) MCPServerCatalogEntryVersion {
+		return MCPServerCatalogEntryVersion{
+			Spec: MCPServerCatalogEntryVersionSpec{
+				Version:        number,
+				Released:       released,
+				RolloutUserIDs: rolloutUserIDs,
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name     string
+		versions []MCPServerCatalogEntryVersion
+		userID   string
+		expected int
+	}{
+		{name: "no versions", userID: "u1"},
+		{name: "newest released", versions: []MCPServerCatalogEntryVersion{version(1, true), version(3, true), version(2, true)}, userID: "u1", expected: 3},
+		{name: "staged version", versions: []MCPServerCatalogEntryVersion{version(1, true), version(2, false)}, userID: "u1", expected: 1},
+		{name: "rolled out to the user", versions: []MCPServerCatalogEntryVersion{version(1, true), version(2, false, "u1")}, userID: "u1", expected: 2},
+		{name: "rolled out to another user", versions: []MCPServerCatalogEntryVersion{version(1, true), version(2, false, "u2")}, userID: "u1", expected: 1},
+		{name: "released version newer than the rollout", versions: []MCPServerCatalogEntryVersion{version(2, false, "u1"), version(3, true)}, userID: "u1", expected: 3},
+		{name: "nothing available", versions: []MCPServerCatalogEntryVersion{version(1, false), version(2, false, "u2")}, userID: "u1"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			list := MCPServerCatalogEntryVersionList{Items: tt.versions}
+			result := list.VersionFor(tt.userID)
+			if tt.expected == 0 {
+				assert.Nil(t, result)
+				return
+			}
+			if assert.NotNil(t, result) {
+				assert.Equal(t, tt.expected, result.Spec.Version)
+				assert.True(t, result.AvailableTo(tt.userID))
+			}
+		})
+	}
+}
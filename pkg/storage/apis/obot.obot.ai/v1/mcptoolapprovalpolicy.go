@@ -0,0 +1,38 @@
+package v1
+
+import (
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type MCPToolApprovalPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec MCPToolApprovalPolicySpec `json:"spec,omitempty"`
+}
+
+type MCPToolApprovalPolicySpec struct {
+	Manifest types.MCPToolApprovalPolicyManifest `json:"manifest"`
+}
+
+func (in *MCPToolApprovalPolicy) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"Display Name", "Spec.Manifest.Name"},
+		{"Resources ", "{{len .Spec.Manifest.Resources}}"},
+		{"Approvers", "{{len .Spec.Manifest.Approvers}}"},
+		{"Disabled", "{{.Spec.Manifest.Disabled}}"},
+	}
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type MCPToolApprovalPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []MCPToolApprovalPolicy `json:"items"`
+}
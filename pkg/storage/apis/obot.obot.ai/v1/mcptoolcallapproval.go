@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"slices"
+
+	"github.com/obot-platform/nah/pkg/fields"
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ fields.Fields = (*MCPToolCallApproval)(nil)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MCPToolCallApproval is an MCP call that an MCPToolApprovalPolicy paused until it is approved or rejected.
+type MCPToolCallApproval struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MCPToolCallApprovalSpec   `json:"spec,omitempty"`
+	Status MCPToolCallApprovalStatus `json:"status,omitempty"`
+}
+
+type MCPToolCallApprovalSpec struct {
+	PolicyName string `json:"policyName,omitempty"`
+	// UserID is the ID of the user that made the call.
+	UserID               string `json:"userID,omitempty"`
+	MCPID                string `json:"mcpID,omitempty"`
+	MCPServerDisplayName string `json:"mcpServerDisplayName,omitempty"`
+	// SessionID and RequestID identify the call in the audit logs of the MCP server, when they are known.
+	SessionID  string `json:"sessionID,omitempty"`
+	RequestID  string `json:"requestID,omitempty"`
+	Method     string `json:"method,omitempty"`
+	Identifier string `json:"identifier,omitempty"`
+	Arguments  string `json:"arguments,omitempty"`
+	// Approvers are copied from the policy, so that changing the policy doesn't change who can decide on paused calls.
+	Approvers      []types.Subject                `json:"approvers,omitempty"`
+	State          types.MCPToolCallApprovalState `json:"state,omitempty"`
+	ExpiresAt      metav1.Time                    `json:"expiresAt"`
+	DecidedBy      string                         `json:"decidedBy,omitempty"`
+	DecisionReason string                         `json:"decisionReason,omitempty"`
+	DecidedAt      *metav1.Time                   `json:"decidedAt,omitempty"`
+}
+
+type MCPToolCallApprovalStatus struct {
+	// EndedAt is the time the call was approved, rejected, or expired.
+	EndedAt *metav1.Time `json:"endedAt,omitempty"`
+}
+
+func (in *MCPToolCallApproval) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"User", "Spec.UserID"},
+		{"Server", "Spec.MCPID"},
+		{"Identifier", "Spec.Identifier"},
+		{"State", "Spec.State"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+}
+
+func (in *MCPToolCallApproval) Has(field string) (exists bool) {
+	return slices.Contains(in.FieldNames(), field)
+}
+
+func (in *MCPToolCallApproval) Get(field string) (value string) {
+	switch field {
+	case "spec.userID":
+		return in.Spec.UserID
+	case "spec.mcpID":
+		return in.Spec.MCPID
+	case "spec.state":
+		return string(in.Spec.State)
+	}
+	return ""
+}
+
+func (in *MCPToolCallApproval) FieldNames() []string {
+	return []string{
+		"spec.userID",
+		"spec.mcpID",
+		"spec.state",
+	}
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type MCPToolCallApprovalList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []MCPToolCallApproval `json:"items"`
+}
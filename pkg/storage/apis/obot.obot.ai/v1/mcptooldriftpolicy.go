@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type MCPToolDriftPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MCPToolDriftPolicySpec   `json:"spec,omitempty"`
+	Status MCPToolDriftPolicyStatus `json:"status,omitempty"`
+}
+
+type MCPToolDriftPolicySpec struct {
+	Manifest types.MCPToolDriftPolicyManifest `json:"manifest,omitempty"`
+}
+
+type MCPToolDriftPolicyStatus struct{}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type MCPToolDriftPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []MCPToolDriftPolicy `json:"items"`
+}
@@ -7,23 +7,24 @@ import (
 )
 
 const (
-	RunFinalizer               = "obot.obot.ai/run"
-	ThreadFinalizer            = "obot.obot.ai/thread"
-	KnowledgeFileFinalizer     = "obot.obot.ai/knowledge-file"
-	WorkspaceFinalizer         = "obot.obot.ai/workspace"
-	KnowledgeSetFinalizer      = "obot.obot.ai/knowledge-set"
-	KnowledgeSourceFinalizer   = "obot.obot.ai/knowledge-source"
-	ToolReferenceFinalizer     = "obot.obot.ai/tool-reference"
-	AgentFinalizer             = "obot.obot.ai/agent"
-	WorkflowFinalizer          = "obot.obot.ai/workflow"
-	MCPServerFinalizer         = "obot.obot.ai/mcp-server"
-	MCPServerInstanceFinalizer = "obot.obot.ai/mcp-server-instance"
-	ProjectMCPServerFinalizer  = "obot.obot.ai/project-mcp-server"
-	SlackReceiverFinalizer     = "obot.obot.ai/slack-receiver"
-	MCPSessionFinalizer        = "obot.obot.ai/mcp-session"
-	OAuthClientFinalizer       = "obot.obot.ai/oauth-client"
-	AccessControlRuleFinalizer = "obot.obot.ai/access-control-rule"
-	SystemMCPServerFinalizer   = "obot.obot.ai/system-mcp-server"
+	RunFinalizer                   = "obot.obot.ai/run"
+	ThreadFinalizer                = "obot.obot.ai/thread"
+	KnowledgeFileFinalizer         = "obot.obot.ai/knowledge-file"
+	WorkspaceFinalizer             = "obot.obot.ai/workspace"
+	KnowledgeSetFinalizer          = "obot.obot.ai/knowledge-set"
+	KnowledgeSourceFinalizer       = "obot.obot.ai/knowledge-source"
+	ToolReferenceFinalizer         = "obot.obot.ai/tool-reference"
+	AgentFinalizer                 = "obot.obot.ai/agent"
+	WorkflowFinalizer              = "obot.obot.ai/workflow"
+	MCPServerFinalizer             = "obot.obot.ai/mcp-server"
+	MCPServerInstanceFinalizer     = "obot.obot.ai/mcp-server-instance"
+	ProjectMCPServerFinalizer      = "obot.obot.ai/project-mcp-server"
+	SlackReceiverFinalizer         = "obot.obot.ai/slack-receiver"
+	MCPSessionFinalizer            = "obot.obot.ai/mcp-session"
+	OAuthClientFinalizer           = "obot.obot.ai/oauth-client"
+	AccessControlRuleFinalizer     = "obot.obot.ai/access-control-rule"
+	SystemMCPServerFinalizer       = "obot.obot.ai/system-mcp-server"
+	MCPServerCatalogEntryFinalizer = "obot.obot.ai/mcp-server-catalog-entry"
 
 	ModelProviderSyncAnnotation       = "obot.ai/model-provider-sync"
 	WorkflowSyncAnnotation            = "obot.ai/workflow-sync"
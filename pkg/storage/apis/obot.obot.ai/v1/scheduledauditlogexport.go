@@ -52,6 +52,7 @@ func (*ScheduledAuditLogExport) GetColumns() [][]string {
 
 type ScheduledAuditLogExportSpec struct {
 	Name                   string                      `json:"name"`
+	Type                   types.AuditLogExportType    `json:"type,omitempty"`
 	Bucket                 string                      `json:"bucket"`
 	KeyPrefix              string                      `json:"keyPrefix,omitempty"`
 	Enabled                bool                        `json:"enabled"`
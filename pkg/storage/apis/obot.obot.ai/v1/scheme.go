@@ -33,6 +33,8 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&ProjectMCPServerList{},
 		&MCPServerCatalogEntry{},
 		&MCPServerCatalogEntryList{},
+		&MCPServerCatalogEntryVersion{},
+		&MCPServerCatalogEntryVersionList{},
 		&Run{},
 		&RunList{},
 		&RunState{},
@@ -95,6 +97,8 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&OAuthTokenList{},
 		&AccessControlRule{},
 		&AccessControlRuleList{},
+		&MCPAccessRequest{},
+		&MCPAccessRequestList{},
 		&MCPSession{},
 		&MCPSessionList{},
 		&MCPWebhookValidation{},
@@ -105,6 +109,20 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&UserDefaultRoleSettingList{},
 		&K8sSettings{},
 		&K8sSettingsList{},
+		&MCPImagePolicy{},
+		&MCPImagePolicyList{},
+		&MCPInjectionScanPolicy{},
+		&MCPInjectionScanPolicyList{},
+		&MCPToolDriftPolicy{},
+		&MCPToolDriftPolicyList{},
+		&MCPAuditLogRetentionPolicy{},
+		&MCPAuditLogRetentionPolicyList{},
+		&MCPAuditLogSavedSearch{},
+		&MCPAuditLogSavedSearchList{},
+		&MCPToolApprovalPolicy{},
+		&MCPToolApprovalPolicyList{},
+		&MCPToolCallApproval{},
+		&MCPToolCallApprovalList{},
 		&AppPreferences{},
 		&AppPreferencesList{},
 		&AuditLogExport{},
@@ -73,6 +73,10 @@ func (in *AccessControlRuleList) DeepCopyObject() runtime.Object {
 func (in *AccessControlRuleSpec) DeepCopyInto(out *AccessControlRuleSpec) {
 	*out = *in
 	in.Manifest.DeepCopyInto(&out.Manifest)
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessControlRuleSpec.
@@ -1320,7 +1324,7 @@ func (in *KnowledgeSummaryStatus) DeepCopy() *KnowledgeSummaryStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPCatalog) DeepCopyInto(out *MCPCatalog) {
+func (in *MCPAccessRequest) DeepCopyInto(out *MCPAccessRequest) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1328,18 +1332,18 @@ func (in *MCPCatalog) DeepCopyInto(out *MCPCatalog) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalog.
-func (in *MCPCatalog) DeepCopy() *MCPCatalog {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAccessRequest.
+func (in *MCPAccessRequest) DeepCopy() *MCPAccessRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPCatalog)
+	out := new(MCPAccessRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MCPCatalog) DeepCopyObject() runtime.Object {
+func (in *MCPAccessRequest) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1347,31 +1351,31 @@ func (in *MCPCatalog) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPCatalogList) DeepCopyInto(out *MCPCatalogList) {
+func (in *MCPAccessRequestList) DeepCopyInto(out *MCPAccessRequestList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MCPCatalog, len(*in))
+		*out = make([]MCPAccessRequest, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogList.
-func (in *MCPCatalogList) DeepCopy() *MCPCatalogList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAccessRequestList.
+func (in *MCPAccessRequestList) DeepCopy() *MCPAccessRequestList {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPCatalogList)
+	out := new(MCPAccessRequestList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MCPCatalogList) DeepCopyObject() runtime.Object {
+func (in *MCPAccessRequestList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1379,77 +1383,50 @@ func (in *MCPCatalogList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPCatalogSpec) DeepCopyInto(out *MCPCatalogSpec) {
+func (in *MCPAccessRequestSpec) DeepCopyInto(out *MCPAccessRequestSpec) {
 	*out = *in
-	if in.SourceURLs != nil {
-		in, out := &in.SourceURLs, &out.SourceURLs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogSpec.
-func (in *MCPCatalogSpec) DeepCopy() *MCPCatalogSpec {
-	if in == nil {
-		return nil
+	out.Manifest = in.Manifest
+	if in.DecidedAt != nil {
+		in, out := &in.DecidedAt, &out.DecidedAt
+		*out = (*in).DeepCopy()
 	}
-	out := new(MCPCatalogSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPCatalogStatus) DeepCopyInto(out *MCPCatalogStatus) {
-	*out = *in
-	in.LastSyncTime.DeepCopyInto(&out.LastSyncTime)
-	if in.SyncErrors != nil {
-		in, out := &in.SyncErrors, &out.SyncErrors
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogStatus.
-func (in *MCPCatalogStatus) DeepCopy() *MCPCatalogStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAccessRequestSpec.
+func (in *MCPAccessRequestSpec) DeepCopy() *MCPAccessRequestSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPCatalogStatus)
+	out := new(MCPAccessRequestSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServer) DeepCopyInto(out *MCPServer) {
+func (in *MCPAccessRequestStatus) DeepCopyInto(out *MCPAccessRequestStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.EndedAt != nil {
+		in, out := &in.EndedAt, &out.EndedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServer.
-func (in *MCPServer) DeepCopy() *MCPServer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAccessRequestStatus.
+func (in *MCPAccessRequestStatus) DeepCopy() *MCPAccessRequestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServer)
+	out := new(MCPAccessRequestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MCPServer) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerCatalogEntry) DeepCopyInto(out *MCPServerCatalogEntry) {
+func (in *MCPAuditLogRetentionPolicy) DeepCopyInto(out *MCPAuditLogRetentionPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1457,18 +1434,18 @@ func (in *MCPServerCatalogEntry) DeepCopyInto(out *MCPServerCatalogEntry) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntry.
-func (in *MCPServerCatalogEntry) DeepCopy() *MCPServerCatalogEntry {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogRetentionPolicy.
+func (in *MCPAuditLogRetentionPolicy) DeepCopy() *MCPAuditLogRetentionPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerCatalogEntry)
+	out := new(MCPAuditLogRetentionPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MCPServerCatalogEntry) DeepCopyObject() runtime.Object {
+func (in *MCPAuditLogRetentionPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1476,31 +1453,31 @@ func (in *MCPServerCatalogEntry) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerCatalogEntryList) DeepCopyInto(out *MCPServerCatalogEntryList) {
+func (in *MCPAuditLogRetentionPolicyList) DeepCopyInto(out *MCPAuditLogRetentionPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MCPServerCatalogEntry, len(*in))
+		*out = make([]MCPAuditLogRetentionPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryList.
-func (in *MCPServerCatalogEntryList) DeepCopy() *MCPServerCatalogEntryList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogRetentionPolicyList.
+func (in *MCPAuditLogRetentionPolicyList) DeepCopy() *MCPAuditLogRetentionPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerCatalogEntryList)
+	out := new(MCPAuditLogRetentionPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MCPServerCatalogEntryList) DeepCopyObject() runtime.Object {
+func (in *MCPAuditLogRetentionPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1508,69 +1485,61 @@ func (in *MCPServerCatalogEntryList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerCatalogEntrySpec) DeepCopyInto(out *MCPServerCatalogEntrySpec) {
+func (in *MCPAuditLogRetentionPolicySpec) DeepCopyInto(out *MCPAuditLogRetentionPolicySpec) {
 	*out = *in
 	in.Manifest.DeepCopyInto(&out.Manifest)
-	if in.UnsupportedTools != nil {
-		in, out := &in.UnsupportedTools, &out.UnsupportedTools
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntrySpec.
-func (in *MCPServerCatalogEntrySpec) DeepCopy() *MCPServerCatalogEntrySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogRetentionPolicySpec.
+func (in *MCPAuditLogRetentionPolicySpec) DeepCopy() *MCPAuditLogRetentionPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerCatalogEntrySpec)
+	out := new(MCPAuditLogRetentionPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerCatalogEntryStatus) DeepCopyInto(out *MCPServerCatalogEntryStatus) {
+func (in *MCPAuditLogRetentionPolicyStatus) DeepCopyInto(out *MCPAuditLogRetentionPolicyStatus) {
 	*out = *in
-	if in.LastUpdated != nil {
-		in, out := &in.LastUpdated, &out.LastUpdated
-		*out = (*in).DeepCopy()
-	}
-	if in.ToolPreviewsLastGenerated != nil {
-		in, out := &in.ToolPreviewsLastGenerated, &out.ToolPreviewsLastGenerated
+	if in.LastRunAt != nil {
+		in, out := &in.LastRunAt, &out.LastRunAt
 		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryStatus.
-func (in *MCPServerCatalogEntryStatus) DeepCopy() *MCPServerCatalogEntryStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogRetentionPolicyStatus.
+func (in *MCPAuditLogRetentionPolicyStatus) DeepCopy() *MCPAuditLogRetentionPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerCatalogEntryStatus)
+	out := new(MCPAuditLogRetentionPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerInstance) DeepCopyInto(out *MCPServerInstance) {
+func (in *MCPAuditLogSavedSearch) DeepCopyInto(out *MCPAuditLogSavedSearch) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = in.Spec
+	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerInstance.
-func (in *MCPServerInstance) DeepCopy() *MCPServerInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogSavedSearch.
+func (in *MCPAuditLogSavedSearch) DeepCopy() *MCPAuditLogSavedSearch {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerInstance)
+	out := new(MCPAuditLogSavedSearch)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MCPServerInstance) DeepCopyObject() runtime.Object {
+func (in *MCPAuditLogSavedSearch) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1578,31 +1547,31 @@ func (in *MCPServerInstance) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerInstanceList) DeepCopyInto(out *MCPServerInstanceList) {
+func (in *MCPAuditLogSavedSearchList) DeepCopyInto(out *MCPAuditLogSavedSearchList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MCPServerInstance, len(*in))
+		*out = make([]MCPAuditLogSavedSearch, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerInstanceList.
-func (in *MCPServerInstanceList) DeepCopy() *MCPServerInstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogSavedSearchList.
+func (in *MCPAuditLogSavedSearchList) DeepCopy() *MCPAuditLogSavedSearchList {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerInstanceList)
+	out := new(MCPAuditLogSavedSearchList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MCPServerInstanceList) DeepCopyObject() runtime.Object {
+func (in *MCPAuditLogSavedSearchList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1610,46 +1579,74 @@ func (in *MCPServerInstanceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerInstanceSpec) DeepCopyInto(out *MCPServerInstanceSpec) {
+func (in *MCPAuditLogSavedSearchSpec) DeepCopyInto(out *MCPAuditLogSavedSearchSpec) {
 	*out = *in
+	out.Manifest = in.Manifest
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerInstanceSpec.
-func (in *MCPServerInstanceSpec) DeepCopy() *MCPServerInstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPAuditLogSavedSearchSpec.
+func (in *MCPAuditLogSavedSearchSpec) DeepCopy() *MCPAuditLogSavedSearchSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerInstanceSpec)
+	out := new(MCPAuditLogSavedSearchSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerList) DeepCopyInto(out *MCPServerList) {
+func (in *MCPCatalog) DeepCopyInto(out *MCPCatalog) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalog.
+func (in *MCPCatalog) DeepCopy() *MCPCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPCatalog) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCatalogList) DeepCopyInto(out *MCPCatalogList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MCPServer, len(*in))
+		*out = make([]MCPCatalog, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerList.
-func (in *MCPServerList) DeepCopy() *MCPServerList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogList.
+func (in *MCPCatalogList) DeepCopy() *MCPCatalogList {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerList)
+	out := new(MCPCatalogList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MCPServerList) DeepCopyObject() runtime.Object {
+func (in *MCPCatalogList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1657,89 +1654,76 @@ func (in *MCPServerList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerSpec) DeepCopyInto(out *MCPServerSpec) {
+func (in *MCPCatalogSpec) DeepCopyInto(out *MCPCatalogSpec) {
 	*out = *in
-	in.Manifest.DeepCopyInto(&out.Manifest)
-	if in.UnsupportedTools != nil {
-		in, out := &in.UnsupportedTools, &out.UnsupportedTools
+	if in.SourceURLs != nil {
+		in, out := &in.SourceURLs, &out.SourceURLs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SourceConfigs != nil {
+		in, out := &in.SourceConfigs, &out.SourceConfigs
+		*out = make(map[string]types.MCPCatalogSourceConfig, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerSpec.
-func (in *MCPServerSpec) DeepCopy() *MCPServerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogSpec.
+func (in *MCPCatalogSpec) DeepCopy() *MCPCatalogSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerSpec)
+	out := new(MCPCatalogSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerStatus) DeepCopyInto(out *MCPServerStatus) {
+func (in *MCPCatalogStatus) DeepCopyInto(out *MCPCatalogStatus) {
 	*out = *in
-	if in.MCPServerInstanceUserCount != nil {
-		in, out := &in.MCPServerInstanceUserCount, &out.MCPServerInstanceUserCount
-		*out = new(int)
-		**out = **in
-	}
-	if in.DeploymentAvailableReplicas != nil {
-		in, out := &in.DeploymentAvailableReplicas, &out.DeploymentAvailableReplicas
-		*out = new(int32)
-		**out = **in
-	}
-	if in.DeploymentReadyReplicas != nil {
-		in, out := &in.DeploymentReadyReplicas, &out.DeploymentReadyReplicas
-		*out = new(int32)
-		**out = **in
-	}
-	if in.DeploymentReplicas != nil {
-		in, out := &in.DeploymentReplicas, &out.DeploymentReplicas
-		*out = new(int32)
-		**out = **in
-	}
-	if in.DeploymentConditions != nil {
-		in, out := &in.DeploymentConditions, &out.DeploymentConditions
-		*out = make([]DeploymentCondition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	in.LastSyncTime.DeepCopyInto(&out.LastSyncTime)
+	if in.SyncErrors != nil {
+		in, out := &in.SyncErrors, &out.SyncErrors
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerStatus.
-func (in *MCPServerStatus) DeepCopy() *MCPServerStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogStatus.
+func (in *MCPCatalogStatus) DeepCopy() *MCPCatalogStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerStatus)
+	out := new(MCPCatalogStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPSession) DeepCopyInto(out *MCPSession) {
+func (in *MCPImagePolicy) DeepCopyInto(out *MCPImagePolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPSession.
-func (in *MCPSession) DeepCopy() *MCPSession {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPImagePolicy.
+func (in *MCPImagePolicy) DeepCopy() *MCPImagePolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPSession)
+	out := new(MCPImagePolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MCPSession) DeepCopyObject() runtime.Object {
+func (in *MCPImagePolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1747,31 +1731,31 @@ func (in *MCPSession) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPSessionList) DeepCopyInto(out *MCPSessionList) {
+func (in *MCPImagePolicyList) DeepCopyInto(out *MCPImagePolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MCPSession, len(*in))
+		*out = make([]MCPImagePolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPSessionList.
-func (in *MCPSessionList) DeepCopy() *MCPSessionList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPImagePolicyList.
+func (in *MCPImagePolicyList) DeepCopy() *MCPImagePolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPSessionList)
+	out := new(MCPImagePolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MCPSessionList) DeepCopyObject() runtime.Object {
+func (in *MCPImagePolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1779,37 +1763,876 @@ func (in *MCPSessionList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPSessionSpec) DeepCopyInto(out *MCPSessionSpec) {
+func (in *MCPImagePolicySpec) DeepCopyInto(out *MCPImagePolicySpec) {
 	*out = *in
-	if in.State != nil {
-		in, out := &in.State, &out.State
-		*out = make([]byte, len(*in))
-		copy(*out, *in)
+	in.Manifest.DeepCopyInto(&out.Manifest)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPImagePolicySpec.
+func (in *MCPImagePolicySpec) DeepCopy() *MCPImagePolicySpec {
+	if in == nil {
+		return nil
 	}
+	out := new(MCPImagePolicySpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPSessionSpec.
-func (in *MCPSessionSpec) DeepCopy() *MCPSessionSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPImagePolicyStatus) DeepCopyInto(out *MCPImagePolicyStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPImagePolicyStatus.
+func (in *MCPImagePolicyStatus) DeepCopy() *MCPImagePolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPSessionSpec)
+	out := new(MCPImagePolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPSessionStatus) DeepCopyInto(out *MCPSessionStatus) {
+func (in *MCPInjectionScanPolicy) DeepCopyInto(out *MCPInjectionScanPolicy) {
 	*out = *in
-	in.LastUsedTime.DeepCopyInto(&out.LastUsedTime)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPSessionStatus.
-func (in *MCPSessionStatus) DeepCopy() *MCPSessionStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPInjectionScanPolicy.
+func (in *MCPInjectionScanPolicy) DeepCopy() *MCPInjectionScanPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPSessionStatus)
+	out := new(MCPInjectionScanPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPInjectionScanPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPInjectionScanPolicyList) DeepCopyInto(out *MCPInjectionScanPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPInjectionScanPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPInjectionScanPolicyList.
+func (in *MCPInjectionScanPolicyList) DeepCopy() *MCPInjectionScanPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPInjectionScanPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPInjectionScanPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPInjectionScanPolicySpec) DeepCopyInto(out *MCPInjectionScanPolicySpec) {
+	*out = *in
+	in.Manifest.DeepCopyInto(&out.Manifest)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPInjectionScanPolicySpec.
+func (in *MCPInjectionScanPolicySpec) DeepCopy() *MCPInjectionScanPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPInjectionScanPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPInjectionScanPolicyStatus) DeepCopyInto(out *MCPInjectionScanPolicyStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPInjectionScanPolicyStatus.
+func (in *MCPInjectionScanPolicyStatus) DeepCopy() *MCPInjectionScanPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPInjectionScanPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServer) DeepCopyInto(out *MCPServer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServer.
+func (in *MCPServer) DeepCopy() *MCPServer {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPServer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntry) DeepCopyInto(out *MCPServerCatalogEntry) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntry.
+func (in *MCPServerCatalogEntry) DeepCopy() *MCPServerCatalogEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPServerCatalogEntry) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryList) DeepCopyInto(out *MCPServerCatalogEntryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServerCatalogEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryList.
+func (in *MCPServerCatalogEntryList) DeepCopy() *MCPServerCatalogEntryList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPServerCatalogEntryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntrySpec) DeepCopyInto(out *MCPServerCatalogEntrySpec) {
+	*out = *in
+	in.Manifest.DeepCopyInto(&out.Manifest)
+	if in.UnsupportedTools != nil {
+		in, out := &in.UnsupportedTools, &out.UnsupportedTools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntrySpec.
+func (in *MCPServerCatalogEntrySpec) DeepCopy() *MCPServerCatalogEntrySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntrySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryStatus) DeepCopyInto(out *MCPServerCatalogEntryStatus) {
+	*out = *in
+	if in.LastUpdated != nil {
+		in, out := &in.LastUpdated, &out.LastUpdated
+		*out = (*in).DeepCopy()
+	}
+	if in.ToolPreviewsLastGenerated != nil {
+		in, out := &in.ToolPreviewsLastGenerated, &out.ToolPreviewsLastGenerated
+		*out = (*in).DeepCopy()
+	}
+	if in.ToolPreviewScanFindings != nil {
+		in, out := &in.ToolPreviewScanFindings, &out.ToolPreviewScanFindings
+		*out = make([]types.MCPInjectionScanFinding, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryStatus.
+func (in *MCPServerCatalogEntryStatus) DeepCopy() *MCPServerCatalogEntryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryVersion) DeepCopyInto(out *MCPServerCatalogEntryVersion) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryVersion.
+func (in *MCPServerCatalogEntryVersion) DeepCopy() *MCPServerCatalogEntryVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPServerCatalogEntryVersion) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryVersionList) DeepCopyInto(out *MCPServerCatalogEntryVersionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServerCatalogEntryVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryVersionList.
+func (in *MCPServerCatalogEntryVersionList) DeepCopy() *MCPServerCatalogEntryVersionList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryVersionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPServerCatalogEntryVersionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerCatalogEntryVersionSpec) DeepCopyInto(out *MCPServerCatalogEntryVersionSpec) {
+	*out = *in
+	in.Manifest.DeepCopyInto(&out.Manifest)
+	if in.RolloutUserIDs != nil {
+		in, out := &in.RolloutUserIDs, &out.RolloutUserIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntryVersionSpec.
+func (in *MCPServerCatalogEntryVersionSpec) DeepCopy() *MCPServerCatalogEntryVersionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerCatalogEntryVersionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerInstance) DeepCopyInto(out *MCPServerInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerInstance.
+func (in *MCPServerInstance) DeepCopy() *MCPServerInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPServerInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerInstanceList) DeepCopyInto(out *MCPServerInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServerInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerInstanceList.
+func (in *MCPServerInstanceList) DeepCopy() *MCPServerInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPServerInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerInstanceSpec) DeepCopyInto(out *MCPServerInstanceSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerInstanceSpec.
+func (in *MCPServerInstanceSpec) DeepCopy() *MCPServerInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerList) DeepCopyInto(out *MCPServerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerList.
+func (in *MCPServerList) DeepCopy() *MCPServerList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPServerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerSpec) DeepCopyInto(out *MCPServerSpec) {
+	*out = *in
+	in.Manifest.DeepCopyInto(&out.Manifest)
+	if in.UnsupportedTools != nil {
+		in, out := &in.UnsupportedTools, &out.UnsupportedTools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IdleTimeoutMinutes != nil {
+		in, out := &in.IdleTimeoutMinutes, &out.IdleTimeoutMinutes
+		*out = new(int)
+		**out = **in
+	}
+	if in.Scaling != nil {
+		in, out := &in.Scaling, &out.Scaling
+		*out = new(types.MCPServerScaling)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerSpec.
+func (in *MCPServerSpec) DeepCopy() *MCPServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerStatus) DeepCopyInto(out *MCPServerStatus) {
+	*out = *in
+	if in.MCPServerInstanceUserCount != nil {
+		in, out := &in.MCPServerInstanceUserCount, &out.MCPServerInstanceUserCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.DeploymentAvailableReplicas != nil {
+		in, out := &in.DeploymentAvailableReplicas, &out.DeploymentAvailableReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeploymentReadyReplicas != nil {
+		in, out := &in.DeploymentReadyReplicas, &out.DeploymentReadyReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeploymentReplicas != nil {
+		in, out := &in.DeploymentReplicas, &out.DeploymentReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeploymentConditions != nil {
+		in, out := &in.DeploymentConditions, &out.DeploymentConditions
+		*out = make([]DeploymentCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerStatus.
+func (in *MCPServerStatus) DeepCopy() *MCPServerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPSession) DeepCopyInto(out *MCPSession) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPSession.
+func (in *MCPSession) DeepCopy() *MCPSession {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPSession) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPSessionList) DeepCopyInto(out *MCPSessionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPSession, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPSessionList.
+func (in *MCPSessionList) DeepCopy() *MCPSessionList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPSessionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPSessionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPSessionSpec) DeepCopyInto(out *MCPSessionSpec) {
+	*out = *in
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPSessionSpec.
+func (in *MCPSessionSpec) DeepCopy() *MCPSessionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPSessionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPSessionStatus) DeepCopyInto(out *MCPSessionStatus) {
+	*out = *in
+	in.LastUsedTime.DeepCopyInto(&out.LastUsedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPSessionStatus.
+func (in *MCPSessionStatus) DeepCopy() *MCPSessionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPSessionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolApprovalPolicy) DeepCopyInto(out *MCPToolApprovalPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolApprovalPolicy.
+func (in *MCPToolApprovalPolicy) DeepCopy() *MCPToolApprovalPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolApprovalPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPToolApprovalPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolApprovalPolicyList) DeepCopyInto(out *MCPToolApprovalPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPToolApprovalPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolApprovalPolicyList.
+func (in *MCPToolApprovalPolicyList) DeepCopy() *MCPToolApprovalPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolApprovalPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPToolApprovalPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolApprovalPolicySpec) DeepCopyInto(out *MCPToolApprovalPolicySpec) {
+	*out = *in
+	in.Manifest.DeepCopyInto(&out.Manifest)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolApprovalPolicySpec.
+func (in *MCPToolApprovalPolicySpec) DeepCopy() *MCPToolApprovalPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolApprovalPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolCallApproval) DeepCopyInto(out *MCPToolCallApproval) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolCallApproval.
+func (in *MCPToolCallApproval) DeepCopy() *MCPToolCallApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolCallApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPToolCallApproval) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolCallApprovalList) DeepCopyInto(out *MCPToolCallApprovalList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPToolCallApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolCallApprovalList.
+func (in *MCPToolCallApprovalList) DeepCopy() *MCPToolCallApprovalList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolCallApprovalList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPToolCallApprovalList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolCallApprovalSpec) DeepCopyInto(out *MCPToolCallApprovalSpec) {
+	*out = *in
+	if in.Approvers != nil {
+		in, out := &in.Approvers, &out.Approvers
+		*out = make([]types.Subject, len(*in))
+		copy(*out, *in)
+	}
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+	if in.DecidedAt != nil {
+		in, out := &in.DecidedAt, &out.DecidedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolCallApprovalSpec.
+func (in *MCPToolCallApprovalSpec) DeepCopy() *MCPToolCallApprovalSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolCallApprovalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolCallApprovalStatus) DeepCopyInto(out *MCPToolCallApprovalStatus) {
+	*out = *in
+	if in.EndedAt != nil {
+		in, out := &in.EndedAt, &out.EndedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolCallApprovalStatus.
+func (in *MCPToolCallApprovalStatus) DeepCopy() *MCPToolCallApprovalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolCallApprovalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolDriftPolicy) DeepCopyInto(out *MCPToolDriftPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolDriftPolicy.
+func (in *MCPToolDriftPolicy) DeepCopy() *MCPToolDriftPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolDriftPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPToolDriftPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolDriftPolicyList) DeepCopyInto(out *MCPToolDriftPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPToolDriftPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolDriftPolicyList.
+func (in *MCPToolDriftPolicyList) DeepCopy() *MCPToolDriftPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolDriftPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPToolDriftPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolDriftPolicySpec) DeepCopyInto(out *MCPToolDriftPolicySpec) {
+	*out = *in
+	out.Manifest = in.Manifest
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolDriftPolicySpec.
+func (in *MCPToolDriftPolicySpec) DeepCopy() *MCPToolDriftPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolDriftPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPToolDriftPolicyStatus) DeepCopyInto(out *MCPToolDriftPolicyStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolDriftPolicyStatus.
+func (in *MCPToolDriftPolicyStatus) DeepCopy() *MCPToolDriftPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolDriftPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -48,6 +48,10 @@ const (
 	AuditLogExportPrefix          = "ael1"
 	ScheduledAuditLogExportPrefix = "sael1"
 	SystemMCPServerPrefix         = "sms1"
+	MCPAccessRequestPrefix        = "mar1"
+	MCPAuditLogSavedSearchPrefix  = "alss1"
+	MCPToolApprovalPolicyPrefix   = "mtap1"
+	MCPToolCallApprovalPrefix     = "mtca1"
 )
 
 func IsThreadID(id string) bool {
@@ -19,11 +19,15 @@ const (
 	KnowledgeCredID         = "knowledge"
 	TaskInvoke              = "task-invoke"
 
-	DefaultNamespace       = "default"
-	DefaultCatalog         = "default"
-	DefaultRoleSettingName = "user-default-role-setting"
-	K8sSettingsName        = "k8s-settings"
-	AppPreferencesName     = "app-preferences"
+	DefaultNamespace               = "default"
+	DefaultCatalog                 = "default"
+	DefaultRoleSettingName         = "user-default-role-setting"
+	K8sSettingsName                = "k8s-settings"
+	AppPreferencesName             = "app-preferences"
+	MCPImagePolicyName             = "mcp-image-policy"
+	MCPAuditLogRetentionPolicyName = "mcp-audit-log-retention-policy"
+	MCPInjectionScanPolicyName     = "mcp-injection-scan-policy"
+	MCPToolDriftPolicyName         = "mcp-tool-drift-policy"
 
 	ModelProviderCredential = "sys.model.provider.credential"
 
@@ -0,0 +1,439 @@
+// Package toolapproval pauses the MCP calls that an MCPToolApprovalPolicy selects until they are approved.
+package toolapproval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/logger"
+	"github.com/obot-platform/obot/pkg/auditlogquery"
+	"github.com/obot-platform/obot/pkg/auditlogredact"
+	gateway "github.com/obot-platform/obot/pkg/gateway/client"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/tidwall/gjson"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var log = logger.Package()
+
+// DefaultTimeout is how long a call waits for a decision when its policy doesn't set a timeout.
+const DefaultTimeout = 10 * time.Minute
+
+// Call is an MCP call that might need approval.
+type Call struct {
+	Namespace                 string
+	MCPServerName             string
+	MCPServerDisplayName      string
+	MCPServerCatalogEntryName string
+	MCPCatalogName            string
+	PowerUserWorkspaceID      string
+	UserID                    string
+	SessionID                 string
+	RequestID                 string
+	ClientName                string
+	ClientVersion             string
+	ClientIP                  string
+	UserAgent                 string
+	Method                    string
+	Identifier                string
+	// RequestBody is the JSON-RPC request of the call.
+	RequestBody json.RawMessage
+}
+
+// RejectedError is returned for calls that were rejected or that weren't decided on in time.
+type RejectedError struct {
+	Approval *v1.MCPToolCallApproval
+}
+
+func (e *RejectedError) Error() string {
+	if e.Approval.Spec.State == types.MCPToolCallApprovalStateExpired {
+		return fmt.Sprintf("the call to %s was not approved in time", e.Approval.Spec.Identifier)
+	}
+
+	msg := fmt.Sprintf("the call to %s was rejected", e.Approval.Spec.Identifier)
+	if e.Approval.Spec.DecisionReason != "" {
+		msg += ": " + e.Approval.Spec.DecisionReason
+	}
+	return msg
+}
+
+// PolicyResourceIndex is the name of the index of policies by the resources they apply to. The policy indexer that is
+// passed to NewGate must have it, from PolicyIndexers.
+const PolicyResourceIndex = "resources"
+
+// PolicyIndexers are the indexers that the Gate looks up policies with.
+var PolicyIndexers = cache.Indexers{
+	PolicyResourceIndex: func(obj any) ([]string, error) {
+		policy, ok := obj.(*v1.MCPToolApprovalPolicy)
+		if !ok {
+			return nil, nil
+		}
+
+		keys := make([]string, 0, len(policy.Spec.Manifest.Resources))
+		for _, resource := range policy.Spec.Manifest.Resources {
+			keys = append(keys, resourceKey(resource.Type, resource.ID))
+		}
+		return keys, nil
+	},
+}
+
+func resourceKey(resourceType types.ResourceType, id string) string {
+	return string(resourceType) + "/" + id
+}
+
+// Gate holds MCP calls until they are approved.
+type Gate struct {
+	client        kclient.Client
+	policies      cache.Indexer
+	gatewayClient *gateway.Client
+	httpClient    *http.Client
+	pollInterval  time.Duration
+}
+
+// NewGate returns a Gate that looks up policies in the indexer of an MCPToolApprovalPolicy informer, because every
+// proxied call is checked against them.
+func NewGate(client kclient.Client, policies cache.Indexer, gatewayClient *gateway.Client) *Gate {
+	return &Gate{
+		client:        client,
+		policies:      policies,
+		gatewayClient: gatewayClient,
+		httpClient:    &http.Client{Timeout: notificationTimeout},
+		pollInterval:  time.Second,
+	}
+}
+
+// Identifier returns the tool, prompt, or resource that a JSON-RPC call with the given method is for.
+func Identifier(method string, params gjson.Result) string {
+	switch method {
+	case "tools/call", "prompts/get":
+		return params.Get("name").String()
+	case "resources/read":
+		return params.Get("uri").String()
+	}
+	return ""
+}
+
+// Policy returns the policy that requires approval for the call, or nil if no policy does.
+func (g *Gate) Policy(ctx context.Context, call Call) (*v1.MCPToolApprovalPolicy, error) {
+	if g == nil {
+		return nil, nil
+	}
+
+	keys := []string{
+		resourceKey(types.ResourceTypeMCPServer, call.MCPServerName),
+		resourceKey(types.ResourceTypeSelector, "*"),
+	}
+	if call.MCPServerCatalogEntryName != "" {
+		keys = append(keys, resourceKey(types.ResourceTypeMCPServerCatalogEntry, call.MCPServerCatalogEntryName))
+	}
+	if call.MCPCatalogName != "" {
+		keys = append(keys, resourceKey(types.ResourceTypeMcpCatalog, call.MCPCatalogName))
+	}
+
+	var (
+		policies []v1.MCPToolApprovalPolicy
+		seen     = make(map[string]struct{})
+	)
+	for _, key := range keys {
+		objs, err := g.policies.ByIndex(PolicyResourceIndex, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tool approval policies from the resource index: %w", err)
+		}
+
+		for _, obj := range objs {
+			policy, ok := obj.(*v1.MCPToolApprovalPolicy)
+			if !ok || policy.Namespace != call.Namespace {
+				continue
+			}
+			if _, ok := seen[policy.Name]; ok {
+				continue
+			}
+			seen[policy.Name] = struct{}{}
+			// The policies are shared with the informer's cache, so the one that is returned is a copy.
+			policies = append(policies, *policy.DeepCopy())
+		}
+	}
+
+	return matchPolicy(policies, call), nil
+}
+
+// matchPolicy returns the first policy, by name, that applies to the call. Policies are matched on the server, its catalog entry,
+// and its catalog, the same way as webhook validations.
+func matchPolicy(policies []v1.MCPToolApprovalPolicy, call Call) *v1.MCPToolApprovalPolicy {
+	slices.SortFunc(policies, func(a, b v1.MCPToolApprovalPolicy) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	for i, policy := range policies {
+		manifest := policy.Spec.Manifest
+		if manifest.Disabled || len(manifest.Selectors) == 0 || !manifest.Selectors.Matches(call.Method, call.Identifier) {
+			continue
+		}
+
+		for _, resource := range manifest.Resources {
+			var applies bool
+			switch resource.Type {
+			case types.ResourceTypeMCPServer:
+				applies = resource.ID == call.MCPServerName
+			case types.ResourceTypeMCPServerCatalogEntry:
+				applies = call.MCPServerCatalogEntryName != "" && resource.ID == call.MCPServerCatalogEntryName
+			case types.ResourceTypeMcpCatalog:
+				applies = call.MCPCatalogName != "" && resource.ID == call.MCPCatalogName
+			case types.ResourceTypeSelector:
+				applies = resource.ID == "*"
+			}
+			if applies {
+				return &policies[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// Await holds the call until it is approved, if a policy requires approval for it. It returns the approval of calls that
+// needed one. A *RejectedError is returned for calls that were rejected or not decided on in time, and they are logged
+// in the audit log, because they never reach the MCP server.
+func (g *Gate) Await(ctx context.Context, call Call) (*v1.MCPToolCallApproval, error) {
+	policy, err := g.Policy(ctx, call)
+	if err != nil || policy == nil {
+		return nil, err
+	}
+
+	return g.AwaitPolicy(ctx, call, policy)
+}
+
+// AwaitPolicy holds the call until it is approved, like Await, for a policy that is already known to apply to it.
+func (g *Gate) AwaitPolicy(ctx context.Context, call Call, policy *v1.MCPToolApprovalPolicy) (*v1.MCPToolCallApproval, error) {
+	start := time.Now()
+	redactor := g.redactor(ctx, call)
+
+	// Approvers see the arguments, so they are redacted like they would be in the audit log.
+	redacted := gatewaytypes.MCPAuditLog{RequestBody: call.RequestBody}
+	redactor.Redact(&redacted)
+
+	timeout := DefaultTimeout
+	if policy.Spec.Manifest.TimeoutMinutes > 0 {
+		timeout = time.Duration(policy.Spec.Manifest.TimeoutMinutes) * time.Minute
+	}
+
+	approval := &v1.MCPToolCallApproval{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.MCPToolCallApprovalPrefix,
+			Namespace:    call.Namespace,
+		},
+		Spec: v1.MCPToolCallApprovalSpec{
+			PolicyName:           policy.Name,
+			UserID:               call.UserID,
+			MCPID:                call.MCPServerName,
+			MCPServerDisplayName: call.MCPServerDisplayName,
+			SessionID:            call.SessionID,
+			RequestID:            call.RequestID,
+			Method:               call.Method,
+			Identifier:           call.Identifier,
+			Arguments:            string(auditlogquery.Arguments(redacted.RequestBody)),
+			Approvers:            policy.Spec.Manifest.Approvers,
+			State:                types.MCPToolCallApprovalStatePending,
+			ExpiresAt:            metav1.NewTime(start.Add(timeout)),
+		},
+	}
+	if err := g.client.Create(ctx, approval); err != nil {
+		return nil, fmt.Errorf("failed to create tool call approval: %w", err)
+	}
+
+	log.Infof("Holding MCP call for approval: approval=%s, policy=%s, server=%s, method=%s, identifier=%s",
+		approval.Name, policy.Name, call.MCPServerName, call.Method, call.Identifier)
+	if url := policy.Spec.Manifest.NotificationURL; url != "" {
+		go g.notify(url, approval.DeepCopy())
+	}
+
+	approval, err := g.wait(ctx, approval)
+	if err != nil {
+		return nil, err
+	}
+	if approval.Spec.State == types.MCPToolCallApprovalStateApproved {
+		return approval, nil
+	}
+
+	rejected := &RejectedError{Approval: approval}
+	g.logRejected(call, approval, rejected, redactor, start)
+	return approval, rejected
+}
+
+// wait polls the approval until it is decided. If it isn't decided before it expires or the caller gives up, it is marked
+// as expired.
+func (g *Gate) wait(ctx context.Context, approval *v1.MCPToolCallApproval) (*v1.MCPToolCallApproval, error) {
+	expired := time.NewTimer(time.Until(approval.Spec.ExpiresAt.Time))
+	defer expired.Stop()
+	ticker := time.NewTicker(g.pollInterval)
+	defer ticker.Stop()
+
+	key := kclient.ObjectKeyFromObject(approval)
+	for {
+		select {
+		case <-ctx.Done():
+			return g.expire(key, "the call was canceled before it was approved")
+		case <-expired.C:
+			return g.expire(key, "the call was not approved in time")
+		case <-ticker.C:
+			if err := g.client.Get(ctx, key, approval); err != nil {
+				if ctx.Err() != nil {
+					continue
+				}
+				return nil, fmt.Errorf("failed to get tool call approval: %w", err)
+			}
+			if approval.Spec.State != types.MCPToolCallApprovalStatePending {
+				return approval, nil
+			}
+		}
+	}
+}
+
+// expire marks the approval as expired, unless it was decided on in the meantime.
+func (g *Gate) expire(key kclient.ObjectKey, reason string) (*v1.MCPToolCallApproval, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var approval v1.MCPToolCallApproval
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := g.client.Get(ctx, key, &approval); err != nil {
+			return err
+		}
+		if approval.Spec.State != types.MCPToolCallApprovalStatePending {
+			return nil
+		}
+
+		now := metav1.Now()
+		approval.Spec.State = types.MCPToolCallApprovalStateExpired
+		approval.Spec.DecisionReason = reason
+		approval.Spec.DecidedAt = &now
+		return g.client.Update(ctx, &approval)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to expire tool call approval: %w", err)
+	}
+
+	return &approval, nil
+}
+
+// redactor returns the audit log redaction of the call's server.
+func (g *Gate) redactor(ctx context.Context, call Call) *auditlogredact.Redactor {
+	var server v1.MCPServer
+	if err := g.client.Get(ctx, kclient.ObjectKey{Namespace: call.Namespace, Name: call.MCPServerName}, &server); err != nil {
+		log.Errorf("failed to get MCP server %s for tool call approval, keeping metadata only: %v", call.MCPServerName, err)
+		return auditlogredact.MetadataOnly()
+	}
+
+	redactor, err := auditlogredact.New(server.Spec.Manifest.AuditLogRedaction)
+	if err != nil {
+		log.Errorf("invalid audit log redaction for MCP server %s, keeping metadata only: %v", call.MCPServerName, err)
+		return auditlogredact.MetadataOnly()
+	}
+	return redactor
+}
+
+func (g *Gate) logRejected(call Call, approval *v1.MCPToolCallApproval, err error, redactor *auditlogredact.Redactor, start time.Time) {
+	if g.gatewayClient == nil {
+		return
+	}
+
+	status := http.StatusForbidden
+	if approval.Spec.State == types.MCPToolCallApprovalStateExpired {
+		status = http.StatusRequestTimeout
+	}
+
+	entry := gatewaytypes.MCPAuditLog{
+		CreatedAt:                 start,
+		UserID:                    call.UserID,
+		MCPID:                     call.MCPServerName,
+		PowerUserWorkspaceID:      call.PowerUserWorkspaceID,
+		MCPServerDisplayName:      call.MCPServerDisplayName,
+		MCPServerCatalogEntryName: call.MCPServerCatalogEntryName,
+		ClientName:                call.ClientName,
+		ClientVersion:             call.ClientVersion,
+		ClientIP:                  call.ClientIP,
+		CallType:                  call.Method,
+		CallIdentifier:            call.Identifier,
+		RequestBody:               call.RequestBody,
+		ResponseStatus:            status,
+		Error:                     err.Error(),
+		ProcessingTimeMs:          time.Since(start).Milliseconds(),
+		SessionID:                 call.SessionID,
+		RequestID:                 call.RequestID,
+		UserAgent:                 call.UserAgent,
+		ApprovalID:                approval.Name,
+		ApprovalState:             string(approval.Spec.State),
+		ApprovalDecidedBy:         approval.Spec.DecidedBy,
+	}
+	redactor.Redact(&entry)
+	g.gatewayClient.LogMCPAuditEntry(entry)
+}
+
+// AnnotateAuditLogs sets the approval of the logs of approved calls to the server. The fields are cleared on all other logs,
+// so that they can only come from the gate.
+func (g *Gate) AnnotateAuditLogs(ctx context.Context, namespace, mcpID string, logs []gatewaytypes.MCPAuditLog) error {
+	var needsApproval bool
+	for i := range logs {
+		logs[i].ApprovalID, logs[i].ApprovalState, logs[i].ApprovalDecidedBy = "", "", ""
+		needsApproval = needsApproval || logs[i].SessionID != "" && logs[i].RequestID != ""
+	}
+	if g == nil || !needsApproval {
+		return nil
+	}
+
+	var approvals v1.MCPToolCallApprovalList
+	if err := g.client.List(ctx, &approvals, &kclient.ListOptions{
+		Namespace: namespace,
+		FieldSelector: fields.SelectorFromSet(map[string]string{
+			"spec.mcpID": mcpID,
+			"spec.state": string(types.MCPToolCallApprovalStateApproved),
+		}),
+	}); err != nil {
+		return fmt.Errorf("failed to list tool call approvals: %w", err)
+	}
+
+	annotate(logs, approvals.Items)
+	return nil
+}
+
+func annotate(logs []gatewaytypes.MCPAuditLog, approvals []v1.MCPToolCallApproval) {
+	type callKey struct{ sessionID, requestID string }
+	byCall := make(map[callKey]*v1.MCPToolCallApproval, len(approvals))
+	for i, approval := range approvals {
+		if approval.Spec.SessionID != "" && approval.Spec.RequestID != "" {
+			byCall[callKey{approval.Spec.SessionID, approval.Spec.RequestID}] = &approvals[i]
+		}
+	}
+
+	for i := range logs {
+		approval, ok := byCall[callKey{logs[i].SessionID, logs[i].RequestID}]
+		if !ok || logs[i].CallIdentifier != approval.Spec.Identifier {
+			continue
+		}
+		logs[i].ApprovalID = approval.Name
+		logs[i].ApprovalState = string(approval.Spec.State)
+		logs[i].ApprovalDecidedBy = approval.Spec.DecidedBy
+	}
+}
+
+// IsRejected returns the approval of a call that was rejected or not decided on in time.
+func IsRejected(err error) (*v1.MCPToolCallApproval, bool) {
+	var rejected *RejectedError
+	if errors.As(err, &rejected) {
+		return rejected.Approval, true
+	}
+	return nil, false
+}
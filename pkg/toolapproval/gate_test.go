@@ -0,0 +1,222 @@
+package toolapproval
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func policy(name string, resource types.Resource, selectors types.MCPSelectors, disabled bool) v1.MCPToolApprovalPolicy {
+	return v1.MCPToolApprovalPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1.MCPToolApprovalPolicySpec{
+			Manifest: types.MCPToolApprovalPolicyManifest{
+				Resources: []types.Resource{resource},
+				Selectors: selectors,
+				Disabled:  disabled,
+			},
+		},
+	}
+}
+
+func TestMatchPolicy(t *testing.T) {
+	sendEmail := types.MCPSelectors{{Method: "tools/call", Identifiers: []string{"send_email"}}}
+	anyTool := types.MCPSelectors{{Method: "tools/call"}}
+	call := Call{
+		MCPServerName:             "ms1",
+		MCPServerCatalogEntryName: "entry1",
+		MCPCatalogName:            "default",
+		Method:                    "tools/call",
+		Identifier:                "send_email",
+	}
+
+	tests := []struct {
+		name     string
+		policies []v1.MCPToolApprovalPolicy
+		call     Call
+		expected string
+	}{
+		{
+			name:     "server",
+			policies: []v1.MCPToolApprovalPolicy{policy("p1", types.Resource{Type: types.ResourceTypeMCPServer, ID: "ms1"}, sendEmail, false)},
+			call:     call,
+			expected: "p1",
+		},
+		{
+			name:     "catalog entry",
+			policies: []v1.MCPToolApprovalPolicy{policy("p1", types.Resource{Type: types.ResourceTypeMCPServerCatalogEntry, ID: "entry1"}, anyTool, false)},
+			call:     call,
+			expected: "p1",
+		},
+		{
+			name:     "catalog",
+			policies: []v1.MCPToolApprovalPolicy{policy("p1", types.Resource{Type: types.ResourceTypeMcpCatalog, ID: "default"}, anyTool, false)},
+			call:     call,
+			expected: "p1",
+		},
+		{
+			name:     "all servers",
+			policies: []v1.MCPToolApprovalPolicy{policy("p1", types.Resource{Type: types.ResourceTypeSelector, ID: "*"}, sendEmail, false)},
+			call:     call,
+			expected: "p1",
+		},
+		{
+			name:     "other server",
+			policies: []v1.MCPToolApprovalPolicy{policy("p1", types.Resource{Type: types.ResourceTypeMCPServer, ID: "ms2"}, sendEmail, false)},
+			call:     call,
+		},
+		{
+			name:     "other tool",
+			policies: []v1.MCPToolApprovalPolicy{policy("p1", types.Resource{Type: types.ResourceTypeMCPServer, ID: "ms1"}, types.MCPSelectors{{Method: "tools/call", Identifiers: []string{"read_email"}}}, false)},
+			call:     call,
+		},
+		{
+			name:     "other method",
+			policies: []v1.MCPToolApprovalPolicy{policy("p1", types.Resource{Type: types.ResourceTypeMCPServer, ID: "ms1"}, anyTool, false)},
+			call:     Call{MCPServerName: "ms1", Method: "tools/list"},
+		},
+		{
+			name:     "disabled",
+			policies: []v1.MCPToolApprovalPolicy{policy("p1", types.Resource{Type: types.ResourceTypeMCPServer, ID: "ms1"}, sendEmail, true)},
+			call:     call,
+		},
+		{
+			name:     "no selectors",
+			policies: []v1.MCPToolApprovalPolicy{policy("p1", types.Resource{Type: types.ResourceTypeMCPServer, ID: "ms1"}, nil, false)},
+			call:     call,
+		},
+		{
+			name: "first by name",
+			policies: []v1.MCPToolApprovalPolicy{
+				policy("p2", types.Resource{Type: types.ResourceTypeMCPServer, ID: "ms1"}, sendEmail, false),
+				policy("p1", types.Resource{Type: types.ResourceTypeSelector, ID: "*"}, anyTool, false),
+			},
+			call:     call,
+			expected: "p1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := matchPolicy(tt.policies, tt.call)
+			if tt.expected == "" {
+				assert.Nil(t, policy)
+			} else if assert.NotNil(t, policy) {
+				assert.Equal(t, tt.expected, policy.Name)
+			}
+		})
+	}
+}
+
+func TestPolicy(t *testing.T) {
+	sendEmail := types.MCPSelectors{{Method: "tools/call", Identifiers: []string{"send_email"}}}
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, PolicyIndexers)
+	for _, p := range []v1.MCPToolApprovalPolicy{
+		policy("p1", types.Resource{Type: types.ResourceTypeMCPServer, ID: "ms2"}, sendEmail, false),
+		policy("p2", types.Resource{Type: types.ResourceTypeMCPServerCatalogEntry, ID: "entry1"}, sendEmail, false),
+		policy("p3", types.Resource{Type: types.ResourceTypeSelector, ID: "*"}, types.MCPSelectors{{Method: "prompts/get"}}, false),
+	} {
+		p.Namespace = "default"
+		require.NoError(t, indexer.Add(&p))
+	}
+
+	gate := &Gate{policies: indexer}
+	call := Call{Namespace: "default", MCPServerName: "ms1", MCPServerCatalogEntryName: "entry1", Method: "tools/call", Identifier: "send_email"}
+
+	result, err := gate.Policy(context.Background(), call)
+	require.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, "p2", result.Name)
+	}
+
+	// Policies in other namespaces don't apply.
+	call.Namespace = "other"
+	result, err = gate.Policy(context.Background(), call)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestNotify(t *testing.T) {
+	var received heldCallNotification
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	approval := &v1.MCPToolCallApproval{
+		ObjectMeta: metav1.ObjectMeta{Name: "tca1"},
+		Spec: v1.MCPToolCallApprovalSpec{
+			PolicyName:           "p1",
+			UserID:               "u1",
+			MCPID:                "ms1",
+			MCPServerDisplayName: "Email",
+			Method:               "tools/call",
+			Identifier:           "send_email",
+			Arguments:            `{"to":"someone@example.com"}`,
+		},
+	}
+
+	gate := &Gate{httpClient: server.Client()}
+	gate.notify(server.URL, approval)
+
+	assert.Equal(t, "A call to send_email on Email is waiting for approval (tca1).", received.Text)
+	assert.Equal(t, "tca1", received.ApprovalID)
+	assert.Equal(t, "ms1", received.MCPServerName)
+	assert.Equal(t, "send_email", received.Identifier)
+}
+
+func TestIdentifier(t *testing.T) {
+	params := gjson.Parse(`{"name":"send_email","uri":"file:///a"}`)
+	assert.Equal(t, "send_email", Identifier("tools/call", params))
+	assert.Equal(t, "send_email", Identifier("prompts/get", params))
+	assert.Equal(t, "file:///a", Identifier("resources/read", params))
+	assert.Equal(t, "", Identifier("tools/list", params))
+}
+
+func TestAnnotate(t *testing.T) {
+	approvals := []v1.MCPToolCallApproval{{
+		ObjectMeta: metav1.ObjectMeta{Name: "mtca1abc"},
+		Spec: v1.MCPToolCallApprovalSpec{
+			SessionID:  "s1",
+			RequestID:  "1",
+			Identifier: "send_email",
+			State:      types.MCPToolCallApprovalStateApproved,
+			DecidedBy:  "u2",
+		},
+	}}
+	logs := []gatewaytypes.MCPAuditLog{
+		{SessionID: "s1", RequestID: "1", CallIdentifier: "send_email"},
+		{SessionID: "s1", RequestID: "2", CallIdentifier: "send_email"},
+		{SessionID: "s1", RequestID: "1", CallIdentifier: "read_email"},
+	}
+
+	annotate(logs, approvals)
+	assert.Equal(t, "mtca1abc", logs[0].ApprovalID)
+	assert.Equal(t, "approved", logs[0].ApprovalState)
+	assert.Equal(t, "u2", logs[0].ApprovalDecidedBy)
+	assert.Empty(t, logs[1].ApprovalID)
+	assert.Empty(t, logs[2].ApprovalID)
+}
+
+func TestAnnotateAuditLogsClearsSubmittedApprovals(t *testing.T) {
+	var g *Gate
+	logs := []gatewaytypes.MCPAuditLog{{ApprovalID: "forged", ApprovalState: "approved", ApprovalDecidedBy: "u2"}}
+
+	assert.NoError(t, g.AnnotateAuditLogs(t.Context(), "default", "ms1", logs))
+	assert.Empty(t, logs[0].ApprovalID)
+	assert.Empty(t, logs[0].ApprovalState)
+	assert.Empty(t, logs[0].ApprovalDecidedBy)
+}
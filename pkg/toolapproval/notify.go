@@ -0,0 +1,81 @@
+package toolapproval
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+// notificationTimeout is how long a notification about a held call can take.
+const notificationTimeout = 10 * time.Second
+
+// heldCallNotification is the body that is posted to a policy's notification URL when a call is held. The text field
+// makes it work with chat incoming webhooks, like Slack's.
+type heldCallNotification struct {
+	Text                 string    `json:"text"`
+	ApprovalID           string    `json:"approvalID"`
+	PolicyName           string    `json:"policyName"`
+	UserID               string    `json:"userID"`
+	MCPServerName        string    `json:"mcpServerName"`
+	MCPServerDisplayName string    `json:"mcpServerDisplayName"`
+	Method               string    `json:"method"`
+	Identifier           string    `json:"identifier"`
+	ExpiresAt            time.Time `json:"expiresAt"`
+}
+
+func newHeldCallNotification(approval *v1.MCPToolCallApproval) heldCallNotification {
+	server := approval.Spec.MCPServerDisplayName
+	if server == "" {
+		server = approval.Spec.MCPID
+	}
+
+	return heldCallNotification{
+		Text:                 fmt.Sprintf("A call to %s on %s is waiting for approval (%s).", approval.Spec.Identifier, server, approval.Name),
+		ApprovalID:           approval.Name,
+		PolicyName:           approval.Spec.PolicyName,
+		UserID:               approval.Spec.UserID,
+		MCPServerName:        approval.Spec.MCPID,
+		MCPServerDisplayName: approval.Spec.MCPServerDisplayName,
+		Method:               approval.Spec.Method,
+		Identifier:           approval.Spec.Identifier,
+		ExpiresAt:            approval.Spec.ExpiresAt.Time,
+	}
+}
+
+// notify posts a held call to the policy's notification URL, so that approvers find out about it. The arguments aren't
+// sent, because approvers see them in Obot. Failures are only logged, because the call waits for a decision either way.
+func (g *Gate) notify(url string, approval *v1.MCPToolCallApproval) {
+	body, err := json.Marshal(newHeldCallNotification(approval))
+	if err != nil {
+		log.Errorf("failed to marshal notification for tool call approval %s: %v", approval.Name, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), notificationTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		log.Errorf("failed to create notification for tool call approval %s: %v", approval.Name, err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		log.Warnf("failed to send notification for tool call approval %s: %v", approval.Name, err)
+		return
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		log.Warnf("notification for tool call approval %s failed with status %d", approval.Name, resp.StatusCode)
+	}
+}
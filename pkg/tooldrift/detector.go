@@ -0,0 +1,326 @@
+// Package tooldrift detects MCP tools that change after they were first listed, and holds changed tools back until an
+// admin approves the change, if the MCPToolDriftPolicy says so.
+package tooldrift
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/logger"
+	gateway "github.com/obot-platform/obot/pkg/gateway/client"
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/obot-platform/obot/pkg/hash"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var log = logger.Package()
+
+// Source is the server that listed tools, and the user that listed them.
+type Source struct {
+	// ID is the catalog entry that the server was created from, or the server if it wasn't created from an entry or if
+	// its users supply its URL or configuration. See Detector.SourceID.
+	ID                   string
+	MCPID                string
+	MCPServerDisplayName string
+	UserID               string
+}
+
+// Tool is the part of a tool that is pinned.
+type Tool struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	InputSchema json.RawMessage `json:"inputSchema,omitempty"`
+}
+
+// Hash returns the hash of the name, description, and input schema of the tool. The schema is normalized first, so that
+// the order of its keys and its whitespace don't count as changes.
+func (t Tool) Hash() string {
+	var schema any
+	if len(t.InputSchema) > 0 {
+		if err := json.Unmarshal(t.InputSchema, &schema); err != nil {
+			schema = string(t.InputSchema)
+		}
+	}
+
+	return hash.String(map[string]any{
+		"name":        t.Name,
+		"description": t.Description,
+		"inputSchema": schema,
+	})
+}
+
+// ChangedError is returned for calls to tools that changed and are blocked until the change is approved.
+type ChangedError struct {
+	ToolName string
+}
+
+func (e *ChangedError) Error() string {
+	return fmt.Sprintf("the tool %s changed from its pinned version, and can't be called until an admin approves the change", e.ToolName)
+}
+
+// Detector pins the tools that MCP servers list, and compares later lists to the pins.
+type Detector struct {
+	client        kclient.Client
+	gatewayClient *gateway.Client
+}
+
+func NewDetector(client kclient.Client, gatewayClient *gateway.Client) *Detector {
+	return &Detector{
+		client:        client,
+		gatewayClient: gatewayClient,
+	}
+}
+
+// Policy returns the drift policy. Without a policy, tools are pinned and changes are reported, but nothing is blocked.
+func (d *Detector) Policy(ctx context.Context) (types.MCPToolDriftPolicyManifest, error) {
+	if d == nil {
+		return types.MCPToolDriftPolicyManifest{Disabled: true}, nil
+	}
+
+	var policy v1.MCPToolDriftPolicy
+	if err := d.client.Get(ctx, kclient.ObjectKey{Namespace: system.DefaultNamespace, Name: system.MCPToolDriftPolicyName}, &policy); apierrors.IsNotFound(err) {
+		return types.MCPToolDriftPolicyManifest{}, nil
+	} else if err != nil {
+		return types.MCPToolDriftPolicyManifest{}, fmt.Errorf("failed to get MCP tool drift policy: %w", err)
+	}
+	return policy.Spec.Manifest, nil
+}
+
+// SourceID returns the ID of the source of a server. The servers of a catalog entry share the pins of their tools, unless
+// each user supplies the URL or configuration of their server. Then the tools that the servers list can be different for
+// every user, so each server has its own pins.
+func (d *Detector) SourceID(ctx context.Context, catalogEntryName, serverName string) (string, error) {
+	if catalogEntryName == "" {
+		return serverName, nil
+	}
+
+	var entry v1.MCPServerCatalogEntry
+	if err := d.client.Get(ctx, kclient.ObjectKey{Namespace: system.DefaultNamespace, Name: catalogEntryName}, &entry); apierrors.IsNotFound(err) {
+		return serverName, nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to get catalog entry %s: %w", catalogEntryName, err)
+	}
+
+	return sourceID(entry.Spec.Manifest, catalogEntryName, serverName), nil
+}
+
+func sourceID(manifest types.MCPServerCatalogEntryManifest, catalogEntryName, serverName string) string {
+	if hasUserConfig(manifest) {
+		return serverName
+	}
+	return catalogEntryName
+}
+
+// hasUserConfig reports whether users supply the URL, environment variables, or headers of the servers of the entry.
+func hasUserConfig(manifest types.MCPServerCatalogEntryManifest) bool {
+	for _, env := range manifest.Env {
+		if env.Value == "" {
+			return true
+		}
+	}
+
+	if manifest.RemoteConfig != nil {
+		if manifest.RemoteConfig.FixedURL == "" {
+			return true
+		}
+		for _, header := range manifest.RemoteConfig.Headers {
+			if header.Value == "" {
+				return true
+			}
+		}
+	}
+
+	if manifest.CompositeConfig != nil {
+		for _, component := range manifest.CompositeConfig.ComponentServers {
+			if hasUserConfig(component.Manifest) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Check compares the tools that a server listed to their pins. Tools that weren't listed before are pinned, and changes
+// are recorded and reported with a finding. It returns the names of the changed tools that are blocked.
+func (d *Detector) Check(ctx context.Context, source Source, tools []Tool) (map[string]struct{}, error) {
+	policy, err := d.Policy(ctx)
+	if err != nil || policy.Disabled || len(tools) == 0 {
+		return nil, err
+	}
+
+	pins, err := d.gatewayClient.GetMCPToolPinsForSource(ctx, source.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tool pins: %w", err)
+	}
+
+	var (
+		now     = time.Now()
+		blocked map[string]struct{}
+		created []gatewaytypes.MCPToolPin
+	)
+	for _, tool := range tools {
+		h := tool.Hash()
+
+		pin, ok := pins[tool.Name]
+		if !ok {
+			created = append(created, gatewaytypes.MCPToolPin{
+				CreatedAt:            now,
+				SourceID:             source.ID,
+				ToolName:             tool.Name,
+				MCPServerDisplayName: source.MCPServerDisplayName,
+				Hash:                 h,
+				Description:          tool.Description,
+				InputSchema:          normalizedSchema(tool.InputSchema),
+				CurrentHash:          h,
+				CurrentDescription:   tool.Description,
+				CurrentInputSchema:   normalizedSchema(tool.InputSchema),
+			})
+			continue
+		}
+
+		if pin.CurrentHash != h {
+			if err := d.recordChange(ctx, source, &pin, tool, h, now); err != nil {
+				return nil, err
+			}
+		}
+
+		if pin.Changed() && policy.BlockChangedTools {
+			if blocked == nil {
+				blocked = map[string]struct{}{}
+			}
+			blocked[tool.Name] = struct{}{}
+		}
+	}
+
+	if err := d.gatewayClient.CreateMCPToolPins(ctx, created); err != nil {
+		return nil, fmt.Errorf("failed to pin tools: %w", err)
+	}
+
+	return blocked, nil
+}
+
+// recordChange stores the version of a tool that its server listed now, which is different from the one it listed
+// before. Changing back to the approved version isn't reported.
+func (d *Detector) recordChange(ctx context.Context, source Source, pin *gatewaytypes.MCPToolPin, tool Tool, h string, now time.Time) error {
+	pin.MCPServerDisplayName = source.MCPServerDisplayName
+	pin.CurrentHash = h
+	pin.CurrentDescription = tool.Description
+	pin.CurrentInputSchema = normalizedSchema(tool.InputSchema)
+
+	var finding *gatewaytypes.MCPAuditLogFinding
+	if pin.Changed() {
+		pin.ChangedAt = &now
+		finding = &gatewaytypes.MCPAuditLogFinding{
+			CreatedAt:            now,
+			DedupeKey:            fmt.Sprintf("%s:%s:%s:%s", types.MCPAuditLogFindingTypeToolChanged, source.ID, tool.Name, h),
+			Type:                 string(types.MCPAuditLogFindingTypeToolChanged),
+			Severity:             string(types.MCPAuditLogFindingSeverityHigh),
+			Description:          fmt.Sprintf("The tool %s of %s changed from its pinned version. Review the change in tool pin %d.", tool.Name, source.MCPServerDisplayName, pin.ID),
+			UserID:               source.UserID,
+			MCPID:                source.MCPID,
+			MCPServerDisplayName: source.MCPServerDisplayName,
+			CallIdentifier:       tool.Name,
+		}
+		log.Warnf("MCP tool changed from its pinned version: source=%s, tool=%s, pin=%d", source.ID, tool.Name, pin.ID)
+	} else {
+		pin.ChangedAt = nil
+	}
+
+	if err := d.gatewayClient.UpdateMCPToolPinCurrent(ctx, pin, finding); err != nil {
+		return fmt.Errorf("failed to update tool pin: %w", err)
+	}
+	return nil
+}
+
+// CheckCall returns a ChangedError if the tool changed and calls to it are blocked until the change is approved. The
+// version that the server listed most recently is the one that is checked.
+func (d *Detector) CheckCall(ctx context.Context, sourceID, toolName string) error {
+	policy, err := d.Policy(ctx)
+	if err != nil || policy.Disabled || !policy.BlockChangedTools {
+		return err
+	}
+
+	pin, err := d.gatewayClient.GetMCPToolPinForTool(ctx, sourceID, toolName)
+	if err != nil {
+		return fmt.Errorf("failed to get tool pin: %w", err)
+	}
+	if pin != nil && pin.Changed() {
+		return &ChangedError{ToolName: toolName}
+	}
+	return nil
+}
+
+// Apply checks the tools in a JSON-RPC response to tools/list, and leaves the blocked ones out of it.
+func (d *Detector) Apply(ctx context.Context, source Source, message []byte) ([]byte, error) {
+	var msg map[string]any
+	dec := json.NewDecoder(bytes.NewReader(message))
+	dec.UseNumber()
+	if err := dec.Decode(&msg); err != nil {
+		return message, nil
+	}
+
+	result, _ := msg["result"].(map[string]any)
+	items, _ := result["tools"].([]any)
+	if len(items) == 0 {
+		return message, nil
+	}
+
+	tools := make([]Tool, 0, len(items))
+	for _, item := range items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return message, nil
+		}
+		var tool Tool
+		if err := json.Unmarshal(data, &tool); err != nil || tool.Name == "" {
+			continue
+		}
+		tools = append(tools, tool)
+	}
+
+	blocked, err := d.Check(ctx, source, tools)
+	if err != nil || len(blocked) == 0 {
+		return message, err
+	}
+
+	kept := make([]any, 0, len(items))
+	for _, item := range items {
+		m, _ := item.(map[string]any)
+		name, _ := m["name"].(string)
+		if _, ok := blocked[name]; !ok {
+			kept = append(kept, item)
+		}
+	}
+	result["tools"] = kept
+
+	changed, err := json.Marshal(msg)
+	if err != nil {
+		return message, nil
+	}
+	return changed, nil
+}
+
+// normalizedSchema returns the input schema with sorted keys and without whitespace, so that it diffs cleanly.
+func normalizedSchema(schema json.RawMessage) []byte {
+	if len(schema) == 0 {
+		return nil
+	}
+
+	var v any
+	if err := json.Unmarshal(schema, &v); err != nil {
+		return schema
+	}
+	normalized, err := json.Marshal(v)
+	if err != nil {
+		return schema
+	}
+	return normalized
+}
@@ -0,0 +1,61 @@
+package tooldrift
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	gatewaytypes "github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// Diff returns a unified diff of the description and input schema of a tool, from the approved version to the current
+// version, or an empty string if the tool didn't change.
+func Diff(pin gatewaytypes.MCPToolPin) string {
+	if !pin.Changed() {
+		return ""
+	}
+
+	var diff strings.Builder
+	for _, part := range []struct {
+		name     string
+		from, to string
+	}{
+		{name: "description", from: pin.Description, to: pin.CurrentDescription},
+		{name: "inputSchema", from: indent(pin.InputSchema), to: indent(pin.CurrentInputSchema)},
+	} {
+		if part.from == part.to {
+			continue
+		}
+
+		d, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(part.from),
+			B:        difflib.SplitLines(part.to),
+			FromFile: "approved/" + part.name,
+			ToFile:   "current/" + part.name,
+			Context:  3,
+		})
+		if err != nil {
+			continue
+		}
+		diff.WriteString(d)
+		if !strings.HasSuffix(d, "\n") {
+			// The last lines of the parts don't end with a newline, so one is added to keep the parts apart.
+			diff.WriteString("\n")
+		}
+	}
+	return diff.String()
+}
+
+// indent returns the input schema with one key or value per line, so that the diff shows what changed in it.
+func indent(schema []byte) string {
+	if len(schema) == 0 {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, schema, "", "  "); err != nil {
+		return string(schema)
+	}
+	return buf.String()
+}
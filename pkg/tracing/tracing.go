@@ -0,0 +1,94 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// traceParentAnnotation holds the W3C traceparent of the span that created an object, so that work done for the object later,
+// like a run that is picked up by the controller, can be part of the same trace.
+const traceParentAnnotation = "obot.obot.ai/traceparent"
+
+var tracer = otel.Tracer("obot/controller")
+
+// Inject saves the trace context of ctx on obj. It does nothing if ctx isn't part of a trace.
+func Inject(ctx context.Context, obj metav1.Object) {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	if traceParent := carrier.Get("traceparent"); traceParent != "" {
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[traceParentAnnotation] = traceParent
+		obj.SetAnnotations(annotations)
+	}
+}
+
+// Extract returns ctx with the trace context that was saved on obj, so new spans are part of the trace that created obj.
+// If obj doesn't have a trace context, ctx is returned as is.
+func Extract(ctx context.Context, obj metav1.Object) context.Context {
+	if spanContext := savedSpanContext(obj); spanContext.IsValid() {
+		return trace.ContextWithRemoteSpanContext(ctx, spanContext)
+	}
+	return ctx
+}
+
+func savedSpanContext(obj metav1.Object) trace.SpanContext {
+	traceParent := obj.GetAnnotations()[traceParentAnnotation]
+	if traceParent == "" {
+		return trace.SpanContext{}
+	}
+	ctx := propagation.TraceContext{}.Extract(context.Background(), propagation.MapCarrier{"traceparent": traceParent})
+	return trace.SpanContextFromContext(ctx)
+}
+
+// Reconcile wraps a controller handler so that it records a span, with the given name, each time it reconciles an object.
+// If the object was created in a trace, the span links to it.
+func Reconcile(name string, next router.Handler) router.Handler {
+	return router.HandlerFunc(func(req router.Request, resp router.Response) error {
+		opts := []trace.SpanStartOption{
+			trace.WithAttributes(
+				attribute.String("k8s.object.kind", req.GVK.Kind),
+				attribute.String("k8s.namespace.name", req.Namespace),
+				attribute.String("k8s.object.name", req.Name),
+			),
+		}
+		if req.Object != nil {
+			if spanContext := savedSpanContext(req.Object); spanContext.IsValid() {
+				opts = append(opts, trace.WithLinks(trace.Link{SpanContext: spanContext}))
+			}
+		}
+
+		ctx, span := tracer.Start(req.Ctx, name, opts...)
+		defer span.End()
+
+		err := next.Handle(req.WithContext(ctx), resp)
+		RecordError(span, err)
+		return err
+	})
+}
+
+// RecordError marks span as failed if err isn't nil.
+func RecordError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+// RecordHTTPStatus sets the response status of span, and marks it as failed for server errors.
+func RecordHTTPStatus(span trace.Span, status int) {
+	span.SetAttributes(attribute.Int("http.response.status_code", status))
+	if status >= 500 {
+		span.SetStatus(codes.Error, fmt.Sprintf("HTTP %d", status))
+	}
+}
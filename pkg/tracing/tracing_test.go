@@ -0,0 +1,32 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestInjectExtract(t *testing.T) {
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1, 2, 3},
+		SpanID:     trace.SpanID{4, 5, 6},
+		TraceFlags: trace.FlagsSampled,
+	})
+
+	var obj corev1.ConfigMap
+	Inject(context.Background(), &obj)
+	assert.Empty(t, obj.Annotations, "nothing is saved without a trace")
+	assert.Equal(t, context.Background(), Extract(context.Background(), &obj))
+
+	Inject(trace.ContextWithSpanContext(context.Background(), spanContext), &obj)
+	assert.Equal(t, "00-01020300000000000000000000000000-0405060000000000-01", obj.Annotations[traceParentAnnotation])
+
+	extracted := trace.SpanContextFromContext(Extract(context.Background(), &obj))
+	assert.Equal(t, spanContext.TraceID(), extracted.TraceID())
+	assert.Equal(t, spanContext.SpanID(), extracted.SpanID())
+	assert.True(t, extracted.IsSampled())
+	assert.True(t, extracted.IsRemote())
+}
@@ -1,14 +1,21 @@
 package validation
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"net/url"
+	"path"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/auditlogredact"
+	"k8s.io/apimachinery/pkg/api/resource"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 )
 
 var hostnameRegex = regexp.MustCompile(`^(?:\*\.)?[a-zA-Z0-9-]+(?:\.[a-zA-Z0-9-]+)*$`)
@@ -633,7 +640,11 @@ func getRuntimeValidators() RuntimeValidators {
 
 func ValidateServerManifest(manifest types.MCPServerManifest) error {
 	if validator, ok := getRuntimeValidators()[manifest.Runtime]; ok {
-		return validator.ValidateConfig(manifest)
+		if err := validator.ValidateConfig(manifest); err != nil {
+			return err
+		}
+		return validateManifestExtensions(manifest.Runtime, manifest.K8sOverrides, manifest.EgressPolicy, manifest.TrustLevel,
+			manifest.PersistentVolume, manifest.HealthProbe, manifest.Rollout, manifest.AuditLogRedaction)
 	}
 
 	return types.RuntimeValidationError{
@@ -645,7 +656,11 @@ func ValidateServerManifest(manifest types.MCPServerManifest) error {
 
 func ValidateCatalogEntryManifest(manifest types.MCPServerCatalogEntryManifest) error {
 	if validator, ok := getRuntimeValidators()[manifest.Runtime]; ok {
-		return validator.ValidateCatalogConfig(manifest)
+		if err := validator.ValidateCatalogConfig(manifest); err != nil {
+			return err
+		}
+		return validateManifestExtensions(manifest.Runtime, manifest.K8sOverrides, manifest.EgressPolicy, manifest.TrustLevel,
+			manifest.PersistentVolume, manifest.HealthProbe, manifest.Rollout, manifest.AuditLogRedaction)
 	}
 
 	return types.RuntimeValidationError{
@@ -654,3 +669,396 @@ func ValidateCatalogEntryManifest(manifest types.MCPServerCatalogEntryManifest)
 		Message: "unsupported runtime",
 	}
 }
+
+// validateManifestExtensions validates the settings that server and catalog entry manifests share beyond their runtime config.
+func validateManifestExtensions(
+	runtime types.Runtime,
+	overrides *types.K8sOverrides,
+	egressPolicy *types.EgressPolicy,
+	trustLevel types.TrustLevel,
+	volume *types.PersistentVolume,
+	probe *types.HealthProbe,
+	rollout *types.Rollout,
+	redaction *types.AuditLogRedaction,
+) error {
+	if err := ValidateK8sOverrides(runtime, overrides); err != nil {
+		return err
+	}
+	if err := ValidateEgressPolicy(runtime, egressPolicy); err != nil {
+		return err
+	}
+	if err := ValidateTrustLevel(runtime, trustLevel); err != nil {
+		return err
+	}
+	if err := ValidatePersistentVolume(runtime, volume); err != nil {
+		return err
+	}
+	if err := ValidateHealthProbe(runtime, probe); err != nil {
+		return err
+	}
+	if err := ValidateRollout(runtime, rollout, volume); err != nil {
+		return err
+	}
+	return ValidateAuditLogRedaction(runtime, redaction)
+}
+
+// reservedVolumeNames and reservedMountPaths are used by the volumes that Obot adds to every MCP server pod.
+var (
+	reservedVolumeNames = []string{"files", "run-file", "run-shim-file", "tmp", "workspace"}
+	reservedMountPaths  = []string{"/files", "/run", "/tmp"}
+)
+
+// ValidateK8sOverrides validates the per-server Kubernetes overrides in a manifest.
+func ValidateK8sOverrides(runtime types.Runtime, overrides *types.K8sOverrides) error {
+	if overrides == nil {
+		return nil
+	}
+
+	invalid := func(field, format string, args ...any) error {
+		return types.RuntimeValidationError{
+			Runtime: runtime,
+			Field:   "k8sOverrides." + field,
+			Message: fmt.Sprintf(format, args...),
+		}
+	}
+
+	if r := overrides.Resources; r != nil {
+		for _, q := range []struct {
+			field, request, limit string
+		}{
+			{"cpu", r.CPURequest, r.CPULimit},
+			{"memory", r.MemoryRequest, r.MemoryLimit},
+		} {
+			request, err := parsePositiveQuantity(q.request)
+			if err != nil {
+				return invalid("resources."+q.field+"Request", "%v", err)
+			}
+			limit, err := parsePositiveQuantity(q.limit)
+			if err != nil {
+				return invalid("resources."+q.field+"Limit", "%v", err)
+			}
+			if request != nil && limit != nil && request.Cmp(*limit) > 0 {
+				return invalid("resources."+q.field+"Request", "request must not be greater than the limit")
+			}
+		}
+	}
+
+	for key, value := range overrides.NodeSelector {
+		if errs := k8svalidation.IsQualifiedName(key); len(errs) > 0 {
+			return invalid("nodeSelector", "invalid label key %q: %s", key, strings.Join(errs, ", "))
+		}
+		if errs := k8svalidation.IsValidLabelValue(value); len(errs) > 0 {
+			return invalid("nodeSelector", "invalid label value %q: %s", value, strings.Join(errs, ", "))
+		}
+	}
+
+	if overrides.PriorityClassName != "" {
+		if errs := k8svalidation.IsDNS1123Subdomain(overrides.PriorityClassName); len(errs) > 0 {
+			return invalid("priorityClassName", "%s", strings.Join(errs, ", "))
+		}
+	}
+
+	if overrides.ServiceAccountName != "" {
+		if errs := k8svalidation.IsDNS1123Subdomain(overrides.ServiceAccountName); len(errs) > 0 {
+			return invalid("serviceAccountName", "%s", strings.Join(errs, ", "))
+		}
+	}
+
+	var (
+		names      = make(map[string]struct{}, len(overrides.Volumes))
+		mountPaths = make(map[string]struct{}, len(overrides.Volumes))
+	)
+	for i, volume := range overrides.Volumes {
+		field := fmt.Sprintf("volumes[%d]", i)
+
+		if errs := k8svalidation.IsDNS1123Label(volume.Name); len(errs) > 0 {
+			return invalid(field+".name", "%s", strings.Join(errs, ", "))
+		}
+		if slices.Contains(reservedVolumeNames, volume.Name) {
+			return invalid(field+".name", "volume name %q is reserved", volume.Name)
+		}
+		if _, ok := names[volume.Name]; ok {
+			return invalid(field+".name", "duplicate volume name: %s", volume.Name)
+		}
+		names[volume.Name] = struct{}{}
+
+		mountPath := path.Clean(volume.MountPath)
+		if !path.IsAbs(volume.MountPath) || mountPath == "/" {
+			return invalid(field+".mountPath", "mount path must be an absolute path other than /")
+		}
+		for _, reserved := range reservedMountPaths {
+			if mountPath == reserved || strings.HasPrefix(mountPath, reserved+"/") {
+				return invalid(field+".mountPath", "mount path %s is reserved", reserved)
+			}
+		}
+		if _, ok := mountPaths[mountPath]; ok {
+			return invalid(field+".mountPath", "duplicate mount path: %s", mountPath)
+		}
+		mountPaths[mountPath] = struct{}{}
+
+		var sources int
+		if volume.ConfigMap != "" {
+			sources++
+			if errs := k8svalidation.IsDNS1123Subdomain(volume.ConfigMap); len(errs) > 0 {
+				return invalid(field+".configMap", "%s", strings.Join(errs, ", "))
+			}
+		}
+		if volume.Secret != "" {
+			sources++
+			if errs := k8svalidation.IsDNS1123Subdomain(volume.Secret); len(errs) > 0 {
+				return invalid(field+".secret", "%s", strings.Join(errs, ", "))
+			}
+		}
+		if volume.EmptyDir != nil {
+			sources++
+			if volume.EmptyDir.SizeLimit != "" {
+				if _, err := resource.ParseQuantity(volume.EmptyDir.SizeLimit); err != nil {
+					return invalid(field+".emptyDir.sizeLimit", "invalid quantity %q: %v", volume.EmptyDir.SizeLimit, err)
+				}
+			}
+		}
+		if sources != 1 {
+			return invalid(field, "exactly one of configMap, secret, or emptyDir must be set")
+		}
+	}
+
+	return nil
+}
+
+// ValidateEgressPolicy validates the allowed egress destinations in a manifest.
+func ValidateEgressPolicy(runtime types.Runtime, policy *types.EgressPolicy) error {
+	if policy == nil {
+		return nil
+	}
+
+	for i, host := range policy.AllowedHosts {
+		if errs := k8svalidation.IsDNS1123Subdomain(strings.ToLower(host)); len(errs) > 0 {
+			return types.RuntimeValidationError{
+				Runtime: runtime,
+				Field:   fmt.Sprintf("egressPolicy.allowedHosts[%d]", i),
+				Message: fmt.Sprintf("invalid hostname %q: %s", host, strings.Join(errs, ", ")),
+			}
+		}
+	}
+
+	for i, cidr := range policy.AllowedCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return types.RuntimeValidationError{
+				Runtime: runtime,
+				Field:   fmt.Sprintf("egressPolicy.allowedCIDRs[%d]", i),
+				Message: fmt.Sprintf("invalid CIDR %q", cidr),
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateTrustLevel validates the trust level in a manifest.
+func ValidateTrustLevel(runtime types.Runtime, trustLevel types.TrustLevel) error {
+	switch trustLevel {
+	case "", types.TrustLevelTrusted, types.TrustLevelUntrusted:
+		return nil
+	}
+
+	return types.RuntimeValidationError{
+		Runtime: runtime,
+		Field:   "trustLevel",
+		Message: fmt.Sprintf("invalid trust level %q, must be %q or %q", trustLevel, types.TrustLevelTrusted, types.TrustLevelUntrusted),
+	}
+}
+
+// ValidatePersistentVolume validates the persistent volume in a manifest.
+func ValidatePersistentVolume(runtime types.Runtime, volume *types.PersistentVolume) error {
+	if volume == nil {
+		return nil
+	}
+
+	invalid := func(field, format string, args ...any) error {
+		return types.RuntimeValidationError{
+			Runtime: runtime,
+			Field:   "persistentVolume" + field,
+			Message: fmt.Sprintf(format, args...),
+		}
+	}
+
+	switch runtime {
+	case types.RuntimeRemote, types.RuntimeComposite:
+		return invalid("", "persistent volumes are not supported for %s servers", runtime)
+	}
+
+	mountPath := path.Clean(volume.MountPath)
+	if !path.IsAbs(volume.MountPath) || mountPath == "/" {
+		return invalid(".mountPath", "mount path must be an absolute path other than /")
+	}
+	for _, reserved := range reservedMountPaths {
+		if mountPath == reserved || strings.HasPrefix(mountPath, reserved+"/") {
+			return invalid(".mountPath", "mount path %s is reserved", reserved)
+		}
+	}
+
+	if _, err := parsePositiveQuantity(volume.Size); err != nil {
+		return invalid(".size", "%v", err)
+	}
+
+	switch volume.Scope {
+	case "", types.PersistentVolumeScopeServer, types.PersistentVolumeScopeUser:
+	default:
+		return invalid(".scope", "invalid scope %q, must be %q or %q", volume.Scope, types.PersistentVolumeScopeServer, types.PersistentVolumeScopeUser)
+	}
+
+	switch volume.RetentionPolicy {
+	case "", types.PersistentVolumeRetentionDelete, types.PersistentVolumeRetentionRetain:
+	default:
+		return invalid(".retentionPolicy", "invalid retention policy %q, must be %q or %q", volume.RetentionPolicy, types.PersistentVolumeRetentionDelete, types.PersistentVolumeRetentionRetain)
+	}
+
+	return nil
+}
+
+// parsePositiveQuantity parses a resource quantity, returning nil if it is empty.
+func parsePositiveQuantity(value string) (*resource.Quantity, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	q, err := resource.ParseQuantity(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid quantity %q: %v", value, err)
+	}
+	if q.Sign() <= 0 {
+		return nil, fmt.Errorf("quantity %q must be greater than zero", value)
+	}
+
+	return &q, nil
+}
+
+// maxSLOWindowDays is the longest SLO window, which is how long probe results are kept.
+const maxSLOWindowDays = 30
+
+// ValidateHealthProbe validates the health probe in a manifest.
+func ValidateHealthProbe(runtime types.Runtime, probe *types.HealthProbe) error {
+	if probe == nil {
+		return nil
+	}
+
+	invalid := func(field, format string, args ...any) error {
+		return types.RuntimeValidationError{
+			Runtime: runtime,
+			Field:   "healthProbe" + field,
+			Message: fmt.Sprintf(format, args...),
+		}
+	}
+
+	if runtime == types.RuntimeComposite {
+		return invalid("", "health probes are not supported for composite servers")
+	}
+
+	if probe.IntervalSeconds < 0 {
+		return invalid(".intervalSeconds", "interval must not be negative")
+	}
+
+	if len(probe.ToolArguments) > 0 {
+		if probe.ToolName == "" {
+			return invalid(".toolArguments", "tool arguments require a tool name")
+		}
+
+		var args map[string]any
+		if err := json.Unmarshal(probe.ToolArguments, &args); err != nil {
+			return invalid(".toolArguments", "tool arguments must be a JSON object")
+		}
+	}
+
+	if probe.SLOTarget < 0 || probe.SLOTarget >= 100 {
+		return invalid(".sloTarget", "SLO target must be a percentage less than 100")
+	}
+
+	if probe.SLOWindowDays < 0 || probe.SLOWindowDays > maxSLOWindowDays {
+		return invalid(".sloWindowDays", "SLO window must be between 1 and %d days", maxSLOWindowDays)
+	}
+
+	return nil
+}
+
+// maxDrainTimeoutMinutes is the longest that the old version of a server can keep serving its sessions after a blue/green rollout.
+const maxDrainTimeoutMinutes = 24 * 60
+
+// ValidateRollout validates the rollout in a manifest.
+func ValidateRollout(runtime types.Runtime, rollout *types.Rollout, volume *types.PersistentVolume) error {
+	if rollout == nil {
+		return nil
+	}
+
+	invalid := func(field, format string, args ...any) error {
+		return types.RuntimeValidationError{
+			Runtime: runtime,
+			Field:   "rollout" + field,
+			Message: fmt.Sprintf(format, args...),
+		}
+	}
+
+	switch rollout.Strategy {
+	case "", types.RolloutStrategyRecreate:
+	case types.RolloutStrategyBlueGreen:
+		if volume != nil {
+			// The volume can only be mounted by one pod at a time, so the old and new versions can't run side by side.
+			return invalid(".strategy", "blue/green rollouts are not supported for servers with a persistent volume")
+		}
+	default:
+		return invalid(".strategy", "invalid strategy %q, must be %q or %q", rollout.Strategy, types.RolloutStrategyRecreate, types.RolloutStrategyBlueGreen)
+	}
+
+	if rollout.DrainTimeoutMinutes < 0 || rollout.DrainTimeoutMinutes > maxDrainTimeoutMinutes {
+		return invalid(".drainTimeoutMinutes", "drain timeout must be between 0 and %d minutes", maxDrainTimeoutMinutes)
+	}
+
+	return nil
+}
+
+// ValidateAuditLogRedaction validates the audit log redaction in a manifest.
+func ValidateAuditLogRedaction(runtime types.Runtime, redaction *types.AuditLogRedaction) error {
+	if redaction == nil {
+		return nil
+	}
+
+	invalid := func(field, format string, args ...any) error {
+		return types.RuntimeValidationError{
+			Runtime: runtime,
+			Field:   "auditLogRedaction" + field,
+			Message: fmt.Sprintf(format, args...),
+		}
+	}
+
+	for i, detector := range redaction.DisabledDetectors {
+		if !auditlogredact.IsDetector(detector) {
+			return invalid(fmt.Sprintf(".disabledDetectors[%d]", i), "unknown detector %q", detector)
+		}
+	}
+
+	names := make(map[string]struct{}, len(redaction.Rules))
+	for i, rule := range redaction.Rules {
+		field := fmt.Sprintf(".rules[%d]", i)
+		if rule.Name == "" {
+			return invalid(field+".name", "name is required")
+		}
+		if _, ok := names[rule.Name]; ok {
+			return invalid(field+".name", "duplicate rule name %q", rule.Name)
+		}
+		names[rule.Name] = struct{}{}
+
+		switch {
+		case (rule.Pattern == "") == (rule.JSONPath == ""):
+			return invalid(field, "exactly one of pattern and jsonPath must be set")
+		case rule.Pattern != "":
+			if _, err := regexp.Compile(rule.Pattern); err != nil {
+				return invalid(field+".pattern", "invalid regular expression: %v", err)
+			}
+		default:
+			if err := auditlogredact.ValidateJSONPath(rule.JSONPath); err != nil {
+				return invalid(field+".jsonPath", "%v", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"encoding/json"
 	"errors"
 	"strings"
 	"testing"
@@ -1157,3 +1158,278 @@ func TestValidateToolOverrides(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateK8sOverrides(t *testing.T) {
+	tests := []struct {
+		name        string
+		overrides   *types.K8sOverrides
+		expectError bool
+		errorField  string
+	}{
+		{
+			name:        "nil overrides",
+			overrides:   nil,
+			expectError: false,
+		},
+		{
+			name: "valid overrides",
+			overrides: &types.K8sOverrides{
+				Resources: &types.K8sResourceOverrides{
+					CPURequest:    "500m",
+					CPULimit:      "2",
+					MemoryRequest: "1Gi",
+					MemoryLimit:   "4Gi",
+				},
+				NodeSelector:       map[string]string{"kubernetes.io/arch": "amd64"},
+				PriorityClassName:  "high-priority",
+				ServiceAccountName: "browser-automation",
+				Volumes: []types.K8sVolume{
+					{Name: "cache", MountPath: "/cache", EmptyDir: &types.K8sEmptyDirVolume{SizeLimit: "1Gi"}},
+					{Name: "config", MountPath: "/etc/server", ReadOnly: true, ConfigMap: "server-config"},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid quantity",
+			overrides: &types.K8sOverrides{
+				Resources: &types.K8sResourceOverrides{MemoryLimit: "lots"},
+			},
+			expectError: true,
+			errorField:  "k8sOverrides.resources.memoryLimit",
+		},
+		{
+			name: "request greater than limit",
+			overrides: &types.K8sOverrides{
+				Resources: &types.K8sResourceOverrides{CPURequest: "2", CPULimit: "1"},
+			},
+			expectError: true,
+			errorField:  "k8sOverrides.resources.cpuRequest",
+		},
+		{
+			name: "invalid node selector key",
+			overrides: &types.K8sOverrides{
+				NodeSelector: map[string]string{"not a label": "true"},
+			},
+			expectError: true,
+			errorField:  "k8sOverrides.nodeSelector",
+		},
+		{
+			name: "invalid service account name",
+			overrides: &types.K8sOverrides{
+				ServiceAccountName: "Not_Valid",
+			},
+			expectError: true,
+			errorField:  "k8sOverrides.serviceAccountName",
+		},
+		{
+			name: "reserved volume name",
+			overrides: &types.K8sOverrides{
+				Volumes: []types.K8sVolume{{Name: "files", MountPath: "/data", EmptyDir: &types.K8sEmptyDirVolume{}}},
+			},
+			expectError: true,
+			errorField:  "k8sOverrides.volumes[0].name",
+		},
+		{
+			name: "reserved mount path",
+			overrides: &types.K8sOverrides{
+				Volumes: []types.K8sVolume{{Name: "data", MountPath: "/run/data", EmptyDir: &types.K8sEmptyDirVolume{}}},
+			},
+			expectError: true,
+			errorField:  "k8sOverrides.volumes[0].mountPath",
+		},
+		{
+			name: "duplicate mount path",
+			overrides: &types.K8sOverrides{
+				Volumes: []types.K8sVolume{
+					{Name: "one", MountPath: "/data", EmptyDir: &types.K8sEmptyDirVolume{}},
+					{Name: "two", MountPath: "/data/", Secret: "data"},
+				},
+			},
+			expectError: true,
+			errorField:  "k8sOverrides.volumes[1].mountPath",
+		},
+		{
+			name: "volume with more than one source",
+			overrides: &types.K8sOverrides{
+				Volumes: []types.K8sVolume{{Name: "data", MountPath: "/data", ConfigMap: "data", Secret: "data"}},
+			},
+			expectError: true,
+			errorField:  "k8sOverrides.volumes[0]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateK8sOverrides(types.RuntimeContainerized, tt.overrides)
+			if !tt.expectError {
+				require.NoError(t, err)
+				return
+			}
+
+			var validationErr types.RuntimeValidationError
+			require.True(t, errors.As(err, &validationErr))
+			require.Equal(t, tt.errorField, validationErr.Field)
+		})
+	}
+}
+
+func TestValidateEgressPolicy(t *testing.T) {
+	require.NoError(t, ValidateEgressPolicy(types.RuntimeNPX, nil))
+	require.NoError(t, ValidateEgressPolicy(types.RuntimeNPX, &types.EgressPolicy{
+		AllowedHosts: []string{"api.github.com", "Registry.npmjs.org"},
+		AllowedCIDRs: []string{"10.0.0.0/8", "2001:db8::/32"},
+	}))
+
+	var validationErr types.RuntimeValidationError
+	err := ValidateEgressPolicy(types.RuntimeNPX, &types.EgressPolicy{AllowedHosts: []string{"https://api.github.com"}})
+	require.True(t, errors.As(err, &validationErr))
+	require.Equal(t, "egressPolicy.allowedHosts[0]", validationErr.Field)
+
+	err = ValidateEgressPolicy(types.RuntimeNPX, &types.EgressPolicy{AllowedCIDRs: []string{"10.0.0.1"}})
+	require.True(t, errors.As(err, &validationErr))
+	require.Equal(t, "egressPolicy.allowedCIDRs[0]", validationErr.Field)
+}
+
+func TestValidateTrustLevel(t *testing.T) {
+	require.NoError(t, ValidateTrustLevel(types.RuntimeNPX, ""))
+	require.NoError(t, ValidateTrustLevel(types.RuntimeNPX, types.TrustLevelTrusted))
+	require.NoError(t, ValidateTrustLevel(types.RuntimeNPX, types.TrustLevelUntrusted))
+
+	var validationErr types.RuntimeValidationError
+	err := ValidateTrustLevel(types.RuntimeNPX, "vetted")
+	require.True(t, errors.As(err, &validationErr))
+	require.Equal(t, "trustLevel", validationErr.Field)
+}
+
+func TestValidatePersistentVolume(t *testing.T) {
+	require.NoError(t, ValidatePersistentVolume(types.RuntimeNPX, nil))
+	require.NoError(t, ValidatePersistentVolume(types.RuntimeNPX, &types.PersistentVolume{MountPath: "/data"}))
+	require.NoError(t, ValidatePersistentVolume(types.RuntimeContainerized, &types.PersistentVolume{
+		MountPath:       "/home/user/.cache",
+		Size:            "5Gi",
+		Scope:           types.PersistentVolumeScopeUser,
+		RetentionPolicy: types.PersistentVolumeRetentionDelete,
+	}))
+
+	tests := []struct {
+		name    string
+		runtime types.Runtime
+		volume  types.PersistentVolume
+		field   string
+	}{
+		{name: "remote runtime", runtime: types.RuntimeRemote, volume: types.PersistentVolume{MountPath: "/data"}, field: "persistentVolume"},
+		{name: "relative mount path", runtime: types.RuntimeNPX, volume: types.PersistentVolume{MountPath: "data"}, field: "persistentVolume.mountPath"},
+		{name: "root mount path", runtime: types.RuntimeNPX, volume: types.PersistentVolume{MountPath: "/"}, field: "persistentVolume.mountPath"},
+		{name: "reserved mount path", runtime: types.RuntimeNPX, volume: types.PersistentVolume{MountPath: "/tmp/data"}, field: "persistentVolume.mountPath"},
+		{name: "invalid size", runtime: types.RuntimeNPX, volume: types.PersistentVolume{MountPath: "/data", Size: "0"}, field: "persistentVolume.size"},
+		{name: "invalid scope", runtime: types.RuntimeNPX, volume: types.PersistentVolume{MountPath: "/data", Scope: "project"}, field: "persistentVolume.scope"},
+		{name: "invalid retention policy", runtime: types.RuntimeNPX, volume: types.PersistentVolume{MountPath: "/data", RetentionPolicy: "forever"}, field: "persistentVolume.retentionPolicy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var validationErr types.RuntimeValidationError
+			err := ValidatePersistentVolume(tt.runtime, &tt.volume)
+			require.True(t, errors.As(err, &validationErr))
+			require.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
+
+func TestValidateHealthProbe(t *testing.T) {
+	require.NoError(t, ValidateHealthProbe(types.RuntimeRemote, nil))
+	require.NoError(t, ValidateHealthProbe(types.RuntimeRemote, &types.HealthProbe{Disabled: true}))
+	require.NoError(t, ValidateHealthProbe(types.RuntimeNPX, &types.HealthProbe{
+		IntervalSeconds: 300,
+		ToolName:        "get_status",
+		ToolArguments:   json.RawMessage(`{"verbose": false}`),
+		SLOTarget:       99.9,
+		SLOWindowDays:   7,
+	}))
+
+	tests := []struct {
+		name    string
+		runtime types.Runtime
+		probe   types.HealthProbe
+		field   string
+	}{
+		{name: "composite runtime", runtime: types.RuntimeComposite, field: "healthProbe"},
+		{name: "negative interval", runtime: types.RuntimeRemote, probe: types.HealthProbe{IntervalSeconds: -1}, field: "healthProbe.intervalSeconds"},
+		{name: "arguments without tool", runtime: types.RuntimeRemote, probe: types.HealthProbe{ToolArguments: json.RawMessage(`{}`)}, field: "healthProbe.toolArguments"},
+		{name: "arguments not an object", runtime: types.RuntimeRemote, probe: types.HealthProbe{ToolName: "search", ToolArguments: json.RawMessage(`["a"]`)}, field: "healthProbe.toolArguments"},
+		{name: "SLO target of 100", runtime: types.RuntimeRemote, probe: types.HealthProbe{SLOTarget: 100}, field: "healthProbe.sloTarget"},
+		{name: "SLO window too long", runtime: types.RuntimeRemote, probe: types.HealthProbe{SLOWindowDays: 90}, field: "healthProbe.sloWindowDays"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var validationErr types.RuntimeValidationError
+			err := ValidateHealthProbe(tt.runtime, &tt.probe)
+			require.True(t, errors.As(err, &validationErr))
+			require.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
+
+func TestValidateRollout(t *testing.T) {
+	require.NoError(t, ValidateRollout(types.RuntimeNPX, nil, nil))
+	require.NoError(t, ValidateRollout(types.RuntimeNPX, &types.Rollout{Strategy: types.RolloutStrategyRecreate}, &types.PersistentVolume{MountPath: "/data"}))
+	require.NoError(t, ValidateRollout(types.RuntimeContainerized, &types.Rollout{Strategy: types.RolloutStrategyBlueGreen, DrainTimeoutMinutes: 60}, nil))
+
+	tests := []struct {
+		name    string
+		rollout types.Rollout
+		volume  *types.PersistentVolume
+		field   string
+	}{
+		{name: "unknown strategy", rollout: types.Rollout{Strategy: "canary"}, field: "rollout.strategy"},
+		{name: "blue/green with persistent volume", rollout: types.Rollout{Strategy: types.RolloutStrategyBlueGreen}, volume: &types.PersistentVolume{MountPath: "/data"}, field: "rollout.strategy"},
+		{name: "negative drain timeout", rollout: types.Rollout{DrainTimeoutMinutes: -1}, field: "rollout.drainTimeoutMinutes"},
+		{name: "drain timeout too long", rollout: types.Rollout{DrainTimeoutMinutes: 2 * maxDrainTimeoutMinutes}, field: "rollout.drainTimeoutMinutes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var validationErr types.RuntimeValidationError
+			err := ValidateRollout(types.RuntimeNPX, &tt.rollout, tt.volume)
+			require.True(t, errors.As(err, &validationErr))
+			require.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
+
+func TestValidateAuditLogRedaction(t *testing.T) {
+	require.NoError(t, ValidateAuditLogRedaction(types.RuntimeNPX, nil))
+	require.NoError(t, ValidateAuditLogRedaction(types.RuntimeRemote, &types.AuditLogRedaction{
+		MetadataOnly:      true,
+		DisabledDetectors: []types.AuditLogDetector{types.AuditLogDetectorEmail},
+		Rules: []types.AuditLogRedactionRule{
+			{Name: "account", Pattern: `ACCT-\d{8}`},
+			{Name: "ssn", JSONPath: "$.params.arguments.ssn"},
+		},
+	}))
+
+	tests := []struct {
+		name      string
+		redaction types.AuditLogRedaction
+		field     string
+	}{
+		{name: "unknown detector", redaction: types.AuditLogRedaction{DisabledDetectors: []types.AuditLogDetector{"phone"}}, field: "auditLogRedaction.disabledDetectors[0]"},
+		{name: "missing name", redaction: types.AuditLogRedaction{Rules: []types.AuditLogRedactionRule{{Pattern: "x"}}}, field: "auditLogRedaction.rules[0].name"},
+		{name: "duplicate name", redaction: types.AuditLogRedaction{Rules: []types.AuditLogRedactionRule{{Name: "a", Pattern: "x"}, {Name: "a", Pattern: "y"}}}, field: "auditLogRedaction.rules[1].name"},
+		{name: "pattern and JSON path", redaction: types.AuditLogRedaction{Rules: []types.AuditLogRedactionRule{{Name: "a", Pattern: "x", JSONPath: "$.x"}}}, field: "auditLogRedaction.rules[0]"},
+		{name: "neither pattern nor JSON path", redaction: types.AuditLogRedaction{Rules: []types.AuditLogRedactionRule{{Name: "a"}}}, field: "auditLogRedaction.rules[0]"},
+		{name: "invalid pattern", redaction: types.AuditLogRedaction{Rules: []types.AuditLogRedactionRule{{Name: "a", Pattern: "("}}}, field: "auditLogRedaction.rules[0].pattern"},
+		{name: "invalid JSON path", redaction: types.AuditLogRedaction{Rules: []types.AuditLogRedactionRule{{Name: "a", JSONPath: "params.ssn"}}}, field: "auditLogRedaction.rules[0].jsonPath"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var validationErr types.RuntimeValidationError
+			err := ValidateAuditLogRedaction(types.RuntimeNPX, &tt.redaction)
+			require.True(t, errors.As(err, &validationErr))
+			require.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}